@@ -9,6 +9,7 @@ replace github.com/Alexey-step/rocket-factory/platform => ../platform
 require (
 	github.com/IBM/sarama v1.45.2
 	github.com/caarlos0/env/v11 v11.3.1
+	github.com/google/uuid v1.6.0
 	github.com/joho/godotenv v1.5.1
 )
 
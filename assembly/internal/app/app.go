@@ -41,6 +41,13 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	// Консьюмер возвратов
+	go func() {
+		if err := a.runOrderRefundedConsumer(ctx); err != nil {
+			errCh <- errors.Errorf("order refunded consumer crashed: %v", err)
+		}
+	}()
+
 	// Ожидание либо ошибки, либо завершения контекста (например, сигнал SIGINT/SIGTERM)
 	select {
 	case <-ctx.Done():
@@ -101,3 +108,14 @@ func (a *App) runConsumer(ctx context.Context) error {
 
 	return nil
 }
+
+func (a *App) runOrderRefundedConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 OrderRefunded Kafka consumer running")
+
+	err := a.diContainer.OrderRefundedConsumerService().RunConsumer(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -10,7 +10,9 @@ import (
 	kafkaConverter "github.com/Alexey-step/rocket-factory/assembly/internal/converter/kafka"
 	"github.com/Alexey-step/rocket-factory/assembly/internal/converter/kafka/decoder"
 	"github.com/Alexey-step/rocket-factory/assembly/internal/service"
+	assemblyService "github.com/Alexey-step/rocket-factory/assembly/internal/service/assembly"
 	orderConsumer "github.com/Alexey-step/rocket-factory/assembly/internal/service/consumer/order_consumer"
+	orderRefundedConsumer "github.com/Alexey-step/rocket-factory/assembly/internal/service/consumer/order_refunded_consumer"
 	orderProducer "github.com/Alexey-step/rocket-factory/assembly/internal/service/producer/order_producer"
 	"github.com/Alexey-step/rocket-factory/platform/pkg/closer"
 	wrappedKafka "github.com/Alexey-step/rocket-factory/platform/pkg/kafka"
@@ -25,12 +27,18 @@ type diContainer struct {
 	orderProducerService service.OrderProducerService
 	orderConsumerService service.OrderConsumerService
 
-	consumerGroup sarama.ConsumerGroup
-	syncProducer  sarama.SyncProducer
+	orderRefundedConsumerService service.OrderRefundedConsumerService
 
-	orderAssembledProducer wrappedKafka.Producer
-	orderPaidConsumer      wrappedKafka.Consumer
-	orderPaidDecoder       kafkaConverter.OrderPaidDecoder
+	consumerGroup              sarama.ConsumerGroup
+	orderRefundedConsumerGroup sarama.ConsumerGroup
+	syncProducer               sarama.SyncProducer
+
+	orderAssembledProducer       wrappedKafka.Producer
+	orderAssemblyStartedProducer wrappedKafka.Producer
+	orderPaidConsumer            wrappedKafka.Consumer
+	orderPaidDecoder             kafkaConverter.OrderPaidDecoder
+	orderRefundedConsumer        wrappedKafka.Consumer
+	orderRefundedDecoder         kafkaConverter.OrderRefundedDecoder
 }
 
 func NewDiContainer() *diContainer {
@@ -39,8 +47,7 @@ func NewDiContainer() *diContainer {
 
 func (d *diContainer) AssemblyService() service.AssemblyService {
 	if d.assemblyService == nil {
-		d.assemblyService = service.NewService(
-			d.OrderProducerService())
+		d.assemblyService = assemblyService.NewService()
 	}
 	return d.assemblyService
 }
@@ -51,6 +58,7 @@ func (d *diContainer) OrderConsumerService() service.OrderConsumerService {
 			d.OrderPaidConsumer(),
 			d.OrderPaidDecoder(),
 			d.OrderProducerService(),
+			d.AssemblyService(),
 		)
 	}
 	return d.orderConsumerService
@@ -60,6 +68,7 @@ func (d *diContainer) OrderProducerService() service.OrderProducerService {
 	if d.orderProducerService == nil {
 		d.orderProducerService = orderProducer.NewService(
 			d.OrderAssembledProducer(),
+			d.OrderAssemblyStartedProducer(),
 		)
 	}
 	return d.orderProducerService
@@ -137,3 +146,71 @@ func (d *diContainer) OrderAssembledProducer() wrappedKafka.Producer {
 	}
 	return d.orderAssembledProducer
 }
+
+func (d *diContainer) OrderAssemblyStartedProducer() wrappedKafka.Producer {
+	if d.orderAssemblyStartedProducer == nil {
+		d.orderAssemblyStartedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().OrderAssemblyStartedProducer.TopicName(),
+			logger.Logger(),
+		)
+	}
+	return d.orderAssemblyStartedProducer
+}
+
+func (d *diContainer) OrderRefundedConsumerService() service.OrderRefundedConsumerService {
+	if d.orderRefundedConsumerService == nil {
+		d.orderRefundedConsumerService = orderRefundedConsumer.NewService(
+			d.OrderRefundedConsumer(),
+			d.OrderRefundedDecoder(),
+			d.AssemblyService(),
+		)
+	}
+	return d.orderRefundedConsumerService
+}
+
+// OrderRefundedConsumerGroup - отдельная consumer group: события возврата должны
+// обрабатываться, пока консьюмер OrderPaid занят сборкой. Группа своя у каждой реплики,
+// потому что сборка, которую нужно прервать, может идти на любой из них
+func (d *diContainer) OrderRefundedConsumerGroup() sarama.ConsumerGroup {
+	if d.orderRefundedConsumerGroup == nil {
+		group, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().OrderRefundedConsumer.GroupID(),
+			config.AppConfig().OrderRefundedConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create order refunded consumer group: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka order refunded consumer group", func(ctx context.Context) error {
+			return group.Close()
+		})
+
+		d.orderRefundedConsumerGroup = group
+	}
+
+	return d.orderRefundedConsumerGroup
+}
+
+func (d *diContainer) OrderRefundedConsumer() wrappedKafka.Consumer {
+	if d.orderRefundedConsumer == nil {
+		d.orderRefundedConsumer = wrappedKafkaConsumer.NewConsumer(
+			logger.Logger(),
+			d.OrderRefundedConsumerGroup(),
+			[]string{
+				config.AppConfig().OrderRefundedConsumer.Topic(),
+			},
+			kafkaMiddleware.Logging(logger.Logger()),
+		)
+	}
+
+	return d.orderRefundedConsumer
+}
+
+func (d *diContainer) OrderRefundedDecoder() kafkaConverter.OrderRefundedDecoder {
+	if d.orderRefundedDecoder == nil {
+		d.orderRefundedDecoder = decoder.NewOrderRefundedDecoder()
+	}
+
+	return d.orderRefundedDecoder
+}
@@ -11,10 +11,12 @@ import (
 var appConfig *config
 
 type config struct {
-	Logger                 LoggerConfig
-	Kafka                  KafkaConfig
-	OrderAssembledProducer OrderAssembledProducerConfig
-	OrderPaidConsumer      OrderPaidConsumerConfig
+	Logger                       LoggerConfig
+	Kafka                        KafkaConfig
+	OrderAssembledProducer       OrderAssembledProducerConfig
+	OrderAssemblyStartedProducer OrderAssemblyStartedProducerConfig
+	OrderPaidConsumer            OrderPaidConsumerConfig
+	OrderRefundedConsumer        OrderRefundedConsumerConfig
 }
 
 func Load(path ...string) error {
@@ -38,16 +40,28 @@ func Load(path ...string) error {
 		return err
 	}
 
+	orderAssemblyStartedProducerCfg, err := env.NewOrderAssemblyStartedProducerConfig()
+	if err != nil {
+		return err
+	}
+
 	orderPaidConsumerCfg, err := env.NewOrderPaidConsumerConfig()
 	if err != nil {
 		return err
 	}
 
+	orderRefundedConsumerCfg, err := env.NewOrderRefundedConsumerConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
-		Logger:                 loggerCfg,
-		Kafka:                  kafkaCfg,
-		OrderAssembledProducer: orderAssembledProducerCfg,
-		OrderPaidConsumer:      orderPaidConsumerCfg,
+		Logger:                       loggerCfg,
+		Kafka:                        kafkaCfg,
+		OrderAssembledProducer:       orderAssembledProducerCfg,
+		OrderAssemblyStartedProducer: orderAssemblyStartedProducerCfg,
+		OrderPaidConsumer:            orderPaidConsumerCfg,
+		OrderRefundedConsumer:        orderRefundedConsumerCfg,
 	}
 
 	return nil
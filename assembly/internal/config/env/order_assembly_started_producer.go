@@ -0,0 +1,26 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type orderAssemblyStartedProducerEnvConfig struct {
+	TopicName string `env:"ORDER_ASSEMBLY_STARTED_TOPIC_NAME,required"`
+}
+
+type orderAssemblyStartedProducerConfig struct {
+	raw orderAssemblyStartedProducerEnvConfig
+}
+
+func NewOrderAssemblyStartedProducerConfig() (*orderAssemblyStartedProducerConfig, error) {
+	var raw orderAssemblyStartedProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderAssemblyStartedProducerConfig{raw: raw}, nil
+}
+
+func (cfg *orderAssemblyStartedProducerConfig) TopicName() string {
+	return cfg.raw.TopicName
+}
@@ -0,0 +1,57 @@
+package env
+
+import (
+	"os"
+
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type orderRefundedConsumerEnvConfig struct {
+	Topic     string `env:"ORDER_REFUNDED_TOPIC_NAME,required"`
+	GroupID   string `env:"ORDER_REFUNDED_CONSUMER_GROUP_ID,required"`
+	ReplicaID string `env:"REPLICA_ID"`
+}
+
+type orderRefundedConsumerConfig struct {
+	raw orderRefundedConsumerEnvConfig
+}
+
+func NewOrderRefundedConsumerConfig() (*orderRefundedConsumerConfig, error) {
+	var raw orderRefundedConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if raw.ReplicaID == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+		raw.ReplicaID = hostname
+	}
+
+	return &orderRefundedConsumerConfig{raw: raw}, nil
+}
+
+func (cfg *orderRefundedConsumerConfig) Topic() string {
+	return cfg.raw.Topic
+}
+
+// GroupID - своя consumer group у каждой реплики: сборки хранятся в памяти реплики,
+// поэтому событие возврата должна получить каждая из них, а не одна на группу.
+// Реплика определяется по REPLICA_ID, по умолчанию - по имени хоста
+func (cfg *orderRefundedConsumerConfig) GroupID() string {
+	return cfg.raw.GroupID + "-" + cfg.raw.ReplicaID
+}
+
+// Config читает топик с конца: возвраты, пришедшие до запуска реплики, ей не нужны.
+// Сборок тех заказов у неё нет, а ShipAssembled по возвращённому заказу order не применит
+func (cfg *orderRefundedConsumerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRoundRobin()}
+	config.Consumer.Offsets.Initial = sarama.OffsetNewest
+
+	return config
+}
@@ -12,6 +12,10 @@ type OrderAssembledProducerConfig interface {
 	Config() *sarama.Config
 }
 
+type OrderAssemblyStartedProducerConfig interface {
+	TopicName() string
+}
+
 type OrderPaidConsumerConfig interface {
 	Topic() string
 	GroupID() string
@@ -21,3 +25,9 @@ type OrderPaidConsumerConfig interface {
 type KafkaConfig interface {
 	Brokers() []string
 }
+
+type OrderRefundedConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	Config() *sarama.Config
+}
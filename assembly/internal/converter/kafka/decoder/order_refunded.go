@@ -0,0 +1,31 @@
+package decoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Alexey-step/rocket-factory/assembly/internal/model"
+	eventsV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/events/v1"
+)
+
+type orderRefundedDecoder struct{}
+
+func NewOrderRefundedDecoder() *orderRefundedDecoder {
+	return &orderRefundedDecoder{}
+}
+
+func (d *orderRefundedDecoder) Decode(data []byte) (model.OrderRefunded, error) {
+	var pb eventsV1.OrderRefunded
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return model.OrderRefunded{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return model.OrderRefunded{
+		EventUUID:       pb.EventUuid,
+		OrderUUID:       pb.OrderUuid,
+		UserUUID:        pb.UserUuid,
+		TransactionUUID: pb.TransactionUuid,
+		RefundUUID:      pb.RefundUuid,
+	}, nil
+}
@@ -5,3 +5,7 @@ import "github.com/Alexey-step/rocket-factory/assembly/internal/model"
 type OrderPaidDecoder interface {
 	Decode(data []byte) (model.OrderPaid, error)
 }
+
+type OrderRefundedDecoder interface {
+	Decode(data []byte) (model.OrderRefunded, error)
+}
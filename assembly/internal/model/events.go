@@ -8,9 +8,23 @@ type OrderPaid struct {
 	TransactionUUID string //	Идентификатор транзакции, сгенерированный в результате оплаты
 }
 
+type ShipAssemblyStarted struct {
+	EventUUID string // Уникальный идентификатор события (для идемпотентности)
+	OrderUUID string // Идентификатор заказа, который начали собирать
+	UserUUID  string // Идентификатор пользователя
+}
+
 type ShipAssembled struct {
 	EventUUID    string // Уникальный идентификатор события (для идемпотентности)
 	OrderUUID    string // Идентификатор собранного заказа
 	UserUUID     string // Идентификатор пользователя
 	BuildTimeSec int64  // Время (в секундах), потраченное на сборку корабля
 }
+
+type OrderRefunded struct {
+	EventUUID       string // Уникальный идентификатор события (для идемпотентности)
+	OrderUUID       string // Идентификатор заказа, по которому вернули оплату
+	UserUUID        string // Идентификатор пользователя
+	TransactionUUID string // Идентификатор транзакции оплаты
+	RefundUUID      string // Идентификатор транзакции возврата
+}
@@ -0,0 +1,84 @@
+package assembly
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	def "github.com/Alexey-step/rocket-factory/assembly/internal/service"
+)
+
+var _ def.AssemblyService = (*service)(nil)
+
+// abortedTTL - сколько помнить отменённые заказы: OrderRefunded может прийти
+// раньше, чем консьюмер OrderPaid доберётся до того же заказа
+const abortedTTL = time.Hour
+
+// service отслеживает идущие сборки, чтобы их можно было прервать по возврату оплаты.
+// Состояние хранится в памяти реплики, поэтому каждая реплика читает события возврата
+// своей consumer group и получает все возвраты. Прерывание сборки лишь экономит время:
+// заказ в REFUNDING order не переводит в COMPLETED, даже если ShipAssembled всё же придёт
+type service struct {
+	mu      sync.Mutex
+	builds  map[string]context.CancelFunc
+	aborted map[string]time.Time
+}
+
+func NewService() *service {
+	return &service{
+		builds:  make(map[string]context.CancelFunc),
+		aborted: make(map[string]time.Time),
+	}
+}
+
+// StartBuild регистрирует сборку заказа. buildCtx отменяется при AbortBuild,
+// finish нужно вызвать по окончании сборки. ok == false, если заказ уже отменён.
+func (s *service) StartBuild(ctx context.Context, orderUUID string) (context.Context, func(), bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pruneAborted(time.Now())
+	if _, ok := s.aborted[orderUUID]; ok {
+		return nil, nil, false
+	}
+
+	buildCtx, cancel := context.WithCancel(ctx)
+	s.builds[orderUUID] = cancel
+
+	finish := func() {
+		s.mu.Lock()
+		delete(s.builds, orderUUID)
+		s.mu.Unlock()
+
+		cancel()
+	}
+
+	return buildCtx, finish, true
+}
+
+// AbortBuild прерывает сборку заказа и запрещает её запуск в течение abortedTTL.
+// Возвращает true, если сборка шла в момент вызова.
+func (s *service) AbortBuild(orderUUID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.aborted[orderUUID] = time.Now()
+
+	cancel, ok := s.builds[orderUUID]
+	if !ok {
+		return false
+	}
+
+	cancel()
+	delete(s.builds, orderUUID)
+
+	return true
+}
+
+func (s *service) pruneAborted(now time.Time) {
+	for orderUUID, abortedAt := range s.aborted {
+		if now.Sub(abortedAt) > abortedTTL {
+			delete(s.aborted, orderUUID)
+		}
+	}
+}
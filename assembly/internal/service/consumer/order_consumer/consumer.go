@@ -17,17 +17,20 @@ type service struct {
 	orderConsumer    kafka.Consumer
 	orderPaidDecoder kafkaConverter.OrderPaidDecoder
 	orderProducer    assemblyService.OrderProducerService
+	assemblyService  assemblyService.AssemblyService
 }
 
 func NewService(
 	orderConsumer kafka.Consumer,
 	orderPaidDecoder kafkaConverter.OrderPaidDecoder,
 	orderProducer assemblyService.OrderProducerService,
+	assemblyService assemblyService.AssemblyService,
 ) *service {
 	return &service{
 		orderConsumer:    orderConsumer,
 		orderPaidDecoder: orderPaidDecoder,
 		orderProducer:    orderProducer,
+		assemblyService:  assemblyService,
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/Alexey-step/rocket-factory/assembly/internal/model"
@@ -29,12 +30,44 @@ func (s *service) OrderHandler(ctx context.Context, msg kafka.Message) error {
 		zap.String("transaction_uuid", event.TransactionUUID),
 	)
 
+	// Сборку можно прервать возвратом оплаты (событие OrderRefunded)
+	buildCtx, finish, ok := s.assemblyService.StartBuild(ctx, event.OrderUUID)
+	if !ok {
+		logger.Info(ctx, "Order refunded before assembly started, skipping",
+			zap.String("order_uuid", event.OrderUUID),
+		)
+		return nil
+	}
+	defer finish()
+
+	assemblyStarted := model.ShipAssemblyStarted{
+		EventUUID: uuid.NewString(),
+		OrderUUID: event.OrderUUID,
+		UserUUID:  event.UserUUID,
+	}
+
+	err = s.orderProducer.ProduceShipAssemblyStarted(ctx, assemblyStarted)
+	if err != nil {
+		logger.Error(ctx, "Failed to produce ship assembly started event",
+			zap.Any("ship_assembly_started", assemblyStarted),
+			zap.Error(err),
+		)
+		return err
+	}
+
 	//nolint:gosec
 	delay := time.Duration(rand.Intn(10)+1) * time.Second
 	select {
 	case <-time.After(delay):
-	case <-ctx.Done():
-		return ctx.Err()
+	case <-buildCtx.Done():
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		logger.Info(ctx, "Ship assembly aborted: order refunded",
+			zap.String("order_uuid", event.OrderUUID),
+		)
+		return nil
 	}
 
 	shipAssembled := model.ShipAssembled{
@@ -0,0 +1,47 @@
+package order_refunded_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/Alexey-step/rocket-factory/assembly/internal/converter/kafka"
+	assemblyService "github.com/Alexey-step/rocket-factory/assembly/internal/service"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/kafka"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+var _ assemblyService.OrderRefundedConsumerService = (*service)(nil)
+
+type service struct {
+	orderRefundedConsumer kafka.Consumer
+	orderRefundedDecoder  kafkaConverter.OrderRefundedDecoder
+	assemblyService       assemblyService.AssemblyService
+}
+
+func NewService(
+	orderRefundedConsumer kafka.Consumer,
+	orderRefundedDecoder kafkaConverter.OrderRefundedDecoder,
+	assemblyService assemblyService.AssemblyService,
+) *service {
+	return &service{
+		orderRefundedConsumer: orderRefundedConsumer,
+		orderRefundedDecoder:  orderRefundedDecoder,
+		assemblyService:       assemblyService,
+	}
+}
+
+func (s *service) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting order refunded consumer service")
+
+	err := s.orderRefundedConsumer.Consume(ctx, s.OrderRefundedHandler)
+	if err != nil {
+		logger.Error(ctx, "Failed to start order refunded consumer service",
+			zap.Error(err),
+		)
+		return err
+	}
+
+	logger.Info(ctx, "Order refunded consumer service started successfully")
+	return nil
+}
@@ -4,7 +4,11 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // AssemblyService is an autogenerated mock type for the AssemblyService type
 type AssemblyService struct {
@@ -19,6 +23,120 @@ func (_m *AssemblyService) EXPECT() *AssemblyService_Expecter {
 	return &AssemblyService_Expecter{mock: &_m.Mock}
 }
 
+// AbortBuild provides a mock function with given fields: orderUUID
+func (_m *AssemblyService) AbortBuild(orderUUID string) bool {
+	ret := _m.Called(orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AbortBuild")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(orderUUID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// AssemblyService_AbortBuild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AbortBuild'
+type AssemblyService_AbortBuild_Call struct {
+	*mock.Call
+}
+
+// AbortBuild is a helper method to define mock.On call
+//   - orderUUID string
+func (_e *AssemblyService_Expecter) AbortBuild(orderUUID interface{}) *AssemblyService_AbortBuild_Call {
+	return &AssemblyService_AbortBuild_Call{Call: _e.mock.On("AbortBuild", orderUUID)}
+}
+
+func (_c *AssemblyService_AbortBuild_Call) Run(run func(orderUUID string)) *AssemblyService_AbortBuild_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *AssemblyService_AbortBuild_Call) Return(aborted bool) *AssemblyService_AbortBuild_Call {
+	_c.Call.Return(aborted)
+	return _c
+}
+
+func (_c *AssemblyService_AbortBuild_Call) RunAndReturn(run func(string) bool) *AssemblyService_AbortBuild_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartBuild provides a mock function with given fields: ctx, orderUUID
+func (_m *AssemblyService) StartBuild(ctx context.Context, orderUUID string) (context.Context, func(), bool) {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartBuild")
+	}
+
+	var r0 context.Context
+	var r1 func()
+	var r2 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string) (context.Context, func(), bool)); ok {
+		return rf(ctx, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) context.Context); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) func()); ok {
+		r1 = rf(ctx, orderUUID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) bool); ok {
+		r2 = rf(ctx, orderUUID)
+	} else {
+		r2 = ret.Get(2).(bool)
+	}
+
+	return r0, r1, r2
+}
+
+// AssemblyService_StartBuild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartBuild'
+type AssemblyService_StartBuild_Call struct {
+	*mock.Call
+}
+
+// StartBuild is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *AssemblyService_Expecter) StartBuild(ctx interface{}, orderUUID interface{}) *AssemblyService_StartBuild_Call {
+	return &AssemblyService_StartBuild_Call{Call: _e.mock.On("StartBuild", ctx, orderUUID)}
+}
+
+func (_c *AssemblyService_StartBuild_Call) Run(run func(ctx context.Context, orderUUID string)) *AssemblyService_StartBuild_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AssemblyService_StartBuild_Call) Return(buildCtx context.Context, finish func(), ok bool) *AssemblyService_StartBuild_Call {
+	_c.Call.Return(buildCtx, finish, ok)
+	return _c
+}
+
+func (_c *AssemblyService_StartBuild_Call) RunAndReturn(run func(context.Context, string) (context.Context, func(), bool)) *AssemblyService_StartBuild_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewAssemblyService creates a new instance of AssemblyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAssemblyService(t interface {
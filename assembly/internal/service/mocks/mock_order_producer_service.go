@@ -71,6 +71,53 @@ func (_c *OrderProducerService_ProduceShipAssembled_Call) RunAndReturn(run func(
 	return _c
 }
 
+// ProduceShipAssemblyStarted provides a mock function with given fields: ctx, event
+func (_m *OrderProducerService) ProduceShipAssemblyStarted(ctx context.Context, event model.ShipAssemblyStarted) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceShipAssemblyStarted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ShipAssemblyStarted) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderProducerService_ProduceShipAssemblyStarted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProduceShipAssemblyStarted'
+type OrderProducerService_ProduceShipAssemblyStarted_Call struct {
+	*mock.Call
+}
+
+// ProduceShipAssemblyStarted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.ShipAssemblyStarted
+func (_e *OrderProducerService_Expecter) ProduceShipAssemblyStarted(ctx interface{}, event interface{}) *OrderProducerService_ProduceShipAssemblyStarted_Call {
+	return &OrderProducerService_ProduceShipAssemblyStarted_Call{Call: _e.mock.On("ProduceShipAssemblyStarted", ctx, event)}
+}
+
+func (_c *OrderProducerService_ProduceShipAssemblyStarted_Call) Run(run func(ctx context.Context, event model.ShipAssemblyStarted)) *OrderProducerService_ProduceShipAssemblyStarted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.ShipAssemblyStarted))
+	})
+	return _c
+}
+
+func (_c *OrderProducerService_ProduceShipAssemblyStarted_Call) Return(_a0 error) *OrderProducerService_ProduceShipAssemblyStarted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderProducerService_ProduceShipAssemblyStarted_Call) RunAndReturn(run func(context.Context, model.ShipAssemblyStarted) error) *OrderProducerService_ProduceShipAssemblyStarted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewOrderProducerService creates a new instance of OrderProducerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOrderProducerService(t interface {
@@ -13,15 +13,42 @@ import (
 )
 
 type service struct {
-	orderProducer kafka.Producer
+	orderProducer                kafka.Producer
+	orderAssemblyStartedProducer kafka.Producer
 }
 
-func NewService(orderProducer kafka.Producer) *service {
+func NewService(orderProducer, orderAssemblyStartedProducer kafka.Producer) *service {
 	return &service{
-		orderProducer: orderProducer,
+		orderProducer:                orderProducer,
+		orderAssemblyStartedProducer: orderAssemblyStartedProducer,
 	}
 }
 
+func (s *service) ProduceShipAssemblyStarted(ctx context.Context, event model.ShipAssemblyStarted) error {
+	msg := &eventsV1.ShipAssemblyStarted{
+		EventUuid: event.EventUUID,
+		OrderUuid: event.OrderUUID,
+		UserUuid:  event.UserUUID,
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal ship assembly started", zap.Error(err))
+		return err
+	}
+
+	err = s.orderAssemblyStartedProducer.Send(ctx, event.OrderUUID, payload)
+	if err != nil {
+		logger.Error(ctx, "Failed to send ship assembly started",
+			zap.Any("event", event),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
 func (s *service) ProduceShipAssembled(ctx context.Context, event model.ShipAssembled) error {
 	msg := &eventsV1.ShipAssembled{
 		EventUuid:    event.EventUUID,
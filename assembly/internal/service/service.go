@@ -11,19 +11,15 @@ type OrderConsumerService interface {
 }
 
 type OrderProducerService interface {
+	ProduceShipAssemblyStarted(ctx context.Context, event model.ShipAssemblyStarted) error
 	ProduceShipAssembled(ctx context.Context, event model.ShipAssembled) error
 }
 
-type AssemblyService interface{}
-
-type service struct {
-	assemblyProducerService OrderProducerService
+type OrderRefundedConsumerService interface {
+	RunConsumer(ctx context.Context) error
 }
 
-func NewService(
-	assemblyProducerService OrderProducerService,
-) *service {
-	return &service{
-		assemblyProducerService: assemblyProducerService,
-	}
+type AssemblyService interface {
+	StartBuild(ctx context.Context, orderUUID string) (buildCtx context.Context, finish func(), ok bool)
+	AbortBuild(orderUUID string) (aborted bool)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/Alexey-step/rocket-factory/inventory/internal/converter"
 	"github.com/Alexey-step/rocket-factory/inventory/internal/model"
 	"github.com/Alexey-step/rocket-factory/inventory/internal/service/mocks"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
 	inventoryV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/inventory/v1"
 )
 
@@ -65,7 +66,7 @@ func getMockedModelPart(uuid string) model.Part {
 		UUID:          uuid,
 		Name:          "Falcon Engine",
 		Description:   "Primary propulsion unit",
-		Price:         5000.0,
+		Price:         money.New(500_000, money.RUB),
 		StockQuantity: 10,
 		Category:      model.CategoryEngine,
 		Dimensions: model.Dimensions{
@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexey-step/rocket-factory/inventory/internal/converter"
+	"github.com/Alexey-step/rocket-factory/inventory/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+	inventoryV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ReserveStock(ctx context.Context, req *inventoryV1.ReserveStockRequest) (*inventoryV1.ReserveStockResponse, error) {
+	err := a.service.ReserveStock(ctx, req.GetOrderUuid(), converter.StockItemsToModel(req.GetItems()))
+	if err != nil {
+		var stockErr *model.InsufficientStockError
+		if errors.As(err, &stockErr) {
+			st, detailsErr := status.New(codes.FailedPrecondition, stockErr.Error()).
+				WithDetails(converter.StockShortagesToProto(stockErr.Shortages))
+			if detailsErr != nil {
+				logger.Error(ctx, "failed to attach stock shortages to status", zap.Error(detailsErr))
+				return nil, status.Error(codes.FailedPrecondition, stockErr.Error())
+			}
+			return nil, st.Err()
+		}
+		return nil, stockStatusError(req.GetOrderUuid(), err)
+	}
+
+	return &inventoryV1.ReserveStockResponse{}, nil
+}
+
+func (a *api) ReleaseStock(ctx context.Context, req *inventoryV1.ReleaseStockRequest) (*inventoryV1.ReleaseStockResponse, error) {
+	err := a.service.ReleaseStock(ctx, req.GetOrderUuid())
+	if err != nil {
+		return nil, stockStatusError(req.GetOrderUuid(), err)
+	}
+
+	return &inventoryV1.ReleaseStockResponse{}, nil
+}
+
+func (a *api) CommitStock(ctx context.Context, req *inventoryV1.CommitStockRequest) (*inventoryV1.CommitStockResponse, error) {
+	err := a.service.CommitStock(ctx, req.GetOrderUuid())
+	if err != nil {
+		return nil, stockStatusError(req.GetOrderUuid(), err)
+	}
+
+	return &inventoryV1.CommitStockResponse{}, nil
+}
+
+func stockStatusError(orderUUID string, err error) error {
+	switch {
+	case errors.Is(err, model.ErrInvalidStockRequest):
+		return status.Errorf(codes.InvalidArgument, "invalid stock request for order %s", orderUUID)
+	case errors.Is(err, model.ErrReservationNotFound):
+		return status.Errorf(codes.NotFound, "stock reservation for order %s not found", orderUUID)
+	case errors.Is(err, model.ErrReservationCompleted):
+		return status.Errorf(codes.FailedPrecondition, "stock reservation for order %s already completed", orderUUID)
+	case errors.Is(err, model.ErrReservationConflict):
+		return status.Errorf(codes.AlreadyExists, "stock reservation for order %s already exists with other items", orderUUID)
+	default:
+		return status.Errorf(codes.Internal, "internal error while processing stock for order %s", orderUUID)
+	}
+}
@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexey-step/rocket-factory/inventory/internal/model"
+	"github.com/Alexey-step/rocket-factory/inventory/internal/service/mocks"
+	inventoryV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func TestReserveStockExistingReservation(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected codes.Code
+	}{
+		{name: "released or committed", err: model.ErrReservationCompleted, expected: codes.FailedPrecondition},
+		{name: "other items", err: model.ErrReservationConflict, expected: codes.AlreadyExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			orderUUID := gofakeit.UUID()
+
+			service := mocks.NewInventoryService(t)
+			inventoryApi := NewAPI(service)
+
+			service.On("ReserveStock", ctx, orderUUID, mock.Anything).Return(tt.err).Once()
+
+			_, err := inventoryApi.ReserveStock(ctx, &inventoryV1.ReserveStockRequest{
+				OrderUuid: orderUUID,
+				Items: []*inventoryV1.StockItem{
+					{PartUuid: gofakeit.UUID(), Quantity: 1},
+				},
+			})
+			assert.Equal(t, tt.expected, status.Code(err))
+		})
+	}
+}
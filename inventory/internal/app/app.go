@@ -96,7 +96,15 @@ func (a *App) initListener(_ context.Context) error {
 }
 
 func (a *App) initGRPCServer(ctx context.Context) error {
-	authInterceptor := interceptor.NewAuthInterceptor(a.diContainer.IamClient(ctx))
+	// Снять и списать резерв можно и без сессии: так делают фоновые процессы order
+	authInterceptor := interceptor.NewAuthInterceptor(
+		a.diContainer.IamClient(ctx),
+		interceptor.WithServiceToken(
+			config.AppConfig().InventoryGRPC.ServiceToken(),
+			inventoryV1.InventoryService_ReleaseStock_FullMethodName,
+			inventoryV1.InventoryService_CommitStock_FullMethodName,
+		),
+	)
 	a.grpcServer = grpc.NewServer(
 		grpc.Creds(insecure.NewCredentials()),
 		grpc.ChainUnaryInterceptor(
@@ -9,6 +9,8 @@ import (
 type inventoryGRPCEnvConfig struct {
 	Host string `env:"GRPC_HOST,required"`
 	Port string `env:"GRPC_PORT,required"`
+	// ServiceToken - токен, с которым сервисы снимают и списывают резерв без сессии пользователя
+	ServiceToken string `env:"GRPC_SERVICE_TOKEN"`
 }
 
 type inventoryGRPCConfig struct {
@@ -27,3 +29,7 @@ func NewInventoryGRPCConfig() (*inventoryGRPCConfig, error) {
 func (cfg *inventoryGRPCConfig) Address() string {
 	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
 }
+
+func (cfg *inventoryGRPCConfig) ServiceToken() string {
+	return cfg.raw.ServiceToken
+}
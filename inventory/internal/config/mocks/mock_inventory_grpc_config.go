@@ -64,6 +64,51 @@ func (_c *InventoryGRPCConfig_Address_Call) RunAndReturn(run func() string) *Inv
 	return _c
 }
 
+// ServiceToken provides a mock function with no fields
+func (_m *InventoryGRPCConfig) ServiceToken() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServiceToken")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// InventoryGRPCConfig_ServiceToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServiceToken'
+type InventoryGRPCConfig_ServiceToken_Call struct {
+	*mock.Call
+}
+
+// ServiceToken is a helper method to define mock.On call
+func (_e *InventoryGRPCConfig_Expecter) ServiceToken() *InventoryGRPCConfig_ServiceToken_Call {
+	return &InventoryGRPCConfig_ServiceToken_Call{Call: _e.mock.On("ServiceToken")}
+}
+
+func (_c *InventoryGRPCConfig_ServiceToken_Call) Run(run func()) *InventoryGRPCConfig_ServiceToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_ServiceToken_Call) Return(_a0 string) *InventoryGRPCConfig_ServiceToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_ServiceToken_Call) RunAndReturn(run func() string) *InventoryGRPCConfig_ServiceToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewInventoryGRPCConfig creates a new instance of InventoryGRPCConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewInventoryGRPCConfig(t interface {
@@ -4,6 +4,8 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Alexey-step/rocket-factory/inventory/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+	commonV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/common/v1"
 	inventoryV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/inventory/v1"
 )
 
@@ -18,7 +20,7 @@ func PartToProto(part model.Part) *inventoryV1.Part {
 		Name:          part.Name,
 		Description:   part.Description,
 		StockQuantity: part.StockQuantity,
-		Price:         part.Price,
+		Price:         MoneyToProto(part.Price),
 		Metadata:      metadataToProto(part.Metadata),
 		Category:      CategoryToProto(part.Category),
 		Manufacturer:  manufacturerToProto(part.Manufacturer),
@@ -27,6 +29,13 @@ func PartToProto(part model.Part) *inventoryV1.Part {
 	}
 }
 
+func MoneyToProto(m money.Money) *commonV1.Money {
+	return &commonV1.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
 func CategoryToProto(category model.Category) inventoryV1.Category {
 	switch category {
 	case model.CategoryEngine:
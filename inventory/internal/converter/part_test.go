@@ -9,6 +9,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Alexey-step/rocket-factory/inventory/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
 	inventory_v1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/inventory/v1"
 )
 
@@ -21,7 +22,8 @@ func TestPartToProto(t *testing.T) {
 	assert.Equal(t, part.Name, protoPart.Name)
 	assert.Equal(t, part.Description, protoPart.Description)
 	assert.Equal(t, part.StockQuantity, protoPart.StockQuantity)
-	assert.Equal(t, part.Price, protoPart.Price)
+	assert.Equal(t, part.Price.Amount, protoPart.Price.Amount)
+	assert.Equal(t, part.Price.Currency, protoPart.Price.Currency)
 	assert.Equal(t, CategoryToProto(part.Category), protoPart.Category)
 	assert.Equal(t, part.Manufacturer.Name, protoPart.Manufacturer.Name)
 	assert.Equal(t, part.Manufacturer.Country, protoPart.Manufacturer.Country)
@@ -69,7 +71,7 @@ func getMockPart() model.Part {
 		UUID:          gofakeit.UUID(),
 		Name:          gofakeit.Name(),
 		Description:   "Primary propulsion unit",
-		Price:         gofakeit.Float64Range(100, 10_000),
+		Price:         money.New(int64(gofakeit.Number(10_000, 1_000_000)), money.RUB),
 		StockQuantity: int64(gofakeit.Number(1, 100)),
 		Category:      "ENGINE",
 		Dimensions: model.Dimensions{
@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/Alexey-step/rocket-factory/inventory/internal/model"
+	inventoryV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// StockViolationType - тип нарушения в google.rpc.PreconditionFailure при нехватке деталей
+const StockViolationType = "STOCK"
+
+func StockItemsToModel(items []*inventoryV1.StockItem) []model.StockItem {
+	out := make([]model.StockItem, 0, len(items))
+	for _, item := range items {
+		out = append(out, model.StockItem{
+			PartUUID: item.GetPartUuid(),
+			Quantity: item.GetQuantity(),
+		})
+	}
+
+	return out
+}
+
+func StockShortagesToProto(shortages []model.StockShortage) *errdetails.PreconditionFailure {
+	violations := make([]*errdetails.PreconditionFailure_Violation, 0, len(shortages))
+	for _, shortage := range shortages {
+		violations = append(violations, &errdetails.PreconditionFailure_Violation{
+			Type:        StockViolationType,
+			Subject:     shortage.PartUUID,
+			Description: fmt.Sprintf("requested %d, available %d", shortage.Requested, shortage.Available),
+		})
+	}
+
+	return &errdetails.PreconditionFailure{Violations: violations}
+}
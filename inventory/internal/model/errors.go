@@ -6,3 +6,12 @@ var (
 	ErrPartNotFound  = errors.New("part not found")
 	ErrPartsNotFound = errors.New("parts not found")
 )
+
+// Stock errors
+var (
+	ErrInsufficientStock    = errors.New("insufficient stock")
+	ErrInvalidStockRequest  = errors.New("invalid stock request")
+	ErrReservationNotFound  = errors.New("stock reservation not found")
+	ErrReservationCompleted = errors.New("stock reservation already committed or released")
+	ErrReservationConflict  = errors.New("stock reservation already exists with other items")
+)
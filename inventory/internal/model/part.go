@@ -2,13 +2,15 @@ package model
 
 import (
 	"time"
+
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
 )
 
 type Part struct {
 	UUID          string
 	Name          string
 	Description   string
-	Price         float64
+	Price         money.Money // Цена за единицу
 	StockQuantity int64
 	Category      Category
 	Dimensions    Dimensions
@@ -3,6 +3,7 @@ package converter
 import (
 	"github.com/Alexey-step/rocket-factory/inventory/internal/model"
 	repoModel "github.com/Alexey-step/rocket-factory/inventory/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
 )
 
 func PartToModel(part repoModel.Part) model.Part {
@@ -10,7 +11,7 @@ func PartToModel(part repoModel.Part) model.Part {
 		UUID:          part.UUID,
 		Name:          part.Name,
 		StockQuantity: part.StockQuantity,
-		Price:         part.Price,
+		Price:         money.New(part.Price.Amount, part.Price.Currency),
 		CreatedAt:     part.CreatedAt,
 		UpdatedAt:     part.UpdatedAt,
 		Category:      model.Category(part.Category),
@@ -59,3 +60,15 @@ func PartsToModel(parts []repoModel.Part) []model.Part {
 	}
 	return result
 }
+
+func StockItemsToRepoModel(items []model.StockItem) []repoModel.ReservationItem {
+	out := make([]repoModel.ReservationItem, 0, len(items))
+	for _, item := range items {
+		out = append(out, repoModel.ReservationItem{
+			PartUUID: item.PartUUID,
+			Quantity: item.Quantity,
+		})
+	}
+
+	return out
+}
@@ -7,19 +7,26 @@ import (
 )
 
 type Part struct {
-	ID            primitive.ObjectID  `bson:"_id,omitempty"`
-	UUID          string              `bson:"uuid"`
-	Name          string              `bson:"name"`
-	Description   string              `bson:"description"`
-	Price         float64             `bson:"price"`
-	StockQuantity int64               `bson:"stock_quantity"`
-	Category      Category            `bson:"category"`
-	Dimensions    Dimensions          `bson:"dimensions"`
-	Manufacturer  Manufacturer        `bson:"manufacturer"`
-	Tags          []string            `bson:"tags"`
-	Metadata      map[string]Metadata `bson:"metadata"`
-	CreatedAt     time.Time           `bson:"created_at"`
-	UpdatedAt     *time.Time          `bson:"updated_at,omitempty"`
+	ID               primitive.ObjectID  `bson:"_id,omitempty"`
+	UUID             string              `bson:"uuid"`
+	Name             string              `bson:"name"`
+	Description      string              `bson:"description"`
+	Price            Money               `bson:"price"`
+	StockQuantity    int64               `bson:"stock_quantity"`
+	ReservedQuantity int64               `bson:"reserved_quantity"`
+	Category         Category            `bson:"category"`
+	Dimensions       Dimensions          `bson:"dimensions"`
+	Manufacturer     Manufacturer        `bson:"manufacturer"`
+	Tags             []string            `bson:"tags"`
+	Metadata         map[string]Metadata `bson:"metadata"`
+	CreatedAt        time.Time           `bson:"created_at"`
+	UpdatedAt        *time.Time          `bson:"updated_at,omitempty"`
+}
+
+// Money - сумма в минимальных единицах валюты
+type Money struct {
+	Amount   int64  `bson:"amount"`
+	Currency string `bson:"currency"`
 }
 
 type Category string
@@ -60,3 +67,26 @@ type PartsFilter struct {
 	ManufacturerCountries []string
 	Tags                  []string
 }
+
+// Reservation - резерв деталей под заказ
+type Reservation struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	OrderUUID string             `bson:"order_uuid"`
+	Items     []ReservationItem  `bson:"items"`
+	Status    ReservationStatus  `bson:"status"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt *time.Time         `bson:"updated_at,omitempty"`
+}
+
+type ReservationItem struct {
+	PartUUID string `bson:"part_uuid"`
+	Quantity int64  `bson:"quantity"`
+}
+
+type ReservationStatus string
+
+const (
+	ReservationStatusReserved  ReservationStatus = "RESERVED"
+	ReservationStatusReleased  ReservationStatus = "RELEASED"
+	ReservationStatusCommitted ReservationStatus = "COMMITTED"
+)
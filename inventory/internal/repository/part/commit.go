@@ -0,0 +1,34 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	repoModel "github.com/Alexey-step/rocket-factory/inventory/internal/repository/model"
+)
+
+func (r *repository) CommitStock(ctx context.Context, orderUUID string) error {
+	reservation, err := r.completeReservation(ctx, orderUUID, repoModel.ReservationStatusCommitted)
+	if err != nil || reservation == nil {
+		return err
+	}
+
+	// Свободный остаток уменьшен ещё при резервировании, здесь снимаем только резерв
+	now := time.Now()
+	for _, item := range reservation.Items {
+		_, err = r.collection.UpdateOne(ctx,
+			bson.M{"uuid": item.PartUUID},
+			bson.M{
+				"$inc": bson.M{"reserved_quantity": -item.Quantity},
+				"$set": bson.M{"updated_at": now},
+			},
+		)
+		if err != nil {
+			logReservationError(ctx, "failed to commit reserved part", orderUUID, err)
+		}
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	repoModel "github.com/Alexey-step/rocket-factory/inventory/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
 )
 
 func (r *repository) InitParts(ctx context.Context) {
@@ -62,7 +63,7 @@ func generateParts() []repoModel.Part {
 			UUID:          uuid.NewString(),
 			Name:          names[idx],
 			Description:   descriptions[idx],
-			Price:         roundTo(gofakeit.Float64Range(100, 10_000)),
+			Price:         repoModel.Money{Amount: int64(gofakeit.Number(10_000, 1_000_000)), Currency: money.RUB},
 			StockQuantity: int64(gofakeit.Number(1, 100)),
 			Category:      repoModel.Category(gofakeit.RandomString([]string{"UNKNOWN", "ENGINE", "FUEL", "PORTHOLE", "WING"})),
 			Dimensions:    generateDimensions(),
@@ -0,0 +1,40 @@
+package part
+
+import (
+	"context"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+// migratePrices переводит цены, сохранённые числом в рублях, в документ
+// {amount: <копейки>, currency: "RUB"}. Уже переведённые документы не затрагиваются,
+// поэтому миграцию безопасно запускать при каждом старте.
+func (r *repository) migratePrices(ctx context.Context) error {
+	filter := bson.M{"price": bson.M{"$type": "number"}}
+
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.D{
+			{Key: "price", Value: bson.D{
+				{Key: "amount", Value: bson.D{{Key: "$toLong", Value: bson.D{
+					{Key: "$round", Value: bson.A{bson.D{{Key: "$multiply", Value: bson.A{"$price", 100}}}, 0}},
+				}}}},
+				{Key: "currency", Value: money.RUB},
+			}},
+		}}},
+	}
+
+	res, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if res.ModifiedCount > 0 {
+		log.Printf("✅ Migrated prices of %d parts to minor units\n", res.ModifiedCount)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Alexey-step/rocket-factory/inventory/internal/model"
+	repoModel "github.com/Alexey-step/rocket-factory/inventory/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+func (r *repository) ReleaseStock(ctx context.Context, orderUUID string) error {
+	reservation, err := r.completeReservation(ctx, orderUUID, repoModel.ReservationStatusReleased)
+	if err != nil || reservation == nil {
+		return err
+	}
+
+	r.returnToStock(ctx, reservation.Items)
+
+	return nil
+}
+
+// completeReservation атомарно переводит резерв из RESERVED в конечный статус.
+// Возвращает nil без ошибки, если резерв уже находится в этом статусе.
+func (r *repository) completeReservation(ctx context.Context, orderUUID string, status repoModel.ReservationStatus) (*repoModel.Reservation, error) {
+	var reservation repoModel.Reservation
+	err := r.reservations.FindOneAndUpdate(ctx,
+		bson.M{"order_uuid": orderUUID, "status": repoModel.ReservationStatusReserved},
+		bson.M{"$set": bson.M{"status": status, "updated_at": time.Now()}},
+	).Decode(&reservation)
+	if err == nil {
+		return &reservation, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+
+	err = r.reservations.FindOne(ctx, bson.M{"order_uuid": orderUUID}).Decode(&reservation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrReservationNotFound
+		}
+		return nil, err
+	}
+
+	if reservation.Status == status {
+		return nil, nil
+	}
+
+	return nil, model.ErrReservationCompleted
+}
+
+// returnToStock возвращает позиции резерва в свободный остаток
+func (r *repository) returnToStock(ctx context.Context, items []repoModel.ReservationItem) {
+	now := time.Now()
+	for _, item := range items {
+		_, err := r.collection.UpdateOne(ctx,
+			bson.M{"uuid": item.PartUUID},
+			bson.M{
+				"$inc": bson.M{"stock_quantity": item.Quantity, "reserved_quantity": -item.Quantity},
+				"$set": bson.M{"updated_at": now},
+			},
+		)
+		if err != nil {
+			logger.Error(ctx, "failed to return part to stock",
+				zap.String("part_uuid", item.PartUUID),
+				zap.Int64("quantity", item.Quantity),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func logReservationError(ctx context.Context, msg, orderUUID string, err error) {
+	logger.Error(ctx, msg,
+		zap.String("order_uuid", orderUUID),
+		zap.Error(err),
+	)
+}
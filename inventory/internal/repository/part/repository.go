@@ -15,7 +15,8 @@ import (
 var _ def.InventoryRepository = (*repository)(nil)
 
 type repository struct {
-	collection *mongo.Collection
+	collection   *mongo.Collection
+	reservations *mongo.Collection
 }
 
 func NewRepository(db *mongo.Database) *repository {
@@ -36,8 +37,25 @@ func NewRepository(db *mongo.Database) *repository {
 		panic("Failed to create indexes: " + err.Error())
 	}
 
+	reservations := db.Collection("reservations")
+
+	// Один резерв на заказ - на уникальном индексе держится идемпотентность ReserveStock
+	_, err = reservations.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "order_uuid", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic("Failed to create reservation indexes: " + err.Error())
+	}
+
 	s := &repository{
-		collection: collection,
+		collection:   collection,
+		reservations: reservations,
+	}
+
+	err = s.migratePrices(ctx)
+	if err != nil {
+		panic("Failed to migrate part prices: " + err.Error())
 	}
 
 	// Проверяем, нужно ли отключить инициализацию тестовых данных
@@ -0,0 +1,191 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Alexey-step/rocket-factory/inventory/internal/model"
+	"github.com/Alexey-step/rocket-factory/inventory/internal/repository/converter"
+	repoModel "github.com/Alexey-step/rocket-factory/inventory/internal/repository/model"
+)
+
+// ReserveStock резервирует детали под заказ по принципу "всё или ничего".
+// MongoDB развёрнута без реплики, поэтому вместо транзакции каждая деталь списывается
+// условным $inc, а при нехватке уже списанные позиции возвращаются обратно.
+// Позиция попадает в резерв только после того, как её остаток списан: если процесс
+// упадёт посередине, ReleaseStock не вернёт на склад то, что не списывалось.
+//
+// Ограничение: это не атомарно. Если процесс упадёт между списанием остатка детали
+// и записью её в резерв, эти детали пропадут из свободного остатка, и автоматически
+// их не вернуть - остаток придётся поправить вручную. Окно - один запрос к MongoDB
+// на позицию; полностью его закроют только транзакции, то есть replica set.
+//
+// Повторный вызов с тем же ключом ничего не делает, если резерв активен и состоит
+// из тех же позиций. Снятый или списанный резерв - model.ErrReservationCompleted,
+// резерв с другими позициями - model.ErrReservationConflict.
+func (r *repository) ReserveStock(ctx context.Context, orderUUID string, items []model.StockItem) error {
+	now := time.Now()
+	reservation := repoModel.Reservation{
+		OrderUUID: orderUUID,
+		Items:     []repoModel.ReservationItem{},
+		Status:    repoModel.ReservationStatusReserved,
+		CreatedAt: now,
+	}
+
+	_, err := r.reservations.InsertOne(ctx, reservation)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return r.checkExistingReservation(ctx, orderUUID, items)
+		}
+		return err
+	}
+
+	var (
+		reserved  []repoModel.ReservationItem
+		shortages []model.StockShortage
+	)
+	for _, item := range converter.StockItemsToRepoModel(items) {
+		res, err := r.collection.UpdateOne(ctx,
+			bson.M{"uuid": item.PartUUID, "stock_quantity": bson.M{"$gte": item.Quantity}},
+			bson.M{
+				"$inc": bson.M{"stock_quantity": -item.Quantity, "reserved_quantity": item.Quantity},
+				"$set": bson.M{"updated_at": now},
+			},
+		)
+		if err != nil {
+			_ = r.rollbackReservation(ctx, orderUUID, reserved)
+			return err
+		}
+
+		if res.MatchedCount == 0 {
+			shortages = append(shortages, model.StockShortage{
+				PartUUID:  item.PartUUID,
+				Requested: item.Quantity,
+			})
+			continue
+		}
+
+		// Остаток уже списан: если позиция не запишется в резерв, её надо вернуть сразу,
+		// иначе она потеряется для ReleaseStock
+		_, err = r.reservations.UpdateOne(ctx,
+			bson.M{"order_uuid": orderUUID},
+			bson.M{"$push": bson.M{"items": item}},
+		)
+		if err != nil {
+			r.returnToStock(context.WithoutCancel(ctx), []repoModel.ReservationItem{item})
+			_ = r.rollbackReservation(ctx, orderUUID, reserved)
+			return err
+		}
+		reserved = append(reserved, item)
+	}
+
+	if len(shortages) == 0 {
+		return nil
+	}
+
+	// Если откатить не удалось, отвечаем не нехваткой, а ошибкой: order тогда
+	// сам вызовет ReleaseStock и вернёт оставшиеся в резерве позиции
+	if err := r.rollbackReservation(ctx, orderUUID, reserved); err != nil {
+		return err
+	}
+
+	available, err := r.availableStock(ctx, shortages)
+	if err != nil {
+		return err
+	}
+	for i := range shortages {
+		shortages[i].Available = available[shortages[i].PartUUID]
+	}
+
+	return &model.InsufficientStockError{Shortages: shortages}
+}
+
+// checkExistingReservation проверяет, что уже созданный резерв - тот же самый запрос.
+// Только активный резерв с теми же позициями считается повтором
+func (r *repository) checkExistingReservation(ctx context.Context, orderUUID string, items []model.StockItem) error {
+	var reservation repoModel.Reservation
+	err := r.reservations.FindOne(ctx, bson.M{"order_uuid": orderUUID}).Decode(&reservation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// Резерв успели откатить между вставкой и чтением
+			return model.ErrReservationConflict
+		}
+		return err
+	}
+
+	if reservation.Status != repoModel.ReservationStatusReserved {
+		return model.ErrReservationCompleted
+	}
+
+	requested := make(map[string]int64, len(items))
+	for _, item := range converter.StockItemsToRepoModel(items) {
+		requested[item.PartUUID] += item.Quantity
+	}
+	reserved := make(map[string]int64, len(reservation.Items))
+	for _, item := range reservation.Items {
+		reserved[item.PartUUID] += item.Quantity
+	}
+	if !maps.Equal(requested, reserved) {
+		return model.ErrReservationConflict
+	}
+
+	return nil
+}
+
+// rollbackReservation возвращает на склад уже списанные позиции и удаляет резерв.
+// Позиция сначала убирается из резерва и только потом возвращается на склад, чтобы
+// после падения посередине резерв не содержал уже возвращённых деталей. Ошибка
+// означает, что резерв остался и его снимет ReleaseStock
+func (r *repository) rollbackReservation(ctx context.Context, orderUUID string, items []repoModel.ReservationItem) error {
+	// Откат должен завершиться, даже если клиент уже отменил запрос
+	ctx = context.WithoutCancel(ctx)
+
+	for _, item := range items {
+		_, err := r.reservations.UpdateOne(ctx,
+			bson.M{"order_uuid": orderUUID},
+			bson.M{"$pull": bson.M{"items": bson.M{"part_uuid": item.PartUUID}}},
+		)
+		if err != nil {
+			logReservationError(ctx, "failed to remove item from reservation", orderUUID, err)
+			return err
+		}
+		r.returnToStock(ctx, []repoModel.ReservationItem{item})
+	}
+
+	_, err := r.reservations.DeleteOne(ctx, bson.M{"order_uuid": orderUUID})
+	if err != nil {
+		logReservationError(ctx, "failed to delete reservation", orderUUID, err)
+	}
+
+	return nil
+}
+
+// availableStock возвращает текущие остатки деталей; отсутствующие детали считаются нулевыми
+func (r *repository) availableStock(ctx context.Context, shortages []model.StockShortage) (map[string]int64, error) {
+	uuids := make([]string, 0, len(shortages))
+	for _, shortage := range shortages {
+		uuids = append(uuids, shortage.PartUUID)
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"uuid": bson.M{"$in": uuids}})
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []repoModel.Part
+	if err = cursor.All(ctx, &parts); err != nil {
+		return nil, err
+	}
+
+	available := make(map[string]int64, len(parts))
+	for _, part := range parts {
+		available[part.UUID] = part.StockQuantity
+	}
+
+	return available, nil
+}
@@ -10,4 +10,7 @@ type InventoryRepository interface {
 	ListParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error)
 	GetPart(ctx context.Context, orderUUID string) (model.Part, error)
 	InitParts(ctx context.Context)
+	ReserveStock(ctx context.Context, orderUUID string, items []model.StockItem) error
+	ReleaseStock(ctx context.Context, orderUUID string) error
+	CommitStock(ctx context.Context, orderUUID string) error
 }
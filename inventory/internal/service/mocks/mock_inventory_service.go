@@ -24,6 +24,53 @@ func (_m *InventoryService) EXPECT() *InventoryService_Expecter {
 	return &InventoryService_Expecter{mock: &_m.Mock}
 }
 
+// CommitStock provides a mock function with given fields: ctx, orderUUID
+func (_m *InventoryService) CommitStock(ctx context.Context, orderUUID string) error {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryService_CommitStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitStock'
+type InventoryService_CommitStock_Call struct {
+	*mock.Call
+}
+
+// CommitStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *InventoryService_Expecter) CommitStock(ctx interface{}, orderUUID interface{}) *InventoryService_CommitStock_Call {
+	return &InventoryService_CommitStock_Call{Call: _e.mock.On("CommitStock", ctx, orderUUID)}
+}
+
+func (_c *InventoryService_CommitStock_Call) Run(run func(ctx context.Context, orderUUID string)) *InventoryService_CommitStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryService_CommitStock_Call) Return(_a0 error) *InventoryService_CommitStock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryService_CommitStock_Call) RunAndReturn(run func(context.Context, string) error) *InventoryService_CommitStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPart provides a mock function with given fields: ctx, orderUUID
 func (_m *InventoryService) GetPart(ctx context.Context, orderUUID string) (model.Part, error) {
 	ret := _m.Called(ctx, orderUUID)
@@ -140,6 +187,101 @@ func (_c *InventoryService_ListParts_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// ReleaseStock provides a mock function with given fields: ctx, orderUUID
+func (_m *InventoryService) ReleaseStock(ctx context.Context, orderUUID string) error {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryService_ReleaseStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseStock'
+type InventoryService_ReleaseStock_Call struct {
+	*mock.Call
+}
+
+// ReleaseStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *InventoryService_Expecter) ReleaseStock(ctx interface{}, orderUUID interface{}) *InventoryService_ReleaseStock_Call {
+	return &InventoryService_ReleaseStock_Call{Call: _e.mock.On("ReleaseStock", ctx, orderUUID)}
+}
+
+func (_c *InventoryService_ReleaseStock_Call) Run(run func(ctx context.Context, orderUUID string)) *InventoryService_ReleaseStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ReleaseStock_Call) Return(_a0 error) *InventoryService_ReleaseStock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryService_ReleaseStock_Call) RunAndReturn(run func(context.Context, string) error) *InventoryService_ReleaseStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveStock provides a mock function with given fields: ctx, orderUUID, items
+func (_m *InventoryService) ReserveStock(ctx context.Context, orderUUID string, items []model.StockItem) error {
+	ret := _m.Called(ctx, orderUUID, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.StockItem) error); ok {
+		r0 = rf(ctx, orderUUID, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryService_ReserveStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveStock'
+type InventoryService_ReserveStock_Call struct {
+	*mock.Call
+}
+
+// ReserveStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - items []model.StockItem
+func (_e *InventoryService_Expecter) ReserveStock(ctx interface{}, orderUUID interface{}, items interface{}) *InventoryService_ReserveStock_Call {
+	return &InventoryService_ReserveStock_Call{Call: _e.mock.On("ReserveStock", ctx, orderUUID, items)}
+}
+
+func (_c *InventoryService_ReserveStock_Call) Run(run func(ctx context.Context, orderUUID string, items []model.StockItem)) *InventoryService_ReserveStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]model.StockItem))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ReserveStock_Call) Return(_a0 error) *InventoryService_ReserveStock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryService_ReserveStock_Call) RunAndReturn(run func(context.Context, string, []model.StockItem) error) *InventoryService_ReserveStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewInventoryService creates a new instance of InventoryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewInventoryService(t interface {
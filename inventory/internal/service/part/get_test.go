@@ -12,6 +12,7 @@ import (
 	"github.com/Alexey-step/rocket-factory/inventory/internal/model"
 	"github.com/Alexey-step/rocket-factory/inventory/internal/repository/mocks"
 	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
 )
 
 func TestGetPartRepoSuccess(t *testing.T) {
@@ -52,7 +53,7 @@ func getRepoMockPart(uuid string) model.Part {
 	var (
 		name          = gofakeit.Name()
 		description   = gofakeit.Paragraph(3, 5, 5, " ")
-		price         = gofakeit.Price(100, 1000)
+		price         = money.New(int64(gofakeit.Number(10_000, 100_000)), money.RUB)
 		stockQuantity = gofakeit.Int64()
 		category      = gofakeit.RandomString([]string{"UNKNOWN", "ENGINE", "FUEL", "PORTHOLE", "WING"})
 		dimensions    = model.Dimensions{
@@ -11,6 +11,7 @@ import (
 
 	"github.com/Alexey-step/rocket-factory/inventory/internal/model"
 	"github.com/Alexey-step/rocket-factory/inventory/internal/repository/mocks"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
 )
 
 func TestListPartsRepoSuccess(t *testing.T) {
@@ -80,7 +81,7 @@ func getMockRepoPart() model.Part {
 		uuid          = gofakeit.UUID()
 		name          = gofakeit.Name()
 		description   = gofakeit.Paragraph(3, 5, 5, " ")
-		price         = gofakeit.Price(100, 1000)
+		price         = money.New(int64(gofakeit.Number(10_000, 100_000)), money.RUB)
 		stockQuantity = gofakeit.Int64()
 		category      = gofakeit.RandomString([]string{"UNKNOWN", "ENGINE", "FUEL", "PORTHOLE", "WING"})
 		dimensions    = model.Dimensions{
@@ -0,0 +1,81 @@
+package part
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexey-step/rocket-factory/inventory/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+func (r *service) ReserveStock(ctx context.Context, orderUUID string, items []model.StockItem) error {
+	items, err := mergeStockItems(items)
+	if err != nil {
+		return err
+	}
+
+	err = r.inventoryRepository.ReserveStock(ctx, orderUUID, items)
+	if err != nil {
+		if !errors.Is(err, model.ErrInsufficientStock) {
+			logger.Error(ctx, "failed to reserve stock",
+				zap.String("order_uuid", orderUUID),
+				zap.Error(err),
+			)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *service) ReleaseStock(ctx context.Context, orderUUID string) error {
+	err := r.inventoryRepository.ReleaseStock(ctx, orderUUID)
+	if err != nil {
+		logger.Error(ctx, "failed to release stock",
+			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+func (r *service) CommitStock(ctx context.Context, orderUUID string) error {
+	err := r.inventoryRepository.CommitStock(ctx, orderUUID)
+	if err != nil {
+		logger.Error(ctx, "failed to commit stock",
+			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// mergeStockItems объединяет повторяющиеся детали, чтобы каждая списывалась одним $inc
+func mergeStockItems(items []model.StockItem) ([]model.StockItem, error) {
+	if len(items) == 0 {
+		return nil, model.ErrInvalidStockRequest
+	}
+
+	merged := make([]model.StockItem, 0, len(items))
+	index := make(map[string]int, len(items))
+	for _, item := range items {
+		if item.PartUUID == "" || item.Quantity <= 0 {
+			return nil, model.ErrInvalidStockRequest
+		}
+
+		if i, ok := index[item.PartUUID]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		index[item.PartUUID] = len(merged)
+		merged = append(merged, item)
+	}
+
+	return merged, nil
+}
@@ -0,0 +1,99 @@
+package part
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Alexey-step/rocket-factory/inventory/internal/model"
+	"github.com/Alexey-step/rocket-factory/inventory/internal/repository/mocks"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+func TestReserveStockMergesItems(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+
+	items := []model.StockItem{
+		{PartUUID: partUUID, Quantity: 2},
+		{PartUUID: partUUID, Quantity: 1},
+	}
+	expectedItems := []model.StockItem{
+		{PartUUID: partUUID, Quantity: 3},
+	}
+
+	inventoryRepository := mocks.NewInventoryRepository(t)
+	inventoryService := NewService(inventoryRepository)
+
+	inventoryRepository.On("ReserveStock", ctx, orderUUID, expectedItems).Return(nil).Once()
+
+	err := inventoryService.ReserveStock(ctx, orderUUID, items)
+	assert.NoError(t, err)
+}
+
+func TestReserveStockInvalidQuantity(t *testing.T) {
+	ctx := context.Background()
+
+	inventoryRepository := mocks.NewInventoryRepository(t)
+	inventoryService := NewService(inventoryRepository)
+
+	err := inventoryService.ReserveStock(ctx, gofakeit.UUID(), []model.StockItem{
+		{PartUUID: gofakeit.UUID(), Quantity: 0},
+	})
+	assert.ErrorIs(t, err, model.ErrInvalidStockRequest)
+}
+
+func TestReserveStockInsufficient(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	items := []model.StockItem{{PartUUID: gofakeit.UUID(), Quantity: 5}}
+
+	repoErr := &model.InsufficientStockError{
+		Shortages: []model.StockShortage{
+			{PartUUID: items[0].PartUUID, Requested: 5, Available: 1},
+		},
+	}
+
+	inventoryRepository := mocks.NewInventoryRepository(t)
+	inventoryService := NewService(inventoryRepository)
+
+	inventoryRepository.On("ReserveStock", ctx, orderUUID, items).Return(repoErr).Once()
+
+	err := inventoryService.ReserveStock(ctx, orderUUID, items)
+	assert.ErrorIs(t, err, model.ErrInsufficientStock)
+
+	var stockErr *model.InsufficientStockError
+	assert.ErrorAs(t, err, &stockErr)
+	assert.Equal(t, repoErr.Shortages, stockErr.Shortages)
+}
+
+func TestReleaseStockRepoError(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	repoErr := model.ErrReservationNotFound
+
+	logger.SetNopLogger()
+	inventoryRepository := mocks.NewInventoryRepository(t)
+	inventoryService := NewService(inventoryRepository)
+
+	inventoryRepository.On("ReleaseStock", ctx, orderUUID).Return(repoErr).Once()
+
+	err := inventoryService.ReleaseStock(ctx, orderUUID)
+	assert.ErrorIs(t, err, repoErr)
+}
+
+func TestCommitStockSuccess(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+
+	inventoryRepository := mocks.NewInventoryRepository(t)
+	inventoryService := NewService(inventoryRepository)
+
+	inventoryRepository.On("CommitStock", ctx, orderUUID).Return(nil).Once()
+
+	err := inventoryService.CommitStock(ctx, orderUUID)
+	assert.NoError(t, err)
+}
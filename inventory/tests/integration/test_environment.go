@@ -74,10 +74,13 @@ func (env *TestEnvironment) GetFakePartUUIDS() []string {
 func getDBPart(partUUID string) bson.M {
 	now := time.Now()
 	return bson.M{
-		"uuid":           partUUID,
-		"name":           gofakeit.Name(),
-		"description":    gofakeit.Sentence(10),
-		"price":          gofakeit.Float64Range(100, 10_000),
+		"uuid":        partUUID,
+		"name":        gofakeit.Name(),
+		"description": gofakeit.Sentence(10),
+		"price": bson.M{
+			"amount":   int64(gofakeit.Number(10_000, 1_000_000)),
+			"currency": "RUB",
+		},
 		"stock_quantity": int64(gofakeit.Number(1, 100)),
 		"category":       repoModel.Category("ENGINE"),
 		"dimensions": bson.M{
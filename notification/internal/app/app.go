@@ -30,7 +30,7 @@ func New(ctx context.Context) (*App, error) {
 
 func (a *App) Run(ctx context.Context) error {
 	// Канал для ошибок от компонентов
-	errCh := make(chan error, 2)
+	errCh := make(chan error, 4)
 
 	// Контекст для остановки всех горутин
 	ctx, cancel := context.WithCancel(ctx)
@@ -50,6 +50,20 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	// Консьюмер
+	go func() {
+		if err := a.runOrderExpiredConsumer(ctx); err != nil {
+			errCh <- errors.Errorf("consumer crashed: %v", err)
+		}
+	}()
+
+	// Консьюмер
+	go func() {
+		if err := a.runOrderRefundedConsumer(ctx); err != nil {
+			errCh <- errors.Errorf("consumer crashed: %v", err)
+		}
+	}()
+
 	// Ожидание либо ошибки, либо завершения контекста (например, сигнал SIGINT/SIGTERM)
 	select {
 	case <-ctx.Done():
@@ -147,3 +161,25 @@ func (a *App) runOrderPaidConsumer(ctx context.Context) error {
 
 	return nil
 }
+
+func (a *App) runOrderExpiredConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 OrderExpired Kafka consumer running")
+
+	err := a.diContainer.OrderExpiredConsumerService(ctx).RunConsumer(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *App) runOrderRefundedConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 OrderRefunded Kafka consumer running")
+
+	err := a.diContainer.OrderRefundedConsumerService(ctx).RunConsumer(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -14,7 +14,9 @@ import (
 	"github.com/Alexey-step/rocket-factory/notification/internal/converter/kafka/decoder"
 	"github.com/Alexey-step/rocket-factory/notification/internal/service"
 	orderAssembledConsumerService "github.com/Alexey-step/rocket-factory/notification/internal/service/consumer/order_assembled_consumer"
+	orderExpiredConsumerService "github.com/Alexey-step/rocket-factory/notification/internal/service/consumer/order_expired_consumer"
 	orderPaidConsumerService "github.com/Alexey-step/rocket-factory/notification/internal/service/consumer/order_paid_consumer"
+	orderRefundedConsumerService "github.com/Alexey-step/rocket-factory/notification/internal/service/consumer/order_refunded_consumer"
 	telegramService "github.com/Alexey-step/rocket-factory/notification/internal/service/telegram"
 	"github.com/Alexey-step/rocket-factory/platform/pkg/closer"
 	wrappedKafka "github.com/Alexey-step/rocket-factory/platform/pkg/kafka"
@@ -24,15 +26,23 @@ import (
 )
 
 type diContainer struct {
-	consumerGroup sarama.ConsumerGroup
+	consumerGroup              sarama.ConsumerGroup
+	orderExpiredConsumerGroup  sarama.ConsumerGroup
+	orderRefundedConsumerGroup sarama.ConsumerGroup
 
 	orderAssembledConsumerService service.OrderAssembledConsumerService
 	orderPaidConsumerService      service.OrderPaidConsumerService
+	orderExpiredConsumerService   service.OrderExpiredConsumerService
+	orderRefundedConsumerService  service.OrderRefundedConsumerService
 
 	orderAssembledConsumer wrappedKafka.Consumer
 	orderAssembledDecoder  kafkaConverter.OrderAssembledDecoder
 	orderPaidConsumer      wrappedKafka.Consumer
 	orderPaidDecoder       kafkaConverter.OrderPaidDecoder
+	orderExpiredConsumer   wrappedKafka.Consumer
+	orderExpiredDecoder    kafkaConverter.OrderExpiredDecoder
+	orderRefundedConsumer  wrappedKafka.Consumer
+	orderRefundedDecoder   kafkaConverter.OrderRefundedDecoder
 
 	telegramService service.TelegramService
 	telegramClient  httpClient.TelegramClient
@@ -154,3 +164,109 @@ func (d *diContainer) OrderPaidDecoder() kafkaConverter.OrderPaidDecoder {
 	}
 	return d.orderPaidDecoder
 }
+
+func (d *diContainer) OrderExpiredConsumerService(ctx context.Context) service.OrderExpiredConsumerService {
+	if d.orderExpiredConsumerService == nil {
+		d.orderExpiredConsumerService = orderExpiredConsumerService.NewService(
+			d.OrderExpiredConsumer(),
+			d.OrderExpiredDecoder(),
+			d.TelegramService(ctx),
+		)
+	}
+	return d.orderExpiredConsumerService
+}
+
+// OrderExpiredConsumerGroup - отдельная consumer group, чтобы события об отмене
+// читались независимо от событий оплаты и сборки
+func (d *diContainer) OrderExpiredConsumerGroup() sarama.ConsumerGroup {
+	if d.orderExpiredConsumerGroup == nil {
+		group, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().OrderExpiredConsumer.GroupID(),
+			config.AppConfig().OrderExpiredConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create order expired consumer group: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka order expired consumer group", func(ctx context.Context) error {
+			return group.Close()
+		})
+
+		d.orderExpiredConsumerGroup = group
+	}
+
+	return d.orderExpiredConsumerGroup
+}
+
+func (d *diContainer) OrderExpiredConsumer() wrappedKafka.Consumer {
+	if d.orderExpiredConsumer == nil {
+		d.orderExpiredConsumer = wrappedKafkaConsumer.NewConsumer(
+			logger.Logger(),
+			d.OrderExpiredConsumerGroup(),
+			[]string{
+				config.AppConfig().OrderExpiredConsumer.Topic(),
+			},
+			kafkaMiddleware.Logging(logger.Logger()),
+		)
+	}
+	return d.orderExpiredConsumer
+}
+
+func (d *diContainer) OrderExpiredDecoder() kafkaConverter.OrderExpiredDecoder {
+	if d.orderExpiredDecoder == nil {
+		d.orderExpiredDecoder = decoder.NewOrderExpiredDecoder()
+	}
+	return d.orderExpiredDecoder
+}
+
+func (d *diContainer) OrderRefundedConsumerService(ctx context.Context) service.OrderRefundedConsumerService {
+	if d.orderRefundedConsumerService == nil {
+		d.orderRefundedConsumerService = orderRefundedConsumerService.NewService(
+			d.OrderRefundedConsumer(),
+			d.OrderRefundedDecoder(),
+			d.TelegramService(ctx),
+		)
+	}
+	return d.orderRefundedConsumerService
+}
+
+func (d *diContainer) OrderRefundedConsumerGroup() sarama.ConsumerGroup {
+	if d.orderRefundedConsumerGroup == nil {
+		group, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().OrderRefundedConsumer.GroupID(),
+			config.AppConfig().OrderRefundedConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create order refunded consumer group: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka order refunded consumer group", func(ctx context.Context) error {
+			return group.Close()
+		})
+
+		d.orderRefundedConsumerGroup = group
+	}
+
+	return d.orderRefundedConsumerGroup
+}
+
+func (d *diContainer) OrderRefundedConsumer() wrappedKafka.Consumer {
+	if d.orderRefundedConsumer == nil {
+		d.orderRefundedConsumer = wrappedKafkaConsumer.NewConsumer(
+			logger.Logger(),
+			d.OrderRefundedConsumerGroup(),
+			[]string{
+				config.AppConfig().OrderRefundedConsumer.Topic(),
+			},
+			kafkaMiddleware.Logging(logger.Logger()),
+		)
+	}
+	return d.orderRefundedConsumer
+}
+
+func (d *diContainer) OrderRefundedDecoder() kafkaConverter.OrderRefundedDecoder {
+	if d.orderRefundedDecoder == nil {
+		d.orderRefundedDecoder = decoder.NewOrderRefundedDecoder()
+	}
+	return d.orderRefundedDecoder
+}
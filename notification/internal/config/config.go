@@ -15,6 +15,8 @@ type config struct {
 	Kafka                  KafkaConfig
 	OrderAssembledConsumer OrderAssembledConsumerConfig
 	OrderPaidConsumer      OrderPaidConsumerConfig
+	OrderExpiredConsumer   OrderExpiredConsumerConfig
+	OrderRefundedConsumer  OrderRefundedConsumerConfig
 	TelegramBot            TelegramBotConfig
 }
 
@@ -44,6 +46,16 @@ func Load(path ...string) error {
 		return err
 	}
 
+	orderExpiredConsumerCfg, err := env.NewOrderExpiredConsumerConfig()
+	if err != nil {
+		return err
+	}
+
+	orderRefundedConsumerCfg, err := env.NewOrderRefundedConsumerConfig()
+	if err != nil {
+		return err
+	}
+
 	telegramBotConfig, err := env.NewTelegramBotConfig()
 	if err != nil {
 		return err
@@ -54,6 +66,8 @@ func Load(path ...string) error {
 		Kafka:                  kafkaCfg,
 		OrderAssembledConsumer: orderAssembledConsumerCfg,
 		OrderPaidConsumer:      orderPaidConsumerCfg,
+		OrderExpiredConsumer:   orderExpiredConsumerCfg,
+		OrderRefundedConsumer:  orderRefundedConsumerCfg,
 		TelegramBot:            telegramBotConfig,
 	}
 
@@ -0,0 +1,42 @@
+//nolint:dupl
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type orderExpiredConsumerEnvConfig struct {
+	Topic   string `env:"ORDER_EXPIRED_TOPIC_NAME,required"`
+	GroupID string `env:"ORDER_EXPIRED_CONSUMER_GROUP_ID,required"`
+}
+
+type orderExpiredConsumerConfig struct {
+	raw orderExpiredConsumerEnvConfig
+}
+
+func NewOrderExpiredConsumerConfig() (*orderExpiredConsumerConfig, error) {
+	var raw orderExpiredConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderExpiredConsumerConfig{raw: raw}, nil
+}
+
+func (cfg *orderExpiredConsumerConfig) Topic() string {
+	return cfg.raw.Topic
+}
+
+func (cfg *orderExpiredConsumerConfig) GroupID() string {
+	return cfg.raw.GroupID
+}
+
+func (cfg *orderExpiredConsumerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRoundRobin()}
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	return config
+}
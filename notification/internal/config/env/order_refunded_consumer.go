@@ -0,0 +1,42 @@
+//nolint:dupl
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type orderRefundedConsumerEnvConfig struct {
+	Topic   string `env:"ORDER_REFUNDED_TOPIC_NAME,required"`
+	GroupID string `env:"ORDER_REFUNDED_CONSUMER_GROUP_ID,required"`
+}
+
+type orderRefundedConsumerConfig struct {
+	raw orderRefundedConsumerEnvConfig
+}
+
+func NewOrderRefundedConsumerConfig() (*orderRefundedConsumerConfig, error) {
+	var raw orderRefundedConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderRefundedConsumerConfig{raw: raw}, nil
+}
+
+func (cfg *orderRefundedConsumerConfig) Topic() string {
+	return cfg.raw.Topic
+}
+
+func (cfg *orderRefundedConsumerConfig) GroupID() string {
+	return cfg.raw.GroupID
+}
+
+func (cfg *orderRefundedConsumerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRoundRobin()}
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	return config
+}
@@ -19,6 +19,18 @@ type OrderPaidConsumerConfig interface {
 	Config() *sarama.Config
 }
 
+type OrderExpiredConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	Config() *sarama.Config
+}
+
+type OrderRefundedConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	Config() *sarama.Config
+}
+
 type KafkaConfig interface {
 	Brokers() []string
 }
@@ -0,0 +1,31 @@
+package decoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Alexey-step/rocket-factory/notification/internal/model"
+	eventsV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/events/v1"
+)
+
+type orderExpiredDecoder struct{}
+
+func NewOrderExpiredDecoder() *orderExpiredDecoder {
+	return &orderExpiredDecoder{}
+}
+
+func (d *orderExpiredDecoder) Decode(data []byte) (model.OrderExpired, error) {
+	var pb eventsV1.OrderExpired
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return model.OrderExpired{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return model.OrderExpired{
+		EventUUID: pb.EventUuid,
+		OrderUUID: pb.OrderUuid,
+		UserUUID:  pb.UserUuid,
+		Reason:    pb.Reason,
+		ExpiredAt: pb.ExpiredAt.AsTime(),
+	}, nil
+}
@@ -9,3 +9,11 @@ type OrderPaidDecoder interface {
 type OrderAssembledDecoder interface {
 	Decode(data []byte) (model.ShipAssembled, error)
 }
+
+type OrderExpiredDecoder interface {
+	Decode(data []byte) (model.OrderExpired, error)
+}
+
+type OrderRefundedDecoder interface {
+	Decode(data []byte) (model.OrderRefunded, error)
+}
@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type OrderPaid struct {
 	EventUUID       string //	Уникальный идентификатор события (для идемпотентности)
 	OrderUUID       string //	Идентификатор оплаченного заказа
@@ -14,3 +16,19 @@ type ShipAssembled struct {
 	UserUUID     string // Идентификатор пользователя
 	BuildTimeSec int64  // Время (в секундах), потраченное на сборку корабля
 }
+
+type OrderExpired struct {
+	EventUUID string    // Уникальный идентификатор события (для идемпотентности)
+	OrderUUID string    // Идентификатор отменённого заказа
+	UserUUID  string    // Идентификатор пользователя
+	Reason    string    // Причина отмены (строкой, значение из CancelReason)
+	ExpiredAt time.Time // Момент отмены заказа
+}
+
+type OrderRefunded struct {
+	EventUUID       string // Уникальный идентификатор события (для идемпотентности)
+	OrderUUID       string // Идентификатор заказа, по которому вернули оплату
+	UserUUID        string // Идентификатор пользователя
+	TransactionUUID string // Идентификатор транзакции оплаты
+	RefundUUID      string // Идентификатор транзакции возврата
+}
@@ -0,0 +1,47 @@
+package order_expired_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/Alexey-step/rocket-factory/notification/internal/converter/kafka"
+	notificationService "github.com/Alexey-step/rocket-factory/notification/internal/service"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/kafka"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+var _ notificationService.OrderExpiredConsumerService = (*service)(nil)
+
+type service struct {
+	orderExpiredConsumer kafka.Consumer
+	orderExpiredDecoder  kafkaConverter.OrderExpiredDecoder
+	telegramService      notificationService.TelegramService
+}
+
+func NewService(
+	orderExpiredConsumer kafka.Consumer,
+	orderExpiredDecoder kafkaConverter.OrderExpiredDecoder,
+	telegramService notificationService.TelegramService,
+) *service {
+	return &service{
+		orderExpiredConsumer: orderExpiredConsumer,
+		orderExpiredDecoder:  orderExpiredDecoder,
+		telegramService:      telegramService,
+	}
+}
+
+func (s *service) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting order expired consumer service")
+
+	err := s.orderExpiredConsumer.Consume(ctx, s.OrderExpiredHandler)
+	if err != nil {
+		logger.Error(ctx, "Failed to start order expired consumer service",
+			zap.Error(err),
+		)
+		return err
+	}
+
+	logger.Info(ctx, "OrderExpired consumer service started successfully")
+	return nil
+}
@@ -0,0 +1,38 @@
+package order_expired_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexey-step/rocket-factory/platform/pkg/kafka"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+func (s *service) OrderExpiredHandler(ctx context.Context, msg kafka.Message) error {
+	event, err := s.orderExpiredDecoder.Decode(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode order expired event", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "Processing message",
+		zap.String("topic", msg.Topic),
+		zap.Any("partition", msg.Partition),
+		zap.Any("offset", msg.Offset),
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("reason", event.Reason),
+	)
+
+	err = s.telegramService.SendExpiredNotification(ctx, event)
+	if err != nil {
+		logger.Error(ctx, "Failed to send order expired notification to Telegram",
+			zap.Any("order_expired", event),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package order_refunded_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/Alexey-step/rocket-factory/notification/internal/converter/kafka"
+	notificationService "github.com/Alexey-step/rocket-factory/notification/internal/service"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/kafka"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+var _ notificationService.OrderRefundedConsumerService = (*service)(nil)
+
+type service struct {
+	orderRefundedConsumer kafka.Consumer
+	orderRefundedDecoder  kafkaConverter.OrderRefundedDecoder
+	telegramService       notificationService.TelegramService
+}
+
+func NewService(
+	orderRefundedConsumer kafka.Consumer,
+	orderRefundedDecoder kafkaConverter.OrderRefundedDecoder,
+	telegramService notificationService.TelegramService,
+) *service {
+	return &service{
+		orderRefundedConsumer: orderRefundedConsumer,
+		orderRefundedDecoder:  orderRefundedDecoder,
+		telegramService:       telegramService,
+	}
+}
+
+func (s *service) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting order refunded consumer service")
+
+	err := s.orderRefundedConsumer.Consume(ctx, s.OrderRefundedHandler)
+	if err != nil {
+		logger.Error(ctx, "Failed to start order refunded consumer service",
+			zap.Error(err),
+		)
+		return err
+	}
+
+	logger.Info(ctx, "OrderRefunded consumer service started successfully")
+	return nil
+}
@@ -0,0 +1,38 @@
+package order_refunded_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexey-step/rocket-factory/platform/pkg/kafka"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+func (s *service) OrderRefundedHandler(ctx context.Context, msg kafka.Message) error {
+	event, err := s.orderRefundedDecoder.Decode(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode order refunded event", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "Processing message",
+		zap.String("topic", msg.Topic),
+		zap.Any("partition", msg.Partition),
+		zap.Any("offset", msg.Offset),
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("refund_uuid", event.RefundUUID),
+	)
+
+	err = s.telegramService.SendRefundedNotification(ctx, event)
+	if err != nil {
+		logger.Error(ctx, "Failed to send order refunded notification to Telegram",
+			zap.Any("order_refunded", event),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
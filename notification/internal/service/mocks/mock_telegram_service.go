@@ -71,6 +71,53 @@ func (_c *TelegramService_SendAssembledNotification_Call) RunAndReturn(run func(
 	return _c
 }
 
+// SendExpiredNotification provides a mock function with given fields: ctx, expired
+func (_m *TelegramService) SendExpiredNotification(ctx context.Context, expired model.OrderExpired) error {
+	ret := _m.Called(ctx, expired)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendExpiredNotification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderExpired) error); ok {
+		r0 = rf(ctx, expired)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TelegramService_SendExpiredNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendExpiredNotification'
+type TelegramService_SendExpiredNotification_Call struct {
+	*mock.Call
+}
+
+// SendExpiredNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expired model.OrderExpired
+func (_e *TelegramService_Expecter) SendExpiredNotification(ctx interface{}, expired interface{}) *TelegramService_SendExpiredNotification_Call {
+	return &TelegramService_SendExpiredNotification_Call{Call: _e.mock.On("SendExpiredNotification", ctx, expired)}
+}
+
+func (_c *TelegramService_SendExpiredNotification_Call) Run(run func(ctx context.Context, expired model.OrderExpired)) *TelegramService_SendExpiredNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrderExpired))
+	})
+	return _c
+}
+
+func (_c *TelegramService_SendExpiredNotification_Call) Return(_a0 error) *TelegramService_SendExpiredNotification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TelegramService_SendExpiredNotification_Call) RunAndReturn(run func(context.Context, model.OrderExpired) error) *TelegramService_SendExpiredNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendPaidNotification provides a mock function with given fields: ctx, paid
 func (_m *TelegramService) SendPaidNotification(ctx context.Context, paid model.OrderPaid) error {
 	ret := _m.Called(ctx, paid)
@@ -118,6 +165,53 @@ func (_c *TelegramService_SendPaidNotification_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// SendRefundedNotification provides a mock function with given fields: ctx, refunded
+func (_m *TelegramService) SendRefundedNotification(ctx context.Context, refunded model.OrderRefunded) error {
+	ret := _m.Called(ctx, refunded)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendRefundedNotification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderRefunded) error); ok {
+		r0 = rf(ctx, refunded)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TelegramService_SendRefundedNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendRefundedNotification'
+type TelegramService_SendRefundedNotification_Call struct {
+	*mock.Call
+}
+
+// SendRefundedNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refunded model.OrderRefunded
+func (_e *TelegramService_Expecter) SendRefundedNotification(ctx interface{}, refunded interface{}) *TelegramService_SendRefundedNotification_Call {
+	return &TelegramService_SendRefundedNotification_Call{Call: _e.mock.On("SendRefundedNotification", ctx, refunded)}
+}
+
+func (_c *TelegramService_SendRefundedNotification_Call) Run(run func(ctx context.Context, refunded model.OrderRefunded)) *TelegramService_SendRefundedNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrderRefunded))
+	})
+	return _c
+}
+
+func (_c *TelegramService_SendRefundedNotification_Call) Return(_a0 error) *TelegramService_SendRefundedNotification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TelegramService_SendRefundedNotification_Call) RunAndReturn(run func(context.Context, model.OrderRefunded) error) *TelegramService_SendRefundedNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewTelegramService creates a new instance of TelegramService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTelegramService(t interface {
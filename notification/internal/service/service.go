@@ -14,7 +14,17 @@ type OrderAssembledConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
 
+type OrderExpiredConsumerService interface {
+	RunConsumer(ctx context.Context) error
+}
+
+type OrderRefundedConsumerService interface {
+	RunConsumer(ctx context.Context) error
+}
+
 type TelegramService interface {
 	SendPaidNotification(ctx context.Context, paid model.OrderPaid) error
 	SendAssembledNotification(ctx context.Context, assembled model.ShipAssembled) error
+	SendExpiredNotification(ctx context.Context, expired model.OrderExpired) error
+	SendRefundedNotification(ctx context.Context, refunded model.OrderRefunded) error
 }
@@ -22,6 +22,12 @@ var paidNotificationTemplateFS embed.FS
 //go:embed templates/assembled_notification.tmpl
 var assembledNotificationTemplateFS embed.FS
 
+//go:embed templates/expired_notification.tmpl
+var expiredNotificationTemplateFS embed.FS
+
+//go:embed templates/refunded_notification.tmpl
+var refundedNotificationTemplateFS embed.FS
+
 type PaidNotificationTemplateData struct {
 	OrderUUID       string
 	EventUUID       string
@@ -37,9 +43,27 @@ type AssembledNotificationTemplateData struct {
 	RegisteredAt time.Time
 }
 
+type ExpiredNotificationTemplateData struct {
+	OrderUUID    string
+	EventUUID    string
+	Reason       string
+	ExpiredAt    time.Time
+	RegisteredAt time.Time
+}
+
+type RefundedNotificationTemplateData struct {
+	OrderUUID       string
+	EventUUID       string
+	TransactionUUID string
+	RefundUUID      string
+	RegisteredAt    time.Time
+}
+
 var (
 	paidNotificationTemplate      = template.Must(template.ParseFS(paidNotificationTemplateFS, "templates/paid_notification.tmpl"))
 	assembledNotificationTemplate = template.Must(template.ParseFS(assembledNotificationTemplateFS, "templates/assembled_notification.tmpl"))
+	expiredNotificationTemplate   = template.Must(template.ParseFS(expiredNotificationTemplateFS, "templates/expired_notification.tmpl"))
+	refundedNotificationTemplate  = template.Must(template.ParseFS(refundedNotificationTemplateFS, "templates/refunded_notification.tmpl"))
 )
 
 type service struct {
@@ -89,6 +113,42 @@ func (s *service) SendAssembledNotification(ctx context.Context, assembled model
 	return nil
 }
 
+func (s *service) SendExpiredNotification(ctx context.Context, expired model.OrderExpired) error {
+	message, err := buildExpiredNotificationMessage(expired)
+	if err != nil {
+		return err
+	}
+
+	err = s.telegramClient.SendMessage(ctx, chatID, message)
+	if err != nil {
+		return err
+	}
+	logger.Info(ctx, "Telegram message sent to chat",
+		zap.Int("chat_id", chatID),
+		zap.String("message", message),
+	)
+
+	return nil
+}
+
+func (s *service) SendRefundedNotification(ctx context.Context, refunded model.OrderRefunded) error {
+	message, err := buildRefundedNotificationMessage(refunded)
+	if err != nil {
+		return err
+	}
+
+	err = s.telegramClient.SendMessage(ctx, chatID, message)
+	if err != nil {
+		return err
+	}
+	logger.Info(ctx, "Telegram message sent to chat",
+		zap.Int("chat_id", chatID),
+		zap.String("message", message),
+	)
+
+	return nil
+}
+
 func buildPaidNotificationMessage(paid model.OrderPaid) (string, error) {
 	data := &PaidNotificationTemplateData{
 		OrderUUID:       paid.OrderUUID,
@@ -121,3 +181,37 @@ func buildAssembledNotificationMessage(assembled model.ShipAssembled) (string, e
 	}
 	return buf.String(), nil
 }
+
+func buildExpiredNotificationMessage(expired model.OrderExpired) (string, error) {
+	data := &ExpiredNotificationTemplateData{
+		OrderUUID:    expired.OrderUUID,
+		EventUUID:    expired.EventUUID,
+		Reason:       expired.Reason,
+		ExpiredAt:    expired.ExpiredAt,
+		RegisteredAt: time.Now(),
+	}
+
+	var buf bytes.Buffer
+	err := expiredNotificationTemplate.Execute(&buf, data)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func buildRefundedNotificationMessage(refunded model.OrderRefunded) (string, error) {
+	data := &RefundedNotificationTemplateData{
+		OrderUUID:       refunded.OrderUUID,
+		EventUUID:       refunded.EventUUID,
+		TransactionUUID: refunded.TransactionUUID,
+		RefundUUID:      refunded.RefundUUID,
+		RegisteredAt:    time.Now(),
+	}
+
+	var buf bytes.Buffer
+	err := refundedNotificationTemplate.Execute(&buf, data)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
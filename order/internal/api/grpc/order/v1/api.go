@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"github.com/Alexey-step/rocket-factory/order/internal/service"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/order/v1"
+)
+
+type api struct {
+	orderV1.UnimplementedOrderServiceServer
+
+	service service.OrderService
+}
+
+func NewAPI(service service.OrderService) *api {
+	return &api{
+		service: service,
+	}
+}
@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"context"
+
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/order/v1"
+)
+
+func (a *api) CancelOrder(ctx context.Context, req *orderV1.CancelOrderRequest) (*orderV1.CancelOrderResponse, error) {
+	userUUID, err := userUUIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.service.CancelOrder(ctx, userUUID, req.GetOrderUuid())
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+
+	return &orderV1.CancelOrderResponse{}, nil
+}
@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/converter"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/order/v1"
+)
+
+func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest) (*orderV1.CreateOrderResponse, error) {
+	userUUID, err := userUUIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	request := converter.CreateOrderRequestProtoToModel(req)
+	orderInfo, err := a.service.CreateOrder(ctx, userUUID, request, req.GetIdempotencyKey())
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+
+	return &orderV1.CreateOrderResponse{
+		OrderUuid:  orderInfo.OrderUUID,
+		TotalPrice: converter.MoneyToProto(orderInfo.TotalPrice),
+	}, nil
+}
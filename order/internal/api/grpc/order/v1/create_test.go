@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/order/v1"
+)
+
+func TestCreateOrderSuccess(t *testing.T) {
+	userUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+	idempotencyKey := gofakeit.UUID()
+	ctx := contextWithUser(userUUID)
+
+	request := model.OrderRequest{
+		Items:     []model.OrderItemInfo{{PartUUID: partUUID, Quantity: 3}},
+		Currency:  "USD",
+		PromoCode: "SPRING",
+	}
+	info := model.OrderCreationInfo{
+		OrderUUID:  gofakeit.UUID(),
+		TotalPrice: money.New(4_200, "USD"),
+	}
+
+	service := mocks.NewOrderService(t)
+	orderApi := NewAPI(service)
+
+	service.On("CreateOrder", ctx, userUUID, request, idempotencyKey).Return(info, nil).Once()
+
+	resp, err := orderApi.CreateOrder(ctx, &orderV1.CreateOrderRequest{
+		Items:          []*orderV1.CreateOrderItem{{PartUuid: partUUID, Quantity: 3}},
+		Currency:       "USD",
+		PromoCode:      "SPRING",
+		IdempotencyKey: idempotencyKey,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, info.OrderUUID, resp.GetOrderUuid())
+	assert.Equal(t, int64(4_200), resp.GetTotalPrice().GetAmount())
+	assert.Equal(t, "USD", resp.GetTotalPrice().GetCurrency())
+}
+
+func TestCreateOrderInsufficientStock(t *testing.T) {
+	userUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+	ctx := contextWithUser(userUUID)
+
+	request := model.OrderRequest{
+		Items: []model.OrderItemInfo{{PartUUID: partUUID, Quantity: 5}},
+	}
+	stockErr := &model.InsufficientStockError{
+		Shortages: []model.StockShortage{{PartUUID: partUUID, Requested: 5, Available: 1}},
+	}
+
+	logger.SetNopLogger()
+	service := mocks.NewOrderService(t)
+	orderApi := NewAPI(service)
+
+	service.On("CreateOrder", ctx, userUUID, request, "").Return(model.OrderCreationInfo{}, stockErr).Once()
+
+	resp, err := orderApi.CreateOrder(ctx, &orderV1.CreateOrderRequest{
+		Items: []*orderV1.CreateOrderItem{{PartUuid: partUUID, Quantity: 5}},
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Nil(t, resp)
+}
@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+// domainErrors - соответствие доменных ошибок gRPC-кодам, те же ошибки, что и в
+// HTTP API (api/order/v1/errors.go). Порядок важен: проверка идёт сверху вниз до первого совпадения
+var domainErrors = []struct {
+	err     error
+	code    codes.Code
+	message string
+}{
+	{model.ErrOrderForbidden, codes.PermissionDenied, "order belongs to another user"},
+	{model.ErrOrderNotFound, codes.NotFound, "order not found"},
+	{model.ErrPartsNotFound, codes.NotFound, "one or more parts not found"},
+	{model.ErrPaymentNotFound, codes.NotFound, "order payment not found"},
+	{model.ErrPartsInvalidRequest, codes.InvalidArgument, "quantity of every part must be greater than zero"},
+	{model.ErrOrdersInvalidFilter, codes.InvalidArgument, "invalid orders filter"},
+	{model.ErrOrdersInvalidCursor, codes.InvalidArgument, "invalid page cursor"},
+	{model.ErrCurrencyNotSupported, codes.InvalidArgument, "order currency is not supported"},
+	{model.ErrPromoCodeNotFound, codes.InvalidArgument, "promo code not found"},
+	{model.ErrPromoCodeInactive, codes.InvalidArgument, "promo code is not active"},
+	{model.ErrPromoCodeNotApplicable, codes.InvalidArgument, "promo code is not applicable to the order"},
+	{model.ErrPromoCodeExhausted, codes.FailedPrecondition, "promo code usage limit reached"},
+	{model.ErrOrderInvalidTransition, codes.FailedPrecondition, "order status does not allow this operation"},
+	{model.ErrIdempotencyKeyConflict, codes.Aborted, "idempotency key already used with a different request"},
+	{model.ErrIdempotencyKeyInProgress, codes.Aborted, "request with this idempotency key is still in progress"},
+	{model.ErrOrderConflict, codes.Aborted, "order was changed by a concurrent request"},
+}
+
+// newStatusError переводит ошибку сервиса в gRPC-статус: сначала доменные ошибки,
+// затем ошибки смежных сервисов (inventory, payment) и таймауты. Всё остальное - Internal
+// без подробностей, чтобы не раскрывать внутренние ошибки клиенту
+func newStatusError(ctx context.Context, err error) error {
+	st := newStatus(err)
+
+	switch st.Code() {
+	case codes.Internal, codes.Unavailable, codes.DeadlineExceeded:
+		logger.Error(ctx, "Order gRPC request failed", zap.String("code", st.Code().String()), zap.Error(err))
+	default:
+		logger.Info(ctx, "Order gRPC request rejected", zap.String("code", st.Code().String()), zap.Error(err))
+	}
+
+	return st.Err()
+}
+
+func newStatus(err error) *status.Status {
+	var transitionErr *model.InvalidStatusTransitionError
+	var stockErr *model.InsufficientStockError
+	var bomErr *model.BOMValidationError
+	switch {
+	case errors.As(err, &transitionErr):
+		return status.New(codes.FailedPrecondition,
+			fmt.Sprintf("order in status %s cannot be moved to %s", transitionErr.From, transitionErr.To))
+	case errors.As(err, &stockErr):
+		return status.New(codes.FailedPrecondition, stockErr.Error())
+	case errors.As(err, &bomErr):
+		return status.New(codes.InvalidArgument, bomErr.Error())
+	}
+
+	for _, d := range domainErrors {
+		if errors.Is(err, d.err) {
+			return status.New(d.code, d.message)
+		}
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, "request deadline exceeded")
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, "request cancelled")
+	}
+
+	if st, ok := status.FromError(err); ok {
+		return newDownstreamStatus(st)
+	}
+
+	return status.New(codes.Internal, "internal error")
+}
+
+// newDownstreamStatus переводит gRPC-статус смежного сервиса в ответ клиенту.
+// Открытый circuit breaker возвращает Unavailable и попадает сюда же
+func newDownstreamStatus(st *status.Status) *status.Status {
+	switch st.Code() {
+	case codes.NotFound:
+		return status.New(codes.NotFound, "requested resource not found")
+	case codes.InvalidArgument:
+		return status.New(codes.InvalidArgument, "downstream service rejected the request")
+	case codes.Unavailable, codes.ResourceExhausted:
+		return status.New(codes.Unavailable, "downstream service is temporarily unavailable")
+	case codes.DeadlineExceeded:
+		return status.New(codes.DeadlineExceeded, "downstream service did not respond in time")
+	default:
+		return status.New(codes.Internal, "downstream service returned an error")
+	}
+}
@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+func TestNewStatusError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{
+			name:     "order not found",
+			err:      model.ErrOrderNotFound,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "wrapped parts not found",
+			err:      fmt.Errorf("%w: %s", model.ErrPartsNotFound, gofakeit.UUID()),
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "foreign order",
+			err:      model.ErrOrderForbidden,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "invalid status transition",
+			err:      &model.InvalidStatusTransitionError{From: model.OrderStatusCompleted, To: model.OrderStatusRefunding},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "idempotency key reused",
+			err:      model.ErrIdempotencyKeyConflict,
+			wantCode: codes.Aborted,
+		},
+		{
+			name:     "promo code exhausted",
+			err:      model.ErrPromoCodeExhausted,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "invalid cursor",
+			err:      model.ErrOrdersInvalidCursor,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "downstream unavailable",
+			err:      fmt.Errorf("reserve stock: %w", status.Error(codes.Unavailable, "connection refused")),
+			wantCode: codes.Unavailable,
+		},
+		{
+			name:     "circuit breaker open",
+			err:      status.Error(codes.Unavailable, "payment: circuit breaker is open"),
+			wantCode: codes.Unavailable,
+		},
+		{
+			name:     "downstream deadline exceeded",
+			err:      status.Error(codes.DeadlineExceeded, "deadline exceeded"),
+			wantCode: codes.DeadlineExceeded,
+		},
+		{
+			name:     "request deadline exceeded",
+			err:      fmt.Errorf("pay order: %w", context.DeadlineExceeded),
+			wantCode: codes.DeadlineExceeded,
+		},
+		{
+			name:     "downstream internal error",
+			err:      status.Error(codes.Internal, "boom"),
+			wantCode: codes.Internal,
+		},
+		{
+			name:     "unexpected error",
+			err:      errors.New("pq: connection reset by peer"),
+			wantCode: codes.Internal,
+		},
+	}
+
+	logger.SetNopLogger()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newStatusError(context.Background(), tt.err)
+
+			st, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantCode, st.Code())
+			// Внутренние ошибки и ответы смежных сервисов наружу не отдаются
+			if tt.wantCode == codes.Internal {
+				assert.NotContains(t, st.Message(), tt.err.Error())
+			}
+		})
+	}
+}
+
+func TestNewStatusErrorKeepsStockDetails(t *testing.T) {
+	stockErr := &model.InsufficientStockError{
+		Shortages: []model.StockShortage{{PartUUID: gofakeit.UUID(), Requested: 5, Available: 1}},
+	}
+
+	logger.SetNopLogger()
+	err := newStatusError(context.Background(), stockErr)
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, stockErr.Error(), status.Convert(err).Message())
+}
@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/converter"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/order/v1"
+)
+
+func (a *api) GetOrder(ctx context.Context, req *orderV1.GetOrderRequest) (*orderV1.GetOrderResponse, error) {
+	userUUID, err := userUUIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := a.service.GetOrder(ctx, userUUID, req.GetOrderUuid())
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+
+	return &orderV1.GetOrderResponse{
+		Order: converter.OrderDataToProto(order),
+	}, nil
+}
@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/converter"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+	interceptor "github.com/Alexey-step/rocket-factory/platform/pkg/middleware/grpc"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+	commonV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/common/v1"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/order/v1"
+)
+
+func TestGetOrderSuccess(t *testing.T) {
+	userUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+	ctx := contextWithUser(userUUID)
+
+	order := model.OrderData{
+		UUID:     orderUUID,
+		UserUUID: userUUID,
+		Items: []model.OrderItem{
+			{
+				PartUUID:  gofakeit.UUID(),
+				Name:      gofakeit.Name(),
+				Category:  model.CategoryEngine,
+				Quantity:  2,
+				UnitPrice: money.New(150_000, money.RUB),
+			},
+		},
+		TotalPrice:   money.New(300_000, money.RUB),
+		ExchangeRate: money.Identity(money.RUB),
+		Status:       model.OrderStatusPendingPayment,
+		CreatedAt:    time.Now(),
+	}
+
+	service := mocks.NewOrderService(t)
+	orderApi := NewAPI(service)
+
+	service.On("GetOrder", ctx, userUUID, orderUUID).Return(order, nil).Once()
+
+	resp, err := orderApi.GetOrder(ctx, &orderV1.GetOrderRequest{OrderUuid: orderUUID})
+
+	require.NoError(t, err)
+	assert.Equal(t, converter.OrderDataToProto(order), resp.GetOrder())
+	assert.Equal(t, orderV1.OrderStatus_ORDER_STATUS_PENDING_PAYMENT, resp.GetOrder().GetStatus())
+}
+
+func TestGetOrderForbidden(t *testing.T) {
+	userUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+	ctx := contextWithUser(userUUID)
+
+	logger.SetNopLogger()
+	service := mocks.NewOrderService(t)
+	orderApi := NewAPI(service)
+
+	service.On("GetOrder", ctx, userUUID, orderUUID).Return(model.OrderData{}, model.ErrOrderForbidden).Once()
+
+	resp, err := orderApi.GetOrder(ctx, &orderV1.GetOrderRequest{OrderUuid: orderUUID})
+
+	assert.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, resp)
+}
+
+func TestGetOrderUnauthenticated(t *testing.T) {
+	service := mocks.NewOrderService(t)
+	orderApi := NewAPI(service)
+
+	resp, err := orderApi.GetOrder(context.Background(), &orderV1.GetOrderRequest{OrderUuid: gofakeit.UUID()})
+
+	assert.Error(t, err)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Nil(t, resp)
+}
+
+func contextWithUser(userUUID string) context.Context {
+	return context.WithValue(context.Background(), interceptor.GetUserContextKey(), &commonV1.User{Uuid: userUUID})
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/converter"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/order/v1"
+)
+
+func (a *api) ListOrders(ctx context.Context, req *orderV1.ListOrdersRequest) (*orderV1.ListOrdersResponse, error) {
+	userUUID, err := userUUIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := a.service.ListOrders(ctx, converter.ListOrdersRequestToFilter(userUUID, req))
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+
+	return converter.OrdersPageToProto(page), nil
+}
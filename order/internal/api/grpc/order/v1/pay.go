@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/converter"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/order/v1"
+)
+
+func (a *api) PayOrder(ctx context.Context, req *orderV1.PayOrderRequest) (*orderV1.PayOrderResponse, error) {
+	userUUID, err := userUUIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentMethod := converter.PaymentMethodProtoToModel(req.GetPaymentMethod())
+	transactionUUID, err := a.service.PayOrder(ctx, userUUID, req.GetOrderUuid(), string(paymentMethod), req.GetIdempotencyKey())
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+
+	return &orderV1.PayOrderResponse{
+		TransactionUuid: transactionUUID,
+	}, nil
+}
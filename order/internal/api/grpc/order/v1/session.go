@@ -0,0 +1,20 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	interceptor "github.com/Alexey-step/rocket-factory/platform/pkg/middleware/grpc"
+)
+
+// userUUIDFromContext возвращает UUID пользователя, которого AuthInterceptor положил в контекст
+func userUUIDFromContext(ctx context.Context) (string, error) {
+	user, ok := interceptor.GetUserFromContext(ctx)
+	if !ok || user.GetUuid() == "" {
+		return "", status.Error(codes.Unauthenticated, "user is not authenticated")
+	}
+
+	return user.GetUuid(), nil
+}
@@ -3,11 +3,22 @@ package v1
 import "github.com/Alexey-step/rocket-factory/order/internal/service"
 
 type api struct {
-	service service.OrderService
+	service        service.OrderService
+	webhookService service.WebhookService
+	reportService  service.ReportService
+	invoiceService service.InvoiceService
 }
 
-func NewAPI(service service.OrderService) *api {
+func NewAPI(
+	service service.OrderService,
+	webhookService service.WebhookService,
+	reportService service.ReportService,
+	invoiceService service.InvoiceService,
+) *api {
 	return &api{
-		service: service,
+		service:        service,
+		webhookService: webhookService,
+		reportService:  reportService,
+		invoiceService: invoiceService,
 	}
 }
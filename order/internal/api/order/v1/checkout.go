@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/converter"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) CheckoutDraft(ctx context.Context, req *orderV1.CheckoutDraftRequest, params orderV1.CheckoutDraftParams) (orderV1.CheckoutDraftRes, error) {
+	userUUID, ok := userUUIDFromContext(ctx)
+	if !ok {
+		return newUnauthorizedError(), nil
+	}
+
+	orderInfo, err := a.service.CheckoutDraft(ctx, userUUID, model.DraftCheckout{
+		Currency:  req.GetCurrency().Or(""),
+		PromoCode: req.GetPromoCode().Or(""),
+	}, params.IdempotencyKey.Or(""))
+	if err != nil {
+		// Ошибки с подробностями в теле отдаём своими схемами, остальные переводит NewError
+		var stockErr *model.InsufficientStockError
+		var bomErr *model.BOMValidationError
+		switch {
+		case errors.As(err, &bomErr):
+			return converter.BOMValidationErrorToDTO(bomErr), nil
+		case errors.As(err, &stockErr):
+			return lo.ToPtr(orderV1.NewInsufficientStockErrorCheckoutDraftConflict(converter.InsufficientStockErrorToDTO(stockErr))), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return &orderV1.CreateOrderResponse{
+		OrderUUID:  converter.StringToUUID(orderInfo.OrderUUID),
+		TotalPrice: converter.MoneyToDTO(orderInfo.TotalPrice),
+	}, nil
+}
@@ -3,38 +3,37 @@ package v1
 import (
 	"context"
 	"errors"
-	"net/http"
 
-	"go.uber.org/zap"
+	"github.com/samber/lo"
 
 	"github.com/Alexey-step/rocket-factory/order/internal/converter"
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
-	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
 	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
 )
 
 func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest, params orderV1.CreateOrderParams) (orderV1.CreateOrderRes, error) {
-	orderInfo, err := a.service.CreateOrder(ctx, req.GetUserUUID().String(), converter.UUIDsToStrings(req.GetPartUuids()))
+	userUUID, ok := userUUIDFromContext(ctx)
+	if !ok {
+		return newUnauthorizedError(), nil
+	}
+
+	orderInfo, err := a.service.CreateOrder(ctx, userUUID, converter.CreateOrderRequestToModel(req), params.IdempotencyKey.Or(""))
 	if err != nil {
-		if errors.Is(err, model.ErrPartsNotFound) {
-			logger.Error(ctx, "Some parts not found",
-				zap.Strings("part_uuids", converter.UUIDsToStrings(req.GetPartUuids())),
-				zap.Error(err),
-			)
-			return &orderV1.NotFoundError{
-				Code:    http.StatusNotFound,
-				Message: "Одна или несколько частей не найдены",
-			}, nil
+		// Ошибки с подробностями в теле отдаём своими схемами, остальные переводит NewError
+		var stockErr *model.InsufficientStockError
+		var bomErr *model.BOMValidationError
+		switch {
+		case errors.As(err, &bomErr):
+			return converter.BOMValidationErrorToDTO(bomErr), nil
+		case errors.As(err, &stockErr):
+			return lo.ToPtr(orderV1.NewInsufficientStockErrorCreateOrderConflict(converter.InsufficientStockErrorToDTO(stockErr))), nil
+		default:
+			return nil, err
 		}
-		logger.Error(ctx, "Failed to create order",
-			zap.Strings("part_uuids", converter.UUIDsToStrings(req.GetPartUuids())),
-			zap.Error(err),
-		)
-		return nil, err
 	}
 
 	return &orderV1.CreateOrderResponse{
 		OrderUUID:  converter.StringToUUID(orderInfo.OrderUUID),
-		TotalPrice: orderInfo.TotalPrice,
+		TotalPrice: converter.MoneyToDTO(orderInfo.TotalPrice),
 	}, nil
 }
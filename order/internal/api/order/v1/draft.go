@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/converter"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) GetDraft(ctx context.Context, _ orderV1.GetDraftParams) (orderV1.GetDraftRes, error) {
+	userUUID, ok := userUUIDFromContext(ctx)
+	if !ok {
+		return newUnauthorizedError(), nil
+	}
+
+	draft, err := a.service.GetDraft(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderV1.GetOrderResponse{
+		Data: converter.OrderDataToDTO(draft),
+	}, nil
+}
+
+func (a *api) AddDraftItem(ctx context.Context, req *orderV1.CreateOrderItem, _ orderV1.AddDraftItemParams) (orderV1.AddDraftItemRes, error) {
+	userUUID, ok := userUUIDFromContext(ctx)
+	if !ok {
+		return newUnauthorizedError(), nil
+	}
+
+	draft, err := a.service.AddDraftItem(ctx, userUUID, model.OrderItemInfo{
+		PartUUID: req.GetPartUUID().String(),
+		Quantity: req.GetQuantity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderV1.GetOrderResponse{
+		Data: converter.OrderDataToDTO(draft),
+	}, nil
+}
+
+func (a *api) SetDraftItemQuantity(ctx context.Context, req *orderV1.SetDraftItemRequest, params orderV1.SetDraftItemQuantityParams) (orderV1.SetDraftItemQuantityRes, error) {
+	userUUID, ok := userUUIDFromContext(ctx)
+	if !ok {
+		return newUnauthorizedError(), nil
+	}
+
+	draft, err := a.service.SetDraftItemQuantity(ctx, userUUID, params.PartUUID.String(), req.GetQuantity())
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderV1.GetOrderResponse{
+		Data: converter.OrderDataToDTO(draft),
+	}, nil
+}
+
+func (a *api) RemoveDraftItem(ctx context.Context, params orderV1.RemoveDraftItemParams) (orderV1.RemoveDraftItemRes, error) {
+	userUUID, ok := userUUIDFromContext(ctx)
+	if !ok {
+		return newUnauthorizedError(), nil
+	}
+
+	draft, err := a.service.RemoveDraftItem(ctx, userUUID, params.PartUUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderV1.GetOrderResponse{
+		Data: converter.OrderDataToDTO(draft),
+	}, nil
+}
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+// apiError - ошибка в том виде, в котором она уходит клиенту
+type apiError struct {
+	status  int
+	code    orderV1.ErrorCode
+	message string
+}
+
+// domainErrors - соответствие доменных ошибок ответам API. Порядок важен:
+// проверка идёт сверху вниз до первого совпадения
+var domainErrors = []struct {
+	err error
+	apiError
+}{
+	{model.ErrAdminRequired, apiError{http.StatusForbidden, orderV1.ErrorCodeADMINREQUIRED, "Отчёты доступны только администраторам"}},
+	{model.ErrOrderForbidden, apiError{http.StatusForbidden, orderV1.ErrorCodeORDERFORBIDDEN, "Заказ принадлежит другому пользователю"}},
+	{model.ErrOrderNotFound, apiError{http.StatusNotFound, orderV1.ErrorCodeORDERNOTFOUND, "Заказ не найден"}},
+	{model.ErrDraftNotFound, apiError{http.StatusNotFound, orderV1.ErrorCodeDRAFTNOTFOUND, "Черновик заказа не найден"}},
+	{model.ErrDraftItemNotFound, apiError{http.StatusNotFound, orderV1.ErrorCodeDRAFTITEMNOTFOUND, "Детали нет в черновике заказа"}},
+	{model.ErrWebhookNotFound, apiError{http.StatusNotFound, orderV1.ErrorCodeWEBHOOKNOTFOUND, "Вебхук не найден"}},
+	{model.ErrPartsNotFound, apiError{http.StatusNotFound, orderV1.ErrorCodePARTNOTFOUND, "Одна или несколько деталей не найдены"}},
+	{model.ErrPaymentNotFound, apiError{http.StatusNotFound, orderV1.ErrorCodePAYMENTNOTFOUND, "Платёж по заказу не найден"}},
+	{model.ErrPartsInvalidRequest, apiError{http.StatusBadRequest, orderV1.ErrorCodeINVALIDITEMS, "Количество каждой детали должно быть больше нуля"}},
+	{model.ErrDraftEmpty, apiError{http.StatusBadRequest, orderV1.ErrorCodeDRAFTEMPTY, "В черновике нет деталей"}},
+	{model.ErrWebhookInvalid, apiError{http.StatusBadRequest, orderV1.ErrorCodeINVALIDWEBHOOK, "Некорректные параметры вебхука"}},
+	{model.ErrReportInvalid, apiError{http.StatusBadRequest, orderV1.ErrorCodeINVALIDREPORT, "Некорректные параметры отчёта"}},
+	{model.ErrOrdersInvalidFilter, apiError{http.StatusBadRequest, orderV1.ErrorCodeINVALIDFILTER, "Некорректные параметры фильтрации"}},
+	{model.ErrOrdersInvalidCursor, apiError{http.StatusBadRequest, orderV1.ErrorCodeINVALIDCURSOR, "Некорректный курсор страницы"}},
+	{model.ErrCurrencyNotSupported, apiError{http.StatusBadRequest, orderV1.ErrorCodeCURRENCYNOTSUPPORTED, "Валюта заказа не поддерживается"}},
+	{model.ErrPromoCodeNotFound, apiError{http.StatusBadRequest, orderV1.ErrorCodePROMOCODENOTFOUND, "Промокод не найден"}},
+	{model.ErrPromoCodeInactive, apiError{http.StatusBadRequest, orderV1.ErrorCodePROMOCODEINACTIVE, "Промокод не действует"}},
+	{model.ErrPromoCodeNotApplicable, apiError{http.StatusBadRequest, orderV1.ErrorCodePROMOCODENOTAPPLICABLE, "Промокод не подходит к заказу"}},
+	{model.ErrPromoCodeExhausted, apiError{http.StatusConflict, orderV1.ErrorCodePROMOCODEEXHAUSTED, "Лимит использований промокода исчерпан"}},
+	{model.ErrIdempotencyKeyConflict, apiError{http.StatusConflict, orderV1.ErrorCodeIDEMPOTENCYKEYREUSED, "Ключ идемпотентности уже использован с другим телом запроса"}},
+	{model.ErrIdempotencyKeyInProgress, apiError{http.StatusConflict, orderV1.ErrorCodeIDEMPOTENCYKEYINPROGRESS, "Запрос с этим ключом идемпотентности ещё выполняется"}},
+	{model.ErrInvoiceNotAvailable, apiError{http.StatusConflict, orderV1.ErrorCodeINVOICENOTAVAILABLE, "Счёт доступен только для оплаченного заказа"}},
+	{model.ErrOrderConflict, apiError{http.StatusConflict, orderV1.ErrorCodeORDERCONFLICT, "Заказ был изменён параллельным запросом"}},
+	{model.ErrInsufficientStock, apiError{http.StatusConflict, orderV1.ErrorCodeINSUFFICIENTSTOCK, "Недостаточно деталей на складе"}},
+	{model.ErrBOMInvalid, apiError{http.StatusUnprocessableEntity, orderV1.ErrorCodeBOMINVALID, "Детали не складываются в комплектную ракету"}},
+}
+
+// newAPIError переводит ошибку сервиса в ответ API: сначала доменные ошибки,
+// затем gRPC-коды смежных сервисов (inventory, payment). Всё остальное - 500
+// без подробностей, чтобы не раскрывать внутренние ошибки клиенту
+func newAPIError(err error) apiError {
+	var transitionErr *model.InvalidStatusTransitionError
+	if errors.As(err, &transitionErr) {
+		return apiError{
+			status:  http.StatusConflict,
+			code:    orderV1.ErrorCodeORDERINVALIDSTATUS,
+			message: fmt.Sprintf("Заказ в статусе %s нельзя перевести в %s", transitionErr.From, transitionErr.To),
+		}
+	}
+
+	for _, d := range domainErrors {
+		if errors.Is(err, d.err) {
+			return d.apiError
+		}
+	}
+
+	if st, ok := status.FromError(err); ok {
+		return newDownstreamAPIError(st)
+	}
+
+	return apiError{http.StatusInternalServerError, orderV1.ErrorCodeINTERNALERROR, "Внутренняя ошибка сервера"}
+}
+
+// newDownstreamAPIError переводит gRPC-статус смежного сервиса в ответ API
+func newDownstreamAPIError(st *status.Status) apiError {
+	switch st.Code() {
+	case codes.NotFound:
+		return apiError{http.StatusNotFound, orderV1.ErrorCodeRESOURCENOTFOUND, "Запрошенный ресурс не найден"}
+	case codes.InvalidArgument:
+		return apiError{http.StatusBadRequest, orderV1.ErrorCodeINVALIDREQUEST, "Смежный сервис отклонил запрос"}
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return apiError{http.StatusServiceUnavailable, orderV1.ErrorCodeSERVICEUNAVAILABLE, "Смежный сервис временно недоступен"}
+	default:
+		return apiError{http.StatusBadGateway, orderV1.ErrorCodeBADGATEWAY, "Смежный сервис вернул ошибку"}
+	}
+}
+
+// NewError - единая точка перевода ошибок, которые вернули обработчики, в HTTP-ответ
+func (a *api) NewError(ctx context.Context, err error) *orderV1.GenericErrorStatusCode {
+	return newErrorResponse(ctx, err)
+}
+
+// newErrorResponse переводит ошибку в HTTP-ответ и логирует её; общий для обработчиков ogen и потока событий
+func newErrorResponse(ctx context.Context, err error) *orderV1.GenericErrorStatusCode {
+	apiErr := newAPIError(err)
+
+	if apiErr.status >= http.StatusInternalServerError {
+		logger.Error(ctx, "Order API request failed",
+			zap.String("error_code", string(apiErr.code)),
+			zap.Error(err),
+		)
+	} else {
+		logger.Info(ctx, "Order API request rejected",
+			zap.String("error_code", string(apiErr.code)),
+			zap.Error(err),
+		)
+	}
+
+	return &orderV1.GenericErrorStatusCode{
+		StatusCode: apiErr.status,
+		Response: orderV1.GenericError{
+			Code:      apiErr.status,
+			ErrorCode: apiErr.code,
+			Message:   apiErr.message,
+		},
+	}
+}
@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func TestNewError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   orderV1.ErrorCode
+	}{
+		{
+			name:       "order not found",
+			err:        model.ErrOrderNotFound,
+			wantStatus: http.StatusNotFound,
+			wantCode:   orderV1.ErrorCodeORDERNOTFOUND,
+		},
+		{
+			name:       "wrapped parts not found",
+			err:        fmt.Errorf("%w: %s", model.ErrPartsNotFound, gofakeit.UUID()),
+			wantStatus: http.StatusNotFound,
+			wantCode:   orderV1.ErrorCodePARTNOTFOUND,
+		},
+		{
+			name:       "foreign order",
+			err:        model.ErrOrderForbidden,
+			wantStatus: http.StatusForbidden,
+			wantCode:   orderV1.ErrorCodeORDERFORBIDDEN,
+		},
+		{
+			name:       "payment not found",
+			err:        model.ErrPaymentNotFound,
+			wantStatus: http.StatusNotFound,
+			wantCode:   orderV1.ErrorCodePAYMENTNOTFOUND,
+		},
+		{
+			name:       "invalid webhook",
+			err:        fmt.Errorf("%w: secret length must be between 16 and 256", model.ErrWebhookInvalid),
+			wantStatus: http.StatusBadRequest,
+			wantCode:   orderV1.ErrorCodeINVALIDWEBHOOK,
+		},
+		{
+			name:       "not an admin",
+			err:        model.ErrAdminRequired,
+			wantStatus: http.StatusForbidden,
+			wantCode:   orderV1.ErrorCodeADMINREQUIRED,
+		},
+		{
+			name:       "invalid report",
+			err:        fmt.Errorf("%w: only one of DAY, WEEK, MONTH can be used", model.ErrReportInvalid),
+			wantStatus: http.StatusBadRequest,
+			wantCode:   orderV1.ErrorCodeINVALIDREPORT,
+		},
+		{
+			name:       "invalid status transition",
+			err:        &model.InvalidStatusTransitionError{From: model.OrderStatusPaid, To: model.OrderStatusPaid},
+			wantStatus: http.StatusConflict,
+			wantCode:   orderV1.ErrorCodeORDERINVALIDSTATUS,
+		},
+		{
+			name:       "idempotency key in progress",
+			err:        model.ErrIdempotencyKeyInProgress,
+			wantStatus: http.StatusConflict,
+			wantCode:   orderV1.ErrorCodeIDEMPOTENCYKEYINPROGRESS,
+		},
+		{
+			name:       "invoice for unpaid order",
+			err:        model.ErrInvoiceNotAvailable,
+			wantStatus: http.StatusConflict,
+			wantCode:   orderV1.ErrorCodeINVOICENOTAVAILABLE,
+		},
+		{
+			name:       "invalid filter",
+			err:        model.ErrOrdersInvalidFilter,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   orderV1.ErrorCodeINVALIDFILTER,
+		},
+		{
+			name:       "downstream not found",
+			err:        status.Error(codes.NotFound, "payment not found"),
+			wantStatus: http.StatusNotFound,
+			wantCode:   orderV1.ErrorCodeRESOURCENOTFOUND,
+		},
+		{
+			name:       "downstream unavailable",
+			err:        fmt.Errorf("reserve stock: %w", status.Error(codes.Unavailable, "connection refused")),
+			wantStatus: http.StatusServiceUnavailable,
+			wantCode:   orderV1.ErrorCodeSERVICEUNAVAILABLE,
+		},
+		{
+			name:       "downstream deadline exceeded",
+			err:        status.Error(codes.DeadlineExceeded, "deadline exceeded"),
+			wantStatus: http.StatusServiceUnavailable,
+			wantCode:   orderV1.ErrorCodeSERVICEUNAVAILABLE,
+		},
+		{
+			name:       "downstream internal error",
+			err:        status.Error(codes.Internal, "boom"),
+			wantStatus: http.StatusBadGateway,
+			wantCode:   orderV1.ErrorCodeBADGATEWAY,
+		},
+		{
+			name:       "unexpected error",
+			err:        errors.New("pq: connection reset by peer"),
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   orderV1.ErrorCodeINTERNALERROR,
+		},
+	}
+
+	logger.SetNopLogger()
+	a := NewAPI(mocks.NewOrderService(t), mocks.NewWebhookService(t), mocks.NewReportService(t), mocks.NewInvoiceService(t))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := a.NewError(context.Background(), tt.err)
+
+			assert.Equal(t, tt.wantStatus, res.StatusCode)
+			assert.Equal(t, tt.wantStatus, res.Response.Code)
+			assert.Equal(t, tt.wantCode, res.Response.ErrorCode)
+			assert.NotContains(t, res.Response.Message, tt.err.Error())
+		})
+	}
+}
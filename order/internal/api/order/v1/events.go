@@ -0,0 +1,179 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/converter"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/service"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+// EventsRoute - маршрут потока событий заказа, orderUUIDParam - параметр пути с UUID заказа
+const (
+	orderUUIDParam = "order_uuid"
+	EventsRoute    = "/api/v1/orders/{" + orderUUIDParam + "}/events"
+)
+
+// statusEvent - данные события status в потоке
+type statusEvent struct {
+	OrderUUID  string               `json:"order_uuid"`
+	FromStatus *orderV1.OrderStatus `json:"from_status,omitempty"`
+	ToStatus   orderV1.OrderStatus  `json:"to_status"`
+	ChangedAt  time.Time            `json:"changed_at"`
+}
+
+type eventsHandler struct {
+	orderService      service.OrderService
+	streamService     service.OrderStreamService
+	heartbeatInterval time.Duration
+}
+
+// NewEventsHandler создаёт обработчик GET EventsRoute. Поток отдаётся
+// в формате Server-Sent Events мимо ogen: сгенерированный сервер не умеет писать ответ частями
+func NewEventsHandler(
+	orderService service.OrderService,
+	streamService service.OrderStreamService,
+	heartbeatInterval time.Duration,
+) *eventsHandler {
+	return &eventsHandler{
+		orderService:      orderService,
+		streamService:     streamService,
+		heartbeatInterval: heartbeatInterval,
+	}
+}
+
+// ServeHTTP сразу отправляет текущий статус заказа, затем каждую смену статуса событием status.
+// Поток закрывается, если события могли потеряться; клиент переподключается и снова получает
+// текущий статус, поэтому пропусков не бывает, а повторы безвредны
+func (h *eventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userUUID, ok := userUUIDFromContext(ctx)
+	if !ok {
+		writeJSON(w, http.StatusUnauthorized, newUnauthorizedError())
+		return
+	}
+
+	orderUUID, err := uuid.Parse(chi.URLParam(r, orderUUIDParam))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, &orderV1.BadRequestError{
+			Code:      http.StatusBadRequest,
+			ErrorCode: orderV1.ErrorCodeINVALIDREQUEST,
+			Message:   "Некорректный UUID заказа",
+		})
+		return
+	}
+
+	// Подписываемся до чтения заказа, чтобы не пропустить смену статуса между ними
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events := h.streamService.Subscribe(ctx, orderUUID.String())
+
+	order, err := h.orderService.GetOrder(ctx, userUUID, orderUUID.String())
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if err = writeStatusEvent(w, statusEvent{
+		OrderUUID: order.UUID,
+		ToStatus:  converter.OrderStatusToDTO(order.Status),
+		ChangedAt: lastStatusChange(order),
+	}); err != nil {
+		return
+	}
+	if err = rc.Flush(); err != nil {
+		logger.Error(ctx, "Order event stream is not flushable", zap.Error(err))
+		return
+	}
+
+	heartbeat := time.NewTicker(h.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case change, ok := <-events:
+			if !ok {
+				return
+			}
+			err = writeStatusEvent(w, statusChangeToEvent(change))
+		case <-heartbeat.C:
+			_, err = fmt.Fprint(w, ": ping\n\n")
+		}
+
+		if err == nil {
+			err = rc.Flush()
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func statusChangeToEvent(change model.OrderStatusChange) statusEvent {
+	event := statusEvent{
+		OrderUUID: change.OrderUUID,
+		ToStatus:  converter.OrderStatusToDTO(change.ToStatus),
+		ChangedAt: change.OccurredAt,
+	}
+	if change.FromStatus != nil {
+		fromStatus := converter.OrderStatusToDTO(*change.FromStatus)
+		event.FromStatus = &fromStatus
+	}
+
+	return event
+}
+
+func lastStatusChange(order model.OrderData) time.Time {
+	if order.UpdatedAt != nil {
+		return *order.UpdatedAt
+	}
+
+	return order.CreatedAt
+}
+
+func writeStatusEvent(w http.ResponseWriter, event statusEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: status\ndata: %s\n\n", data)
+	return err
+}
+
+func writeError(ctx context.Context, w http.ResponseWriter, err error) {
+	res := newErrorResponse(ctx, err)
+	writeJSON(w, res.StatusCode, &res.Response)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body json.Marshaler) {
+	data, err := body.MarshalJSON()
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/go-chi/chi/v5"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+	grpcAuth "github.com/Alexey-step/rocket-factory/platform/pkg/middleware/grpc"
+	commonV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/common/v1"
+)
+
+func serveEvents(handler http.Handler, userUUID, orderUUID string) *httptest.ResponseRecorder {
+	r := chi.NewRouter()
+	r.Method(http.MethodGet, EventsRoute, handler)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/orders/"+orderUUID+"/events", nil)
+	if userUUID != "" {
+		req = req.WithContext(context.WithValue(req.Context(), grpcAuth.GetUserContextKey(), &commonV1.User{Uuid: userUUID}))
+	}
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestOrderEventsStreamsStatusChanges(t *testing.T) {
+	userUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+	createdAt := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	orderService := mocks.NewOrderService(t)
+	streamService := mocks.NewOrderStreamService(t)
+
+	// Подписка закрывается после первого события - как при переполнении буфера
+	events := make(chan model.OrderStatusChange, 1)
+	events <- model.OrderStatusChange{
+		OrderUUID:  orderUUID,
+		UserUUID:   userUUID,
+		FromStatus: lo.ToPtr(model.OrderStatusPendingPayment),
+		ToStatus:   model.OrderStatusCanceled,
+		OccurredAt: createdAt.Add(time.Minute),
+	}
+	close(events)
+
+	streamService.On("Subscribe", mock.Anything, orderUUID).Return((<-chan model.OrderStatusChange)(events)).Once()
+	orderService.On("GetOrder", mock.Anything, userUUID, orderUUID).Return(model.OrderData{
+		UUID:      orderUUID,
+		UserUUID:  userUUID,
+		Status:    model.OrderStatusPendingPayment,
+		CreatedAt: createdAt,
+	}, nil).Once()
+
+	rec := serveEvents(NewEventsHandler(orderService, streamService, time.Minute), userUUID, orderUUID)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	assert.Equal(t, strings.Join([]string{
+		`event: status`,
+		`data: {"order_uuid":"` + orderUUID + `","to_status":"PENDING_PAYMENT","changed_at":"2025-10-01T12:00:00Z"}`,
+		``,
+		`event: status`,
+		`data: {"order_uuid":"` + orderUUID + `","from_status":"PENDING_PAYMENT","to_status":"CANCELLED","changed_at":"2025-10-01T12:01:00Z"}`,
+		``,
+		``,
+	}, "\n"), rec.Body.String())
+}
+
+func TestOrderEventsForeignOrder(t *testing.T) {
+	userUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+
+	logger.SetNopLogger()
+	orderService := mocks.NewOrderService(t)
+	streamService := mocks.NewOrderStreamService(t)
+
+	streamService.On("Subscribe", mock.Anything, orderUUID).Return((<-chan model.OrderStatusChange)(make(chan model.OrderStatusChange))).Once()
+	orderService.On("GetOrder", mock.Anything, userUUID, orderUUID).Return(model.OrderData{}, model.ErrOrderForbidden).Once()
+
+	rec := serveEvents(NewEventsHandler(orderService, streamService, time.Minute), userUUID, orderUUID)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"error_code":"ORDER_FORBIDDEN"`)
+}
+
+func TestOrderEventsUnauthorized(t *testing.T) {
+	rec := serveEvents(NewEventsHandler(mocks.NewOrderService(t), mocks.NewOrderStreamService(t), time.Minute), "", gofakeit.UUID())
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/converter"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) GetOrderHistory(ctx context.Context, params orderV1.GetOrderHistoryParams) (orderV1.GetOrderHistoryRes, error) {
+	userUUID, ok := userUUIDFromContext(ctx)
+	if !ok {
+		return newUnauthorizedError(), nil
+	}
+
+	history, err := a.service.GetOrderHistory(ctx, userUUID, params.OrderUUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderV1.GetOrderHistoryResponse{
+		Data: converter.OrderStatusHistoryToDTO(history),
+	}, nil
+}
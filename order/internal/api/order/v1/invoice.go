@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/converter"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) GetOrderInvoice(ctx context.Context, params orderV1.GetOrderInvoiceParams) (orderV1.GetOrderInvoiceRes, error) {
+	userUUID, ok := userUUIDFromContext(ctx)
+	if !ok {
+		return newUnauthorizedError(), nil
+	}
+
+	invoice, err := a.invoiceService.GetInvoice(ctx, userUUID, params.OrderUUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.InvoiceToDTO(invoice, converter.InvoiceFormatToModel(params.Format)), nil
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/converter"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) ListOrders(ctx context.Context, params orderV1.ListOrdersParams) (orderV1.ListOrdersRes, error) {
+	userUUID, ok := userUUIDFromContext(ctx)
+	if !ok {
+		return newUnauthorizedError(), nil
+	}
+
+	page, err := a.service.ListOrders(ctx, converter.ListOrdersParamsToFilter(userUUID, params))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.OrdersPageToDTO(page), nil
+}
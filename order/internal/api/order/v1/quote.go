@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/converter"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) QuoteOrder(ctx context.Context, req *orderV1.CreateOrderRequest, _ orderV1.QuoteOrderParams) (orderV1.QuoteOrderRes, error) {
+	if _, ok := userUUIDFromContext(ctx); !ok {
+		return newUnauthorizedError(), nil
+	}
+
+	quote, err := a.service.QuoteOrder(ctx, converter.CreateOrderRequestToModel(req))
+	if err != nil {
+		var bomErr *model.BOMValidationError
+		if errors.As(err, &bomErr) {
+			return converter.BOMValidationErrorToDTO(bomErr), nil
+		}
+		return nil, err
+	}
+
+	return converter.OrderQuoteToDTO(quote), nil
+}
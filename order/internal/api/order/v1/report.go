@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/converter"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) GetOrdersReport(ctx context.Context, params orderV1.GetOrdersReportParams) (orderV1.GetOrdersReportRes, error) {
+	userUUID, ok := userUUIDFromContext(ctx)
+	if !ok {
+		return newUnauthorizedError(), nil
+	}
+
+	report, err := a.reportService.GetOrdersReport(ctx, userUUID, converter.OrdersReportParamsToModel(params))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.OrdersReportToDTO(report), nil
+}
@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	customMiddleware "github.com/Alexey-step/rocket-factory/platform/pkg/middleware/http"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+// userUUIDFromContext возвращает UUID пользователя, которого AuthMiddleware положил в контекст
+func userUUIDFromContext(ctx context.Context) (string, bool) {
+	user, ok := customMiddleware.GetUserFromContext(ctx)
+	if !ok || user.GetUuid() == "" {
+		return "", false
+	}
+
+	return user.GetUuid(), true
+}
+
+func newUnauthorizedError() *orderV1.UnauthorizedError {
+	return &orderV1.UnauthorizedError{
+		Code:      http.StatusUnauthorized,
+		ErrorCode: orderV1.ErrorCodeUNAUTHORIZED,
+		Message:   "Пользователь не авторизован",
+	}
+}
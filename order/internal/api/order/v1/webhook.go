@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/converter"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+// defaultWebhookDeliveriesLimit совпадает со значением по умолчанию в спецификации
+const defaultWebhookDeliveriesLimit = 20
+
+func (a *api) CreateWebhook(ctx context.Context, req *orderV1.CreateWebhookRequest, _ orderV1.CreateWebhookParams) (orderV1.CreateWebhookRes, error) {
+	userUUID, ok := userUUIDFromContext(ctx)
+	if !ok {
+		return newUnauthorizedError(), nil
+	}
+
+	webhook, err := a.webhookService.CreateWebhook(ctx, userUUID, converter.CreateWebhookRequestToModel(req))
+	if err != nil {
+		return nil, err
+	}
+
+	dto := converter.WebhookToDTO(webhook)
+	return &dto, nil
+}
+
+func (a *api) ListWebhooks(ctx context.Context, _ orderV1.ListWebhooksParams) (orderV1.ListWebhooksRes, error) {
+	userUUID, ok := userUUIDFromContext(ctx)
+	if !ok {
+		return newUnauthorizedError(), nil
+	}
+
+	webhooks, err := a.webhookService.ListWebhooks(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderV1.ListWebhooksResponse{
+		Data: converter.WebhooksToDTO(webhooks),
+	}, nil
+}
+
+func (a *api) DeleteWebhook(ctx context.Context, params orderV1.DeleteWebhookParams) (orderV1.DeleteWebhookRes, error) {
+	userUUID, ok := userUUIDFromContext(ctx)
+	if !ok {
+		return newUnauthorizedError(), nil
+	}
+
+	if err := a.webhookService.DeleteWebhook(ctx, userUUID, params.WebhookUUID.String()); err != nil {
+		return nil, err
+	}
+
+	return &orderV1.DeleteWebhookNoContent{}, nil
+}
+
+func (a *api) EnableWebhook(ctx context.Context, params orderV1.EnableWebhookParams) (orderV1.EnableWebhookRes, error) {
+	userUUID, ok := userUUIDFromContext(ctx)
+	if !ok {
+		return newUnauthorizedError(), nil
+	}
+
+	webhook, err := a.webhookService.EnableWebhook(ctx, userUUID, params.WebhookUUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	dto := converter.WebhookToDTO(webhook)
+	return &dto, nil
+}
+
+func (a *api) ListWebhookDeliveries(ctx context.Context, params orderV1.ListWebhookDeliveriesParams) (orderV1.ListWebhookDeliveriesRes, error) {
+	userUUID, ok := userUUIDFromContext(ctx)
+	if !ok {
+		return newUnauthorizedError(), nil
+	}
+
+	deliveries, err := a.webhookService.ListWebhookDeliveries(ctx, userUUID, params.WebhookUUID.String(), params.Limit.Or(defaultWebhookDeliveriesLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderV1.ListWebhookDeliveriesResponse{
+		Data: converter.WebhookDeliveriesToDTO(deliveries),
+	}, nil
+}
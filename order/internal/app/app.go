@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"time"
 
@@ -10,12 +11,19 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
 
+	orderAPI "github.com/Alexey-step/rocket-factory/order/internal/api/order/v1"
 	"github.com/Alexey-step/rocket-factory/order/internal/config"
 	"github.com/Alexey-step/rocket-factory/platform/pkg/closer"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/grpc/health"
 	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+	interceptor "github.com/Alexey-step/rocket-factory/platform/pkg/middleware/grpc"
 	customMiddleware "github.com/Alexey-step/rocket-factory/platform/pkg/middleware/http"
 	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
+	orderGRPCV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/order/v1"
 )
 
 const (
@@ -25,6 +33,8 @@ const (
 type App struct {
 	diContainer *diContainer
 	httpServer  *http.Server
+	grpcServer  *grpc.Server
+	listener    net.Listener
 }
 
 func New(ctx context.Context) (*App, error) {
@@ -40,7 +50,7 @@ func New(ctx context.Context) (*App, error) {
 
 func (a *App) Run(ctx context.Context) error {
 	// Канал для ошибок от компонентов
-	errCh := make(chan error, 2)
+	errCh := make(chan error, 5)
 
 	// Контекст для остановки всех горутин
 	ctx, cancel := context.WithCancel(ctx)
@@ -53,6 +63,34 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	// Публикация событий из outbox
+	go func() {
+		if err := a.runOutboxRelay(ctx); err != nil {
+			errCh <- errors.Errorf("outbox relay crashed: %v", err)
+		}
+	}()
+
+	// Отмена неоплаченных заказов
+	go func() {
+		if err := a.runOrderExpirySweeper(ctx); err != nil {
+			errCh <- errors.Errorf("order expiry sweeper crashed: %v", err)
+		}
+	}()
+
+	// Отправка событий на вебхуки
+	go func() {
+		if err := a.runWebhookDispatcher(ctx); err != nil {
+			errCh <- errors.Errorf("webhook dispatcher crashed: %v", err)
+		}
+	}()
+
+	// Раздача смен статусов в потоки событий этой реплики
+	go func() {
+		if err := a.runOrderStreamSubscriber(ctx); err != nil {
+			errCh <- errors.Errorf("order stream subscriber crashed: %v", err)
+		}
+	}()
+
 	// HTTP сервер
 	go func() {
 		if err := a.runHTTPServer(ctx); err != nil {
@@ -60,6 +98,13 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	// gRPC сервер
+	go func() {
+		if err := a.runGRPCServer(ctx); err != nil {
+			errCh <- errors.Errorf("gRPC server crashed: %v", err)
+		}
+	}()
+
 	// Ожидание либо ошибки, либо завершения контекста (например, сигнал SIGINT/SIGTERM)
 	select {
 	case <-ctx.Done():
@@ -82,6 +127,8 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initLogger,
 		a.initCloser,
 		a.initHTTPServer,
+		a.initListener,
+		a.initGRPCServer,
 		a.initMigrations,
 	}
 
@@ -135,11 +182,18 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 	// Добавляем middleware
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
+	// Лимит проверяем до аутентификации, чтобы поток запросов не доходил до IAM
+	r.Use(a.diContainer.RateLimitMiddleware(ctx).Handle)
 	r.Use(authMiddleware.Handle)
 	r.Use(customMiddleware.RequestLogger)
-	r.Use(middleware.Timeout(10 * time.Second))
 
-	r.Mount("/", serv)
+	// Поток событий живёт дольше обычного запроса, поэтому общий таймаут на него не действует
+	r.Method(http.MethodGet, orderAPI.EventsRoute, a.diContainer.OrderEventsAPI(ctx))
+
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.Timeout(10 * time.Second))
+		r.Mount("/", serv)
+	})
 
 	a.httpServer = &http.Server{
 		Addr:        config.AppConfig().OrderHTTP.Address(),
@@ -172,6 +226,61 @@ func (a *App) runHTTPServer(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) initListener(_ context.Context) error {
+	listener, err := net.Listen("tcp", config.AppConfig().OrderGRPC.Address())
+	if err != nil {
+		return err
+	}
+
+	closer.AddNamed("TCP listener", func(ctx context.Context) error {
+		lerr := listener.Close()
+		if lerr != nil && !errors.Is(lerr, net.ErrClosed) {
+			return lerr
+		}
+
+		return nil
+	})
+
+	a.listener = listener
+
+	return nil
+}
+
+func (a *App) initGRPCServer(ctx context.Context) error {
+	authInterceptor := interceptor.NewAuthInterceptor(a.diContainer.IamClient(ctx))
+	a.grpcServer = grpc.NewServer(
+		grpc.Creds(insecure.NewCredentials()),
+		grpc.ChainUnaryInterceptor(
+			authInterceptor.Unary(),
+			interceptor.LoggerInterceptor(),
+			interceptor.Validate(),
+		))
+
+	closer.AddNamed("gRPC server", func(ctx context.Context) error {
+		a.grpcServer.GracefulStop()
+		return nil
+	})
+
+	reflection.Register(a.grpcServer)
+
+	health.RegisterService(a.grpcServer, a.diContainer.DownstreamHealth()...)
+
+	orderGRPCV1.RegisterOrderServiceServer(a.grpcServer, a.diContainer.OrderGRPCV1API(ctx))
+
+	return nil
+}
+
+func (a *App) runGRPCServer(ctx context.Context) error {
+	logger.Info(ctx, fmt.Sprintf("🚀 gRPC OrderService server listening on %s", config.AppConfig().OrderGRPC.Address()))
+
+	err := a.grpcServer.Serve(a.listener)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (a *App) runConsumer(ctx context.Context) error {
 	logger.Info(ctx, "🚀 OrderPaid Kafka consumer running")
 
@@ -182,3 +291,47 @@ func (a *App) runConsumer(ctx context.Context) error {
 
 	return nil
 }
+
+func (a *App) runOutboxRelay(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Outbox relay running")
+
+	err := a.diContainer.OutboxRelay(ctx).Run(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *App) runOrderStreamSubscriber(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Order stream subscriber running")
+
+	err := a.diContainer.OrderStreamService(ctx).RunSubscriber(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *App) runWebhookDispatcher(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Webhook dispatcher running")
+
+	err := a.diContainer.WebhookDispatcherService(ctx).RunDispatcher(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *App) runOrderExpirySweeper(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Order expiry sweeper running")
+
+	err := a.diContainer.OrderExpiryService(ctx).RunSweeper(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -4,63 +4,128 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 
 	"github.com/IBM/sarama"
+	redigo "github.com/gomodule/redigo/redis"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/stdlib"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	orderGRPCAPI "github.com/Alexey-step/rocket-factory/order/internal/api/grpc/order/v1"
 	v1 "github.com/Alexey-step/rocket-factory/order/internal/api/order/v1"
+	"github.com/Alexey-step/rocket-factory/order/internal/client/exchange"
+	staticRates "github.com/Alexey-step/rocket-factory/order/internal/client/exchange/static"
 	grpcClient "github.com/Alexey-step/rocket-factory/order/internal/client/grpc"
 	iamClient "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/iam/v1"
 	inventoryClient "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/inventory/v1"
 	paymentClient "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/payment/v1"
+	httpClient "github.com/Alexey-step/rocket-factory/order/internal/client/http"
+	webhookClient "github.com/Alexey-step/rocket-factory/order/internal/client/http/webhook"
 	"github.com/Alexey-step/rocket-factory/order/internal/config"
 	kafkaConverter "github.com/Alexey-step/rocket-factory/order/internal/converter/kafka"
 	"github.com/Alexey-step/rocket-factory/order/internal/converter/kafka/decoder"
 	"github.com/Alexey-step/rocket-factory/order/internal/repository"
+	idempotencyRepository "github.com/Alexey-step/rocket-factory/order/internal/repository/idempotency"
+	invoiceRepository "github.com/Alexey-step/rocket-factory/order/internal/repository/invoice"
 	orderRepository "github.com/Alexey-step/rocket-factory/order/internal/repository/order"
+	promoRepository "github.com/Alexey-step/rocket-factory/order/internal/repository/promo"
+	reportRepository "github.com/Alexey-step/rocket-factory/order/internal/repository/report"
+	webhookRepository "github.com/Alexey-step/rocket-factory/order/internal/repository/webhook"
 	"github.com/Alexey-step/rocket-factory/order/internal/service"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/bom"
 	orderConsumer "github.com/Alexey-step/rocket-factory/order/internal/service/consumer/order_consumer"
+	webhookDispatcher "github.com/Alexey-step/rocket-factory/order/internal/service/dispatcher/webhook_dispatcher"
+	orderExpiry "github.com/Alexey-step/rocket-factory/order/internal/service/expiry/order_expiry"
+	invoiceService "github.com/Alexey-step/rocket-factory/order/internal/service/invoice"
 	orderService "github.com/Alexey-step/rocket-factory/order/internal/service/order"
 	orderProducer "github.com/Alexey-step/rocket-factory/order/internal/service/producer/order_producer"
+	reportService "github.com/Alexey-step/rocket-factory/order/internal/service/report"
+	orderStream "github.com/Alexey-step/rocket-factory/order/internal/service/stream/order_stream"
+	webhookService "github.com/Alexey-step/rocket-factory/order/internal/service/webhook"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/cache"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/cache/redis"
 	"github.com/Alexey-step/rocket-factory/platform/pkg/closer"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/grpc/health"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/grpc/resilience"
 	wrappedKafka "github.com/Alexey-step/rocket-factory/platform/pkg/kafka"
 	wrappedKafkaConsumer "github.com/Alexey-step/rocket-factory/platform/pkg/kafka/consumer"
 	wrappedKafkaProducer "github.com/Alexey-step/rocket-factory/platform/pkg/kafka/producer"
 	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+	httpMiddleware "github.com/Alexey-step/rocket-factory/platform/pkg/middleware/http"
 	kafkaMiddleware "github.com/Alexey-step/rocket-factory/platform/pkg/middleware/kafka"
 	"github.com/Alexey-step/rocket-factory/platform/pkg/migrator"
 	pgMigrator "github.com/Alexey-step/rocket-factory/platform/pkg/migrator/pg"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/outbox"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
 	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
 	authV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/auth/v1"
 	inventory_v1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/inventory/v1"
+	orderGRPCV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/order/v1"
 	paymentV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/payment/v1"
 	userV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/user/v1"
 )
 
 type diContainer struct {
 	orderV1API      orderV1.Handler
+	orderEventsAPI  http.Handler
+	orderGRPCV1API  orderGRPCV1.OrderServiceServer
 	orderService    service.OrderService
 	orderRepository repository.OrderRepository
 
+	idempotencyRepository repository.IdempotencyRepository
+	promoCodeRepository   repository.PromoCodeRepository
+	webhookRepository     repository.WebhookRepository
+	reportRepository      repository.ReportRepository
+	invoiceRepository     repository.InvoiceRepository
+
 	inventoryClient grpcClient.InventoryClient
 	paymentClient   grpcClient.PaymentClient
 	iamClient       grpcClient.IamClient
+	webhookClient   httpClient.WebhookClient
+
+	inventoryResilience *resilience.Client
+	paymentResilience   *resilience.Client
+	iamResilience       *resilience.Client
+
+	rateProvider exchange.RateProvider
+	bomValidator service.BOMValidator
 
 	postgresDB *pgxpool.Pool
 	migrator   migrator.Migrator
+	txManager  txmanager.TxManager
+
+	redisPool           *redigo.Pool
+	redisClient         cache.RedisClient
+	rateLimitMiddleware *httpMiddleware.RateLimitMiddleware
+
+	outboxWriter outbox.Writer
+	outboxRelay  outbox.Relay
 
 	orderProducerService service.OrderProducerService
 	orderConsumerService service.OrderConsumerService
+	orderExpiryService   service.OrderExpiryService
+
+	webhookService           service.WebhookService
+	webhookDispatcherService service.WebhookDispatcherService
+	orderStreamService       service.OrderStreamService
+	reportService            service.ReportService
+	invoiceService           service.InvoiceService
 
 	consumerGroup sarama.ConsumerGroup
 	syncProducer  sarama.SyncProducer
 
 	orderPaidProducer      wrappedKafka.Producer
+	orderExpiredProducer   wrappedKafka.Producer
+	orderRefundedProducer  wrappedKafka.Producer
+	orderCreatedProducer   wrappedKafka.Producer
+	orderCancelledProducer wrappedKafka.Producer
+	orderCompletedProducer wrappedKafka.Producer
 	orderAssembledConsumer wrappedKafka.Consumer
 	orderAssembledDecoder  kafkaConverter.OrderAssembledDecoder
+
+	orderAssemblyStartedDecoder kafkaConverter.OrderAssemblyStartedDecoder
 }
 
 func NewDIContainer() *diContainer {
@@ -69,29 +134,132 @@ func NewDIContainer() *diContainer {
 
 func (d *diContainer) OrderV1API(ctx context.Context) orderV1.Handler {
 	if d.orderV1API == nil {
-		d.orderV1API = v1.NewAPI(d.OrderService(ctx))
+		d.orderV1API = v1.NewAPI(
+			d.OrderService(ctx),
+			d.WebhookService(ctx),
+			d.ReportService(ctx),
+			d.InvoiceService(ctx),
+		)
 	}
 
 	return d.orderV1API
 }
 
+func (d *diContainer) OrderEventsAPI(ctx context.Context) http.Handler {
+	if d.orderEventsAPI == nil {
+		d.orderEventsAPI = v1.NewEventsHandler(
+			d.OrderService(ctx),
+			d.OrderStreamService(ctx),
+			config.AppConfig().OrderStream.HeartbeatInterval(),
+		)
+	}
+
+	return d.orderEventsAPI
+}
+
+func (d *diContainer) OrderGRPCV1API(ctx context.Context) orderGRPCV1.OrderServiceServer {
+	if d.orderGRPCV1API == nil {
+		d.orderGRPCV1API = orderGRPCAPI.NewAPI(d.OrderService(ctx))
+	}
+
+	return d.orderGRPCV1API
+}
+
 func (d *diContainer) OrderService(ctx context.Context) service.OrderService {
 	if d.orderService == nil {
 		d.orderService = orderService.NewService(
 			d.OrderRepository(ctx),
+			d.IdempotencyRepository(ctx),
+			d.PromoCodeRepository(ctx),
 			d.InventoryClient(ctx),
 			d.PaymentClient(ctx),
+			d.RateProvider(ctx),
+			d.BOMValidator(ctx),
 			d.OrderProducerService(ctx),
+			d.WebhookService(ctx),
+			d.OrderStreamService(ctx),
+			d.TxManager(ctx),
 		)
 	}
 	return d.orderService
 }
 
+func (d *diContainer) OrderStreamService(_ context.Context) service.OrderStreamService {
+	if d.orderStreamService == nil {
+		d.orderStreamService = orderStream.NewService(
+			d.RedisClient(),
+			config.AppConfig().OrderStream.Channel(),
+			config.AppConfig().OrderStream.BufferSize(),
+		)
+	}
+	return d.orderStreamService
+}
+
+func (d *diContainer) WebhookService(ctx context.Context) service.WebhookService {
+	if d.webhookService == nil {
+		cfg := config.AppConfig().Webhook
+		d.webhookService = webhookService.NewService(
+			d.WebhookRepository(ctx),
+			d.WebhookClient(ctx),
+			d.TxManager(ctx),
+			webhookService.Config{
+				MaxAttempts:          cfg.MaxAttempts(),
+				RetryBackoff:         cfg.RetryBackoff(),
+				MaxRetryBackoff:      cfg.MaxRetryBackoff(),
+				DisableAfterFailures: cfg.DisableAfterFailures(),
+				ClaimLease:           cfg.ClaimLease(),
+			},
+		)
+	}
+	return d.webhookService
+}
+
+func (d *diContainer) ReportService(ctx context.Context) service.ReportService {
+	if d.reportService == nil {
+		d.reportService = reportService.NewService(
+			d.ReportRepository(ctx),
+			config.AppConfig().OrderReport.AdminUserUUIDs(),
+			config.AppConfig().OrderReport.MaxRange(),
+		)
+	}
+	return d.reportService
+}
+
+func (d *diContainer) InvoiceService(ctx context.Context) service.InvoiceService {
+	if d.invoiceService == nil {
+		cfg := config.AppConfig().Invoice
+		d.invoiceService = invoiceService.NewService(
+			d.OrderRepository(ctx),
+			d.InvoiceRepository(ctx),
+			d.InventoryClient(ctx),
+			d.TxManager(ctx),
+			invoiceService.Config{
+				SellerName:    cfg.SellerName(),
+				SellerAddress: cfg.SellerAddress(),
+				NumberPrefix:  cfg.NumberPrefix(),
+			},
+		)
+	}
+	return d.invoiceService
+}
+
+func (d *diContainer) WebhookDispatcherService(ctx context.Context) service.WebhookDispatcherService {
+	if d.webhookDispatcherService == nil {
+		d.webhookDispatcherService = webhookDispatcher.NewService(
+			d.WebhookService(ctx),
+			config.AppConfig().Webhook.PollInterval(),
+			config.AppConfig().Webhook.BatchSize(),
+		)
+	}
+	return d.webhookDispatcherService
+}
+
 func (d *diContainer) InventoryClient(_ context.Context) grpcClient.InventoryClient {
 	if d.inventoryClient == nil {
 		inventoryConn, err := grpc.NewClient(
 			config.AppConfig().Inventory.Address(),
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithChainUnaryInterceptor(d.InventoryResilience().UnaryInterceptor()),
 		)
 		if err != nil {
 			log.Printf("failed to connect to invertory: %v\n", err)
@@ -101,16 +269,97 @@ func (d *diContainer) InventoryClient(_ context.Context) grpcClient.InventoryCli
 			return inventoryConn.Close()
 		})
 
-		d.inventoryClient = inventoryClient.NewClient(inventory_v1.NewInventoryServiceClient(inventoryConn))
+		d.inventoryClient = inventoryClient.NewClient(
+			inventory_v1.NewInventoryServiceClient(inventoryConn),
+			config.AppConfig().Inventory.ServiceToken(),
+		)
 	}
 	return d.inventoryClient
 }
 
+func (d *diContainer) InventoryResilience() *resilience.Client {
+	if d.inventoryResilience == nil {
+		d.inventoryResilience = newResilienceClient("inventory", config.AppConfig().Inventory,
+			inventory_v1.InventoryService_ListParts_FullMethodName,
+		)
+	}
+	return d.inventoryResilience
+}
+
+func (d *diContainer) PaymentResilience() *resilience.Client {
+	if d.paymentResilience == nil {
+		// PayOrder не повторяется: без ключа идемпотентности повтор спишет деньги ещё раз.
+		// Возврат у транзакции один, поэтому RefundPayment повторять безопасно
+		d.paymentResilience = newResilienceClient("payment", config.AppConfig().Payment,
+			paymentV1.PaymentService_RefundPayment_FullMethodName,
+		)
+	}
+	return d.paymentResilience
+}
+
+func (d *diContainer) IamResilience() *resilience.Client {
+	if d.iamResilience == nil {
+		d.iamResilience = newResilienceClient("iam", config.AppConfig().Iam,
+			authV1.AuthService_Whoami_FullMethodName,
+		)
+	}
+	return d.iamResilience
+}
+
+// DownstreamHealth - состояние circuit breaker'ов смежных сервисов для gRPC health
+func (d *diContainer) DownstreamHealth() []health.Checker {
+	return []health.Checker{
+		d.InventoryResilience(),
+		d.PaymentResilience(),
+		d.IamResilience(),
+	}
+}
+
+func newResilienceClient(name string, cfg config.GRPCClientConfig, retryMethods ...string) *resilience.Client {
+	return resilience.NewClient(resilience.Config{
+		Name:                    name,
+		Timeout:                 cfg.Timeout(),
+		MethodTimeouts:          cfg.MethodTimeouts(),
+		RetryMethods:            retryMethods,
+		MaxAttempts:             cfg.RetryMaxAttempts(),
+		RetryBackoff:            cfg.RetryBackoff(),
+		MaxRetryBackoff:         cfg.MaxRetryBackoff(),
+		BreakerFailureThreshold: cfg.BreakerFailureThreshold(),
+		BreakerOpenTimeout:      cfg.BreakerOpenTimeout(),
+	}, logger.Logger())
+}
+
+func (d *diContainer) RateProvider(_ context.Context) exchange.RateProvider {
+	if d.rateProvider == nil {
+		provider, err := staticRates.NewProvider(config.AppConfig().ExchangeRates.File())
+		if err != nil {
+			panic(fmt.Sprintf("failed to load exchange rates: %s\n", err.Error()))
+		}
+
+		d.rateProvider = provider
+	}
+	return d.rateProvider
+}
+
+func (d *diContainer) BOMValidator(_ context.Context) service.BOMValidator {
+	if d.bomValidator == nil {
+		rules, err := bom.LoadRules(config.AppConfig().BOMRules.File())
+		if err != nil {
+			panic(fmt.Sprintf("failed to load bom rules: %s\n", err.Error()))
+		}
+
+		d.bomValidator = bom.NewValidator(rules...)
+	}
+
+	return d.bomValidator
+}
+
 func (d *diContainer) PaymentClient(_ context.Context) grpcClient.PaymentClient {
 	if d.paymentClient == nil {
 		paymentConn, err := grpc.NewClient(
 			config.AppConfig().Payment.Address(),
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithChainUnaryInterceptor(d.PaymentResilience().UnaryInterceptor()),
 		)
 		if err != nil {
 			log.Printf("failed to connect to payment: %v\n", err)
@@ -130,6 +379,7 @@ func (d *diContainer) IamClient(_ context.Context) grpcClient.IamClient {
 		iamConn, err := grpc.NewClient(
 			config.AppConfig().Iam.Address(),
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithChainUnaryInterceptor(d.IamResilience().UnaryInterceptor()),
 		)
 		if err != nil {
 			log.Printf("failed to connect to iam: %v\n", err)
@@ -154,6 +404,57 @@ func (d *diContainer) OrderRepository(ctx context.Context) repository.OrderRepos
 	return d.orderRepository
 }
 
+func (d *diContainer) IdempotencyRepository(ctx context.Context) repository.IdempotencyRepository {
+	if d.idempotencyRepository == nil {
+		d.idempotencyRepository = idempotencyRepository.NewIdempotencyRepository(
+			d.PostgresDB(ctx),
+			config.AppConfig().Idempotency.KeyTTL(),
+		)
+	}
+	return d.idempotencyRepository
+}
+
+func (d *diContainer) PromoCodeRepository(ctx context.Context) repository.PromoCodeRepository {
+	if d.promoCodeRepository == nil {
+		d.promoCodeRepository = promoRepository.NewPromoCodeRepository(d.PostgresDB(ctx))
+	}
+	return d.promoCodeRepository
+}
+
+func (d *diContainer) WebhookRepository(ctx context.Context) repository.WebhookRepository {
+	if d.webhookRepository == nil {
+		d.webhookRepository = webhookRepository.NewWebhookRepository(d.PostgresDB(ctx))
+	}
+	return d.webhookRepository
+}
+
+func (d *diContainer) ReportRepository(ctx context.Context) repository.ReportRepository {
+	if d.reportRepository == nil {
+		d.reportRepository = reportRepository.NewReportRepository(
+			d.PostgresDB(ctx),
+			config.AppConfig().OrderReport.StatementTimeout(),
+		)
+	}
+	return d.reportRepository
+}
+
+func (d *diContainer) InvoiceRepository(ctx context.Context) repository.InvoiceRepository {
+	if d.invoiceRepository == nil {
+		d.invoiceRepository = invoiceRepository.NewInvoiceRepository(d.PostgresDB(ctx))
+	}
+	return d.invoiceRepository
+}
+
+func (d *diContainer) WebhookClient(_ context.Context) httpClient.WebhookClient {
+	if d.webhookClient == nil {
+		d.webhookClient = webhookClient.NewClient(
+			config.AppConfig().Webhook.RequestTimeout(),
+			config.AppConfig().Webhook.AllowPrivateNetworks(),
+		)
+	}
+	return d.webhookClient
+}
+
 func (d *diContainer) PostgresDB(ctx context.Context) *pgxpool.Pool {
 	if d.postgresDB == nil {
 		pool, err := pgxpool.New(ctx, config.AppConfig().Postgres.URI())
@@ -178,6 +479,88 @@ func (d *diContainer) PostgresDB(ctx context.Context) *pgxpool.Pool {
 	return d.postgresDB
 }
 
+func (d *diContainer) RedisPool() *redigo.Pool {
+	if d.redisPool == nil {
+		d.redisPool = &redigo.Pool{
+			MaxIdle:     config.AppConfig().Redis.MaxIDLE(),
+			IdleTimeout: config.AppConfig().Redis.IDLETimeout(),
+			DialContext: func(ctx context.Context) (redigo.Conn, error) {
+				return redigo.DialContext(ctx, "tcp", config.AppConfig().Redis.Address())
+			},
+		}
+
+		pool := d.redisPool
+		closer.AddNamed("Redis pool", func(ctx context.Context) error {
+			return pool.Close()
+		})
+	}
+
+	return d.redisPool
+}
+
+func (d *diContainer) RedisClient() cache.RedisClient {
+	if d.redisClient == nil {
+		d.redisClient = redis.NewClient(d.RedisPool(), logger.Logger(), config.AppConfig().Redis.ConnectionTimeout())
+	}
+
+	return d.redisClient
+}
+
+func (d *diContainer) RateLimitMiddleware(_ context.Context) *httpMiddleware.RateLimitMiddleware {
+	if d.rateLimitMiddleware == nil {
+		policy, err := httpMiddleware.LoadRateLimitPolicy(config.AppConfig().RateLimit.File())
+		if err != nil {
+			panic(fmt.Sprintf("failed to load rate limit policy: %s\n", err.Error()))
+		}
+
+		d.rateLimitMiddleware = httpMiddleware.NewRateLimitMiddleware(d.RedisClient(), policy)
+	}
+
+	return d.rateLimitMiddleware
+}
+
+func (d *diContainer) TxManager(ctx context.Context) txmanager.TxManager {
+	if d.txManager == nil {
+		d.txManager = txmanager.NewTxManager(d.PostgresDB(ctx))
+	}
+
+	return d.txManager
+}
+
+func (d *diContainer) OutboxWriter(ctx context.Context) outbox.Writer {
+	if d.outboxWriter == nil {
+		d.outboxWriter = outbox.NewWriter(d.PostgresDB(ctx), outbox.DefaultTable)
+	}
+
+	return d.outboxWriter
+}
+
+func (d *diContainer) OutboxRelay(ctx context.Context) outbox.Relay {
+	if d.outboxRelay == nil {
+		d.outboxRelay = outbox.NewRelay(
+			d.PostgresDB(ctx),
+			map[string]wrappedKafka.Producer{
+				config.AppConfig().OrderPaidProducer.TopicName():      d.OrderPaidProducer(),
+				config.AppConfig().OrderExpiredProducer.TopicName():   d.OrderExpiredProducer(),
+				config.AppConfig().OrderRefundedProducer.TopicName():  d.OrderRefundedProducer(),
+				config.AppConfig().OrderCreatedProducer.TopicName():   d.OrderCreatedProducer(),
+				config.AppConfig().OrderCancelledProducer.TopicName(): d.OrderCancelledProducer(),
+				config.AppConfig().OrderCompletedProducer.TopicName(): d.OrderCompletedProducer(),
+			},
+			outbox.RelayConfig{
+				Table:        outbox.DefaultTable,
+				BatchSize:    config.AppConfig().Outbox.BatchSize(),
+				PollInterval: config.AppConfig().Outbox.PollInterval(),
+				MaxBackoff:   config.AppConfig().Outbox.MaxBackoff(),
+				Retention:    config.AppConfig().Outbox.Retention(),
+			},
+			logger.Logger(),
+		)
+	}
+
+	return d.outboxRelay
+}
+
 func (d *diContainer) Migrator(_ context.Context) migrator.Migrator {
 	if d.migrator == nil {
 		cfg, err := pgxpool.ParseConfig(config.AppConfig().Postgres.URI())
@@ -196,6 +579,8 @@ func (d *diContainer) OrderConsumerService(ctx context.Context) service.OrderCon
 	if d.orderConsumerService == nil {
 		d.orderConsumerService = orderConsumer.NewService(
 			d.OrderAssembledDecoder(),
+			d.OrderAssemblyStartedDecoder(),
+			config.AppConfig().OrderAssembledConsumer.AssemblyStartedTopic(),
 			d.OrderAssembledConsumer(),
 			d.OrderService(ctx),
 		)
@@ -203,15 +588,33 @@ func (d *diContainer) OrderConsumerService(ctx context.Context) service.OrderCon
 	return d.orderConsumerService
 }
 
-func (d *diContainer) OrderProducerService(_ context.Context) service.OrderProducerService {
+func (d *diContainer) OrderProducerService(ctx context.Context) service.OrderProducerService {
 	if d.orderProducerService == nil {
-		d.orderProducerService = orderProducer.NewService(
-			d.OrderPaidProducer(),
-		)
+		d.orderProducerService = orderProducer.NewService(d.OutboxWriter(ctx), orderProducer.Topics{
+			OrderPaid:      config.AppConfig().OrderPaidProducer.TopicName(),
+			OrderExpired:   config.AppConfig().OrderExpiredProducer.TopicName(),
+			OrderRefunded:  config.AppConfig().OrderRefundedProducer.TopicName(),
+			OrderCreated:   config.AppConfig().OrderCreatedProducer.TopicName(),
+			OrderCancelled: config.AppConfig().OrderCancelledProducer.TopicName(),
+			OrderCompleted: config.AppConfig().OrderCompletedProducer.TopicName(),
+		})
 	}
 	return d.orderProducerService
 }
 
+func (d *diContainer) OrderExpiryService(ctx context.Context) service.OrderExpiryService {
+	if d.orderExpiryService == nil {
+		d.orderExpiryService = orderExpiry.NewService(
+			d.OrderService(ctx),
+			d.IdempotencyRepository(ctx),
+			config.AppConfig().OrderExpiry.TTL(),
+			config.AppConfig().OrderExpiry.Interval(),
+			config.AppConfig().OrderExpiry.BatchSize(),
+		)
+	}
+	return d.orderExpiryService
+}
+
 func (d *diContainer) ConsumerGroup() sarama.ConsumerGroup {
 	if d.consumerGroup == nil {
 		group, err := sarama.NewConsumerGroup(
@@ -239,6 +642,7 @@ func (d *diContainer) OrderAssembledConsumer() wrappedKafka.Consumer {
 			d.ConsumerGroup(),
 			[]string{
 				config.AppConfig().OrderAssembledConsumer.Topic(),
+				config.AppConfig().OrderAssembledConsumer.AssemblyStartedTopic(),
 			},
 			kafkaMiddleware.Logging(logger.Logger()),
 		)
@@ -255,6 +659,14 @@ func (d *diContainer) OrderAssembledDecoder() kafkaConverter.OrderAssembledDecod
 	return d.orderAssembledDecoder
 }
 
+func (d *diContainer) OrderAssemblyStartedDecoder() kafkaConverter.OrderAssemblyStartedDecoder {
+	if d.orderAssemblyStartedDecoder == nil {
+		d.orderAssemblyStartedDecoder = decoder.NewOrderAssemblyStartedDecoder()
+	}
+
+	return d.orderAssemblyStartedDecoder
+}
+
 func (d *diContainer) SyncProducer() sarama.SyncProducer {
 	if d.syncProducer == nil {
 		p, err := sarama.NewSyncProducer(
@@ -284,3 +696,58 @@ func (d *diContainer) OrderPaidProducer() wrappedKafka.Producer {
 	}
 	return d.orderPaidProducer
 }
+
+func (d *diContainer) OrderExpiredProducer() wrappedKafka.Producer {
+	if d.orderExpiredProducer == nil {
+		d.orderExpiredProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().OrderExpiredProducer.TopicName(),
+			logger.Logger(),
+		)
+	}
+	return d.orderExpiredProducer
+}
+
+func (d *diContainer) OrderRefundedProducer() wrappedKafka.Producer {
+	if d.orderRefundedProducer == nil {
+		d.orderRefundedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().OrderRefundedProducer.TopicName(),
+			logger.Logger(),
+		)
+	}
+	return d.orderRefundedProducer
+}
+
+func (d *diContainer) OrderCreatedProducer() wrappedKafka.Producer {
+	if d.orderCreatedProducer == nil {
+		d.orderCreatedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().OrderCreatedProducer.TopicName(),
+			logger.Logger(),
+		)
+	}
+	return d.orderCreatedProducer
+}
+
+func (d *diContainer) OrderCancelledProducer() wrappedKafka.Producer {
+	if d.orderCancelledProducer == nil {
+		d.orderCancelledProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().OrderCancelledProducer.TopicName(),
+			logger.Logger(),
+		)
+	}
+	return d.orderCancelledProducer
+}
+
+func (d *diContainer) OrderCompletedProducer() wrappedKafka.Producer {
+	if d.orderCompletedProducer == nil {
+		d.orderCompletedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().OrderCompletedProducer.TopicName(),
+			logger.Logger(),
+		)
+	}
+	return d.orderCompletedProducer
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+	commonV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/common/v1"
 	inventoryV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/inventory/v1"
 )
 
@@ -29,7 +31,7 @@ func PartToModel(part *inventoryV1.Part) model.Part {
 		UUID:          part.Uuid,
 		Name:          part.Name,
 		Description:   part.Description,
-		Price:         part.Price,
+		Price:         MoneyToModel(part.Price),
 		StockQuantity: part.StockQuantity,
 		Category:      model.Category(part.Category),
 		Dimensions:    DimensionsToModel(part.Dimensions),
@@ -41,6 +43,13 @@ func PartToModel(part *inventoryV1.Part) model.Part {
 	}
 }
 
+func MoneyToModel(m *commonV1.Money) money.Money {
+	if m == nil {
+		return money.Money{}
+	}
+	return money.New(m.Amount, m.Currency)
+}
+
 func DimensionsToModel(dimensions *inventoryV1.Dimensions) model.Dimensions {
 	if dimensions == nil {
 		return model.Dimensions{}
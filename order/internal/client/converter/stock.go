@@ -0,0 +1,61 @@
+package converter
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	inventoryV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+// stockViolationType - тип нарушения, которым inventory помечает нехватку деталей
+const stockViolationType = "STOCK"
+
+func OrderItemsToStockProto(items []model.OrderItem) []*inventoryV1.StockItem {
+	out := make([]*inventoryV1.StockItem, 0, len(items))
+	for _, item := range items {
+		out = append(out, &inventoryV1.StockItem{
+			PartUuid: item.PartUUID,
+			Quantity: item.Quantity,
+		})
+	}
+
+	return out
+}
+
+// StockErrorToModel превращает FAILED_PRECONDITION с PreconditionFailure в InsufficientStockError,
+// остальные ошибки возвращает как есть
+func StockErrorToModel(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.FailedPrecondition {
+		return err
+	}
+
+	var shortages []model.StockShortage
+	for _, detail := range st.Details() {
+		failure, ok := detail.(*errdetails.PreconditionFailure)
+		if !ok {
+			continue
+		}
+
+		for _, violation := range failure.GetViolations() {
+			if violation.GetType() != stockViolationType {
+				continue
+			}
+
+			shortage := model.StockShortage{PartUUID: violation.GetSubject()}
+			// Описание имеет вид "requested N, available M"; при другом формате оставляем нули
+			_, _ = fmt.Sscanf(violation.GetDescription(), "requested %d, available %d", &shortage.Requested, &shortage.Available)
+			shortages = append(shortages, shortage)
+		}
+	}
+
+	if len(shortages) == 0 {
+		return err
+	}
+
+	return &model.InsufficientStockError{Shortages: shortages}
+}
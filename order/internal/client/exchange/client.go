@@ -0,0 +1,12 @@
+package exchange
+
+import (
+	"context"
+
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+type RateProvider interface {
+	// GetRate возвращает курс from -> to; неизвестная валюта - model.ErrCurrencyNotSupported
+	GetRate(ctx context.Context, from, to string) (rate money.Rate, err error)
+}
@@ -0,0 +1,98 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	money "github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+// RateProvider is an autogenerated mock type for the RateProvider type
+type RateProvider struct {
+	mock.Mock
+}
+
+type RateProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RateProvider) EXPECT() *RateProvider_Expecter {
+	return &RateProvider_Expecter{mock: &_m.Mock}
+}
+
+// GetRate provides a mock function with given fields: ctx, from, to
+func (_m *RateProvider) GetRate(ctx context.Context, from string, to string) (money.Rate, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRate")
+	}
+
+	var r0 money.Rate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (money.Rate, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) money.Rate); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		r0 = ret.Get(0).(money.Rate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RateProvider_GetRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRate'
+type RateProvider_GetRate_Call struct {
+	*mock.Call
+}
+
+// GetRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from string
+//   - to string
+func (_e *RateProvider_Expecter) GetRate(ctx interface{}, from interface{}, to interface{}) *RateProvider_GetRate_Call {
+	return &RateProvider_GetRate_Call{Call: _e.mock.On("GetRate", ctx, from, to)}
+}
+
+func (_c *RateProvider_GetRate_Call) Run(run func(ctx context.Context, from string, to string)) *RateProvider_GetRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RateProvider_GetRate_Call) Return(rate money.Rate, err error) *RateProvider_GetRate_Call {
+	_c.Call.Return(rate, err)
+	return _c
+}
+
+func (_c *RateProvider_GetRate_Call) RunAndReturn(run func(context.Context, string, string) (money.Rate, error)) *RateProvider_GetRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRateProvider creates a new instance of RateProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRateProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RateProvider {
+	mock := &RateProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
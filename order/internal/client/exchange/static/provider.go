@@ -0,0 +1,78 @@
+package static
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	def "github.com/Alexey-step/rocket-factory/order/internal/client/exchange"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+var _ def.RateProvider = (*provider)(nil)
+
+// ratesFile - формат файла курсов: сколько единиц каждой валюты дают за единицу базовой
+//
+//	{"base": "RUB", "rates": {"USD": "0.0108", "EUR": "0.0099"}}
+type ratesFile struct {
+	Base  string            `json:"base"`
+	Rates map[string]string `json:"rates"`
+}
+
+// provider - курсы из локального файла, для работы без внешнего источника курсов
+type provider struct {
+	base  string
+	rates map[string]money.Rate // Курсы base -> валюта
+}
+
+func NewProvider(path string) (*provider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read exchange rates file: %w", err)
+	}
+
+	var file ratesFile
+	if err = json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse exchange rates file: %w", err)
+	}
+
+	if file.Base == "" {
+		return nil, fmt.Errorf("exchange rates file %s: base currency is empty", path)
+	}
+
+	rates := make(map[string]money.Rate, len(file.Rates)+1)
+	rates[file.Base] = money.Identity(file.Base)
+	for currency, value := range file.Rates {
+		rate, err := money.ParseRate(file.Base, currency, value)
+		if err != nil {
+			return nil, fmt.Errorf("exchange rates file %s: %w", path, err)
+		}
+		rates[currency] = rate
+	}
+
+	return &provider{
+		base:  file.Base,
+		rates: rates,
+	}, nil
+}
+
+func (p *provider) GetRate(_ context.Context, from, to string) (money.Rate, error) {
+	fromRate, ok := p.rates[from]
+	if !ok {
+		return money.Rate{}, fmt.Errorf("%w: %s", model.ErrCurrencyNotSupported, from)
+	}
+
+	toRate, ok := p.rates[to]
+	if !ok {
+		return money.Rate{}, fmt.Errorf("%w: %s", model.ErrCurrencyNotSupported, to)
+	}
+
+	if from == to {
+		return money.Identity(from), nil
+	}
+
+	// Курсы в файле заданы от базовой валюты, остальные пары считаются через неё
+	return money.CrossRate(fromRate, toRate)
+}
@@ -9,10 +9,14 @@ import (
 
 type InventoryClient interface {
 	ListParts(ctx context.Context, filter model.PartsFilter) (parts []model.Part, err error)
+	ReserveStock(ctx context.Context, orderUUID string, items []model.OrderItem) error
+	ReleaseStock(ctx context.Context, orderUUID string) error
+	CommitStock(ctx context.Context, orderUUID string) error
 }
 
 type PaymentClient interface {
-	PayOrder(ctx context.Context, userUUID, orderUUID, paymentMethod string) (transactionUUID string, err error)
+	PayOrder(ctx context.Context, userUUID, orderUUID, paymentMethod, idempotencyKey string) (transactionUUID string, err error)
+	RefundPayment(ctx context.Context, userUUID, orderUUID, transactionUUID string) (refundUUID string, err error)
 }
 
 type IamClient interface {
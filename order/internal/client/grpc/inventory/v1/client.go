@@ -9,10 +9,14 @@ var _ def.InventoryClient = (*client)(nil)
 
 type client struct {
 	generatedClient generatedInventoryV1.InventoryServiceClient
+	serviceToken    string
 }
 
-func NewClient(generatedClient generatedInventoryV1.InventoryServiceClient) *client {
+// NewClient создаёт клиент inventory. serviceToken передаётся в ReleaseStock и CommitStock:
+// их вызывают и фоновые процессы, у которых нет сессии пользователя
+func NewClient(generatedClient generatedInventoryV1.InventoryServiceClient, serviceToken string) *client {
 	return &client{
 		generatedClient: generatedClient,
+		serviceToken:    serviceToken,
 	}
 }
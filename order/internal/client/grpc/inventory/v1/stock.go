@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"context"
+
+	clientConverter "github.com/Alexey-step/rocket-factory/order/internal/client/converter"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	grpcAuth "github.com/Alexey-step/rocket-factory/platform/pkg/middleware/grpc"
+	generatedInventoryV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) ReserveStock(ctx context.Context, orderUUID string, items []model.OrderItem) error {
+	ctx = grpcAuth.ForwardSessionUUIDToGRPC(ctx)
+
+	_, err := c.generatedClient.ReserveStock(ctx, &generatedInventoryV1.ReserveStockRequest{
+		OrderUuid: orderUUID,
+		Items:     clientConverter.OrderItemsToStockProto(items),
+	})
+	if err != nil {
+		return clientConverter.StockErrorToModel(err)
+	}
+
+	return nil
+}
+
+func (c *client) ReleaseStock(ctx context.Context, orderUUID string) error {
+	ctx = grpcAuth.ForwardSessionUUIDToGRPC(ctx)
+	ctx = grpcAuth.AddServiceTokenToGRPC(ctx, c.serviceToken)
+
+	_, err := c.generatedClient.ReleaseStock(ctx, &generatedInventoryV1.ReleaseStockRequest{
+		OrderUuid: orderUUID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) CommitStock(ctx context.Context, orderUUID string) error {
+	ctx = grpcAuth.ForwardSessionUUIDToGRPC(ctx)
+	ctx = grpcAuth.AddServiceTokenToGRPC(ctx, c.serviceToken)
+
+	_, err := c.generatedClient.CommitStock(ctx, &generatedInventoryV1.CommitStockRequest{
+		OrderUuid: orderUUID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
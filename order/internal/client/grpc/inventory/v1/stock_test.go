@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	grpcAuth "github.com/Alexey-step/rocket-factory/platform/pkg/middleware/grpc"
+	authV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/auth/v1"
+	generatedInventoryV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/inventory/v1"
+)
+
+const testServiceToken = "service-secret"
+
+// rejectingIAMClient не знает ни одной сессии
+type rejectingIAMClient struct {
+	grpcAuth.IAMClient
+}
+
+func (rejectingIAMClient) Whoami(context.Context, *authV1.WhoamiRequest) (*authV1.WhoamiResponse, error) {
+	return nil, errors.New("session not found")
+}
+
+// stockServer запоминает заказы, резерв которых сняли или списали
+type stockServer struct {
+	generatedInventoryV1.UnimplementedInventoryServiceServer
+
+	released  []string
+	committed []string
+}
+
+func (s *stockServer) ReleaseStock(_ context.Context, req *generatedInventoryV1.ReleaseStockRequest) (*generatedInventoryV1.ReleaseStockResponse, error) {
+	s.released = append(s.released, req.GetOrderUuid())
+	return &generatedInventoryV1.ReleaseStockResponse{}, nil
+}
+
+func (s *stockServer) CommitStock(_ context.Context, req *generatedInventoryV1.CommitStockRequest) (*generatedInventoryV1.CommitStockResponse, error) {
+	s.committed = append(s.committed, req.GetOrderUuid())
+	return &generatedInventoryV1.CommitStockResponse{}, nil
+}
+
+// startInventory поднимает inventory с той же проверкой авторизации, что и в сервисе
+func startInventory(t *testing.T, server *stockServer) generatedInventoryV1.InventoryServiceClient {
+	t.Helper()
+
+	authInterceptor := grpcAuth.NewAuthInterceptor(
+		rejectingIAMClient{},
+		grpcAuth.WithServiceToken(
+			testServiceToken,
+			generatedInventoryV1.InventoryService_ReleaseStock_FullMethodName,
+			generatedInventoryV1.InventoryService_CommitStock_FullMethodName,
+		),
+	)
+
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(authInterceptor.Unary()))
+	generatedInventoryV1.RegisterInventoryServiceServer(grpcServer, server)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return generatedInventoryV1.NewInventoryServiceClient(conn)
+}
+
+// Фоновая отмена заказов снимает резерв без сессии пользователя
+func TestReleaseStockWithoutSession(t *testing.T) {
+	server := &stockServer{}
+	inventoryClient := NewClient(startInventory(t, server), testServiceToken)
+	orderUUID := gofakeit.UUID()
+
+	err := inventoryClient.ReleaseStock(context.Background(), orderUUID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{orderUUID}, server.released)
+}
+
+func TestCommitStockWithoutSession(t *testing.T) {
+	server := &stockServer{}
+	inventoryClient := NewClient(startInventory(t, server), testServiceToken)
+	orderUUID := gofakeit.UUID()
+
+	err := inventoryClient.CommitStock(context.Background(), orderUUID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{orderUUID}, server.committed)
+}
+
+func TestReleaseStockWithoutSessionAndToken(t *testing.T) {
+	server := &stockServer{}
+	inventoryClient := NewClient(startInventory(t, server), "")
+
+	err := inventoryClient.ReleaseStock(context.Background(), gofakeit.UUID())
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Empty(t, server.released)
+}
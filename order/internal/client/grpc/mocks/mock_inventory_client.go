@@ -25,6 +25,53 @@ func (_m *InventoryClient) EXPECT() *InventoryClient_Expecter {
 	return &InventoryClient_Expecter{mock: &_m.Mock}
 }
 
+// CommitStock provides a mock function with given fields: ctx, orderUUID
+func (_m *InventoryClient) CommitStock(ctx context.Context, orderUUID string) error {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryClient_CommitStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitStock'
+type InventoryClient_CommitStock_Call struct {
+	*mock.Call
+}
+
+// CommitStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *InventoryClient_Expecter) CommitStock(ctx interface{}, orderUUID interface{}) *InventoryClient_CommitStock_Call {
+	return &InventoryClient_CommitStock_Call{Call: _e.mock.On("CommitStock", ctx, orderUUID)}
+}
+
+func (_c *InventoryClient_CommitStock_Call) Run(run func(ctx context.Context, orderUUID string)) *InventoryClient_CommitStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_CommitStock_Call) Return(_a0 error) *InventoryClient_CommitStock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryClient_CommitStock_Call) RunAndReturn(run func(context.Context, string) error) *InventoryClient_CommitStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListParts provides a mock function with given fields: ctx, filter
 func (_m *InventoryClient) ListParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error) {
 	ret := _m.Called(ctx, filter)
@@ -84,6 +131,101 @@ func (_c *InventoryClient_ListParts_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ReleaseStock provides a mock function with given fields: ctx, orderUUID
+func (_m *InventoryClient) ReleaseStock(ctx context.Context, orderUUID string) error {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryClient_ReleaseStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseStock'
+type InventoryClient_ReleaseStock_Call struct {
+	*mock.Call
+}
+
+// ReleaseStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *InventoryClient_Expecter) ReleaseStock(ctx interface{}, orderUUID interface{}) *InventoryClient_ReleaseStock_Call {
+	return &InventoryClient_ReleaseStock_Call{Call: _e.mock.On("ReleaseStock", ctx, orderUUID)}
+}
+
+func (_c *InventoryClient_ReleaseStock_Call) Run(run func(ctx context.Context, orderUUID string)) *InventoryClient_ReleaseStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_ReleaseStock_Call) Return(_a0 error) *InventoryClient_ReleaseStock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryClient_ReleaseStock_Call) RunAndReturn(run func(context.Context, string) error) *InventoryClient_ReleaseStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveStock provides a mock function with given fields: ctx, orderUUID, items
+func (_m *InventoryClient) ReserveStock(ctx context.Context, orderUUID string, items []model.OrderItem) error {
+	ret := _m.Called(ctx, orderUUID, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.OrderItem) error); ok {
+		r0 = rf(ctx, orderUUID, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryClient_ReserveStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveStock'
+type InventoryClient_ReserveStock_Call struct {
+	*mock.Call
+}
+
+// ReserveStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - items []model.OrderItem
+func (_e *InventoryClient_Expecter) ReserveStock(ctx interface{}, orderUUID interface{}, items interface{}) *InventoryClient_ReserveStock_Call {
+	return &InventoryClient_ReserveStock_Call{Call: _e.mock.On("ReserveStock", ctx, orderUUID, items)}
+}
+
+func (_c *InventoryClient_ReserveStock_Call) Run(run func(ctx context.Context, orderUUID string, items []model.OrderItem)) *InventoryClient_ReserveStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]model.OrderItem))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_ReserveStock_Call) Return(_a0 error) *InventoryClient_ReserveStock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryClient_ReserveStock_Call) RunAndReturn(run func(context.Context, string, []model.OrderItem) error) *InventoryClient_ReserveStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewInventoryClient creates a new instance of InventoryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewInventoryClient(t interface {
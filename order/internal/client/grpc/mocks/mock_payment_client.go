@@ -23,9 +23,9 @@ func (_m *PaymentClient) EXPECT() *PaymentClient_Expecter {
 	return &PaymentClient_Expecter{mock: &_m.Mock}
 }
 
-// PayOrder provides a mock function with given fields: ctx, userUUID, orderUUID, paymentMethod
-func (_m *PaymentClient) PayOrder(ctx context.Context, userUUID string, orderUUID string, paymentMethod string) (string, error) {
-	ret := _m.Called(ctx, userUUID, orderUUID, paymentMethod)
+// PayOrder provides a mock function with given fields: ctx, userUUID, orderUUID, paymentMethod, idempotencyKey
+func (_m *PaymentClient) PayOrder(ctx context.Context, userUUID string, orderUUID string, paymentMethod string, idempotencyKey string) (string, error) {
+	ret := _m.Called(ctx, userUUID, orderUUID, paymentMethod, idempotencyKey)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
@@ -33,17 +33,17 @@ func (_m *PaymentClient) PayOrder(ctx context.Context, userUUID string, orderUUI
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
-		return rf(ctx, userUUID, orderUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (string, error)); ok {
+		return rf(ctx, userUUID, orderUUID, paymentMethod, idempotencyKey)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
-		r0 = rf(ctx, userUUID, orderUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) string); ok {
+		r0 = rf(ctx, userUUID, orderUUID, paymentMethod, idempotencyKey)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
-		r1 = rf(ctx, userUUID, orderUUID, paymentMethod)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, userUUID, orderUUID, paymentMethod, idempotencyKey)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,13 +61,14 @@ type PaymentClient_PayOrder_Call struct {
 //   - userUUID string
 //   - orderUUID string
 //   - paymentMethod string
-func (_e *PaymentClient_Expecter) PayOrder(ctx interface{}, userUUID interface{}, orderUUID interface{}, paymentMethod interface{}) *PaymentClient_PayOrder_Call {
-	return &PaymentClient_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, userUUID, orderUUID, paymentMethod)}
+//   - idempotencyKey string
+func (_e *PaymentClient_Expecter) PayOrder(ctx interface{}, userUUID interface{}, orderUUID interface{}, paymentMethod interface{}, idempotencyKey interface{}) *PaymentClient_PayOrder_Call {
+	return &PaymentClient_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, userUUID, orderUUID, paymentMethod, idempotencyKey)}
 }
 
-func (_c *PaymentClient_PayOrder_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string, paymentMethod string)) *PaymentClient_PayOrder_Call {
+func (_c *PaymentClient_PayOrder_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string, paymentMethod string, idempotencyKey string)) *PaymentClient_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
 	})
 	return _c
 }
@@ -77,7 +78,66 @@ func (_c *PaymentClient_PayOrder_Call) Return(transactionUUID string, err error)
 	return _c
 }
 
-func (_c *PaymentClient_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, string) (string, error)) *PaymentClient_PayOrder_Call {
+func (_c *PaymentClient_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, string, string) (string, error)) *PaymentClient_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundPayment provides a mock function with given fields: ctx, userUUID, orderUUID, transactionUUID
+func (_m *PaymentClient) RefundPayment(ctx context.Context, userUUID string, orderUUID string, transactionUUID string) (string, error) {
+	ret := _m.Called(ctx, userUUID, orderUUID, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+		return rf(ctx, userUUID, orderUUID, transactionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = rf(ctx, userUUID, orderUUID, transactionUUID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, userUUID, orderUUID, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentClient_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type PaymentClient_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - orderUUID string
+//   - transactionUUID string
+func (_e *PaymentClient_Expecter) RefundPayment(ctx interface{}, userUUID interface{}, orderUUID interface{}, transactionUUID interface{}) *PaymentClient_RefundPayment_Call {
+	return &PaymentClient_RefundPayment_Call{Call: _e.mock.On("RefundPayment", ctx, userUUID, orderUUID, transactionUUID)}
+}
+
+func (_c *PaymentClient_RefundPayment_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string, transactionUUID string)) *PaymentClient_RefundPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentClient_RefundPayment_Call) Return(refundUUID string, err error) *PaymentClient_RefundPayment_Call {
+	_c.Call.Return(refundUUID, err)
+	return _c
+}
+
+func (_c *PaymentClient_RefundPayment_Call) RunAndReturn(run func(context.Context, string, string, string) (string, error)) *PaymentClient_RefundPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"context"
+
+	generatedPaymentV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (c *client) RefundPayment(ctx context.Context, userUUID, orderUUID, transactionUUID string) (refundUUID string, err error) {
+	res, err := c.generatedClient.RefundPayment(ctx, &generatedPaymentV1.RefundPaymentRequest{
+		OrderUuid:       orderUUID,
+		UserUuid:        userUUID,
+		TransactionUuid: transactionUUID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.RefundUuid, nil
+}
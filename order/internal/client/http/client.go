@@ -0,0 +1,13 @@
+package http
+
+import (
+	"context"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+type WebhookClient interface {
+	// Send отправляет подписанный запрос получателю. Ошибка - только если ответа не было:
+	// код ответа, в том числе неуспешный, возвращается в model.WebhookResponse
+	Send(ctx context.Context, msg model.WebhookMessage) (resp model.WebhookResponse, err error)
+}
@@ -0,0 +1,97 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+// WebhookClient is an autogenerated mock type for the WebhookClient type
+type WebhookClient struct {
+	mock.Mock
+}
+
+type WebhookClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookClient) EXPECT() *WebhookClient_Expecter {
+	return &WebhookClient_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function with given fields: ctx, msg
+func (_m *WebhookClient) Send(ctx context.Context, msg model.WebhookMessage) (model.WebhookResponse, error) {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 model.WebhookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.WebhookMessage) (model.WebhookResponse, error)); ok {
+		return rf(ctx, msg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.WebhookMessage) model.WebhookResponse); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Get(0).(model.WebhookResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.WebhookMessage) error); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookClient_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type WebhookClient_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg model.WebhookMessage
+func (_e *WebhookClient_Expecter) Send(ctx interface{}, msg interface{}) *WebhookClient_Send_Call {
+	return &WebhookClient_Send_Call{Call: _e.mock.On("Send", ctx, msg)}
+}
+
+func (_c *WebhookClient_Send_Call) Run(run func(ctx context.Context, msg model.WebhookMessage)) *WebhookClient_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.WebhookMessage))
+	})
+	return _c
+}
+
+func (_c *WebhookClient_Send_Call) Return(resp model.WebhookResponse, err error) *WebhookClient_Send_Call {
+	_c.Call.Return(resp, err)
+	return _c
+}
+
+func (_c *WebhookClient_Send_Call) RunAndReturn(run func(context.Context, model.WebhookMessage) (model.WebhookResponse, error)) *WebhookClient_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookClient creates a new instance of WebhookClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookClient {
+	mock := &WebhookClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
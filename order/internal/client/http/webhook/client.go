@@ -0,0 +1,122 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+// Заголовки запроса к получателю
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// maxResponseBody - сколько байт ответа сохраняется в журнал доставки
+const maxResponseBody = 1024
+
+var errPrivateAddress = errors.New("webhook address resolves to a private network")
+
+type client struct {
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewClient создаёт клиент вебхуков. Без allowPrivateNetworks запросы на loopback и
+// внутренние адреса запрещены, чтобы вебхуком нельзя было достучаться до сервисов в нашей сети.
+// Редиректы не выполняются: подписанный запрос уходит только на зарегистрированный адрес
+func NewClient(timeout time.Duration, allowPrivateNetworks bool) *client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivateNetworks {
+		dialer.Control = denyPrivateNetworks
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &client{
+		httpClient: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now: time.Now,
+	}
+}
+
+func (c *client) Send(ctx context.Context, msg model.WebhookMessage) (model.WebhookResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, msg.URL, bytes.NewReader(msg.Payload))
+	if err != nil {
+		return model.WebhookResponse{}, err
+	}
+
+	timestamp := c.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "rocket-factory-webhooks/1.0")
+	req.Header.Set(HeaderEvent, string(msg.EventType))
+	req.Header.Set(HeaderDelivery, msg.EventUUID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(msg.Secret, timestamp, msg.Payload))
+
+	started := time.Now()
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return model.WebhookResponse{Duration: time.Since(started)}, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	// Тело нужно только для журнала, ошибку чтения не считаем ошибкой доставки
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+
+	return model.WebhookResponse{
+		StatusCode: resp.StatusCode,
+		Body:       string(body),
+		Duration:   time.Since(started),
+	}, nil
+}
+
+// Sign возвращает значение заголовка X-Webhook-Signature: HMAC-SHA256 от "<timestamp>.<тело>".
+// Метка времени входит в подпись, чтобы получатель мог отбросить повтор старого запроса
+func Sign(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = fmt.Fprintf(mac, "%d.", timestamp)
+	_, _ = mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// denyPrivateNetworks проверяет уже разрешённый адрес перед соединением,
+// поэтому DNS-имя, указывающее на внутренний адрес, тоже отклоняется
+func denyPrivateNetworks(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+		return errPrivateAddress
+	}
+
+	return nil
+}
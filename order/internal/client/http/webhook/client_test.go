@@ -0,0 +1,107 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+func TestSendSignsPayload(t *testing.T) {
+	secret := gofakeit.Password(true, true, true, false, false, 32)
+	payload := []byte(`{"event_type":"ORDER_PAID"}`)
+	eventUUID := gofakeit.UUID()
+	now := time.Unix(1_760_000_000, 0)
+
+	var received *http.Request
+	var body []byte
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer receiver.Close()
+
+	c := NewClient(time.Second, true)
+	c.now = func() time.Time { return now }
+
+	resp, err := c.Send(context.Background(), model.WebhookMessage{
+		URL:       receiver.URL,
+		Secret:    secret,
+		EventUUID: eventUUID,
+		EventType: model.WebhookEventOrderPaid,
+		Payload:   payload,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	require.NotNil(t, received)
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, payload, body)
+	assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	assert.Equal(t, string(model.WebhookEventOrderPaid), received.Header.Get(HeaderEvent))
+	assert.Equal(t, eventUUID, received.Header.Get(HeaderDelivery))
+	assert.Equal(t, "1760000000", received.Header.Get(HeaderTimestamp))
+	assert.Equal(t, Sign(secret, now.Unix(), payload), received.Header.Get(HeaderSignature))
+	assert.NotEqual(t, Sign("another-secret-value", now.Unix(), payload), received.Header.Get(HeaderSignature))
+}
+
+func TestSendReturnsErrorResponse(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("maintenance"))
+	}))
+	defer receiver.Close()
+
+	resp, err := NewClient(time.Second, true).Send(context.Background(), model.WebhookMessage{
+		URL:     receiver.URL,
+		Payload: []byte(`{}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, "maintenance", resp.Body)
+}
+
+func TestSendDoesNotFollowRedirects(t *testing.T) {
+	redirected := false
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer receiver.Close()
+
+	resp, err := NewClient(time.Second, true).Send(context.Background(), model.WebhookMessage{
+		URL:     receiver.URL,
+		Payload: []byte(`{}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+	assert.False(t, redirected)
+}
+
+func TestSendBlocksPrivateNetworks(t *testing.T) {
+	called := false
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		called = true
+	}))
+	defer receiver.Close()
+
+	_, err := NewClient(time.Second, false).Send(context.Background(), model.WebhookMessage{
+		URL:     receiver.URL,
+		Payload: []byte(`{}`),
+	})
+	assert.ErrorIs(t, err, errPrivateAddress)
+	assert.False(t, called)
+}
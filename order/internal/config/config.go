@@ -13,6 +13,7 @@ var appConfig *config
 type config struct {
 	Logger    LoggerConfig
 	OrderHTTP OrderHTTPConfig
+	OrderGRPC OrderGRPCConfig
 	Inventory InventoryGRPCConfig
 	Payment   PaymentGRPCConfig
 	Iam       IamGRPCConfig
@@ -20,7 +21,23 @@ type config struct {
 
 	Kafka                  KafkaConfig
 	OrderPaidProducer      OrderPaidProducerConfig
+	OrderExpiredProducer   OrderExpiredProducerConfig
+	OrderRefundedProducer  OrderRefundedProducerConfig
+	OrderCreatedProducer   OrderCreatedProducerConfig
+	OrderCancelledProducer OrderCancelledProducerConfig
+	OrderCompletedProducer OrderCompletedProducerConfig
 	OrderAssembledConsumer OrderAssembledConsumerConfig
+	Outbox                 OutboxConfig
+	OrderExpiry            OrderExpiryConfig
+	Idempotency            IdempotencyConfig
+	Webhook                WebhookConfig
+	OrderStream            OrderStreamConfig
+	OrderReport            OrderReportConfig
+	Invoice                InvoiceConfig
+	ExchangeRates          ExchangeRatesConfig
+	BOMRules               BOMRulesConfig
+	Redis                  RedisConfig
+	RateLimit              RateLimitConfig
 }
 
 func Load(path ...string) error {
@@ -39,6 +56,11 @@ func Load(path ...string) error {
 		return err
 	}
 
+	orderGRPCCfg, err := env.NewOrderGRPCConfig()
+	if err != nil {
+		return err
+	}
+
 	inventoryGRPCCfg, err := env.NewInventoryGRPCConfig()
 	if err != nil {
 		return err
@@ -69,21 +91,118 @@ func Load(path ...string) error {
 		return err
 	}
 
+	orderExpiredProducerCfg, err := env.NewOrderExpiredProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	orderRefundedProducerCfg, err := env.NewOrderRefundedProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	orderCreatedProducerCfg, err := env.NewOrderCreatedProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	orderCancelledProducerCfg, err := env.NewOrderCancelledProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	orderCompletedProducerCfg, err := env.NewOrderCompletedProducerConfig()
+	if err != nil {
+		return err
+	}
+
 	orderAssembledConsumerCfg, err := env.NewOrderAssembledConsumerConfig()
 	if err != nil {
 		return err
 	}
 
+	outboxCfg, err := env.NewOutboxConfig()
+	if err != nil {
+		return err
+	}
+
+	orderExpiryCfg, err := env.NewOrderExpiryConfig()
+	if err != nil {
+		return err
+	}
+
+	idempotencyCfg, err := env.NewIdempotencyConfig()
+	if err != nil {
+		return err
+	}
+
+	webhookCfg, err := env.NewWebhookConfig()
+	if err != nil {
+		return err
+	}
+
+	orderStreamCfg, err := env.NewOrderStreamConfig()
+	if err != nil {
+		return err
+	}
+
+	orderReportCfg, err := env.NewOrderReportConfig()
+	if err != nil {
+		return err
+	}
+
+	invoiceCfg, err := env.NewInvoiceConfig()
+	if err != nil {
+		return err
+	}
+
+	exchangeRatesCfg, err := env.NewExchangeRatesConfig()
+	if err != nil {
+		return err
+	}
+
+	bomRulesCfg, err := env.NewBOMRulesConfig()
+	if err != nil {
+		return err
+	}
+
+	redisCfg, err := env.NewRedisConfig()
+	if err != nil {
+		return err
+	}
+
+	rateLimitCfg, err := env.NewRateLimitConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		Logger:                 loggerCfg,
 		OrderHTTP:              orderHTTPCfg,
+		OrderGRPC:              orderGRPCCfg,
 		Inventory:              inventoryGRPCCfg,
 		Payment:                paymentGRPCCfg,
 		Iam:                    iamGRPCCfg,
 		Postgres:               postgresCfg,
 		Kafka:                  kafkaCfg,
 		OrderPaidProducer:      orderPaidProducerCfg,
+		OrderExpiredProducer:   orderExpiredProducerCfg,
+		OrderRefundedProducer:  orderRefundedProducerCfg,
+		OrderCreatedProducer:   orderCreatedProducerCfg,
+		OrderCancelledProducer: orderCancelledProducerCfg,
+		OrderCompletedProducer: orderCompletedProducerCfg,
 		OrderAssembledConsumer: orderAssembledConsumerCfg,
+		Outbox:                 outboxCfg,
+		OrderExpiry:            orderExpiryCfg,
+		Idempotency:            idempotencyCfg,
+		Webhook:                webhookCfg,
+		OrderStream:            orderStreamCfg,
+		OrderReport:            orderReportCfg,
+		Invoice:                invoiceCfg,
+		ExchangeRates:          exchangeRatesCfg,
+		BOMRules:               bomRulesCfg,
+		Redis:                  redisCfg,
+		RateLimit:              rateLimitCfg,
 	}
 
 	return nil
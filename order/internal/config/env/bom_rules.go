@@ -0,0 +1,27 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type bomRulesEnvConfig struct {
+	File string `env:"BOM_RULES_FILE" envDefault:"./deploy/compose/order/bom_rules.json"`
+}
+
+type bomRulesConfig struct {
+	raw bomRulesEnvConfig
+}
+
+func NewBOMRulesConfig() (*bomRulesConfig, error) {
+	var raw bomRulesEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &bomRulesConfig{raw: raw}, nil
+}
+
+// File - путь к файлу с правилами комплектности ракеты
+func (cfg *bomRulesConfig) File() string {
+	return cfg.raw.File
+}
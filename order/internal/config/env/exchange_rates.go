@@ -0,0 +1,27 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type exchangeRatesEnvConfig struct {
+	File string `env:"EXCHANGE_RATES_FILE" envDefault:"./deploy/compose/order/exchange_rates.json"`
+}
+
+type exchangeRatesConfig struct {
+	raw exchangeRatesEnvConfig
+}
+
+func NewExchangeRatesConfig() (*exchangeRatesConfig, error) {
+	var raw exchangeRatesEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &exchangeRatesConfig{raw: raw}, nil
+}
+
+// File - путь к файлу со статическими курсами валют
+func (cfg *exchangeRatesConfig) File() string {
+	return cfg.raw.File
+}
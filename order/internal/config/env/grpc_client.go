@@ -0,0 +1,55 @@
+package env
+
+import (
+	"time"
+)
+
+// grpcClientEnvConfig - общие настройки устойчивости gRPC-клиента, префикс задаёт конкретный клиент
+type grpcClientEnvConfig struct {
+	Timeout                 time.Duration            `env:"TIMEOUT" envDefault:"2s"`
+	MethodTimeouts          map[string]time.Duration `env:"METHOD_TIMEOUTS"`
+	RetryMaxAttempts        int                      `env:"RETRY_MAX_ATTEMPTS" envDefault:"3"`
+	RetryBackoff            time.Duration            `env:"RETRY_BACKOFF" envDefault:"100ms"`
+	MaxRetryBackoff         time.Duration            `env:"MAX_RETRY_BACKOFF" envDefault:"1s"`
+	BreakerFailureThreshold int                      `env:"BREAKER_FAILURE_THRESHOLD" envDefault:"5"`
+	BreakerOpenTimeout      time.Duration            `env:"BREAKER_OPEN_TIMEOUT" envDefault:"10s"`
+}
+
+type grpcClientConfig struct {
+	raw grpcClientEnvConfig
+}
+
+// Timeout - таймаут одной попытки вызова по умолчанию
+func (cfg grpcClientConfig) Timeout() time.Duration {
+	return cfg.raw.Timeout
+}
+
+// MethodTimeouts - таймауты отдельных методов, например ListParts:1s,ReserveStock:3s
+func (cfg grpcClientConfig) MethodTimeouts() map[string]time.Duration {
+	return cfg.raw.MethodTimeouts
+}
+
+// RetryMaxAttempts - сколько всего попыток делать для идемпотентных методов
+func (cfg grpcClientConfig) RetryMaxAttempts() int {
+	return cfg.raw.RetryMaxAttempts
+}
+
+// RetryBackoff - базовая задержка перед повтором
+func (cfg grpcClientConfig) RetryBackoff() time.Duration {
+	return cfg.raw.RetryBackoff
+}
+
+// MaxRetryBackoff - верхняя граница задержки перед повтором
+func (cfg grpcClientConfig) MaxRetryBackoff() time.Duration {
+	return cfg.raw.MaxRetryBackoff
+}
+
+// BreakerFailureThreshold - сколько отказов подряд открывает circuit breaker
+func (cfg grpcClientConfig) BreakerFailureThreshold() int {
+	return cfg.raw.BreakerFailureThreshold
+}
+
+// BreakerOpenTimeout - сколько circuit breaker остаётся открытым до пробного запроса
+func (cfg grpcClientConfig) BreakerOpenTimeout() time.Duration {
+	return cfg.raw.BreakerOpenTimeout
+}
@@ -9,9 +9,13 @@ import (
 type iamGRPCEnvConfig struct {
 	Host string `env:"IAM_GRPC_HOST,required"`
 	Port string `env:"IAM_GRPC_PORT,required"`
+
+	Client grpcClientEnvConfig `envPrefix:"IAM_GRPC_"`
 }
 
 type iamGRPCConfig struct {
+	grpcClientConfig
+
 	raw iamGRPCEnvConfig
 }
 
@@ -21,7 +25,7 @@ func NewIamGRPCConfig() (*iamGRPCConfig, error) {
 		return nil, err
 	}
 
-	return &iamGRPCConfig{raw: raw}, nil
+	return &iamGRPCConfig{grpcClientConfig: grpcClientConfig{raw: raw.Client}, raw: raw}, nil
 }
 
 func (cfg *iamGRPCConfig) Address() string {
@@ -0,0 +1,30 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type idempotencyEnvConfig struct {
+	KeyTTL time.Duration `env:"IDEMPOTENCY_KEY_TTL" envDefault:"24h"`
+}
+
+type idempotencyConfig struct {
+	raw idempotencyEnvConfig
+}
+
+func NewIdempotencyConfig() (*idempotencyConfig, error) {
+	var raw idempotencyEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &idempotencyConfig{raw: raw}, nil
+}
+
+// KeyTTL - сколько действует ключ идемпотентности: позже повтор с тем же ключом
+// выполняется как новый запрос, а сам ключ удаляется фоновой очисткой
+func (cfg *idempotencyConfig) KeyTTL() time.Duration {
+	return cfg.raw.KeyTTL
+}
@@ -9,9 +9,15 @@ import (
 type inventoryGRPCEnvConfig struct {
 	Host string `env:"INVENTORY_GRPC_HOST,required"`
 	Port string `env:"INVENTORY_GRPC_PORT,required"`
+	// ServiceToken - токен для вызовов без сессии пользователя, например из фоновой отмены заказов
+	ServiceToken string `env:"INVENTORY_GRPC_SERVICE_TOKEN"`
+
+	Client grpcClientEnvConfig `envPrefix:"INVENTORY_GRPC_"`
 }
 
 type inventoryGRPCConfig struct {
+	grpcClientConfig
+
 	raw inventoryGRPCEnvConfig
 }
 
@@ -21,9 +27,13 @@ func NewInventoryGRPCConfig() (*inventoryGRPCConfig, error) {
 		return nil, err
 	}
 
-	return &inventoryGRPCConfig{raw: raw}, nil
+	return &inventoryGRPCConfig{grpcClientConfig: grpcClientConfig{raw: raw.Client}, raw: raw}, nil
 }
 
 func (cfg *inventoryGRPCConfig) Address() string {
 	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
 }
+
+func (cfg *inventoryGRPCConfig) ServiceToken() string {
+	return cfg.raw.ServiceToken
+}
@@ -0,0 +1,39 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type invoiceEnvConfig struct {
+	SellerName    string `env:"INVOICE_SELLER_NAME" envDefault:"Rocket Factory"`
+	SellerAddress string `env:"INVOICE_SELLER_ADDRESS"`
+	NumberPrefix  string `env:"INVOICE_NUMBER_PREFIX" envDefault:"INV"`
+}
+
+type invoiceConfig struct {
+	raw invoiceEnvConfig
+}
+
+func NewInvoiceConfig() (*invoiceConfig, error) {
+	var raw invoiceEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &invoiceConfig{raw: raw}, nil
+}
+
+// SellerName - название продавца в шапке счёта
+func (cfg *invoiceConfig) SellerName() string {
+	return cfg.raw.SellerName
+}
+
+// SellerAddress - адрес продавца в шапке счёта
+func (cfg *invoiceConfig) SellerAddress() string {
+	return cfg.raw.SellerAddress
+}
+
+// NumberPrefix - префикс номера счёта: INV-2026-000042
+func (cfg *invoiceConfig) NumberPrefix() string {
+	return cfg.raw.NumberPrefix
+}
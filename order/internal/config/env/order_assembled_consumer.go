@@ -6,8 +6,9 @@ import (
 )
 
 type orderAssembledConsumerEnvConfig struct {
-	Topic   string `env:"ORDER_ASSEMBLED_TOPIC_NAME,required"`
-	GroupID string `env:"ORDER_ASSEMBLED_CONSUMER_GROUP_ID,required"`
+	Topic                string `env:"ORDER_ASSEMBLED_TOPIC_NAME,required"`
+	AssemblyStartedTopic string `env:"ORDER_ASSEMBLY_STARTED_TOPIC_NAME,required"`
+	GroupID              string `env:"ORDER_ASSEMBLED_CONSUMER_GROUP_ID,required"`
 }
 
 type orderAssembledConsumerConfig struct {
@@ -27,6 +28,11 @@ func (cfg *orderAssembledConsumerConfig) Topic() string {
 	return cfg.raw.Topic
 }
 
+// AssemblyStartedTopic - топик с событиями о начале сборки, читается той же consumer group
+func (cfg *orderAssembledConsumerConfig) AssemblyStartedTopic() string {
+	return cfg.raw.AssemblyStartedTopic
+}
+
 func (cfg *orderAssembledConsumerConfig) GroupID() string {
 	return cfg.raw.GroupID
 }
@@ -0,0 +1,26 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type orderCancelledProducerEnvConfig struct {
+	TopicName string `env:"ORDER_CANCELLED_TOPIC_NAME,required"`
+}
+
+type orderCancelledProducerConfig struct {
+	raw orderCancelledProducerEnvConfig
+}
+
+func NewOrderCancelledProducerConfig() (*orderCancelledProducerConfig, error) {
+	var raw orderCancelledProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderCancelledProducerConfig{raw: raw}, nil
+}
+
+func (cfg *orderCancelledProducerConfig) TopicName() string {
+	return cfg.raw.TopicName
+}
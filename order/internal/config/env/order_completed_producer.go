@@ -0,0 +1,26 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type orderCompletedProducerEnvConfig struct {
+	TopicName string `env:"ORDER_COMPLETED_TOPIC_NAME,required"`
+}
+
+type orderCompletedProducerConfig struct {
+	raw orderCompletedProducerEnvConfig
+}
+
+func NewOrderCompletedProducerConfig() (*orderCompletedProducerConfig, error) {
+	var raw orderCompletedProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderCompletedProducerConfig{raw: raw}, nil
+}
+
+func (cfg *orderCompletedProducerConfig) TopicName() string {
+	return cfg.raw.TopicName
+}
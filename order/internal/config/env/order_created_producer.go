@@ -0,0 +1,26 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type orderCreatedProducerEnvConfig struct {
+	TopicName string `env:"ORDER_CREATED_TOPIC_NAME,required"`
+}
+
+type orderCreatedProducerConfig struct {
+	raw orderCreatedProducerEnvConfig
+}
+
+func NewOrderCreatedProducerConfig() (*orderCreatedProducerConfig, error) {
+	var raw orderCreatedProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderCreatedProducerConfig{raw: raw}, nil
+}
+
+func (cfg *orderCreatedProducerConfig) TopicName() string {
+	return cfg.raw.TopicName
+}
@@ -0,0 +1,41 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type orderExpiryEnvConfig struct {
+	TTL       time.Duration `env:"ORDER_PAYMENT_TTL" envDefault:"30m"`
+	Interval  time.Duration `env:"ORDER_EXPIRY_INTERVAL" envDefault:"1m"`
+	BatchSize int           `env:"ORDER_EXPIRY_BATCH_SIZE" envDefault:"100"`
+}
+
+type orderExpiryConfig struct {
+	raw orderExpiryEnvConfig
+}
+
+func NewOrderExpiryConfig() (*orderExpiryConfig, error) {
+	var raw orderExpiryEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderExpiryConfig{raw: raw}, nil
+}
+
+// TTL - сколько заказ может ждать оплаты до автоматической отмены
+func (cfg *orderExpiryConfig) TTL() time.Duration {
+	return cfg.raw.TTL
+}
+
+// Interval - период запуска отмены просроченных заказов
+func (cfg *orderExpiryConfig) Interval() time.Duration {
+	return cfg.raw.Interval
+}
+
+// BatchSize - сколько заказов отменяется в одной транзакции
+func (cfg *orderExpiryConfig) BatchSize() int {
+	return cfg.raw.BatchSize
+}
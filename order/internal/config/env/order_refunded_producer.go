@@ -0,0 +1,26 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type orderRefundedProducerEnvConfig struct {
+	TopicName string `env:"ORDER_REFUNDED_TOPIC_NAME,required"`
+}
+
+type orderRefundedProducerConfig struct {
+	raw orderRefundedProducerEnvConfig
+}
+
+func NewOrderRefundedProducerConfig() (*orderRefundedProducerConfig, error) {
+	var raw orderRefundedProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderRefundedProducerConfig{raw: raw}, nil
+}
+
+func (cfg *orderRefundedProducerConfig) TopicName() string {
+	return cfg.raw.TopicName
+}
@@ -0,0 +1,42 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type orderReportEnvConfig struct {
+	AdminUserUUIDs   []string      `env:"ORDER_ADMIN_USER_UUIDS" envSeparator:","`
+	MaxRange         time.Duration `env:"ORDER_REPORT_MAX_RANGE" envDefault:"8784h"`
+	StatementTimeout time.Duration `env:"ORDER_REPORT_STATEMENT_TIMEOUT" envDefault:"30s"`
+}
+
+type orderReportConfig struct {
+	raw orderReportEnvConfig
+}
+
+func NewOrderReportConfig() (*orderReportConfig, error) {
+	var raw orderReportEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderReportConfig{raw: raw}, nil
+}
+
+// AdminUserUUIDs - пользователи, которым доступны отчёты. Ролей в IAM нет,
+// поэтому администраторы перечисляются в конфиге
+func (cfg *orderReportConfig) AdminUserUUIDs() []string {
+	return cfg.raw.AdminUserUUIDs
+}
+
+// MaxRange - максимальная длина периода отчёта, по умолчанию 366 дней
+func (cfg *orderReportConfig) MaxRange() time.Duration {
+	return cfg.raw.MaxRange
+}
+
+// StatementTimeout - ограничение на время выполнения запроса отчёта в Postgres
+func (cfg *orderReportConfig) StatementTimeout() time.Duration {
+	return cfg.raw.StatementTimeout
+}
@@ -0,0 +1,41 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type orderStreamEnvConfig struct {
+	Channel           string        `env:"ORDER_STREAM_CHANNEL" envDefault:"order:status"`
+	BufferSize        int           `env:"ORDER_STREAM_BUFFER_SIZE" envDefault:"16"`
+	HeartbeatInterval time.Duration `env:"ORDER_STREAM_HEARTBEAT_INTERVAL" envDefault:"15s"`
+}
+
+type orderStreamConfig struct {
+	raw orderStreamEnvConfig
+}
+
+func NewOrderStreamConfig() (*orderStreamConfig, error) {
+	var raw orderStreamEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderStreamConfig{raw: raw}, nil
+}
+
+// Channel - канал Redis, через который реплики обмениваются сменами статусов
+func (cfg *orderStreamConfig) Channel() string {
+	return cfg.raw.Channel
+}
+
+// BufferSize - сколько событий ждут отправки клиенту, прежде чем поток закрывается
+func (cfg *orderStreamConfig) BufferSize() int {
+	return cfg.raw.BufferSize
+}
+
+// HeartbeatInterval - как часто слать комментарий, чтобы прокси не закрывали простаивающий поток
+func (cfg *orderStreamConfig) HeartbeatInterval() time.Duration {
+	return cfg.raw.HeartbeatInterval
+}
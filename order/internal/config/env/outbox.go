@@ -0,0 +1,43 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type outboxEnvConfig struct {
+	BatchSize    int           `env:"OUTBOX_BATCH_SIZE" envDefault:"100"`
+	PollInterval time.Duration `env:"OUTBOX_POLL_INTERVAL" envDefault:"1s"`
+	MaxBackoff   time.Duration `env:"OUTBOX_MAX_BACKOFF" envDefault:"5m"`
+	Retention    time.Duration `env:"OUTBOX_RETENTION" envDefault:"168h"`
+}
+
+type outboxConfig struct {
+	raw outboxEnvConfig
+}
+
+func NewOutboxConfig() (*outboxConfig, error) {
+	var raw outboxEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &outboxConfig{raw: raw}, nil
+}
+
+func (cfg *outboxConfig) BatchSize() int {
+	return cfg.raw.BatchSize
+}
+
+func (cfg *outboxConfig) PollInterval() time.Duration {
+	return cfg.raw.PollInterval
+}
+
+func (cfg *outboxConfig) MaxBackoff() time.Duration {
+	return cfg.raw.MaxBackoff
+}
+
+func (cfg *outboxConfig) Retention() time.Duration {
+	return cfg.raw.Retention
+}
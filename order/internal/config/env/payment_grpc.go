@@ -9,9 +9,13 @@ import (
 type paymentGRPCEnvConfig struct {
 	Host string `env:"PAYMENT_GRPC_HOST,required"`
 	Port string `env:"PAYMENT_GRPC_PORT,required"`
+
+	Client grpcClientEnvConfig `envPrefix:"PAYMENT_GRPC_"`
 }
 
 type paymentGRPCConfig struct {
+	grpcClientConfig
+
 	raw paymentGRPCEnvConfig
 }
 
@@ -21,7 +25,7 @@ func NewPaymentGRPCConfig() (*paymentGRPCConfig, error) {
 		return nil, err
 	}
 
-	return &paymentGRPCConfig{raw: raw}, nil
+	return &paymentGRPCConfig{grpcClientConfig: grpcClientConfig{raw: raw.Client}, raw: raw}, nil
 }
 
 func (cfg *paymentGRPCConfig) Address() string {
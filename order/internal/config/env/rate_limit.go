@@ -0,0 +1,27 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type rateLimitEnvConfig struct {
+	File string `env:"RATE_LIMITS_FILE" envDefault:"./deploy/compose/order/rate_limits.json"`
+}
+
+type rateLimitConfig struct {
+	raw rateLimitEnvConfig
+}
+
+func NewRateLimitConfig() (*rateLimitConfig, error) {
+	var raw rateLimitEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &rateLimitConfig{raw: raw}, nil
+}
+
+// File - путь к файлу с лимитами частоты запросов к HTTP API
+func (cfg *rateLimitConfig) File() string {
+	return cfg.raw.File
+}
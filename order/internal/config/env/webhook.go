@@ -0,0 +1,78 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type webhookEnvConfig struct {
+	PollInterval         time.Duration `env:"WEBHOOK_POLL_INTERVAL" envDefault:"1s"`
+	BatchSize            int           `env:"WEBHOOK_BATCH_SIZE" envDefault:"20"`
+	RequestTimeout       time.Duration `env:"WEBHOOK_REQUEST_TIMEOUT" envDefault:"5s"`
+	MaxAttempts          int           `env:"WEBHOOK_MAX_ATTEMPTS" envDefault:"8"`
+	RetryBackoff         time.Duration `env:"WEBHOOK_RETRY_BACKOFF" envDefault:"10s"`
+	MaxRetryBackoff      time.Duration `env:"WEBHOOK_MAX_RETRY_BACKOFF" envDefault:"1h"`
+	DisableAfterFailures int           `env:"WEBHOOK_DISABLE_AFTER_FAILURES" envDefault:"20"`
+	ClaimLease           time.Duration `env:"WEBHOOK_CLAIM_LEASE" envDefault:"5m"`
+	AllowPrivateNetworks bool          `env:"WEBHOOK_ALLOW_PRIVATE_NETWORKS" envDefault:"false"`
+}
+
+type webhookConfig struct {
+	raw webhookEnvConfig
+}
+
+func NewWebhookConfig() (*webhookConfig, error) {
+	var raw webhookEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &webhookConfig{raw: raw}, nil
+}
+
+// PollInterval - как часто проверять очередь доставок
+func (cfg *webhookConfig) PollInterval() time.Duration {
+	return cfg.raw.PollInterval
+}
+
+// BatchSize - сколько доставок закрепляется и отправляется за один проход
+func (cfg *webhookConfig) BatchSize() int {
+	return cfg.raw.BatchSize
+}
+
+// RequestTimeout - сколько ждать ответа получателя
+func (cfg *webhookConfig) RequestTimeout() time.Duration {
+	return cfg.raw.RequestTimeout
+}
+
+// MaxAttempts - сколько попыток делается, прежде чем доставка помечается неудачной
+func (cfg *webhookConfig) MaxAttempts() int {
+	return cfg.raw.MaxAttempts
+}
+
+// RetryBackoff - задержка перед первой повторной попыткой, дальше удваивается
+func (cfg *webhookConfig) RetryBackoff() time.Duration {
+	return cfg.raw.RetryBackoff
+}
+
+// MaxRetryBackoff - верхняя граница задержки между попытками
+func (cfg *webhookConfig) MaxRetryBackoff() time.Duration {
+	return cfg.raw.MaxRetryBackoff
+}
+
+// DisableAfterFailures - после скольких неудачных попыток подряд вебхук отключается
+func (cfg *webhookConfig) DisableAfterFailures() int {
+	return cfg.raw.DisableAfterFailures
+}
+
+// ClaimLease - на сколько пачка доставок закрепляется за репликой. Должно хватать
+// на отправку всей пачки: BatchSize * RequestTimeout
+func (cfg *webhookConfig) ClaimLease() time.Duration {
+	return cfg.raw.ClaimLease
+}
+
+// AllowPrivateNetworks разрешает вебхуки на внутренние адреса, например для локальной разработки
+func (cfg *webhookConfig) AllowPrivateNetworks() bool {
+	return cfg.raw.AllowPrivateNetworks
+}
@@ -1,6 +1,10 @@
 package config
 
-import "github.com/IBM/sarama"
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+)
 
 type LoggerConfig interface {
 	Level() string
@@ -12,12 +16,29 @@ type OrderHTTPConfig interface {
 	MigrationsDir() string
 }
 
+type OrderGRPCConfig interface {
+	Address() string
+}
+
+type GRPCClientConfig interface {
+	Timeout() time.Duration
+	MethodTimeouts() map[string]time.Duration
+	RetryMaxAttempts() int
+	RetryBackoff() time.Duration
+	MaxRetryBackoff() time.Duration
+	BreakerFailureThreshold() int
+	BreakerOpenTimeout() time.Duration
+}
+
 type InventoryGRPCConfig interface {
 	Address() string
+	ServiceToken() string
+	GRPCClientConfig
 }
 
 type PaymentGRPCConfig interface {
 	Address() string
+	GRPCClientConfig
 }
 
 type PostgresConfig interface {
@@ -27,6 +48,7 @@ type PostgresConfig interface {
 
 type OrderAssembledConsumerConfig interface {
 	Topic() string
+	AssemblyStartedTopic() string
 	GroupID() string
 	Config() *sarama.Config
 }
@@ -36,10 +58,99 @@ type OrderPaidProducerConfig interface {
 	Config() *sarama.Config
 }
 
+type OrderExpiredProducerConfig interface {
+	TopicName() string
+}
+
+type OrderRefundedProducerConfig interface {
+	TopicName() string
+}
+
+type OrderCreatedProducerConfig interface {
+	TopicName() string
+}
+
+type OrderCancelledProducerConfig interface {
+	TopicName() string
+}
+
+type OrderCompletedProducerConfig interface {
+	TopicName() string
+}
+
 type KafkaConfig interface {
 	Brokers() []string
 }
 
 type IamGRPCConfig interface {
 	Address() string
+	GRPCClientConfig
+}
+
+type OutboxConfig interface {
+	BatchSize() int
+	PollInterval() time.Duration
+	MaxBackoff() time.Duration
+	Retention() time.Duration
+}
+
+type OrderExpiryConfig interface {
+	TTL() time.Duration
+	Interval() time.Duration
+	BatchSize() int
+}
+
+type IdempotencyConfig interface {
+	KeyTTL() time.Duration
+}
+
+type WebhookConfig interface {
+	PollInterval() time.Duration
+	BatchSize() int
+	RequestTimeout() time.Duration
+	MaxAttempts() int
+	RetryBackoff() time.Duration
+	MaxRetryBackoff() time.Duration
+	DisableAfterFailures() int
+	ClaimLease() time.Duration
+	AllowPrivateNetworks() bool
+}
+
+type OrderStreamConfig interface {
+	Channel() string
+	BufferSize() int
+	HeartbeatInterval() time.Duration
+}
+
+type OrderReportConfig interface {
+	AdminUserUUIDs() []string
+	MaxRange() time.Duration
+	StatementTimeout() time.Duration
+}
+
+type InvoiceConfig interface {
+	SellerName() string
+	SellerAddress() string
+	NumberPrefix() string
+}
+
+type ExchangeRatesConfig interface {
+	File() string
+}
+
+type BOMRulesConfig interface {
+	File() string
+}
+
+type RedisConfig interface {
+	Host() string
+	Port() string
+	ConnectionTimeout() time.Duration
+	MaxIDLE() int
+	IDLETimeout() time.Duration
+	Address() string
+}
+
+type RateLimitConfig interface {
+	File() string
 }
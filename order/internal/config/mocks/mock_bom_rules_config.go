@@ -0,0 +1,79 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// BOMRulesConfig is an autogenerated mock type for the BOMRulesConfig type
+type BOMRulesConfig struct {
+	mock.Mock
+}
+
+type BOMRulesConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BOMRulesConfig) EXPECT() *BOMRulesConfig_Expecter {
+	return &BOMRulesConfig_Expecter{mock: &_m.Mock}
+}
+
+// File provides a mock function with no fields
+func (_m *BOMRulesConfig) File() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for File")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// BOMRulesConfig_File_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'File'
+type BOMRulesConfig_File_Call struct {
+	*mock.Call
+}
+
+// File is a helper method to define mock.On call
+func (_e *BOMRulesConfig_Expecter) File() *BOMRulesConfig_File_Call {
+	return &BOMRulesConfig_File_Call{Call: _e.mock.On("File")}
+}
+
+func (_c *BOMRulesConfig_File_Call) Run(run func()) *BOMRulesConfig_File_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BOMRulesConfig_File_Call) Return(_a0 string) *BOMRulesConfig_File_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BOMRulesConfig_File_Call) RunAndReturn(run func() string) *BOMRulesConfig_File_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBOMRulesConfig creates a new instance of BOMRulesConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBOMRulesConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BOMRulesConfig {
+	mock := &BOMRulesConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
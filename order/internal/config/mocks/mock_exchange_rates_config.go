@@ -0,0 +1,79 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// ExchangeRatesConfig is an autogenerated mock type for the ExchangeRatesConfig type
+type ExchangeRatesConfig struct {
+	mock.Mock
+}
+
+type ExchangeRatesConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ExchangeRatesConfig) EXPECT() *ExchangeRatesConfig_Expecter {
+	return &ExchangeRatesConfig_Expecter{mock: &_m.Mock}
+}
+
+// File provides a mock function with no fields
+func (_m *ExchangeRatesConfig) File() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for File")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ExchangeRatesConfig_File_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'File'
+type ExchangeRatesConfig_File_Call struct {
+	*mock.Call
+}
+
+// File is a helper method to define mock.On call
+func (_e *ExchangeRatesConfig_Expecter) File() *ExchangeRatesConfig_File_Call {
+	return &ExchangeRatesConfig_File_Call{Call: _e.mock.On("File")}
+}
+
+func (_c *ExchangeRatesConfig_File_Call) Run(run func()) *ExchangeRatesConfig_File_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ExchangeRatesConfig_File_Call) Return(_a0 string) *ExchangeRatesConfig_File_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExchangeRatesConfig_File_Call) RunAndReturn(run func() string) *ExchangeRatesConfig_File_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewExchangeRatesConfig creates a new instance of ExchangeRatesConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewExchangeRatesConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ExchangeRatesConfig {
+	mock := &ExchangeRatesConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,355 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GRPCClientConfig is an autogenerated mock type for the GRPCClientConfig type
+type GRPCClientConfig struct {
+	mock.Mock
+}
+
+type GRPCClientConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GRPCClientConfig) EXPECT() *GRPCClientConfig_Expecter {
+	return &GRPCClientConfig_Expecter{mock: &_m.Mock}
+}
+
+// BreakerFailureThreshold provides a mock function with no fields
+func (_m *GRPCClientConfig) BreakerFailureThreshold() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BreakerFailureThreshold")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GRPCClientConfig_BreakerFailureThreshold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BreakerFailureThreshold'
+type GRPCClientConfig_BreakerFailureThreshold_Call struct {
+	*mock.Call
+}
+
+// BreakerFailureThreshold is a helper method to define mock.On call
+func (_e *GRPCClientConfig_Expecter) BreakerFailureThreshold() *GRPCClientConfig_BreakerFailureThreshold_Call {
+	return &GRPCClientConfig_BreakerFailureThreshold_Call{Call: _e.mock.On("BreakerFailureThreshold")}
+}
+
+func (_c *GRPCClientConfig_BreakerFailureThreshold_Call) Run(run func()) *GRPCClientConfig_BreakerFailureThreshold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GRPCClientConfig_BreakerFailureThreshold_Call) Return(_a0 int) *GRPCClientConfig_BreakerFailureThreshold_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GRPCClientConfig_BreakerFailureThreshold_Call) RunAndReturn(run func() int) *GRPCClientConfig_BreakerFailureThreshold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BreakerOpenTimeout provides a mock function with no fields
+func (_m *GRPCClientConfig) BreakerOpenTimeout() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BreakerOpenTimeout")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GRPCClientConfig_BreakerOpenTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BreakerOpenTimeout'
+type GRPCClientConfig_BreakerOpenTimeout_Call struct {
+	*mock.Call
+}
+
+// BreakerOpenTimeout is a helper method to define mock.On call
+func (_e *GRPCClientConfig_Expecter) BreakerOpenTimeout() *GRPCClientConfig_BreakerOpenTimeout_Call {
+	return &GRPCClientConfig_BreakerOpenTimeout_Call{Call: _e.mock.On("BreakerOpenTimeout")}
+}
+
+func (_c *GRPCClientConfig_BreakerOpenTimeout_Call) Run(run func()) *GRPCClientConfig_BreakerOpenTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GRPCClientConfig_BreakerOpenTimeout_Call) Return(_a0 time.Duration) *GRPCClientConfig_BreakerOpenTimeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GRPCClientConfig_BreakerOpenTimeout_Call) RunAndReturn(run func() time.Duration) *GRPCClientConfig_BreakerOpenTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxRetryBackoff provides a mock function with no fields
+func (_m *GRPCClientConfig) MaxRetryBackoff() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxRetryBackoff")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GRPCClientConfig_MaxRetryBackoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxRetryBackoff'
+type GRPCClientConfig_MaxRetryBackoff_Call struct {
+	*mock.Call
+}
+
+// MaxRetryBackoff is a helper method to define mock.On call
+func (_e *GRPCClientConfig_Expecter) MaxRetryBackoff() *GRPCClientConfig_MaxRetryBackoff_Call {
+	return &GRPCClientConfig_MaxRetryBackoff_Call{Call: _e.mock.On("MaxRetryBackoff")}
+}
+
+func (_c *GRPCClientConfig_MaxRetryBackoff_Call) Run(run func()) *GRPCClientConfig_MaxRetryBackoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GRPCClientConfig_MaxRetryBackoff_Call) Return(_a0 time.Duration) *GRPCClientConfig_MaxRetryBackoff_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GRPCClientConfig_MaxRetryBackoff_Call) RunAndReturn(run func() time.Duration) *GRPCClientConfig_MaxRetryBackoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MethodTimeouts provides a mock function with no fields
+func (_m *GRPCClientConfig) MethodTimeouts() map[string]time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MethodTimeouts")
+	}
+
+	var r0 map[string]time.Duration
+	if rf, ok := ret.Get(0).(func() map[string]time.Duration); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]time.Duration)
+		}
+	}
+
+	return r0
+}
+
+// GRPCClientConfig_MethodTimeouts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MethodTimeouts'
+type GRPCClientConfig_MethodTimeouts_Call struct {
+	*mock.Call
+}
+
+// MethodTimeouts is a helper method to define mock.On call
+func (_e *GRPCClientConfig_Expecter) MethodTimeouts() *GRPCClientConfig_MethodTimeouts_Call {
+	return &GRPCClientConfig_MethodTimeouts_Call{Call: _e.mock.On("MethodTimeouts")}
+}
+
+func (_c *GRPCClientConfig_MethodTimeouts_Call) Run(run func()) *GRPCClientConfig_MethodTimeouts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GRPCClientConfig_MethodTimeouts_Call) Return(_a0 map[string]time.Duration) *GRPCClientConfig_MethodTimeouts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GRPCClientConfig_MethodTimeouts_Call) RunAndReturn(run func() map[string]time.Duration) *GRPCClientConfig_MethodTimeouts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryBackoff provides a mock function with no fields
+func (_m *GRPCClientConfig) RetryBackoff() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryBackoff")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GRPCClientConfig_RetryBackoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryBackoff'
+type GRPCClientConfig_RetryBackoff_Call struct {
+	*mock.Call
+}
+
+// RetryBackoff is a helper method to define mock.On call
+func (_e *GRPCClientConfig_Expecter) RetryBackoff() *GRPCClientConfig_RetryBackoff_Call {
+	return &GRPCClientConfig_RetryBackoff_Call{Call: _e.mock.On("RetryBackoff")}
+}
+
+func (_c *GRPCClientConfig_RetryBackoff_Call) Run(run func()) *GRPCClientConfig_RetryBackoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GRPCClientConfig_RetryBackoff_Call) Return(_a0 time.Duration) *GRPCClientConfig_RetryBackoff_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GRPCClientConfig_RetryBackoff_Call) RunAndReturn(run func() time.Duration) *GRPCClientConfig_RetryBackoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryMaxAttempts provides a mock function with no fields
+func (_m *GRPCClientConfig) RetryMaxAttempts() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryMaxAttempts")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GRPCClientConfig_RetryMaxAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryMaxAttempts'
+type GRPCClientConfig_RetryMaxAttempts_Call struct {
+	*mock.Call
+}
+
+// RetryMaxAttempts is a helper method to define mock.On call
+func (_e *GRPCClientConfig_Expecter) RetryMaxAttempts() *GRPCClientConfig_RetryMaxAttempts_Call {
+	return &GRPCClientConfig_RetryMaxAttempts_Call{Call: _e.mock.On("RetryMaxAttempts")}
+}
+
+func (_c *GRPCClientConfig_RetryMaxAttempts_Call) Run(run func()) *GRPCClientConfig_RetryMaxAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GRPCClientConfig_RetryMaxAttempts_Call) Return(_a0 int) *GRPCClientConfig_RetryMaxAttempts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GRPCClientConfig_RetryMaxAttempts_Call) RunAndReturn(run func() int) *GRPCClientConfig_RetryMaxAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Timeout provides a mock function with no fields
+func (_m *GRPCClientConfig) Timeout() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Timeout")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GRPCClientConfig_Timeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Timeout'
+type GRPCClientConfig_Timeout_Call struct {
+	*mock.Call
+}
+
+// Timeout is a helper method to define mock.On call
+func (_e *GRPCClientConfig_Expecter) Timeout() *GRPCClientConfig_Timeout_Call {
+	return &GRPCClientConfig_Timeout_Call{Call: _e.mock.On("Timeout")}
+}
+
+func (_c *GRPCClientConfig_Timeout_Call) Run(run func()) *GRPCClientConfig_Timeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GRPCClientConfig_Timeout_Call) Return(_a0 time.Duration) *GRPCClientConfig_Timeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GRPCClientConfig_Timeout_Call) RunAndReturn(run func() time.Duration) *GRPCClientConfig_Timeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewGRPCClientConfig creates a new instance of GRPCClientConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGRPCClientConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GRPCClientConfig {
+	mock := &GRPCClientConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IdempotencyConfig is an autogenerated mock type for the IdempotencyConfig type
+type IdempotencyConfig struct {
+	mock.Mock
+}
+
+type IdempotencyConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IdempotencyConfig) EXPECT() *IdempotencyConfig_Expecter {
+	return &IdempotencyConfig_Expecter{mock: &_m.Mock}
+}
+
+// KeyTTL provides a mock function with no fields
+func (_m *IdempotencyConfig) KeyTTL() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeyTTL")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// IdempotencyConfig_KeyTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KeyTTL'
+type IdempotencyConfig_KeyTTL_Call struct {
+	*mock.Call
+}
+
+// KeyTTL is a helper method to define mock.On call
+func (_e *IdempotencyConfig_Expecter) KeyTTL() *IdempotencyConfig_KeyTTL_Call {
+	return &IdempotencyConfig_KeyTTL_Call{Call: _e.mock.On("KeyTTL")}
+}
+
+func (_c *IdempotencyConfig_KeyTTL_Call) Run(run func()) *IdempotencyConfig_KeyTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IdempotencyConfig_KeyTTL_Call) Return(_a0 time.Duration) *IdempotencyConfig_KeyTTL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdempotencyConfig_KeyTTL_Call) RunAndReturn(run func() time.Duration) *IdempotencyConfig_KeyTTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIdempotencyConfig creates a new instance of IdempotencyConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIdempotencyConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IdempotencyConfig {
+	mock := &IdempotencyConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -4,7 +4,11 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // InventoryGRPCConfig is an autogenerated mock type for the InventoryGRPCConfig type
 type InventoryGRPCConfig struct {
@@ -64,6 +68,368 @@ func (_c *InventoryGRPCConfig_Address_Call) RunAndReturn(run func() string) *Inv
 	return _c
 }
 
+// BreakerFailureThreshold provides a mock function with no fields
+func (_m *InventoryGRPCConfig) BreakerFailureThreshold() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BreakerFailureThreshold")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// InventoryGRPCConfig_BreakerFailureThreshold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BreakerFailureThreshold'
+type InventoryGRPCConfig_BreakerFailureThreshold_Call struct {
+	*mock.Call
+}
+
+// BreakerFailureThreshold is a helper method to define mock.On call
+func (_e *InventoryGRPCConfig_Expecter) BreakerFailureThreshold() *InventoryGRPCConfig_BreakerFailureThreshold_Call {
+	return &InventoryGRPCConfig_BreakerFailureThreshold_Call{Call: _e.mock.On("BreakerFailureThreshold")}
+}
+
+func (_c *InventoryGRPCConfig_BreakerFailureThreshold_Call) Run(run func()) *InventoryGRPCConfig_BreakerFailureThreshold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_BreakerFailureThreshold_Call) Return(_a0 int) *InventoryGRPCConfig_BreakerFailureThreshold_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_BreakerFailureThreshold_Call) RunAndReturn(run func() int) *InventoryGRPCConfig_BreakerFailureThreshold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BreakerOpenTimeout provides a mock function with no fields
+func (_m *InventoryGRPCConfig) BreakerOpenTimeout() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BreakerOpenTimeout")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// InventoryGRPCConfig_BreakerOpenTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BreakerOpenTimeout'
+type InventoryGRPCConfig_BreakerOpenTimeout_Call struct {
+	*mock.Call
+}
+
+// BreakerOpenTimeout is a helper method to define mock.On call
+func (_e *InventoryGRPCConfig_Expecter) BreakerOpenTimeout() *InventoryGRPCConfig_BreakerOpenTimeout_Call {
+	return &InventoryGRPCConfig_BreakerOpenTimeout_Call{Call: _e.mock.On("BreakerOpenTimeout")}
+}
+
+func (_c *InventoryGRPCConfig_BreakerOpenTimeout_Call) Run(run func()) *InventoryGRPCConfig_BreakerOpenTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_BreakerOpenTimeout_Call) Return(_a0 time.Duration) *InventoryGRPCConfig_BreakerOpenTimeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_BreakerOpenTimeout_Call) RunAndReturn(run func() time.Duration) *InventoryGRPCConfig_BreakerOpenTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxRetryBackoff provides a mock function with no fields
+func (_m *InventoryGRPCConfig) MaxRetryBackoff() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxRetryBackoff")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// InventoryGRPCConfig_MaxRetryBackoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxRetryBackoff'
+type InventoryGRPCConfig_MaxRetryBackoff_Call struct {
+	*mock.Call
+}
+
+// MaxRetryBackoff is a helper method to define mock.On call
+func (_e *InventoryGRPCConfig_Expecter) MaxRetryBackoff() *InventoryGRPCConfig_MaxRetryBackoff_Call {
+	return &InventoryGRPCConfig_MaxRetryBackoff_Call{Call: _e.mock.On("MaxRetryBackoff")}
+}
+
+func (_c *InventoryGRPCConfig_MaxRetryBackoff_Call) Run(run func()) *InventoryGRPCConfig_MaxRetryBackoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_MaxRetryBackoff_Call) Return(_a0 time.Duration) *InventoryGRPCConfig_MaxRetryBackoff_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_MaxRetryBackoff_Call) RunAndReturn(run func() time.Duration) *InventoryGRPCConfig_MaxRetryBackoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MethodTimeouts provides a mock function with no fields
+func (_m *InventoryGRPCConfig) MethodTimeouts() map[string]time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MethodTimeouts")
+	}
+
+	var r0 map[string]time.Duration
+	if rf, ok := ret.Get(0).(func() map[string]time.Duration); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]time.Duration)
+		}
+	}
+
+	return r0
+}
+
+// InventoryGRPCConfig_MethodTimeouts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MethodTimeouts'
+type InventoryGRPCConfig_MethodTimeouts_Call struct {
+	*mock.Call
+}
+
+// MethodTimeouts is a helper method to define mock.On call
+func (_e *InventoryGRPCConfig_Expecter) MethodTimeouts() *InventoryGRPCConfig_MethodTimeouts_Call {
+	return &InventoryGRPCConfig_MethodTimeouts_Call{Call: _e.mock.On("MethodTimeouts")}
+}
+
+func (_c *InventoryGRPCConfig_MethodTimeouts_Call) Run(run func()) *InventoryGRPCConfig_MethodTimeouts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_MethodTimeouts_Call) Return(_a0 map[string]time.Duration) *InventoryGRPCConfig_MethodTimeouts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_MethodTimeouts_Call) RunAndReturn(run func() map[string]time.Duration) *InventoryGRPCConfig_MethodTimeouts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryBackoff provides a mock function with no fields
+func (_m *InventoryGRPCConfig) RetryBackoff() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryBackoff")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// InventoryGRPCConfig_RetryBackoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryBackoff'
+type InventoryGRPCConfig_RetryBackoff_Call struct {
+	*mock.Call
+}
+
+// RetryBackoff is a helper method to define mock.On call
+func (_e *InventoryGRPCConfig_Expecter) RetryBackoff() *InventoryGRPCConfig_RetryBackoff_Call {
+	return &InventoryGRPCConfig_RetryBackoff_Call{Call: _e.mock.On("RetryBackoff")}
+}
+
+func (_c *InventoryGRPCConfig_RetryBackoff_Call) Run(run func()) *InventoryGRPCConfig_RetryBackoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_RetryBackoff_Call) Return(_a0 time.Duration) *InventoryGRPCConfig_RetryBackoff_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_RetryBackoff_Call) RunAndReturn(run func() time.Duration) *InventoryGRPCConfig_RetryBackoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryMaxAttempts provides a mock function with no fields
+func (_m *InventoryGRPCConfig) RetryMaxAttempts() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryMaxAttempts")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// InventoryGRPCConfig_RetryMaxAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryMaxAttempts'
+type InventoryGRPCConfig_RetryMaxAttempts_Call struct {
+	*mock.Call
+}
+
+// RetryMaxAttempts is a helper method to define mock.On call
+func (_e *InventoryGRPCConfig_Expecter) RetryMaxAttempts() *InventoryGRPCConfig_RetryMaxAttempts_Call {
+	return &InventoryGRPCConfig_RetryMaxAttempts_Call{Call: _e.mock.On("RetryMaxAttempts")}
+}
+
+func (_c *InventoryGRPCConfig_RetryMaxAttempts_Call) Run(run func()) *InventoryGRPCConfig_RetryMaxAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_RetryMaxAttempts_Call) Return(_a0 int) *InventoryGRPCConfig_RetryMaxAttempts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_RetryMaxAttempts_Call) RunAndReturn(run func() int) *InventoryGRPCConfig_RetryMaxAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServiceToken provides a mock function with no fields
+func (_m *InventoryGRPCConfig) ServiceToken() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServiceToken")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// InventoryGRPCConfig_ServiceToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServiceToken'
+type InventoryGRPCConfig_ServiceToken_Call struct {
+	*mock.Call
+}
+
+// ServiceToken is a helper method to define mock.On call
+func (_e *InventoryGRPCConfig_Expecter) ServiceToken() *InventoryGRPCConfig_ServiceToken_Call {
+	return &InventoryGRPCConfig_ServiceToken_Call{Call: _e.mock.On("ServiceToken")}
+}
+
+func (_c *InventoryGRPCConfig_ServiceToken_Call) Run(run func()) *InventoryGRPCConfig_ServiceToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_ServiceToken_Call) Return(_a0 string) *InventoryGRPCConfig_ServiceToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_ServiceToken_Call) RunAndReturn(run func() string) *InventoryGRPCConfig_ServiceToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Timeout provides a mock function with no fields
+func (_m *InventoryGRPCConfig) Timeout() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Timeout")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// InventoryGRPCConfig_Timeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Timeout'
+type InventoryGRPCConfig_Timeout_Call struct {
+	*mock.Call
+}
+
+// Timeout is a helper method to define mock.On call
+func (_e *InventoryGRPCConfig_Expecter) Timeout() *InventoryGRPCConfig_Timeout_Call {
+	return &InventoryGRPCConfig_Timeout_Call{Call: _e.mock.On("Timeout")}
+}
+
+func (_c *InventoryGRPCConfig_Timeout_Call) Run(run func()) *InventoryGRPCConfig_Timeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_Timeout_Call) Return(_a0 time.Duration) *InventoryGRPCConfig_Timeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_Timeout_Call) RunAndReturn(run func() time.Duration) *InventoryGRPCConfig_Timeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewInventoryGRPCConfig creates a new instance of InventoryGRPCConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewInventoryGRPCConfig(t interface {
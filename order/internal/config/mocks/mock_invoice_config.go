@@ -0,0 +1,169 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// InvoiceConfig is an autogenerated mock type for the InvoiceConfig type
+type InvoiceConfig struct {
+	mock.Mock
+}
+
+type InvoiceConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InvoiceConfig) EXPECT() *InvoiceConfig_Expecter {
+	return &InvoiceConfig_Expecter{mock: &_m.Mock}
+}
+
+// NumberPrefix provides a mock function with no fields
+func (_m *InvoiceConfig) NumberPrefix() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NumberPrefix")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// InvoiceConfig_NumberPrefix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NumberPrefix'
+type InvoiceConfig_NumberPrefix_Call struct {
+	*mock.Call
+}
+
+// NumberPrefix is a helper method to define mock.On call
+func (_e *InvoiceConfig_Expecter) NumberPrefix() *InvoiceConfig_NumberPrefix_Call {
+	return &InvoiceConfig_NumberPrefix_Call{Call: _e.mock.On("NumberPrefix")}
+}
+
+func (_c *InvoiceConfig_NumberPrefix_Call) Run(run func()) *InvoiceConfig_NumberPrefix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InvoiceConfig_NumberPrefix_Call) Return(_a0 string) *InvoiceConfig_NumberPrefix_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InvoiceConfig_NumberPrefix_Call) RunAndReturn(run func() string) *InvoiceConfig_NumberPrefix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SellerAddress provides a mock function with no fields
+func (_m *InvoiceConfig) SellerAddress() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SellerAddress")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// InvoiceConfig_SellerAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SellerAddress'
+type InvoiceConfig_SellerAddress_Call struct {
+	*mock.Call
+}
+
+// SellerAddress is a helper method to define mock.On call
+func (_e *InvoiceConfig_Expecter) SellerAddress() *InvoiceConfig_SellerAddress_Call {
+	return &InvoiceConfig_SellerAddress_Call{Call: _e.mock.On("SellerAddress")}
+}
+
+func (_c *InvoiceConfig_SellerAddress_Call) Run(run func()) *InvoiceConfig_SellerAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InvoiceConfig_SellerAddress_Call) Return(_a0 string) *InvoiceConfig_SellerAddress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InvoiceConfig_SellerAddress_Call) RunAndReturn(run func() string) *InvoiceConfig_SellerAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SellerName provides a mock function with no fields
+func (_m *InvoiceConfig) SellerName() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SellerName")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// InvoiceConfig_SellerName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SellerName'
+type InvoiceConfig_SellerName_Call struct {
+	*mock.Call
+}
+
+// SellerName is a helper method to define mock.On call
+func (_e *InvoiceConfig_Expecter) SellerName() *InvoiceConfig_SellerName_Call {
+	return &InvoiceConfig_SellerName_Call{Call: _e.mock.On("SellerName")}
+}
+
+func (_c *InvoiceConfig_SellerName_Call) Run(run func()) *InvoiceConfig_SellerName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InvoiceConfig_SellerName_Call) Return(_a0 string) *InvoiceConfig_SellerName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InvoiceConfig_SellerName_Call) RunAndReturn(run func() string) *InvoiceConfig_SellerName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInvoiceConfig creates a new instance of InvoiceConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInvoiceConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InvoiceConfig {
+	mock := &InvoiceConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -22,6 +22,51 @@ func (_m *OrderAssembledConsumerConfig) EXPECT() *OrderAssembledConsumerConfig_E
 	return &OrderAssembledConsumerConfig_Expecter{mock: &_m.Mock}
 }
 
+// AssemblyStartedTopic provides a mock function with no fields
+func (_m *OrderAssembledConsumerConfig) AssemblyStartedTopic() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssemblyStartedTopic")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OrderAssembledConsumerConfig_AssemblyStartedTopic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssemblyStartedTopic'
+type OrderAssembledConsumerConfig_AssemblyStartedTopic_Call struct {
+	*mock.Call
+}
+
+// AssemblyStartedTopic is a helper method to define mock.On call
+func (_e *OrderAssembledConsumerConfig_Expecter) AssemblyStartedTopic() *OrderAssembledConsumerConfig_AssemblyStartedTopic_Call {
+	return &OrderAssembledConsumerConfig_AssemblyStartedTopic_Call{Call: _e.mock.On("AssemblyStartedTopic")}
+}
+
+func (_c *OrderAssembledConsumerConfig_AssemblyStartedTopic_Call) Run(run func()) *OrderAssembledConsumerConfig_AssemblyStartedTopic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderAssembledConsumerConfig_AssemblyStartedTopic_Call) Return(_a0 string) *OrderAssembledConsumerConfig_AssemblyStartedTopic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderAssembledConsumerConfig_AssemblyStartedTopic_Call) RunAndReturn(run func() string) *OrderAssembledConsumerConfig_AssemblyStartedTopic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Config provides a mock function with no fields
 func (_m *OrderAssembledConsumerConfig) Config() *sarama.Config {
 	ret := _m.Called()
@@ -0,0 +1,79 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// OrderCancelledProducerConfig is an autogenerated mock type for the OrderCancelledProducerConfig type
+type OrderCancelledProducerConfig struct {
+	mock.Mock
+}
+
+type OrderCancelledProducerConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderCancelledProducerConfig) EXPECT() *OrderCancelledProducerConfig_Expecter {
+	return &OrderCancelledProducerConfig_Expecter{mock: &_m.Mock}
+}
+
+// TopicName provides a mock function with no fields
+func (_m *OrderCancelledProducerConfig) TopicName() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TopicName")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OrderCancelledProducerConfig_TopicName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TopicName'
+type OrderCancelledProducerConfig_TopicName_Call struct {
+	*mock.Call
+}
+
+// TopicName is a helper method to define mock.On call
+func (_e *OrderCancelledProducerConfig_Expecter) TopicName() *OrderCancelledProducerConfig_TopicName_Call {
+	return &OrderCancelledProducerConfig_TopicName_Call{Call: _e.mock.On("TopicName")}
+}
+
+func (_c *OrderCancelledProducerConfig_TopicName_Call) Run(run func()) *OrderCancelledProducerConfig_TopicName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderCancelledProducerConfig_TopicName_Call) Return(_a0 string) *OrderCancelledProducerConfig_TopicName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderCancelledProducerConfig_TopicName_Call) RunAndReturn(run func() string) *OrderCancelledProducerConfig_TopicName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderCancelledProducerConfig creates a new instance of OrderCancelledProducerConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderCancelledProducerConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderCancelledProducerConfig {
+	mock := &OrderCancelledProducerConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
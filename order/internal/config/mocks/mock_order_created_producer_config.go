@@ -0,0 +1,79 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// OrderCreatedProducerConfig is an autogenerated mock type for the OrderCreatedProducerConfig type
+type OrderCreatedProducerConfig struct {
+	mock.Mock
+}
+
+type OrderCreatedProducerConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderCreatedProducerConfig) EXPECT() *OrderCreatedProducerConfig_Expecter {
+	return &OrderCreatedProducerConfig_Expecter{mock: &_m.Mock}
+}
+
+// TopicName provides a mock function with no fields
+func (_m *OrderCreatedProducerConfig) TopicName() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TopicName")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OrderCreatedProducerConfig_TopicName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TopicName'
+type OrderCreatedProducerConfig_TopicName_Call struct {
+	*mock.Call
+}
+
+// TopicName is a helper method to define mock.On call
+func (_e *OrderCreatedProducerConfig_Expecter) TopicName() *OrderCreatedProducerConfig_TopicName_Call {
+	return &OrderCreatedProducerConfig_TopicName_Call{Call: _e.mock.On("TopicName")}
+}
+
+func (_c *OrderCreatedProducerConfig_TopicName_Call) Run(run func()) *OrderCreatedProducerConfig_TopicName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderCreatedProducerConfig_TopicName_Call) Return(_a0 string) *OrderCreatedProducerConfig_TopicName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderCreatedProducerConfig_TopicName_Call) RunAndReturn(run func() string) *OrderCreatedProducerConfig_TopicName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderCreatedProducerConfig creates a new instance of OrderCreatedProducerConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderCreatedProducerConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderCreatedProducerConfig {
+	mock := &OrderCreatedProducerConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
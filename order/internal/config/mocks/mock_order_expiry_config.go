@@ -0,0 +1,173 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrderExpiryConfig is an autogenerated mock type for the OrderExpiryConfig type
+type OrderExpiryConfig struct {
+	mock.Mock
+}
+
+type OrderExpiryConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderExpiryConfig) EXPECT() *OrderExpiryConfig_Expecter {
+	return &OrderExpiryConfig_Expecter{mock: &_m.Mock}
+}
+
+// BatchSize provides a mock function with no fields
+func (_m *OrderExpiryConfig) BatchSize() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchSize")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// OrderExpiryConfig_BatchSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchSize'
+type OrderExpiryConfig_BatchSize_Call struct {
+	*mock.Call
+}
+
+// BatchSize is a helper method to define mock.On call
+func (_e *OrderExpiryConfig_Expecter) BatchSize() *OrderExpiryConfig_BatchSize_Call {
+	return &OrderExpiryConfig_BatchSize_Call{Call: _e.mock.On("BatchSize")}
+}
+
+func (_c *OrderExpiryConfig_BatchSize_Call) Run(run func()) *OrderExpiryConfig_BatchSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderExpiryConfig_BatchSize_Call) Return(_a0 int) *OrderExpiryConfig_BatchSize_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderExpiryConfig_BatchSize_Call) RunAndReturn(run func() int) *OrderExpiryConfig_BatchSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Interval provides a mock function with no fields
+func (_m *OrderExpiryConfig) Interval() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Interval")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// OrderExpiryConfig_Interval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Interval'
+type OrderExpiryConfig_Interval_Call struct {
+	*mock.Call
+}
+
+// Interval is a helper method to define mock.On call
+func (_e *OrderExpiryConfig_Expecter) Interval() *OrderExpiryConfig_Interval_Call {
+	return &OrderExpiryConfig_Interval_Call{Call: _e.mock.On("Interval")}
+}
+
+func (_c *OrderExpiryConfig_Interval_Call) Run(run func()) *OrderExpiryConfig_Interval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderExpiryConfig_Interval_Call) Return(_a0 time.Duration) *OrderExpiryConfig_Interval_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderExpiryConfig_Interval_Call) RunAndReturn(run func() time.Duration) *OrderExpiryConfig_Interval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TTL provides a mock function with no fields
+func (_m *OrderExpiryConfig) TTL() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TTL")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// OrderExpiryConfig_TTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TTL'
+type OrderExpiryConfig_TTL_Call struct {
+	*mock.Call
+}
+
+// TTL is a helper method to define mock.On call
+func (_e *OrderExpiryConfig_Expecter) TTL() *OrderExpiryConfig_TTL_Call {
+	return &OrderExpiryConfig_TTL_Call{Call: _e.mock.On("TTL")}
+}
+
+func (_c *OrderExpiryConfig_TTL_Call) Run(run func()) *OrderExpiryConfig_TTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderExpiryConfig_TTL_Call) Return(_a0 time.Duration) *OrderExpiryConfig_TTL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderExpiryConfig_TTL_Call) RunAndReturn(run func() time.Duration) *OrderExpiryConfig_TTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderExpiryConfig creates a new instance of OrderExpiryConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderExpiryConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderExpiryConfig {
+	mock := &OrderExpiryConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,79 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// OrderGRPCConfig is an autogenerated mock type for the OrderGRPCConfig type
+type OrderGRPCConfig struct {
+	mock.Mock
+}
+
+type OrderGRPCConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderGRPCConfig) EXPECT() *OrderGRPCConfig_Expecter {
+	return &OrderGRPCConfig_Expecter{mock: &_m.Mock}
+}
+
+// Address provides a mock function with no fields
+func (_m *OrderGRPCConfig) Address() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Address")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OrderGRPCConfig_Address_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Address'
+type OrderGRPCConfig_Address_Call struct {
+	*mock.Call
+}
+
+// Address is a helper method to define mock.On call
+func (_e *OrderGRPCConfig_Expecter) Address() *OrderGRPCConfig_Address_Call {
+	return &OrderGRPCConfig_Address_Call{Call: _e.mock.On("Address")}
+}
+
+func (_c *OrderGRPCConfig_Address_Call) Run(run func()) *OrderGRPCConfig_Address_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderGRPCConfig_Address_Call) Return(_a0 string) *OrderGRPCConfig_Address_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderGRPCConfig_Address_Call) RunAndReturn(run func() string) *OrderGRPCConfig_Address_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderGRPCConfig creates a new instance of OrderGRPCConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderGRPCConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderGRPCConfig {
+	mock := &OrderGRPCConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
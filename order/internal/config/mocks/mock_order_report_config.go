@@ -0,0 +1,175 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrderReportConfig is an autogenerated mock type for the OrderReportConfig type
+type OrderReportConfig struct {
+	mock.Mock
+}
+
+type OrderReportConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderReportConfig) EXPECT() *OrderReportConfig_Expecter {
+	return &OrderReportConfig_Expecter{mock: &_m.Mock}
+}
+
+// AdminUserUUIDs provides a mock function with no fields
+func (_m *OrderReportConfig) AdminUserUUIDs() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdminUserUUIDs")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// OrderReportConfig_AdminUserUUIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdminUserUUIDs'
+type OrderReportConfig_AdminUserUUIDs_Call struct {
+	*mock.Call
+}
+
+// AdminUserUUIDs is a helper method to define mock.On call
+func (_e *OrderReportConfig_Expecter) AdminUserUUIDs() *OrderReportConfig_AdminUserUUIDs_Call {
+	return &OrderReportConfig_AdminUserUUIDs_Call{Call: _e.mock.On("AdminUserUUIDs")}
+}
+
+func (_c *OrderReportConfig_AdminUserUUIDs_Call) Run(run func()) *OrderReportConfig_AdminUserUUIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderReportConfig_AdminUserUUIDs_Call) Return(_a0 []string) *OrderReportConfig_AdminUserUUIDs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderReportConfig_AdminUserUUIDs_Call) RunAndReturn(run func() []string) *OrderReportConfig_AdminUserUUIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxRange provides a mock function with no fields
+func (_m *OrderReportConfig) MaxRange() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxRange")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// OrderReportConfig_MaxRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxRange'
+type OrderReportConfig_MaxRange_Call struct {
+	*mock.Call
+}
+
+// MaxRange is a helper method to define mock.On call
+func (_e *OrderReportConfig_Expecter) MaxRange() *OrderReportConfig_MaxRange_Call {
+	return &OrderReportConfig_MaxRange_Call{Call: _e.mock.On("MaxRange")}
+}
+
+func (_c *OrderReportConfig_MaxRange_Call) Run(run func()) *OrderReportConfig_MaxRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderReportConfig_MaxRange_Call) Return(_a0 time.Duration) *OrderReportConfig_MaxRange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderReportConfig_MaxRange_Call) RunAndReturn(run func() time.Duration) *OrderReportConfig_MaxRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StatementTimeout provides a mock function with no fields
+func (_m *OrderReportConfig) StatementTimeout() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for StatementTimeout")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// OrderReportConfig_StatementTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StatementTimeout'
+type OrderReportConfig_StatementTimeout_Call struct {
+	*mock.Call
+}
+
+// StatementTimeout is a helper method to define mock.On call
+func (_e *OrderReportConfig_Expecter) StatementTimeout() *OrderReportConfig_StatementTimeout_Call {
+	return &OrderReportConfig_StatementTimeout_Call{Call: _e.mock.On("StatementTimeout")}
+}
+
+func (_c *OrderReportConfig_StatementTimeout_Call) Run(run func()) *OrderReportConfig_StatementTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderReportConfig_StatementTimeout_Call) Return(_a0 time.Duration) *OrderReportConfig_StatementTimeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderReportConfig_StatementTimeout_Call) RunAndReturn(run func() time.Duration) *OrderReportConfig_StatementTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderReportConfig creates a new instance of OrderReportConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderReportConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderReportConfig {
+	mock := &OrderReportConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
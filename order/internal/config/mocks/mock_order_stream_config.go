@@ -0,0 +1,173 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrderStreamConfig is an autogenerated mock type for the OrderStreamConfig type
+type OrderStreamConfig struct {
+	mock.Mock
+}
+
+type OrderStreamConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderStreamConfig) EXPECT() *OrderStreamConfig_Expecter {
+	return &OrderStreamConfig_Expecter{mock: &_m.Mock}
+}
+
+// BufferSize provides a mock function with no fields
+func (_m *OrderStreamConfig) BufferSize() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BufferSize")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// OrderStreamConfig_BufferSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BufferSize'
+type OrderStreamConfig_BufferSize_Call struct {
+	*mock.Call
+}
+
+// BufferSize is a helper method to define mock.On call
+func (_e *OrderStreamConfig_Expecter) BufferSize() *OrderStreamConfig_BufferSize_Call {
+	return &OrderStreamConfig_BufferSize_Call{Call: _e.mock.On("BufferSize")}
+}
+
+func (_c *OrderStreamConfig_BufferSize_Call) Run(run func()) *OrderStreamConfig_BufferSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderStreamConfig_BufferSize_Call) Return(_a0 int) *OrderStreamConfig_BufferSize_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderStreamConfig_BufferSize_Call) RunAndReturn(run func() int) *OrderStreamConfig_BufferSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Channel provides a mock function with no fields
+func (_m *OrderStreamConfig) Channel() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Channel")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OrderStreamConfig_Channel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Channel'
+type OrderStreamConfig_Channel_Call struct {
+	*mock.Call
+}
+
+// Channel is a helper method to define mock.On call
+func (_e *OrderStreamConfig_Expecter) Channel() *OrderStreamConfig_Channel_Call {
+	return &OrderStreamConfig_Channel_Call{Call: _e.mock.On("Channel")}
+}
+
+func (_c *OrderStreamConfig_Channel_Call) Run(run func()) *OrderStreamConfig_Channel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderStreamConfig_Channel_Call) Return(_a0 string) *OrderStreamConfig_Channel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderStreamConfig_Channel_Call) RunAndReturn(run func() string) *OrderStreamConfig_Channel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HeartbeatInterval provides a mock function with no fields
+func (_m *OrderStreamConfig) HeartbeatInterval() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HeartbeatInterval")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// OrderStreamConfig_HeartbeatInterval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HeartbeatInterval'
+type OrderStreamConfig_HeartbeatInterval_Call struct {
+	*mock.Call
+}
+
+// HeartbeatInterval is a helper method to define mock.On call
+func (_e *OrderStreamConfig_Expecter) HeartbeatInterval() *OrderStreamConfig_HeartbeatInterval_Call {
+	return &OrderStreamConfig_HeartbeatInterval_Call{Call: _e.mock.On("HeartbeatInterval")}
+}
+
+func (_c *OrderStreamConfig_HeartbeatInterval_Call) Run(run func()) *OrderStreamConfig_HeartbeatInterval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderStreamConfig_HeartbeatInterval_Call) Return(_a0 time.Duration) *OrderStreamConfig_HeartbeatInterval_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderStreamConfig_HeartbeatInterval_Call) RunAndReturn(run func() time.Duration) *OrderStreamConfig_HeartbeatInterval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderStreamConfig creates a new instance of OrderStreamConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderStreamConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderStreamConfig {
+	mock := &OrderStreamConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,218 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OutboxConfig is an autogenerated mock type for the OutboxConfig type
+type OutboxConfig struct {
+	mock.Mock
+}
+
+type OutboxConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OutboxConfig) EXPECT() *OutboxConfig_Expecter {
+	return &OutboxConfig_Expecter{mock: &_m.Mock}
+}
+
+// BatchSize provides a mock function with no fields
+func (_m *OutboxConfig) BatchSize() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchSize")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// OutboxConfig_BatchSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchSize'
+type OutboxConfig_BatchSize_Call struct {
+	*mock.Call
+}
+
+// BatchSize is a helper method to define mock.On call
+func (_e *OutboxConfig_Expecter) BatchSize() *OutboxConfig_BatchSize_Call {
+	return &OutboxConfig_BatchSize_Call{Call: _e.mock.On("BatchSize")}
+}
+
+func (_c *OutboxConfig_BatchSize_Call) Run(run func()) *OutboxConfig_BatchSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OutboxConfig_BatchSize_Call) Return(_a0 int) *OutboxConfig_BatchSize_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxConfig_BatchSize_Call) RunAndReturn(run func() int) *OutboxConfig_BatchSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxBackoff provides a mock function with no fields
+func (_m *OutboxConfig) MaxBackoff() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxBackoff")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// OutboxConfig_MaxBackoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxBackoff'
+type OutboxConfig_MaxBackoff_Call struct {
+	*mock.Call
+}
+
+// MaxBackoff is a helper method to define mock.On call
+func (_e *OutboxConfig_Expecter) MaxBackoff() *OutboxConfig_MaxBackoff_Call {
+	return &OutboxConfig_MaxBackoff_Call{Call: _e.mock.On("MaxBackoff")}
+}
+
+func (_c *OutboxConfig_MaxBackoff_Call) Run(run func()) *OutboxConfig_MaxBackoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OutboxConfig_MaxBackoff_Call) Return(_a0 time.Duration) *OutboxConfig_MaxBackoff_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxConfig_MaxBackoff_Call) RunAndReturn(run func() time.Duration) *OutboxConfig_MaxBackoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PollInterval provides a mock function with no fields
+func (_m *OutboxConfig) PollInterval() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PollInterval")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// OutboxConfig_PollInterval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PollInterval'
+type OutboxConfig_PollInterval_Call struct {
+	*mock.Call
+}
+
+// PollInterval is a helper method to define mock.On call
+func (_e *OutboxConfig_Expecter) PollInterval() *OutboxConfig_PollInterval_Call {
+	return &OutboxConfig_PollInterval_Call{Call: _e.mock.On("PollInterval")}
+}
+
+func (_c *OutboxConfig_PollInterval_Call) Run(run func()) *OutboxConfig_PollInterval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OutboxConfig_PollInterval_Call) Return(_a0 time.Duration) *OutboxConfig_PollInterval_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxConfig_PollInterval_Call) RunAndReturn(run func() time.Duration) *OutboxConfig_PollInterval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Retention provides a mock function with no fields
+func (_m *OutboxConfig) Retention() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retention")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// OutboxConfig_Retention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retention'
+type OutboxConfig_Retention_Call struct {
+	*mock.Call
+}
+
+// Retention is a helper method to define mock.On call
+func (_e *OutboxConfig_Expecter) Retention() *OutboxConfig_Retention_Call {
+	return &OutboxConfig_Retention_Call{Call: _e.mock.On("Retention")}
+}
+
+func (_c *OutboxConfig_Retention_Call) Run(run func()) *OutboxConfig_Retention_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OutboxConfig_Retention_Call) Return(_a0 time.Duration) *OutboxConfig_Retention_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxConfig_Retention_Call) RunAndReturn(run func() time.Duration) *OutboxConfig_Retention_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOutboxConfig creates a new instance of OutboxConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxConfig {
+	mock := &OutboxConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
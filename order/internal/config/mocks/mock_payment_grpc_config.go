@@ -4,7 +4,11 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // PaymentGRPCConfig is an autogenerated mock type for the PaymentGRPCConfig type
 type PaymentGRPCConfig struct {
@@ -64,6 +68,323 @@ func (_c *PaymentGRPCConfig_Address_Call) RunAndReturn(run func() string) *Payme
 	return _c
 }
 
+// BreakerFailureThreshold provides a mock function with no fields
+func (_m *PaymentGRPCConfig) BreakerFailureThreshold() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BreakerFailureThreshold")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// PaymentGRPCConfig_BreakerFailureThreshold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BreakerFailureThreshold'
+type PaymentGRPCConfig_BreakerFailureThreshold_Call struct {
+	*mock.Call
+}
+
+// BreakerFailureThreshold is a helper method to define mock.On call
+func (_e *PaymentGRPCConfig_Expecter) BreakerFailureThreshold() *PaymentGRPCConfig_BreakerFailureThreshold_Call {
+	return &PaymentGRPCConfig_BreakerFailureThreshold_Call{Call: _e.mock.On("BreakerFailureThreshold")}
+}
+
+func (_c *PaymentGRPCConfig_BreakerFailureThreshold_Call) Run(run func()) *PaymentGRPCConfig_BreakerFailureThreshold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentGRPCConfig_BreakerFailureThreshold_Call) Return(_a0 int) *PaymentGRPCConfig_BreakerFailureThreshold_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentGRPCConfig_BreakerFailureThreshold_Call) RunAndReturn(run func() int) *PaymentGRPCConfig_BreakerFailureThreshold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BreakerOpenTimeout provides a mock function with no fields
+func (_m *PaymentGRPCConfig) BreakerOpenTimeout() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BreakerOpenTimeout")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// PaymentGRPCConfig_BreakerOpenTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BreakerOpenTimeout'
+type PaymentGRPCConfig_BreakerOpenTimeout_Call struct {
+	*mock.Call
+}
+
+// BreakerOpenTimeout is a helper method to define mock.On call
+func (_e *PaymentGRPCConfig_Expecter) BreakerOpenTimeout() *PaymentGRPCConfig_BreakerOpenTimeout_Call {
+	return &PaymentGRPCConfig_BreakerOpenTimeout_Call{Call: _e.mock.On("BreakerOpenTimeout")}
+}
+
+func (_c *PaymentGRPCConfig_BreakerOpenTimeout_Call) Run(run func()) *PaymentGRPCConfig_BreakerOpenTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentGRPCConfig_BreakerOpenTimeout_Call) Return(_a0 time.Duration) *PaymentGRPCConfig_BreakerOpenTimeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentGRPCConfig_BreakerOpenTimeout_Call) RunAndReturn(run func() time.Duration) *PaymentGRPCConfig_BreakerOpenTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxRetryBackoff provides a mock function with no fields
+func (_m *PaymentGRPCConfig) MaxRetryBackoff() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxRetryBackoff")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// PaymentGRPCConfig_MaxRetryBackoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxRetryBackoff'
+type PaymentGRPCConfig_MaxRetryBackoff_Call struct {
+	*mock.Call
+}
+
+// MaxRetryBackoff is a helper method to define mock.On call
+func (_e *PaymentGRPCConfig_Expecter) MaxRetryBackoff() *PaymentGRPCConfig_MaxRetryBackoff_Call {
+	return &PaymentGRPCConfig_MaxRetryBackoff_Call{Call: _e.mock.On("MaxRetryBackoff")}
+}
+
+func (_c *PaymentGRPCConfig_MaxRetryBackoff_Call) Run(run func()) *PaymentGRPCConfig_MaxRetryBackoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentGRPCConfig_MaxRetryBackoff_Call) Return(_a0 time.Duration) *PaymentGRPCConfig_MaxRetryBackoff_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentGRPCConfig_MaxRetryBackoff_Call) RunAndReturn(run func() time.Duration) *PaymentGRPCConfig_MaxRetryBackoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MethodTimeouts provides a mock function with no fields
+func (_m *PaymentGRPCConfig) MethodTimeouts() map[string]time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MethodTimeouts")
+	}
+
+	var r0 map[string]time.Duration
+	if rf, ok := ret.Get(0).(func() map[string]time.Duration); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]time.Duration)
+		}
+	}
+
+	return r0
+}
+
+// PaymentGRPCConfig_MethodTimeouts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MethodTimeouts'
+type PaymentGRPCConfig_MethodTimeouts_Call struct {
+	*mock.Call
+}
+
+// MethodTimeouts is a helper method to define mock.On call
+func (_e *PaymentGRPCConfig_Expecter) MethodTimeouts() *PaymentGRPCConfig_MethodTimeouts_Call {
+	return &PaymentGRPCConfig_MethodTimeouts_Call{Call: _e.mock.On("MethodTimeouts")}
+}
+
+func (_c *PaymentGRPCConfig_MethodTimeouts_Call) Run(run func()) *PaymentGRPCConfig_MethodTimeouts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentGRPCConfig_MethodTimeouts_Call) Return(_a0 map[string]time.Duration) *PaymentGRPCConfig_MethodTimeouts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentGRPCConfig_MethodTimeouts_Call) RunAndReturn(run func() map[string]time.Duration) *PaymentGRPCConfig_MethodTimeouts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryBackoff provides a mock function with no fields
+func (_m *PaymentGRPCConfig) RetryBackoff() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryBackoff")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// PaymentGRPCConfig_RetryBackoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryBackoff'
+type PaymentGRPCConfig_RetryBackoff_Call struct {
+	*mock.Call
+}
+
+// RetryBackoff is a helper method to define mock.On call
+func (_e *PaymentGRPCConfig_Expecter) RetryBackoff() *PaymentGRPCConfig_RetryBackoff_Call {
+	return &PaymentGRPCConfig_RetryBackoff_Call{Call: _e.mock.On("RetryBackoff")}
+}
+
+func (_c *PaymentGRPCConfig_RetryBackoff_Call) Run(run func()) *PaymentGRPCConfig_RetryBackoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentGRPCConfig_RetryBackoff_Call) Return(_a0 time.Duration) *PaymentGRPCConfig_RetryBackoff_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentGRPCConfig_RetryBackoff_Call) RunAndReturn(run func() time.Duration) *PaymentGRPCConfig_RetryBackoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryMaxAttempts provides a mock function with no fields
+func (_m *PaymentGRPCConfig) RetryMaxAttempts() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryMaxAttempts")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// PaymentGRPCConfig_RetryMaxAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryMaxAttempts'
+type PaymentGRPCConfig_RetryMaxAttempts_Call struct {
+	*mock.Call
+}
+
+// RetryMaxAttempts is a helper method to define mock.On call
+func (_e *PaymentGRPCConfig_Expecter) RetryMaxAttempts() *PaymentGRPCConfig_RetryMaxAttempts_Call {
+	return &PaymentGRPCConfig_RetryMaxAttempts_Call{Call: _e.mock.On("RetryMaxAttempts")}
+}
+
+func (_c *PaymentGRPCConfig_RetryMaxAttempts_Call) Run(run func()) *PaymentGRPCConfig_RetryMaxAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentGRPCConfig_RetryMaxAttempts_Call) Return(_a0 int) *PaymentGRPCConfig_RetryMaxAttempts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentGRPCConfig_RetryMaxAttempts_Call) RunAndReturn(run func() int) *PaymentGRPCConfig_RetryMaxAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Timeout provides a mock function with no fields
+func (_m *PaymentGRPCConfig) Timeout() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Timeout")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// PaymentGRPCConfig_Timeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Timeout'
+type PaymentGRPCConfig_Timeout_Call struct {
+	*mock.Call
+}
+
+// Timeout is a helper method to define mock.On call
+func (_e *PaymentGRPCConfig_Expecter) Timeout() *PaymentGRPCConfig_Timeout_Call {
+	return &PaymentGRPCConfig_Timeout_Call{Call: _e.mock.On("Timeout")}
+}
+
+func (_c *PaymentGRPCConfig_Timeout_Call) Run(run func()) *PaymentGRPCConfig_Timeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentGRPCConfig_Timeout_Call) Return(_a0 time.Duration) *PaymentGRPCConfig_Timeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentGRPCConfig_Timeout_Call) RunAndReturn(run func() time.Duration) *PaymentGRPCConfig_Timeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPaymentGRPCConfig creates a new instance of PaymentGRPCConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPaymentGRPCConfig(t interface {
@@ -0,0 +1,443 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookConfig is an autogenerated mock type for the WebhookConfig type
+type WebhookConfig struct {
+	mock.Mock
+}
+
+type WebhookConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookConfig) EXPECT() *WebhookConfig_Expecter {
+	return &WebhookConfig_Expecter{mock: &_m.Mock}
+}
+
+// AllowPrivateNetworks provides a mock function with no fields
+func (_m *WebhookConfig) AllowPrivateNetworks() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllowPrivateNetworks")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// WebhookConfig_AllowPrivateNetworks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllowPrivateNetworks'
+type WebhookConfig_AllowPrivateNetworks_Call struct {
+	*mock.Call
+}
+
+// AllowPrivateNetworks is a helper method to define mock.On call
+func (_e *WebhookConfig_Expecter) AllowPrivateNetworks() *WebhookConfig_AllowPrivateNetworks_Call {
+	return &WebhookConfig_AllowPrivateNetworks_Call{Call: _e.mock.On("AllowPrivateNetworks")}
+}
+
+func (_c *WebhookConfig_AllowPrivateNetworks_Call) Run(run func()) *WebhookConfig_AllowPrivateNetworks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WebhookConfig_AllowPrivateNetworks_Call) Return(_a0 bool) *WebhookConfig_AllowPrivateNetworks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookConfig_AllowPrivateNetworks_Call) RunAndReturn(run func() bool) *WebhookConfig_AllowPrivateNetworks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchSize provides a mock function with no fields
+func (_m *WebhookConfig) BatchSize() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchSize")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// WebhookConfig_BatchSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchSize'
+type WebhookConfig_BatchSize_Call struct {
+	*mock.Call
+}
+
+// BatchSize is a helper method to define mock.On call
+func (_e *WebhookConfig_Expecter) BatchSize() *WebhookConfig_BatchSize_Call {
+	return &WebhookConfig_BatchSize_Call{Call: _e.mock.On("BatchSize")}
+}
+
+func (_c *WebhookConfig_BatchSize_Call) Run(run func()) *WebhookConfig_BatchSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WebhookConfig_BatchSize_Call) Return(_a0 int) *WebhookConfig_BatchSize_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookConfig_BatchSize_Call) RunAndReturn(run func() int) *WebhookConfig_BatchSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimLease provides a mock function with no fields
+func (_m *WebhookConfig) ClaimLease() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimLease")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// WebhookConfig_ClaimLease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimLease'
+type WebhookConfig_ClaimLease_Call struct {
+	*mock.Call
+}
+
+// ClaimLease is a helper method to define mock.On call
+func (_e *WebhookConfig_Expecter) ClaimLease() *WebhookConfig_ClaimLease_Call {
+	return &WebhookConfig_ClaimLease_Call{Call: _e.mock.On("ClaimLease")}
+}
+
+func (_c *WebhookConfig_ClaimLease_Call) Run(run func()) *WebhookConfig_ClaimLease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WebhookConfig_ClaimLease_Call) Return(_a0 time.Duration) *WebhookConfig_ClaimLease_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookConfig_ClaimLease_Call) RunAndReturn(run func() time.Duration) *WebhookConfig_ClaimLease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DisableAfterFailures provides a mock function with no fields
+func (_m *WebhookConfig) DisableAfterFailures() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableAfterFailures")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// WebhookConfig_DisableAfterFailures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableAfterFailures'
+type WebhookConfig_DisableAfterFailures_Call struct {
+	*mock.Call
+}
+
+// DisableAfterFailures is a helper method to define mock.On call
+func (_e *WebhookConfig_Expecter) DisableAfterFailures() *WebhookConfig_DisableAfterFailures_Call {
+	return &WebhookConfig_DisableAfterFailures_Call{Call: _e.mock.On("DisableAfterFailures")}
+}
+
+func (_c *WebhookConfig_DisableAfterFailures_Call) Run(run func()) *WebhookConfig_DisableAfterFailures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WebhookConfig_DisableAfterFailures_Call) Return(_a0 int) *WebhookConfig_DisableAfterFailures_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookConfig_DisableAfterFailures_Call) RunAndReturn(run func() int) *WebhookConfig_DisableAfterFailures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxAttempts provides a mock function with no fields
+func (_m *WebhookConfig) MaxAttempts() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxAttempts")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// WebhookConfig_MaxAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxAttempts'
+type WebhookConfig_MaxAttempts_Call struct {
+	*mock.Call
+}
+
+// MaxAttempts is a helper method to define mock.On call
+func (_e *WebhookConfig_Expecter) MaxAttempts() *WebhookConfig_MaxAttempts_Call {
+	return &WebhookConfig_MaxAttempts_Call{Call: _e.mock.On("MaxAttempts")}
+}
+
+func (_c *WebhookConfig_MaxAttempts_Call) Run(run func()) *WebhookConfig_MaxAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WebhookConfig_MaxAttempts_Call) Return(_a0 int) *WebhookConfig_MaxAttempts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookConfig_MaxAttempts_Call) RunAndReturn(run func() int) *WebhookConfig_MaxAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxRetryBackoff provides a mock function with no fields
+func (_m *WebhookConfig) MaxRetryBackoff() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxRetryBackoff")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// WebhookConfig_MaxRetryBackoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxRetryBackoff'
+type WebhookConfig_MaxRetryBackoff_Call struct {
+	*mock.Call
+}
+
+// MaxRetryBackoff is a helper method to define mock.On call
+func (_e *WebhookConfig_Expecter) MaxRetryBackoff() *WebhookConfig_MaxRetryBackoff_Call {
+	return &WebhookConfig_MaxRetryBackoff_Call{Call: _e.mock.On("MaxRetryBackoff")}
+}
+
+func (_c *WebhookConfig_MaxRetryBackoff_Call) Run(run func()) *WebhookConfig_MaxRetryBackoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WebhookConfig_MaxRetryBackoff_Call) Return(_a0 time.Duration) *WebhookConfig_MaxRetryBackoff_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookConfig_MaxRetryBackoff_Call) RunAndReturn(run func() time.Duration) *WebhookConfig_MaxRetryBackoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PollInterval provides a mock function with no fields
+func (_m *WebhookConfig) PollInterval() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PollInterval")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// WebhookConfig_PollInterval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PollInterval'
+type WebhookConfig_PollInterval_Call struct {
+	*mock.Call
+}
+
+// PollInterval is a helper method to define mock.On call
+func (_e *WebhookConfig_Expecter) PollInterval() *WebhookConfig_PollInterval_Call {
+	return &WebhookConfig_PollInterval_Call{Call: _e.mock.On("PollInterval")}
+}
+
+func (_c *WebhookConfig_PollInterval_Call) Run(run func()) *WebhookConfig_PollInterval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WebhookConfig_PollInterval_Call) Return(_a0 time.Duration) *WebhookConfig_PollInterval_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookConfig_PollInterval_Call) RunAndReturn(run func() time.Duration) *WebhookConfig_PollInterval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestTimeout provides a mock function with no fields
+func (_m *WebhookConfig) RequestTimeout() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestTimeout")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// WebhookConfig_RequestTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestTimeout'
+type WebhookConfig_RequestTimeout_Call struct {
+	*mock.Call
+}
+
+// RequestTimeout is a helper method to define mock.On call
+func (_e *WebhookConfig_Expecter) RequestTimeout() *WebhookConfig_RequestTimeout_Call {
+	return &WebhookConfig_RequestTimeout_Call{Call: _e.mock.On("RequestTimeout")}
+}
+
+func (_c *WebhookConfig_RequestTimeout_Call) Run(run func()) *WebhookConfig_RequestTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WebhookConfig_RequestTimeout_Call) Return(_a0 time.Duration) *WebhookConfig_RequestTimeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookConfig_RequestTimeout_Call) RunAndReturn(run func() time.Duration) *WebhookConfig_RequestTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryBackoff provides a mock function with no fields
+func (_m *WebhookConfig) RetryBackoff() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryBackoff")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// WebhookConfig_RetryBackoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryBackoff'
+type WebhookConfig_RetryBackoff_Call struct {
+	*mock.Call
+}
+
+// RetryBackoff is a helper method to define mock.On call
+func (_e *WebhookConfig_Expecter) RetryBackoff() *WebhookConfig_RetryBackoff_Call {
+	return &WebhookConfig_RetryBackoff_Call{Call: _e.mock.On("RetryBackoff")}
+}
+
+func (_c *WebhookConfig_RetryBackoff_Call) Run(run func()) *WebhookConfig_RetryBackoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WebhookConfig_RetryBackoff_Call) Return(_a0 time.Duration) *WebhookConfig_RetryBackoff_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookConfig_RetryBackoff_Call) RunAndReturn(run func() time.Duration) *WebhookConfig_RetryBackoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookConfig creates a new instance of WebhookConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookConfig {
+	mock := &WebhookConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,21 @@
+package converter
+
+import (
+	"bytes"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+// InvoiceFormatToModel - без параметра format отдаётся PDF
+func InvoiceFormatToModel(format orderV1.OptInvoiceFormat) model.InvoiceFormat {
+	return model.InvoiceFormat(format.Or(orderV1.InvoiceFormatPDF))
+}
+
+func InvoiceToDTO(invoice model.Invoice, format model.InvoiceFormat) orderV1.GetOrderInvoiceRes {
+	if format == model.InvoiceFormatHTML {
+		return &orderV1.GetOrderInvoiceOKTextHTML{Data: bytes.NewReader(invoice.HTML)}
+	}
+
+	return &orderV1.GetOrderInvoiceOKApplicationPdf{Data: bytes.NewReader(invoice.PDF)}
+}
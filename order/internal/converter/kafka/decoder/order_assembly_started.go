@@ -0,0 +1,29 @@
+package decoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	eventsV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/events/v1"
+)
+
+type assemblyStartedDecoder struct{}
+
+func NewOrderAssemblyStartedDecoder() *assemblyStartedDecoder {
+	return &assemblyStartedDecoder{}
+}
+
+func (d *assemblyStartedDecoder) Decode(data []byte) (model.ShipAssemblyStarted, error) {
+	var pb eventsV1.ShipAssemblyStarted
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return model.ShipAssemblyStarted{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return model.ShipAssemblyStarted{
+		EventUUID: pb.EventUuid,
+		OrderUUID: pb.OrderUuid,
+		UserUUID:  pb.UserUuid,
+	}, nil
+}
@@ -5,3 +5,7 @@ import "github.com/Alexey-step/rocket-factory/order/internal/model"
 type OrderAssembledDecoder interface {
 	Decode(data []byte) (model.ShipAssembled, error)
 }
+
+type OrderAssemblyStartedDecoder interface {
+	Decode(data []byte) (model.ShipAssemblyStarted, error)
+}
@@ -2,12 +2,15 @@ package converter
 
 import (
 	"log"
+	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	repoConverter "github.com/Alexey-step/rocket-factory/order/internal/repository/converter"
 	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
 	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
 )
 
@@ -15,8 +18,9 @@ func OrderDataToRepoModel(order model.OrderData) repoModel.OrderData {
 	return repoModel.OrderData{
 		UUID:            order.UUID,
 		UserUUID:        order.UserUUID,
-		PartUuids:       order.PartUuids,
-		TotalPrice:      order.TotalPrice,
+		Items:           repoConverter.OrderItemsToRepoModel(order.Items),
+		TotalPrice:      order.TotalPrice.Amount,
+		Currency:        order.TotalPrice.Currency,
 		TransactionUUID: order.TransactionUUID,
 		PaymentMethod:   lo.ToPtr(repoModel.PaymentMethod(lo.FromPtr(order.PaymentMethod))),
 		Status:          repoModel.OrderStatus(order.Status),
@@ -36,6 +40,11 @@ func OrderDataToDTO(order model.OrderData) orderV1.OrderDto {
 		paymentMethod = orderV1.OptPaymentMethod{Value: paymentMethodToOpt(*order.PaymentMethod)}
 	}
 
+	var cancelReason orderV1.OptCancelReason
+	if order.CancelReason != nil {
+		cancelReason = orderV1.NewOptCancelReason(orderV1.CancelReason(*order.CancelReason))
+	}
+
 	var updatedAt orderV1.OptDateTime
 	if order.UpdatedAt != nil {
 		updatedAt = orderV1.OptDateTime{Value: *order.UpdatedAt}
@@ -44,16 +53,164 @@ func OrderDataToDTO(order model.OrderData) orderV1.OrderDto {
 	return orderV1.OrderDto{
 		OrderUUID:       StringToUUID(order.UUID),
 		UserUUID:        StringToUUID(order.UserUUID),
-		PartUuids:       stringsToUUIDs(order.PartUuids),
-		TotalPrice:      order.TotalPrice,
+		Items:           orderItemsToDTO(order.Items),
+		TotalPrice:      MoneyToDTO(order.TotalPrice),
+		Discounts:       orderDiscountsToDTO(order.Discounts),
+		ExchangeRate:    ExchangeRateToDTO(order.ExchangeRate),
 		TransactionUUID: transactionUUID,
 		PaymentMethod:   paymentMethod,
-		Status:          orderV1.OrderStatus(order.Status),
+		Status:          OrderStatusToDTO(order.Status),
+		CancelReason:    cancelReason,
 		CreatedAt:       order.CreatedAt,
 		UpdatedAt:       updatedAt,
 	}
 }
 
+func ListOrdersParamsToFilter(userUUID string, params orderV1.ListOrdersParams) model.OrdersFilter {
+	filter := model.OrdersFilter{
+		UserUUID:       userUUID,
+		Statuses:       make([]model.OrderStatus, 0, len(params.Status)),
+		PaymentMethods: make([]model.PaymentMethod, 0, len(params.PaymentMethod)),
+		SortBy:         model.OrdersSortBy(params.SortBy.Or(orderV1.OrdersSortByCREATEDAT)),
+		SortOrder:      model.SortOrder(params.SortOrder.Or(orderV1.SortOrderDESC)),
+		Limit:          params.Limit.Or(0),
+	}
+
+	for _, status := range params.Status {
+		filter.Statuses = append(filter.Statuses, orderStatusToModel(status))
+	}
+	for _, paymentMethod := range params.PaymentMethod {
+		filter.PaymentMethods = append(filter.PaymentMethods, model.PaymentMethod(paymentMethod))
+	}
+
+	if createdFrom, ok := params.CreatedFrom.Get(); ok {
+		filter.CreatedFrom = &createdFrom
+	}
+	if createdTo, ok := params.CreatedTo.Get(); ok {
+		filter.CreatedTo = &createdTo
+	}
+	if cursor, ok := params.Cursor.Get(); ok {
+		filter.Cursor = &cursor
+	}
+
+	return filter
+}
+
+func OrdersPageToDTO(page model.OrdersPage) *orderV1.ListOrdersResponse {
+	data := make([]orderV1.OrderDto, 0, len(page.Orders))
+	for _, order := range page.Orders {
+		data = append(data, OrderDataToDTO(order))
+	}
+
+	var nextCursor orderV1.OptString
+	if page.NextCursor != nil {
+		nextCursor = orderV1.NewOptString(*page.NextCursor)
+	}
+
+	return &orderV1.ListOrdersResponse{
+		Data:       data,
+		NextCursor: nextCursor,
+	}
+}
+
+// orderStatusToModel - в API статус отмены называется CANCELLED, в модели и БД - CANCELED
+func orderStatusToModel(status orderV1.OrderStatus) model.OrderStatus {
+	if status == orderV1.OrderStatusCANCELLED {
+		return model.OrderStatusCanceled
+	}
+
+	return model.OrderStatus(status)
+}
+
+func OrderStatusToDTO(status model.OrderStatus) orderV1.OrderStatus {
+	if status == model.OrderStatusCanceled {
+		return orderV1.OrderStatusCANCELLED
+	}
+
+	return orderV1.OrderStatus(status)
+}
+
+func CreateOrderRequestToModel(req *orderV1.CreateOrderRequest) model.OrderRequest {
+	return model.OrderRequest{
+		Items:     CreateOrderItemsToModel(req.GetItems()),
+		Currency:  req.GetCurrency().Or(""),
+		PromoCode: req.GetPromoCode().Or(""),
+	}
+}
+
+func CreateOrderItemsToModel(items []orderV1.CreateOrderItem) []model.OrderItemInfo {
+	out := make([]model.OrderItemInfo, 0, len(items))
+	for _, item := range items {
+		out = append(out, model.OrderItemInfo{
+			PartUUID: item.GetPartUUID().String(),
+			Quantity: item.GetQuantity(),
+		})
+	}
+
+	return out
+}
+
+func orderItemsToDTO(items []model.OrderItem) []orderV1.OrderItem {
+	out := make([]orderV1.OrderItem, 0, len(items))
+	for _, item := range items {
+		out = append(out, orderV1.OrderItem{
+			PartUUID:  StringToUUID(item.PartUUID),
+			Name:      item.Name,
+			Category:  string(item.Category),
+			Quantity:  item.Quantity,
+			UnitPrice: MoneyToDTO(item.UnitPrice),
+		})
+	}
+
+	return out
+}
+
+func MoneyToDTO(m money.Money) orderV1.Money {
+	return orderV1.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+func orderDiscountsToDTO(discounts []model.OrderDiscount) []orderV1.OrderDiscount {
+	out := make([]orderV1.OrderDiscount, 0, len(discounts))
+	for _, discount := range discounts {
+		out = append(out, orderV1.OrderDiscount{
+			PromoCode:   discount.PromoCode,
+			Description: discount.Description,
+			Amount:      MoneyToDTO(discount.Amount),
+		})
+	}
+
+	return out
+}
+
+func ExchangeRateToDTO(rate money.Rate) orderV1.ExchangeRate {
+	return orderV1.ExchangeRate{
+		BaseCurrency: rate.From,
+		Currency:     rate.To,
+		Rate:         rate.String(),
+	}
+}
+
+func InsufficientStockErrorToDTO(err *model.InsufficientStockError) orderV1.InsufficientStockError {
+	shortParts := make([]orderV1.InsufficientStockErrorShortPartsItem, 0, len(err.Shortages))
+	for _, shortage := range err.Shortages {
+		shortParts = append(shortParts, orderV1.InsufficientStockErrorShortPartsItem{
+			PartUUID:  StringToUUID(shortage.PartUUID),
+			Requested: shortage.Requested,
+			Available: shortage.Available,
+		})
+	}
+
+	return orderV1.InsufficientStockError{
+		Code:       http.StatusConflict,
+		ErrorCode:  orderV1.ErrorCodeINSUFFICIENTSTOCK,
+		Message:    "Недостаточно деталей на складе",
+		ShortParts: shortParts,
+	}
+}
+
 func StringToUUID(s string) uuid.UUID {
 	u, err := uuid.Parse(s)
 	if err != nil {
@@ -63,14 +220,6 @@ func StringToUUID(s string) uuid.UUID {
 	return u
 }
 
-func stringsToUUIDs(arr []string) []uuid.UUID {
-	uuids := make([]uuid.UUID, len(arr))
-	for i, s := range arr {
-		uuids[i] = StringToUUID(s)
-	}
-	return uuids
-}
-
 func UUIDsToStrings(arr []uuid.UUID) []string {
 	uuids := make([]string, len(arr))
 	for i, s := range arr {
@@ -82,3 +231,73 @@ func UUIDsToStrings(arr []uuid.UUID) []string {
 func paymentMethodToOpt(paymentMethod model.PaymentMethod) orderV1.PaymentMethod {
 	return orderV1.PaymentMethod(paymentMethod)
 }
+
+func OrderStatusHistoryToDTO(history []model.OrderStatusHistory) []orderV1.OrderStatusChange {
+	out := make([]orderV1.OrderStatusChange, 0, len(history))
+	for _, record := range history {
+		var fromStatus orderV1.OptOrderStatus
+		if record.FromStatus != nil {
+			fromStatus = orderV1.NewOptOrderStatus(OrderStatusToDTO(*record.FromStatus))
+		}
+
+		out = append(out, orderV1.OrderStatusChange{
+			FromStatus: fromStatus,
+			ToStatus:   OrderStatusToDTO(record.ToStatus),
+			ActorType:  orderV1.ActorType(record.Actor.Type),
+			ActorID:    record.Actor.ID,
+			Reason:     record.Reason,
+			ChangedAt:  record.CreatedAt,
+		})
+	}
+
+	return out
+}
+
+func OrderQuoteToDTO(quote model.OrderQuote) *orderV1.QuoteOrderResponse {
+	items := make([]orderV1.QuoteItem, 0, len(quote.Items))
+	for _, item := range quote.Items {
+		items = append(items, orderV1.QuoteItem{
+			PartUUID:          StringToUUID(item.PartUUID),
+			Name:              item.Name,
+			Category:          string(item.Category),
+			Quantity:          item.Quantity,
+			UnitPrice:         MoneyToDTO(item.UnitPrice),
+			LineTotal:         MoneyToDTO(item.LineTotal),
+			AvailableQuantity: item.AvailableQuantity,
+			InStock:           item.InStock,
+		})
+	}
+
+	return &orderV1.QuoteOrderResponse{
+		Items:        items,
+		Subtotal:     MoneyToDTO(quote.Subtotal),
+		Discounts:    orderDiscountsToDTO(quote.Discounts),
+		TotalPrice:   MoneyToDTO(quote.TotalPrice),
+		ExchangeRate: ExchangeRateToDTO(quote.ExchangeRate),
+	}
+}
+
+func BOMValidationErrorToDTO(err *model.BOMValidationError) *orderV1.ValidationError {
+	violations := make([]orderV1.BomViolation, 0, len(err.Violations))
+	for _, violation := range err.Violations {
+		var maxCount orderV1.OptInt64
+		if violation.Max != nil {
+			maxCount = orderV1.NewOptInt64(*violation.Max)
+		}
+
+		violations = append(violations, orderV1.BomViolation{
+			Category: string(violation.Category),
+			Kind:     orderV1.BomViolationKind(violation.Kind),
+			Min:      violation.Min,
+			Max:      maxCount,
+			Actual:   violation.Actual,
+		})
+	}
+
+	return &orderV1.ValidationError{
+		Code:       http.StatusUnprocessableEntity,
+		ErrorCode:  orderV1.ErrorCodeBOMINVALID,
+		Message:    "Детали заказа не складываются в ракету",
+		Violations: violations,
+	}
+}
@@ -0,0 +1,247 @@
+package converter
+
+import (
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+	commonV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/common/v1"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/order/v1"
+)
+
+func OrderDataToProto(order model.OrderData) *orderV1.Order {
+	var updatedAt *timestamppb.Timestamp
+	if order.UpdatedAt != nil {
+		updatedAt = timestamppb.New(*order.UpdatedAt)
+	}
+
+	var cancelReason orderV1.CancelReason
+	if order.CancelReason != nil {
+		cancelReason = cancelReasonToProto(*order.CancelReason)
+	}
+
+	var paymentMethod orderV1.PaymentMethod
+	if order.PaymentMethod != nil {
+		paymentMethod = paymentMethodToProto(*order.PaymentMethod)
+	}
+
+	return &orderV1.Order{
+		OrderUuid:       order.UUID,
+		UserUuid:        order.UserUUID,
+		Items:           orderItemsToProto(order.Items),
+		TotalPrice:      MoneyToProto(order.TotalPrice),
+		Discounts:       orderDiscountsToProto(order.Discounts),
+		ExchangeRate:    exchangeRateToProto(order.ExchangeRate),
+		TransactionUuid: lo.FromPtr(order.TransactionUUID),
+		PaymentMethod:   paymentMethod,
+		Status:          orderStatusToProto(order.Status),
+		CancelReason:    cancelReason,
+		CreatedAt:       timestamppb.New(order.CreatedAt),
+		UpdatedAt:       updatedAt,
+	}
+}
+
+func OrdersPageToProto(page model.OrdersPage) *orderV1.ListOrdersResponse {
+	orders := make([]*orderV1.Order, 0, len(page.Orders))
+	for _, order := range page.Orders {
+		orders = append(orders, OrderDataToProto(order))
+	}
+
+	return &orderV1.ListOrdersResponse{
+		Orders:     orders,
+		NextCursor: lo.FromPtr(page.NextCursor),
+	}
+}
+
+func ListOrdersRequestToFilter(userUUID string, req *orderV1.ListOrdersRequest) model.OrdersFilter {
+	filter := model.OrdersFilter{
+		UserUUID:       userUUID,
+		Statuses:       make([]model.OrderStatus, 0, len(req.GetStatuses())),
+		PaymentMethods: make([]model.PaymentMethod, 0, len(req.GetPaymentMethods())),
+		SortBy:         ordersSortByToModel(req.GetSortBy()),
+		SortOrder:      sortOrderToModel(req.GetSortOrder()),
+		Limit:          int(req.GetLimit()),
+	}
+
+	for _, status := range req.GetStatuses() {
+		filter.Statuses = append(filter.Statuses, orderStatusProtoToModel(status))
+	}
+	for _, paymentMethod := range req.GetPaymentMethods() {
+		filter.PaymentMethods = append(filter.PaymentMethods, PaymentMethodProtoToModel(paymentMethod))
+	}
+
+	if req.GetCreatedFrom() != nil {
+		filter.CreatedFrom = lo.ToPtr(req.GetCreatedFrom().AsTime())
+	}
+	if req.GetCreatedTo() != nil {
+		filter.CreatedTo = lo.ToPtr(req.GetCreatedTo().AsTime())
+	}
+	if req.GetCursor() != "" {
+		filter.Cursor = lo.ToPtr(req.GetCursor())
+	}
+
+	return filter
+}
+
+func CreateOrderRequestProtoToModel(req *orderV1.CreateOrderRequest) model.OrderRequest {
+	items := make([]model.OrderItemInfo, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		items = append(items, model.OrderItemInfo{
+			PartUUID: item.GetPartUuid(),
+			Quantity: item.GetQuantity(),
+		})
+	}
+
+	return model.OrderRequest{
+		Items:     items,
+		Currency:  req.GetCurrency(),
+		PromoCode: req.GetPromoCode(),
+	}
+}
+
+func MoneyToProto(m money.Money) *commonV1.Money {
+	return &commonV1.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+func PaymentMethodProtoToModel(paymentMethod orderV1.PaymentMethod) model.PaymentMethod {
+	switch paymentMethod {
+	case orderV1.PaymentMethod_PAYMENT_METHOD_CARD:
+		return model.PaymentMethodCard
+	case orderV1.PaymentMethod_PAYMENT_METHOD_SBP:
+		return model.PaymentMethodSBP
+	case orderV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD:
+		return model.PaymentMethodCreditCard
+	case orderV1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY:
+		return model.PaymentMethodInvestorMoney
+	default:
+		return model.PaymentMethodUnknown
+	}
+}
+
+func paymentMethodToProto(paymentMethod model.PaymentMethod) orderV1.PaymentMethod {
+	switch paymentMethod {
+	case model.PaymentMethodCard:
+		return orderV1.PaymentMethod_PAYMENT_METHOD_CARD
+	case model.PaymentMethodSBP:
+		return orderV1.PaymentMethod_PAYMENT_METHOD_SBP
+	case model.PaymentMethodCreditCard:
+		return orderV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD
+	case model.PaymentMethodInvestorMoney:
+		return orderV1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY
+	default:
+		return orderV1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	}
+}
+
+func orderStatusToProto(status model.OrderStatus) orderV1.OrderStatus {
+	switch status {
+	case model.OrderStatusDraft:
+		return orderV1.OrderStatus_ORDER_STATUS_DRAFT
+	case model.OrderStatusPendingPayment:
+		return orderV1.OrderStatus_ORDER_STATUS_PENDING_PAYMENT
+	case model.OrderStatusPaid:
+		return orderV1.OrderStatus_ORDER_STATUS_PAID
+	case model.OrderStatusAssembling:
+		return orderV1.OrderStatus_ORDER_STATUS_ASSEMBLING
+	case model.OrderStatusCompleted:
+		return orderV1.OrderStatus_ORDER_STATUS_COMPLETED
+	case model.OrderStatusCanceled:
+		return orderV1.OrderStatus_ORDER_STATUS_CANCELED
+	case model.OrderStatusRefunding:
+		return orderV1.OrderStatus_ORDER_STATUS_REFUNDING
+	case model.OrderStatusRefunded:
+		return orderV1.OrderStatus_ORDER_STATUS_REFUNDED
+	default:
+		return orderV1.OrderStatus_ORDER_STATUS_UNSPECIFIED
+	}
+}
+
+func orderStatusProtoToModel(status orderV1.OrderStatus) model.OrderStatus {
+	switch status {
+	case orderV1.OrderStatus_ORDER_STATUS_DRAFT:
+		return model.OrderStatusDraft
+	case orderV1.OrderStatus_ORDER_STATUS_PENDING_PAYMENT:
+		return model.OrderStatusPendingPayment
+	case orderV1.OrderStatus_ORDER_STATUS_PAID:
+		return model.OrderStatusPaid
+	case orderV1.OrderStatus_ORDER_STATUS_ASSEMBLING:
+		return model.OrderStatusAssembling
+	case orderV1.OrderStatus_ORDER_STATUS_COMPLETED:
+		return model.OrderStatusCompleted
+	case orderV1.OrderStatus_ORDER_STATUS_CANCELED:
+		return model.OrderStatusCanceled
+	case orderV1.OrderStatus_ORDER_STATUS_REFUNDING:
+		return model.OrderStatusRefunding
+	case orderV1.OrderStatus_ORDER_STATUS_REFUNDED:
+		return model.OrderStatusRefunded
+	default:
+		return ""
+	}
+}
+
+func cancelReasonToProto(reason model.CancelReason) orderV1.CancelReason {
+	switch reason {
+	case model.CancelReasonCustomer:
+		return orderV1.CancelReason_CANCEL_REASON_CANCELLED_BY_CUSTOMER
+	case model.CancelReasonPaymentTimeout:
+		return orderV1.CancelReason_CANCEL_REASON_PAYMENT_TIMEOUT
+	default:
+		return orderV1.CancelReason_CANCEL_REASON_UNSPECIFIED
+	}
+}
+
+func ordersSortByToModel(sortBy orderV1.OrdersSortBy) model.OrdersSortBy {
+	if sortBy == orderV1.OrdersSortBy_ORDERS_SORT_BY_TOTAL_PRICE {
+		return model.OrdersSortByTotalPrice
+	}
+
+	return model.OrdersSortByCreatedAt
+}
+
+func sortOrderToModel(sortOrder orderV1.SortOrder) model.SortOrder {
+	if sortOrder == orderV1.SortOrder_SORT_ORDER_ASC {
+		return model.SortOrderAsc
+	}
+
+	return model.SortOrderDesc
+}
+
+func orderItemsToProto(items []model.OrderItem) []*orderV1.OrderItem {
+	out := make([]*orderV1.OrderItem, 0, len(items))
+	for _, item := range items {
+		out = append(out, &orderV1.OrderItem{
+			PartUuid:  item.PartUUID,
+			Name:      item.Name,
+			Category:  string(item.Category),
+			Quantity:  item.Quantity,
+			UnitPrice: MoneyToProto(item.UnitPrice),
+		})
+	}
+
+	return out
+}
+
+func orderDiscountsToProto(discounts []model.OrderDiscount) []*orderV1.OrderDiscount {
+	out := make([]*orderV1.OrderDiscount, 0, len(discounts))
+	for _, discount := range discounts {
+		out = append(out, &orderV1.OrderDiscount{
+			PromoCode:   discount.PromoCode,
+			Description: discount.Description,
+			Amount:      MoneyToProto(discount.Amount),
+		})
+	}
+
+	return out
+}
+
+func exchangeRateToProto(rate money.Rate) *orderV1.ExchangeRate {
+	return &orderV1.ExchangeRate{
+		BaseCurrency: rate.From,
+		Currency:     rate.To,
+		Rate:         rate.String(),
+	}
+}
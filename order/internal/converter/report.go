@@ -0,0 +1,62 @@
+package converter
+
+import (
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func OrdersReportParamsToModel(params orderV1.GetOrdersReportParams) model.OrdersReportFilter {
+	groupBy := make([]model.ReportGroupBy, 0, len(params.GroupBy))
+	for _, group := range params.GroupBy {
+		groupBy = append(groupBy, model.ReportGroupBy(group))
+	}
+
+	return model.OrdersReportFilter{
+		From:    params.From,
+		To:      params.To,
+		GroupBy: groupBy,
+	}
+}
+
+func OrdersReportToDTO(report model.OrdersReport) *orderV1.OrdersReportResponse {
+	groupBy := make([]orderV1.ReportGroupBy, 0, len(report.GroupBy))
+	for _, group := range report.GroupBy {
+		groupBy = append(groupBy, orderV1.ReportGroupBy(group))
+	}
+
+	data := make([]orderV1.OrdersReportRow, 0, len(report.Rows))
+	for _, row := range report.Rows {
+		data = append(data, ordersReportRowToDTO(row))
+	}
+
+	return &orderV1.OrdersReportResponse{
+		From:    report.From,
+		To:      report.To,
+		GroupBy: groupBy,
+		Data:    data,
+	}
+}
+
+func ordersReportRowToDTO(row model.OrdersReportRow) orderV1.OrdersReportRow {
+	out := orderV1.OrdersReportRow{
+		Currency:          row.Currency,
+		OrdersCount:       row.OrdersCount,
+		PaidOrdersCount:   row.PaidOrdersCount,
+		Revenue:           MoneyToDTO(row.Revenue),
+		AverageOrderValue: MoneyToDTO(row.AverageOrderValue),
+		CancelledCount:    row.CancelledCount,
+		RefundedCount:     row.RefundedCount,
+		CancellationRate:  row.CancellationRate,
+	}
+	if row.PeriodStart != nil {
+		out.PeriodStart = orderV1.NewOptDateTime(*row.PeriodStart)
+	}
+	if row.Status != nil {
+		out.Status = orderV1.NewOptOrderStatus(OrderStatusToDTO(*row.Status))
+	}
+	if row.PaymentMethod != nil {
+		out.PaymentMethod = orderV1.NewOptPaymentMethod(paymentMethodToOpt(*row.PaymentMethod))
+	}
+
+	return out
+}
@@ -0,0 +1,93 @@
+package converter
+
+import (
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	orderV1 "github.com/Alexey-step/rocket-factory/shared/pkg/openapi/order/v1"
+)
+
+func CreateWebhookRequestToModel(req *orderV1.CreateWebhookRequest) model.WebhookRequest {
+	eventTypes := make([]model.WebhookEventType, 0, len(req.GetEventTypes()))
+	for _, eventType := range req.GetEventTypes() {
+		eventTypes = append(eventTypes, model.WebhookEventType(eventType))
+	}
+
+	return model.WebhookRequest{
+		URL:        req.GetURL(),
+		Secret:     req.GetSecret(),
+		EventTypes: eventTypes,
+	}
+}
+
+// WebhookToDTO не отдаёт секрет: он известен только владельцу и показывается при создании им самим
+func WebhookToDTO(webhook model.Webhook) orderV1.WebhookDto {
+	eventTypes := make([]orderV1.WebhookEventType, 0, len(webhook.EventTypes))
+	for _, eventType := range webhook.EventTypes {
+		eventTypes = append(eventTypes, orderV1.WebhookEventType(eventType))
+	}
+
+	var disabledAt orderV1.OptDateTime
+	if webhook.DisabledAt != nil {
+		disabledAt = orderV1.NewOptDateTime(*webhook.DisabledAt)
+	}
+
+	return orderV1.WebhookDto{
+		WebhookUUID:         StringToUUID(webhook.UUID),
+		URL:                 webhook.URL,
+		EventTypes:          eventTypes,
+		Enabled:             webhook.Enabled,
+		ConsecutiveFailures: webhook.ConsecutiveFailures,
+		DisabledAt:          disabledAt,
+		CreatedAt:           webhook.CreatedAt,
+	}
+}
+
+func WebhooksToDTO(webhooks []model.Webhook) []orderV1.WebhookDto {
+	out := make([]orderV1.WebhookDto, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		out = append(out, WebhookToDTO(webhook))
+	}
+
+	return out
+}
+
+func WebhookDeliveriesToDTO(deliveries []model.WebhookDelivery) []orderV1.WebhookDelivery {
+	out := make([]orderV1.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		out = append(out, orderV1.WebhookDelivery{
+			EventUUID:     StringToUUID(delivery.EventUUID),
+			EventType:     orderV1.WebhookEventType(delivery.EventType),
+			Status:        orderV1.WebhookDeliveryStatus(delivery.Status),
+			Attempts:      delivery.Attempts,
+			NextAttemptAt: delivery.NextAttemptAt,
+			CreatedAt:     delivery.CreatedAt,
+			Log:           webhookDeliveryAttemptsToDTO(delivery.Log),
+		})
+	}
+
+	return out
+}
+
+func webhookDeliveryAttemptsToDTO(attempts []model.WebhookDeliveryAttempt) []orderV1.WebhookDeliveryAttempt {
+	out := make([]orderV1.WebhookDeliveryAttempt, 0, len(attempts))
+	for _, attempt := range attempts {
+		var statusCode orderV1.OptInt
+		if attempt.StatusCode != nil {
+			statusCode = orderV1.NewOptInt(*attempt.StatusCode)
+		}
+
+		var attemptErr orderV1.OptString
+		if attempt.Error != nil {
+			attemptErr = orderV1.NewOptString(*attempt.Error)
+		}
+
+		out = append(out, orderV1.WebhookDeliveryAttempt{
+			Attempt:     attempt.Attempt,
+			StatusCode:  statusCode,
+			Error:       attemptErr,
+			DurationMs:  attempt.Duration.Milliseconds(),
+			AttemptedAt: attempt.AttemptedAt,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BOMItem - позиция заказа с данными детали из каталога, по которым проверяется комплектность ракеты
+type BOMItem struct {
+	Part     Part
+	Quantity int64
+}
+
+// BOMViolationKind - вид нарушения правил сборки
+type BOMViolationKind string
+
+const (
+	BOMViolationMissing BOMViolationKind = "MISSING" // Деталей категории меньше минимума
+	BOMViolationExcess  BOMViolationKind = "EXCESS"  // Деталей категории больше максимума или категория не нужна для сборки
+)
+
+// BOMViolation - нарушение правила сборки по одной категории деталей
+type BOMViolation struct {
+	Category Category
+	Kind     BOMViolationKind
+	Min      int64
+	Max      *int64 // nil - без ограничения сверху
+	Actual   int64
+}
+
+// BOMValidationError возвращается, когда детали заказа не складываются в ракету
+type BOMValidationError struct {
+	Violations []BOMViolation
+}
+
+func (e *BOMValidationError) Error() string {
+	parts := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		parts = append(parts, fmt.Sprintf("%s %s (got %d)", violation.Kind, violation.Category, violation.Actual))
+	}
+
+	return "incomplete bill of materials: " + strings.Join(parts, ", ")
+}
+
+func (e *BOMValidationError) Unwrap() error {
+	return ErrBOMInvalid
+}
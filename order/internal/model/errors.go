@@ -4,23 +4,72 @@ import "errors"
 
 // Order errors
 var (
-	ErrOrderNotFound         = errors.New("order not found")
-	ErrOrderInternalError    = errors.New("internal error while get order")
-	ErrOrderConflict         = errors.New("order conflict")
-	ErrOrderAlreadyPaid      = errors.New("order already paid, cannot be cancelled")
-	ErrOrderAlreadyCancelled = errors.New("order already cancelled, cannot be cancelled again")
+	ErrOrderNotFound          = errors.New("order not found")
+	ErrOrderInternalError     = errors.New("internal error while get order")
+	ErrOrderConflict          = errors.New("order conflict")
+	ErrOrderInvalidTransition = errors.New("invalid order status transition")
+	ErrOrderForbidden         = errors.New("order belongs to another user")
+	ErrOrdersInvalidFilter    = errors.New("invalid orders filter")
+	ErrOrdersInvalidCursor    = errors.New("invalid orders cursor")
+)
+
+// Draft errors
+var (
+	ErrDraftNotFound     = errors.New("draft order not found")
+	ErrDraftEmpty        = errors.New("draft order has no items")
+	ErrDraftItemNotFound = errors.New("part is not in draft order")
 )
 
 // Parts errors
 var (
-	ErrPartsNotFound = errors.New("parts not found")
+	ErrPartsNotFound       = errors.New("parts not found")
+	ErrPartsInvalidRequest = errors.New("invalid order items")
+	ErrInsufficientStock   = errors.New("insufficient stock")
+	ErrBOMInvalid          = errors.New("order parts do not make a complete rocket")
+)
+
+// Currency errors
+var (
+	ErrCurrencyNotSupported = errors.New("currency not supported")
+)
+
+// Promo code errors
+var (
+	ErrPromoCodeNotFound      = errors.New("promo code not found")
+	ErrPromoCodeInactive      = errors.New("promo code is not active")
+	ErrPromoCodeExhausted     = errors.New("promo code usage limit reached")
+	ErrPromoCodeNotApplicable = errors.New("promo code is not applicable to order")
 )
 
 // Payment errors
 var (
-	ErrPaymentInternalError = errors.New("internal error while processing payment")
-	ErrPaymentConflict      = errors.New("payment conflict")
-	ErrPaymentNotFound      = errors.New("payment not found")
+	ErrPaymentNotFound = errors.New("payment not found")
+)
+
+// Idempotency errors
+var (
+	ErrIdempotencyKeyConflict   = errors.New("idempotency key reused with another request")
+	ErrIdempotencyKeyInProgress = errors.New("request with this idempotency key is in progress")
+	ErrIdempotencyKeyNotFound   = errors.New("idempotency key not found")
+)
+
+// Webhook errors
+var (
+	ErrWebhookNotFound = errors.New("webhook not found")
+	ErrWebhookInvalid  = errors.New("invalid webhook")
+)
+
+// Invoice errors
+var (
+	ErrInvoiceNotFound      = errors.New("invoice not found")
+	ErrInvoiceNotAvailable  = errors.New("invoice is available only for paid orders")
+	ErrInvoiceAlreadyExists = errors.New("invoice for order already exists")
+)
+
+// Report errors
+var (
+	ErrAdminRequired = errors.New("admin access required")
+	ErrReportInvalid = errors.New("invalid report parameters")
 )
 
 // Auth errors
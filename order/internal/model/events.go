@@ -1,5 +1,22 @@
 package model
 
+import (
+	"time"
+
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+// EventSchemaVersion - текущая версия схемы событий заказа в конверте
+const EventSchemaVersion = 1
+
+// EventEnvelope - общий конверт событий жизненного цикла заказа
+type EventEnvelope struct {
+	EventUUID     string    // Уникальный идентификатор события (для идемпотентности)
+	OccurredAt    time.Time // Момент, когда произошло событие
+	CausationID   string    // Что вызвало событие: UUID входящего события, UUID пользователя или имя фонового процесса
+	SchemaVersion int32     // Версия схемы события
+}
+
 type OrderPaid struct {
 	EventUUID       string //	Уникальный идентификатор события (для идемпотентности)
 	OrderUUID       string //	Идентификатор оплаченного заказа
@@ -8,9 +25,50 @@ type OrderPaid struct {
 	TransactionUUID string //	Идентификатор транзакции, сгенерированный в результате оплаты
 }
 
+type ShipAssemblyStarted struct {
+	EventUUID string // Уникальный идентификатор события (для идемпотентности)
+	OrderUUID string // Идентификатор заказа, который начали собирать
+	UserUUID  string // Идентификатор пользователя
+}
+
 type ShipAssembled struct {
 	EventUUID    string // Уникальный идентификатор события (для идемпотентности)
 	OrderUUID    string // Идентификатор собранного заказа
 	UserUUID     string // Идентификатор пользователя
 	BuildTimeSec int64  // Время (в секундах), потраченное на сборку корабля
 }
+
+type OrderRefunded struct {
+	EventUUID       string // Уникальный идентификатор события (для идемпотентности)
+	OrderUUID       string // Идентификатор заказа, по которому вернули оплату
+	UserUUID        string // Идентификатор пользователя
+	TransactionUUID string // Идентификатор транзакции оплаты
+	RefundUUID      string // Идентификатор транзакции возврата
+}
+
+type OrderExpired struct {
+	Envelope  EventEnvelope
+	OrderUUID string       // Идентификатор отменённого заказа
+	UserUUID  string       // Идентификатор пользователя
+	Reason    CancelReason // Причина отмены
+}
+
+type OrderCreated struct {
+	Envelope   EventEnvelope
+	OrderUUID  string      // Идентификатор созданного заказа
+	UserUUID   string      // Идентификатор пользователя
+	TotalPrice money.Money // Сумма заказа с учётом скидок
+}
+
+type OrderCancelled struct {
+	Envelope  EventEnvelope
+	OrderUUID string       // Идентификатор отменённого заказа
+	UserUUID  string       // Идентификатор пользователя
+	Reason    CancelReason // Причина отмены
+}
+
+type OrderCompleted struct {
+	Envelope  EventEnvelope
+	OrderUUID string // Идентификатор выполненного заказа
+	UserUUID  string // Идентификатор пользователя
+}
@@ -0,0 +1,24 @@
+package model
+
+// IdempotencyOperation - операция, к которой привязан ключ идемпотентности
+type IdempotencyOperation string
+
+const (
+	IdempotencyOperationCreateOrder IdempotencyOperation = "CREATE_ORDER"
+	IdempotencyOperationPayOrder    IdempotencyOperation = "PAY_ORDER"
+	IdempotencyOperationCheckout    IdempotencyOperation = "CHECKOUT_DRAFT"
+)
+
+// IdempotencyKey - ключ из заголовка Idempotency-Key; уникален в пределах пользователя и операции
+type IdempotencyKey struct {
+	UserUUID    string
+	Operation   IdempotencyOperation
+	Key         string
+	RequestHash string // Хэш тела запроса, чтобы отличать повтор от повторного использования ключа
+}
+
+// IdempotencyRecord - сохранённое состояние запроса по ключу
+type IdempotencyRecord struct {
+	RequestHash string
+	Response    []byte // nil, пока запрос ещё выполняется
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+type InvoiceFormat string
+
+const (
+	InvoiceFormatHTML InvoiceFormat = "HTML"
+	InvoiceFormatPDF  InvoiceFormat = "PDF"
+)
+
+// Invoice - счёт по оплаченному заказу. Документы рендерятся один раз при выставлении
+// и хранятся как есть, поэтому повторное скачивание отдаёт те же байты
+type Invoice struct {
+	UUID      string
+	OrderUUID string
+	UserUUID  string
+	Number    string // Номер вида INV-2026-000042, сквозной в пределах года
+	Year      int
+	Sequence  int64 // Порядковый номер в году
+	Total     money.Money
+	IssuedAt  time.Time
+	HTML      []byte
+	PDF       []byte
+}
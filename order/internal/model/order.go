@@ -1,17 +1,57 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
 
 type OrderData struct {
 	UUID            string
 	UserUUID        string
-	PartUuids       []string
-	TotalPrice      float64
+	Items           []OrderItem
+	TotalPrice      money.Money // С учётом скидок
+	Discounts       []OrderDiscount
+	ExchangeRate    money.Rate // Курс из базовой валюты каталога в валюту заказа, зафиксированный при оформлении
 	TransactionUUID *string
 	PaymentMethod   *PaymentMethod
 	Status          OrderStatus
+	CancelReason    *CancelReason
 	CreatedAt       time.Time
 	UpdatedAt       *time.Time
+	// Ключ резерва деталей в inventory; nil - резерв создан под UUID заказа
+	StockReservationKey *string
+}
+
+// ReservationKey возвращает ключ, под которым в inventory зарезервированы детали заказа
+func (o OrderData) ReservationKey() string {
+	if o.StockReservationKey != nil {
+		return *o.StockReservationKey
+	}
+
+	return o.UUID
+}
+
+// OrderItem - позиция заказа со снимком данных детали на момент оформления
+type OrderItem struct {
+	PartUUID  string
+	Name      string
+	Category  Category
+	Quantity  int64
+	UnitPrice money.Money // Цена за единицу на момент оформления заказа
+}
+
+// OrderRequest - запрос на создание заказа
+type OrderRequest struct {
+	Items     []OrderItemInfo
+	Currency  string // Валюта заказа; пустая - валюта каталога
+	PromoCode string // Промокод; пустой - без скидки
+}
+
+// OrderItemInfo - позиция из запроса на создание заказа
+type OrderItemInfo struct {
+	PartUUID string
+	Quantity int64
 }
 
 type PaymentMethod string
@@ -27,20 +67,72 @@ const (
 type OrderStatus string
 
 const (
+	OrderStatusDraft          OrderStatus = "DRAFT" // Корзина: позиции ещё меняются, цены и наличие проверяются при оформлении
 	OrderStatusPendingPayment OrderStatus = "PENDING_PAYMENT"
 	OrderStatusPaid           OrderStatus = "PAID"
+	OrderStatusAssembling     OrderStatus = "ASSEMBLING"
 	OrderStatusCanceled       OrderStatus = "CANCELED"
 	OrderStatusCompleted      OrderStatus = "COMPLETED"
+	OrderStatusRefunding      OrderStatus = "REFUNDING" // Отменён после оплаты, возврат ещё не подтверждён payment
+	OrderStatusRefunded       OrderStatus = "REFUNDED"
+)
+
+// CancelReason - причина отмены заказа, сохраняется в самом заказе
+type CancelReason string
+
+const (
+	CancelReasonCustomer       CancelReason = "CANCELLED_BY_CUSTOMER"
+	CancelReasonPaymentTimeout CancelReason = "PAYMENT_TIMEOUT"
 )
 
 type OrderCreationInfo struct {
 	OrderUUID  string
-	TotalPrice float64
+	TotalPrice money.Money
 }
 
 type OrderUpdateInfo struct {
-	TotalPrice      *float64
+	TotalPrice      *money.Money
 	TransactionUUID *string
 	PaymentMethod   *PaymentMethod
 	Status          *OrderStatus
+	CancelReason    *CancelReason
+}
+
+type OrdersSortBy string
+
+const (
+	OrdersSortByCreatedAt  OrdersSortBy = "CREATED_AT"
+	OrdersSortByTotalPrice OrdersSortBy = "TOTAL_PRICE"
+)
+
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "ASC"
+	SortOrderDesc SortOrder = "DESC"
+)
+
+// OrdersFilter - параметры выборки списка заказов пользователя
+type OrdersFilter struct {
+	UserUUID       string
+	Statuses       []OrderStatus
+	PaymentMethods []PaymentMethod
+	CreatedFrom    *time.Time // Включительно
+	CreatedTo      *time.Time // Не включительно
+	SortBy         OrdersSortBy
+	SortOrder      SortOrder
+	Cursor         *string // Непрозрачный курсор из OrdersPage.NextCursor
+	Limit          int
+}
+
+// OrdersPage - страница списка заказов
+type OrdersPage struct {
+	Orders     []OrderData
+	NextCursor *string // nil на последней странице
+}
+
+// DraftCheckout - параметры оформления черновика в заказ
+type DraftCheckout struct {
+	Currency  string // Валюта заказа; пустая - валюта каталога
+	PromoCode string // Промокод; пустой - без скидки
 }
@@ -0,0 +1,58 @@
+package model
+
+import "time"
+
+type ActorType string
+
+const (
+	ActorTypeUser   ActorType = "USER"
+	ActorTypeSystem ActorType = "SYSTEM"
+)
+
+// Actor - инициатор изменения статуса: пользователь из сессии или система по событию из Kafka
+type Actor struct {
+	Type ActorType
+	ID   string // UUID пользователя или UUID события
+}
+
+func UserActor(userUUID string) Actor {
+	return Actor{Type: ActorTypeUser, ID: userUUID}
+}
+
+func EventActor(eventUUID string) Actor {
+	return Actor{Type: ActorTypeSystem, ID: eventUUID}
+}
+
+// SweeperActor - фоновая отмена неоплаченных заказов
+func SweeperActor() Actor {
+	return Actor{Type: ActorTypeSystem, ID: "order-expiry-sweeper"}
+}
+
+// StatusChange - кто и почему меняет статус заказа
+type StatusChange struct {
+	Actor  Actor
+	Reason string
+}
+
+// Причины изменения статуса, которые пишутся в историю
+const (
+	StatusReasonOrderCreated     = "order created"
+	StatusReasonDraftCheckedOut  = "draft order checked out"
+	StatusReasonOrderPaid        = "order paid"
+	StatusReasonOrderCancelled   = "order cancelled by customer"
+	StatusReasonOrderExpired     = "order not paid in time"
+	StatusReasonRefundStarted    = "paid order cancelled by customer, refund started"
+	StatusReasonOrderRefunded    = "payment refunded"
+	StatusReasonAssemblyStarted  = "ship assembly started"
+	StatusReasonAssemblyFinished = "ship assembled"
+)
+
+// OrderStatusHistory - запись в истории статусов заказа
+type OrderStatusHistory struct {
+	OrderUUID  string
+	FromStatus *OrderStatus // nil для записи о создании заказа
+	ToStatus   OrderStatus
+	Actor      Actor
+	Reason     string
+	CreatedAt  time.Time
+}
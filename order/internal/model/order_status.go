@@ -0,0 +1,47 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+)
+
+// orderTransitions - единственная таблица допустимых переходов статусов заказа:
+// DRAFT -> PENDING_PAYMENT -> PAID -> ASSEMBLING -> COMPLETED. Черновик и неоплаченный заказ
+// отменяются (CANCELED), оплаченный до окончания сборки - отменяется с возвратом денег:
+// сначала REFUNDING, после подтверждения возврата payment - REFUNDED
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusDraft:          {OrderStatusPendingPayment, OrderStatusCanceled},
+	OrderStatusPendingPayment: {OrderStatusPaid, OrderStatusCanceled},
+	OrderStatusPaid:           {OrderStatusAssembling, OrderStatusRefunding},
+	OrderStatusAssembling:     {OrderStatusCompleted, OrderStatusRefunding},
+	OrderStatusRefunding:      {OrderStatusRefunded},
+}
+
+// CanTransitionTo сообщает, разрешён ли переход из текущего статуса в to
+func (s OrderStatus) CanTransitionTo(to OrderStatus) bool {
+	return slices.Contains(orderTransitions[s], to)
+}
+
+// ValidateTransition возвращает *InvalidStatusTransitionError, если переход запрещён
+func ValidateTransition(from, to OrderStatus) error {
+	if !from.CanTransitionTo(to) {
+		return &InvalidStatusTransitionError{From: from, To: to}
+	}
+
+	return nil
+}
+
+// InvalidStatusTransitionError возвращается при попытке перевести заказ в недопустимый статус,
+// в том числе когда статус успел измениться конкурентным запросом
+type InvalidStatusTransitionError struct {
+	From OrderStatus
+	To   OrderStatus
+}
+
+func (e *InvalidStatusTransitionError) Error() string {
+	return fmt.Sprintf("invalid order status transition from %s to %s", e.From, e.To)
+}
+
+func (e *InvalidStatusTransitionError) Unwrap() error {
+	return ErrOrderInvalidTransition
+}
@@ -1,12 +1,16 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
 
 type Part struct {
 	UUID          string
 	Name          string
 	Description   string
-	Price         float64
+	Price         money.Money
 	StockQuantity int64
 	Category      Category
 	Dimensions    Dimensions
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+// DiscountType - способ расчёта скидки по промокоду
+type DiscountType string
+
+const (
+	DiscountTypePercent DiscountType = "PERCENT" // Процент от стоимости подходящих позиций
+	DiscountTypeFixed   DiscountType = "FIXED"   // Фиксированная сумма, не больше стоимости подходящих позиций
+)
+
+// PromoCode - промокод с правилом скидки. Суммы заданы в базовой валюте каталога
+type PromoCode struct {
+	Code        string
+	Description string
+	Type        DiscountType
+	Percent     int64       // Для PERCENT: от 1 до 100
+	Amount      money.Money // Для FIXED
+
+	// Условия применения; незаданное условие не ограничивает
+	Category       *Category    // Скидка только на детали категории
+	Manufacturer   *string      // Скидка только на детали производителя
+	MinOrderAmount *money.Money // Минимальная стоимость заказа без скидки
+
+	ValidFrom  time.Time
+	ValidTo    *time.Time // Не включительно
+	UsageLimit *int64     // Сколько заказов можно оформить с промокодом; nil - без ограничения
+	UsedCount  int64
+}
+
+// IsActive сообщает, действует ли промокод в момент now
+func (p PromoCode) IsActive(now time.Time) bool {
+	if now.Before(p.ValidFrom) {
+		return false
+	}
+
+	return p.ValidTo == nil || now.Before(*p.ValidTo)
+}
+
+// AppliesTo сообщает, распространяется ли скидка на деталь
+func (p PromoCode) AppliesTo(part Part) bool {
+	if p.Category != nil && *p.Category != part.Category {
+		return false
+	}
+
+	if p.Manufacturer != nil && !strings.EqualFold(*p.Manufacturer, part.Manufacturer.Name) {
+		return false
+	}
+
+	return true
+}
+
+// OrderDiscount - скидка, применённая к заказу
+type OrderDiscount struct {
+	PromoCode   string
+	Description string
+	Amount      money.Money // В валюте заказа
+}
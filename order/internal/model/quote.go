@@ -0,0 +1,20 @@
+package model
+
+import "github.com/Alexey-step/rocket-factory/platform/pkg/money"
+
+// OrderQuote - расчёт стоимости заказа без его создания
+type OrderQuote struct {
+	Items        []QuoteItem
+	Subtotal     money.Money // До скидок
+	Discounts    []OrderDiscount
+	TotalPrice   money.Money // С учётом скидок
+	ExchangeRate money.Rate
+}
+
+// QuoteItem - позиция расчёта с наличием детали на складе
+type QuoteItem struct {
+	OrderItem
+	LineTotal         money.Money // UnitPrice * Quantity
+	AvailableQuantity int64
+	InStock           bool // Хватает ли детали на складе для заказа
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+// ReportGroupBy - измерение, по которому группируется отчёт по заказам
+type ReportGroupBy string
+
+const (
+	ReportGroupByDay           ReportGroupBy = "DAY"
+	ReportGroupByWeek          ReportGroupBy = "WEEK"
+	ReportGroupByMonth         ReportGroupBy = "MONTH"
+	ReportGroupByStatus        ReportGroupBy = "STATUS"
+	ReportGroupByPaymentMethod ReportGroupBy = "PAYMENT_METHOD"
+)
+
+// IsPeriod сообщает, группирует ли измерение по календарному периоду
+func (g ReportGroupBy) IsPeriod() bool {
+	switch g {
+	case ReportGroupByDay, ReportGroupByWeek, ReportGroupByMonth:
+		return true
+	default:
+		return false
+	}
+}
+
+func (g ReportGroupBy) Valid() bool {
+	switch g {
+	case ReportGroupByDay, ReportGroupByWeek, ReportGroupByMonth,
+		ReportGroupByStatus, ReportGroupByPaymentMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// OrdersReportFilter - параметры отчёта по заказам. Черновики в отчёт не попадают
+type OrdersReportFilter struct {
+	From    time.Time // Включительно
+	To      time.Time // Не включительно
+	GroupBy []ReportGroupBy
+}
+
+// OrdersReport - отчёт по заказам за период
+type OrdersReport struct {
+	From    time.Time
+	To      time.Time
+	GroupBy []ReportGroupBy
+	Rows    []OrdersReportRow
+}
+
+// OrdersReportRow - агрегаты по одной группе заказов. Суммы не конвертируются
+// между валютами, поэтому строки всегда разбиты по валюте заказа. Поля
+// измерений, по которым группировка не запрошена, остаются nil
+type OrdersReportRow struct {
+	PeriodStart       *time.Time
+	Status            *OrderStatus
+	PaymentMethod     *PaymentMethod
+	Currency          string
+	OrdersCount       int64
+	PaidOrdersCount   int64       // Оплаченные и не отменённые заказы: PAID, ASSEMBLING, COMPLETED
+	Revenue           money.Money // Сумма оплаченных заказов
+	AverageOrderValue money.Money // Средний чек по оплаченным заказам
+	CancelledCount    int64
+	RefundedCount     int64
+	CancellationRate  float64 // Доля отменённых заказов от всех заказов группы, от 0 до 1
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StockShortage - нехватка детали на складе при резервировании заказа
+type StockShortage struct {
+	PartUUID  string
+	Requested int64
+	Available int64
+}
+
+// InsufficientStockError возвращается, когда под заказ не хватает одной или нескольких деталей
+type InsufficientStockError struct {
+	Shortages []StockShortage
+}
+
+func (e *InsufficientStockError) Error() string {
+	parts := make([]string, 0, len(e.Shortages))
+	for _, shortage := range e.Shortages {
+		parts = append(parts, fmt.Sprintf("%s (requested %d, available %d)", shortage.PartUUID, shortage.Requested, shortage.Available))
+	}
+
+	return "insufficient stock: " + strings.Join(parts, ", ")
+}
+
+func (e *InsufficientStockError) Unwrap() error {
+	return ErrInsufficientStock
+}
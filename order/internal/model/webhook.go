@@ -0,0 +1,128 @@
+package model
+
+import "time"
+
+// WebhookEventType - тип события, на который подписывается вебхук. Каждый тип
+// соответствует переходу заказа в один из статусов
+type WebhookEventType string
+
+const (
+	WebhookEventOrderCreated    WebhookEventType = "ORDER_CREATED"    // Заказ создан и ждёт оплаты
+	WebhookEventOrderPaid       WebhookEventType = "ORDER_PAID"       // Заказ оплачен
+	WebhookEventOrderAssembling WebhookEventType = "ORDER_ASSEMBLING" // Началась сборка корабля
+	WebhookEventOrderCompleted  WebhookEventType = "ORDER_COMPLETED"  // Корабль собран
+	WebhookEventOrderCancelled  WebhookEventType = "ORDER_CANCELLED"  // Заказ отменён пользователем или по таймауту оплаты
+	WebhookEventOrderRefunded   WebhookEventType = "ORDER_REFUNDED"   // Оплаченный заказ отменён с возвратом денег
+)
+
+var webhookEventTypeByStatus = map[OrderStatus]WebhookEventType{
+	OrderStatusPendingPayment: WebhookEventOrderCreated,
+	OrderStatusPaid:           WebhookEventOrderPaid,
+	OrderStatusAssembling:     WebhookEventOrderAssembling,
+	OrderStatusCompleted:      WebhookEventOrderCompleted,
+	OrderStatusCanceled:       WebhookEventOrderCancelled,
+	OrderStatusRefunded:       WebhookEventOrderRefunded,
+}
+
+// WebhookEventTypeForChange возвращает тип события для смены статуса. Удаление
+// черновика, который ещё не стал заказом, событием не считается
+func WebhookEventTypeForChange(from *OrderStatus, to OrderStatus) (WebhookEventType, bool) {
+	if from != nil && *from == OrderStatusDraft && to == OrderStatusCanceled {
+		return "", false
+	}
+
+	eventType, ok := webhookEventTypeByStatus[to]
+	return eventType, ok
+}
+
+func (t WebhookEventType) Valid() bool {
+	for _, eventType := range webhookEventTypeByStatus {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Webhook - адрес, на который отправляются события заказов пользователя
+type Webhook struct {
+	UUID                string
+	UserUUID            string
+	URL                 string
+	Secret              string // Ключ подписи HMAC-SHA256, наружу не отдаётся
+	EventTypes          []WebhookEventType
+	Enabled             bool
+	ConsecutiveFailures int        // Неудачных попыток доставки подряд
+	DisabledAt          *time.Time // Когда вебхук отключён из-за ошибок доставки
+	CreatedAt           time.Time
+}
+
+// WebhookRequest - параметры регистрации вебхука
+type WebhookRequest struct {
+	URL        string
+	Secret     string
+	EventTypes []WebhookEventType
+}
+
+// OrderStatusChange - смена статуса заказа, о которой уведомляются вебхуки
+type OrderStatusChange struct {
+	OrderUUID  string
+	UserUUID   string
+	FromStatus *OrderStatus
+	ToStatus   OrderStatus
+	OccurredAt time.Time
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"   // Ждёт первой или повторной попытки
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "DELIVERED" // Получатель ответил 2xx
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "FAILED"    // Попытки исчерпаны
+)
+
+// WebhookDelivery - доставка одного события на один вебхук
+type WebhookDelivery struct {
+	ID            int64
+	WebhookUUID   string
+	EventUUID     string
+	EventType     WebhookEventType
+	Payload       []byte // Тело запроса, одинаковое во всех попытках
+	Status        WebhookDeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	Log           []WebhookDeliveryAttempt
+}
+
+// WebhookDeliveryAttempt - запись журнала об одной попытке доставки
+type WebhookDeliveryAttempt struct {
+	Attempt     int
+	StatusCode  *int    // nil, если ответа не было
+	Error       *string // Ошибка соединения или текст неуспешного ответа
+	Duration    time.Duration
+	AttemptedAt time.Time
+}
+
+// PendingWebhookDelivery - доставка, готовая к отправке, вместе с адресом и ключом вебхука
+type PendingWebhookDelivery struct {
+	WebhookDelivery
+	URL    string
+	Secret string
+}
+
+// WebhookMessage - подписанный запрос к получателю
+type WebhookMessage struct {
+	URL       string
+	Secret    string
+	EventUUID string
+	EventType WebhookEventType
+	Payload   []byte
+}
+
+// WebhookResponse - результат одной попытки отправки
+type WebhookResponse struct {
+	StatusCode int
+	Body       string // Начало тела ответа для журнала
+	Duration   time.Duration
+}
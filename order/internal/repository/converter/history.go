@@ -0,0 +1,49 @@
+package converter
+
+import (
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+)
+
+func OrderStatusHistoryToRepoModel(record model.OrderStatusHistory) repoModel.OrderStatusHistory {
+	var fromStatus *string
+	if record.FromStatus != nil {
+		status := string(*record.FromStatus)
+		fromStatus = &status
+	}
+
+	return repoModel.OrderStatusHistory{
+		OrderUUID:  record.OrderUUID,
+		FromStatus: fromStatus,
+		ToStatus:   string(record.ToStatus),
+		ActorType:  string(record.Actor.Type),
+		ActorID:    record.Actor.ID,
+		Reason:     record.Reason,
+		CreatedAt:  record.CreatedAt,
+	}
+}
+
+func OrderStatusHistoryToModel(history []repoModel.OrderStatusHistory) []model.OrderStatusHistory {
+	out := make([]model.OrderStatusHistory, 0, len(history))
+	for _, record := range history {
+		var fromStatus *model.OrderStatus
+		if record.FromStatus != nil {
+			status := model.OrderStatus(*record.FromStatus)
+			fromStatus = &status
+		}
+
+		out = append(out, model.OrderStatusHistory{
+			OrderUUID:  record.OrderUUID,
+			FromStatus: fromStatus,
+			ToStatus:   model.OrderStatus(record.ToStatus),
+			Actor: model.Actor{
+				Type: model.ActorType(record.ActorType),
+				ID:   record.ActorID,
+			},
+			Reason:    record.Reason,
+			CreatedAt: record.CreatedAt,
+		})
+	}
+
+	return out
+}
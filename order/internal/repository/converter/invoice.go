@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+func InvoiceToRepoModel(invoice model.Invoice) repoModel.Invoice {
+	return repoModel.Invoice{
+		UUID:      invoice.UUID,
+		OrderUUID: invoice.OrderUUID,
+		UserUUID:  invoice.UserUUID,
+		Number:    invoice.Number,
+		Year:      invoice.Year,
+		Sequence:  invoice.Sequence,
+		Total:     invoice.Total.Amount,
+		Currency:  invoice.Total.Currency,
+		IssuedAt:  invoice.IssuedAt,
+		HTML:      invoice.HTML,
+		PDF:       invoice.PDF,
+	}
+}
+
+func InvoiceToModel(invoice repoModel.Invoice) model.Invoice {
+	return model.Invoice{
+		UUID:      invoice.UUID,
+		OrderUUID: invoice.OrderUUID,
+		UserUUID:  invoice.UserUUID,
+		Number:    invoice.Number,
+		Year:      invoice.Year,
+		Sequence:  invoice.Sequence,
+		Total:     money.New(invoice.Total, invoice.Currency),
+		IssuedAt:  invoice.IssuedAt,
+		HTML:      invoice.HTML,
+		PDF:       invoice.PDF,
+	}
+}
@@ -1,29 +1,142 @@
 package converter
 
 import (
+	"encoding/base64"
+	"encoding/json"
+
 	"github.com/samber/lo"
 
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
 	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
 )
 
+func OrderDataToRepoModel(order model.OrderData) repoModel.OrderData {
+	return repoModel.OrderData{
+		UUID:                order.UUID,
+		UserUUID:            order.UserUUID,
+		Items:               OrderItemsToRepoModel(order.Items),
+		Discounts:           orderDiscountsToRepoModel(order.Discounts),
+		TotalPrice:          order.TotalPrice.Amount,
+		Currency:            order.TotalPrice.Currency,
+		BaseCurrency:        order.ExchangeRate.From,
+		ExchangeRate:        order.ExchangeRate.Value,
+		Status:              repoModel.OrderStatus(order.Status),
+		CreatedAt:           order.CreatedAt,
+		StockReservationKey: order.StockReservationKey,
+	}
+}
+
 func OrderDataToModel(order repoModel.OrderData) model.OrderData {
 	return model.OrderData{
-		UUID:            order.UUID,
-		UserUUID:        order.UserUUID,
-		PartUuids:       order.PartUuids,
-		TotalPrice:      order.TotalPrice,
-		TransactionUUID: order.TransactionUUID,
-		PaymentMethod:   lo.ToPtr(model.PaymentMethod(lo.FromPtr(order.PaymentMethod))),
-		Status:          model.OrderStatus(order.Status),
-		CreatedAt:       order.CreatedAt,
-		UpdatedAt:       order.UpdatedAt,
+		UUID:                order.UUID,
+		UserUUID:            order.UserUUID,
+		Items:               orderItemsToModel(order.Items),
+		TotalPrice:          money.New(order.TotalPrice, order.Currency),
+		Discounts:           orderDiscountsToModel(order.Discounts),
+		ExchangeRate:        money.Rate{From: order.BaseCurrency, To: order.Currency, Value: order.ExchangeRate},
+		TransactionUUID:     order.TransactionUUID,
+		PaymentMethod:       lo.ToPtr(model.PaymentMethod(lo.FromPtr(order.PaymentMethod))),
+		Status:              model.OrderStatus(order.Status),
+		CancelReason:        cancelReasonToModel(order.CancelReason),
+		CreatedAt:           order.CreatedAt,
+		UpdatedAt:           order.UpdatedAt,
+		StockReservationKey: order.StockReservationKey,
+	}
+}
+
+func cancelReasonToModel(reason *string) *model.CancelReason {
+	if reason == nil {
+		return nil
+	}
+
+	return lo.ToPtr(model.CancelReason(*reason))
+}
+
+func OrderItemsToRepoModel(items []model.OrderItem) []repoModel.OrderItem {
+	out := make([]repoModel.OrderItem, 0, len(items))
+	for _, item := range items {
+		out = append(out, repoModel.OrderItem{
+			PartUUID:  item.PartUUID,
+			Name:      item.Name,
+			Category:  string(item.Category),
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice.Amount,
+			Currency:  item.UnitPrice.Currency,
+		})
+	}
+
+	return out
+}
+
+func orderItemsToModel(items []repoModel.OrderItem) []model.OrderItem {
+	out := make([]model.OrderItem, 0, len(items))
+	for _, item := range items {
+		out = append(out, model.OrderItem{
+			PartUUID:  item.PartUUID,
+			Name:      item.Name,
+			Category:  model.Category(item.Category),
+			Quantity:  item.Quantity,
+			UnitPrice: money.New(item.UnitPrice, item.Currency),
+		})
+	}
+
+	return out
+}
+
+func orderDiscountsToRepoModel(discounts []model.OrderDiscount) []repoModel.OrderDiscount {
+	out := make([]repoModel.OrderDiscount, 0, len(discounts))
+	for _, discount := range discounts {
+		out = append(out, repoModel.OrderDiscount{
+			PromoCode:   discount.PromoCode,
+			Description: discount.Description,
+			Amount:      discount.Amount.Amount,
+			Currency:    discount.Amount.Currency,
+		})
 	}
+
+	return out
+}
+
+func orderDiscountsToModel(discounts []repoModel.OrderDiscount) []model.OrderDiscount {
+	out := make([]model.OrderDiscount, 0, len(discounts))
+	for _, discount := range discounts {
+		out = append(out, model.OrderDiscount{
+			PromoCode:   discount.PromoCode,
+			Description: discount.Description,
+			Amount:      money.New(discount.Amount, discount.Currency),
+		})
+	}
+
+	return out
 }
 
 func OrderCreateInfoToModel(orderCreateInfo repoModel.OrderCreationInfo) model.OrderCreationInfo {
 	return model.OrderCreationInfo{
 		OrderUUID:  orderCreateInfo.OrderUUID,
-		TotalPrice: orderCreateInfo.TotalPrice,
+		TotalPrice: money.New(orderCreateInfo.TotalPrice, orderCreateInfo.Currency),
 	}
 }
+
+func OrdersCursorToString(cursor repoModel.OrdersCursor) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func StringToOrdersCursor(s string) (repoModel.OrdersCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return repoModel.OrdersCursor{}, model.ErrOrdersInvalidCursor
+	}
+
+	var cursor repoModel.OrdersCursor
+	if err = json.Unmarshal(data, &cursor); err != nil {
+		return repoModel.OrdersCursor{}, model.ErrOrdersInvalidCursor
+	}
+
+	return cursor, nil
+}
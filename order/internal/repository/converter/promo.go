@@ -0,0 +1,36 @@
+package converter
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+func PromoCodeToModel(promo repoModel.PromoCode) model.PromoCode {
+	var category *model.Category
+	if promo.Category != nil {
+		category = lo.ToPtr(model.Category(*promo.Category))
+	}
+
+	var minOrderAmount *money.Money
+	if promo.MinOrderAmount != nil {
+		minOrderAmount = lo.ToPtr(money.New(*promo.MinOrderAmount, promo.Currency))
+	}
+
+	return model.PromoCode{
+		Code:           promo.Code,
+		Description:    promo.Description,
+		Type:           model.DiscountType(promo.DiscountType),
+		Percent:        promo.Percent,
+		Amount:         money.New(promo.Amount, promo.Currency),
+		Category:       category,
+		Manufacturer:   promo.Manufacturer,
+		MinOrderAmount: minOrderAmount,
+		ValidFrom:      promo.ValidFrom,
+		ValidTo:        promo.ValidTo,
+		UsageLimit:     promo.UsageLimit,
+		UsedCount:      promo.UsedCount,
+	}
+}
@@ -0,0 +1,31 @@
+package converter
+
+import (
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+func OrdersReportRowToModel(row repoModel.OrdersReportRow) model.OrdersReportRow {
+	out := model.OrdersReportRow{
+		PeriodStart:       row.PeriodStart,
+		Currency:          row.Currency,
+		OrdersCount:       row.OrdersCount,
+		PaidOrdersCount:   row.PaidOrdersCount,
+		Revenue:           money.New(row.Revenue, row.Currency),
+		AverageOrderValue: money.New(row.AverageOrderValue, row.Currency),
+		CancelledCount:    row.CancelledCount,
+		RefundedCount:     row.RefundedCount,
+		CancellationRate:  row.CancellationRate,
+	}
+	if row.Status != nil {
+		status := model.OrderStatus(*row.Status)
+		out.Status = &status
+	}
+	if row.PaymentMethod != nil {
+		paymentMethod := model.PaymentMethod(*row.PaymentMethod)
+		out.PaymentMethod = &paymentMethod
+	}
+
+	return out
+}
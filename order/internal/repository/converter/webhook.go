@@ -0,0 +1,55 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+)
+
+func WebhookToModel(webhook repoModel.Webhook) model.Webhook {
+	return model.Webhook{
+		UUID:     webhook.UUID,
+		UserUUID: webhook.UserUUID,
+		URL:      webhook.URL,
+		Secret:   webhook.Secret,
+		EventTypes: lo.Map(webhook.EventTypes, func(eventType string, _ int) model.WebhookEventType {
+			return model.WebhookEventType(eventType)
+		}),
+		Enabled:             webhook.Enabled,
+		ConsecutiveFailures: webhook.ConsecutiveFailures,
+		DisabledAt:          webhook.DisabledAt,
+		CreatedAt:           webhook.CreatedAt,
+	}
+}
+
+func WebhookEventTypesToRepoModel(eventTypes []model.WebhookEventType) []string {
+	return lo.Map(eventTypes, func(eventType model.WebhookEventType, _ int) string {
+		return string(eventType)
+	})
+}
+
+func WebhookDeliveryToModel(delivery repoModel.WebhookDelivery, log []repoModel.WebhookDeliveryAttempt) model.WebhookDelivery {
+	return model.WebhookDelivery{
+		ID:            delivery.ID,
+		WebhookUUID:   delivery.WebhookUUID,
+		EventUUID:     delivery.EventUUID,
+		EventType:     model.WebhookEventType(delivery.EventType),
+		Payload:       delivery.Payload,
+		Status:        model.WebhookDeliveryStatus(delivery.Status),
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		CreatedAt:     delivery.CreatedAt,
+		Log: lo.Map(log, func(attempt repoModel.WebhookDeliveryAttempt, _ int) model.WebhookDeliveryAttempt {
+			return model.WebhookDeliveryAttempt{
+				Attempt:     attempt.Attempt,
+				StatusCode:  attempt.StatusCode,
+				Error:       attempt.Error,
+				Duration:    time.Duration(attempt.DurationMs) * time.Millisecond,
+				AttemptedAt: attempt.AttemptedAt,
+			}
+		}),
+	}
+}
@@ -0,0 +1,39 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+// AcquireIdempotencyKey захватывает ключ под выполнение запроса.
+// Незавершённый ключ, захваченный раньше чем lockTTL назад, можно перехватить:
+// значит, предыдущий обработчик упал, не успев сохранить ответ.
+// Истёкший ключ захватывается заново вместе со сбросом сохранённого ответа.
+func (r *repository) AcquireIdempotencyKey(ctx context.Context, key model.IdempotencyKey, lockTTL time.Duration) (bool, error) {
+	now := time.Now()
+
+	query, args, err := sq.Insert("idempotency_keys").
+		PlaceholderFormat(sq.Dollar).
+		Columns("user_uuid", "operation", "key", "request_hash", "created_at", "locked_at").
+		Values(key.UserUUID, key.Operation, key.Key, key.RequestHash, now, now).
+		Suffix(`ON CONFLICT (user_uuid, operation, key) DO UPDATE
+			SET request_hash = EXCLUDED.request_hash, created_at = EXCLUDED.created_at, locked_at = EXCLUDED.locked_at,
+				response = NULL, completed_at = NULL
+			WHERE (idempotency_keys.response IS NULL AND idempotency_keys.locked_at < ?)
+				OR idempotency_keys.created_at < ?`, now.Add(-lockTTL), now.Add(-r.keyTTL)).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	tag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
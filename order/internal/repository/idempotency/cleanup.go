@@ -0,0 +1,26 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// DeleteExpiredIdempotencyKeys удаляет истёкшие ключи и возвращает, сколько удалено
+func (r *repository) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	query, args, err := sq.Delete("idempotency_keys").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Lt{"created_at": time.Now().Add(-r.keyTTL)}).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,49 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+// SaveIdempotencyResponse сохраняет ответ, который будет возвращаться на повторы
+func (r *repository) SaveIdempotencyResponse(ctx context.Context, key model.IdempotencyKey, response []byte) error {
+	query, args, err := sq.Update("idempotency_keys").
+		PlaceholderFormat(sq.Dollar).
+		Set("response", response).
+		Set("completed_at", time.Now()).
+		Where(sq.Eq{
+			"user_uuid": key.UserUUID,
+			"operation": key.Operation,
+			"key":       key.Key,
+		}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	return err
+}
+
+// DeleteIdempotencyKey освобождает незавершённый ключ, чтобы запрос можно было повторить
+func (r *repository) DeleteIdempotencyKey(ctx context.Context, key model.IdempotencyKey) error {
+	query, args, err := sq.Delete("idempotency_keys").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{
+			"user_uuid": key.UserUUID,
+			"operation": key.Operation,
+			"key":       key.Key,
+			"response":  nil,
+		}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	return err
+}
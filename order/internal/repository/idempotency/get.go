@@ -0,0 +1,40 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+// GetIdempotencyKey возвращает ключ, если он ещё не истёк
+func (r *repository) GetIdempotencyKey(ctx context.Context, key model.IdempotencyKey) (model.IdempotencyRecord, error) {
+	query, args, err := sq.Select("request_hash", "response").
+		From("idempotency_keys").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{
+			"user_uuid": key.UserUUID,
+			"operation": key.Operation,
+			"key":       key.Key,
+		}).
+		Where(sq.GtOrEq{"created_at": time.Now().Add(-r.keyTTL)}).
+		ToSql()
+	if err != nil {
+		return model.IdempotencyRecord{}, err
+	}
+
+	var record model.IdempotencyRecord
+	err = r.db.QueryRow(ctx, query, args...).Scan(&record.RequestHash, &record.Response)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.IdempotencyRecord{}, model.ErrIdempotencyKeyNotFound
+		}
+		return model.IdempotencyRecord{}, err
+	}
+
+	return record, nil
+}
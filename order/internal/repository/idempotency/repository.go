@@ -0,0 +1,25 @@
+package idempotency
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/Alexey-step/rocket-factory/order/internal/repository"
+)
+
+var _ def.IdempotencyRepository = (*repository)(nil)
+
+type repository struct {
+	db     *pgxpool.Pool
+	keyTTL time.Duration
+}
+
+// NewIdempotencyRepository создаёт репозиторий ключей идемпотентности. Ключ старше keyTTL
+// считается истёкшим: он не находится при поиске и может быть захвачен заново
+func NewIdempotencyRepository(db *pgxpool.Pool, keyTTL time.Duration) *repository {
+	return &repository{
+		db:     db,
+		keyTTL: keyTTL,
+	}
+}
@@ -0,0 +1,117 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/converter"
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
+)
+
+func (r *repository) GetInvoiceByOrder(ctx context.Context, orderUUID string) (model.Invoice, error) {
+	query, args, err := sq.Select(
+		"uuid",
+		"order_uuid",
+		"user_uuid",
+		"number",
+		"year",
+		"sequence",
+		"total",
+		"currency",
+		"issued_at",
+		"html",
+		"pdf").
+		From("invoices").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"order_uuid": orderUUID}).
+		ToSql()
+	if err != nil {
+		return model.Invoice{}, err
+	}
+
+	var invoice repoModel.Invoice
+	err = txmanager.GetQuerier(ctx, r.db).QueryRow(ctx, query, args...).Scan(
+		&invoice.UUID,
+		&invoice.OrderUUID,
+		&invoice.UserUUID,
+		&invoice.Number,
+		&invoice.Year,
+		&invoice.Sequence,
+		&invoice.Total,
+		&invoice.Currency,
+		&invoice.IssuedAt,
+		&invoice.HTML,
+		&invoice.PDF,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Invoice{}, model.ErrInvoiceNotFound
+		}
+		return model.Invoice{}, err
+	}
+
+	return converter.InvoiceToModel(invoice), nil
+}
+
+// NextInvoiceSequence выдаёт следующий номер счёта в году. Строка счётчика остаётся
+// заблокированной до конца транзакции, поэтому вызывать только внутри неё
+func (r *repository) NextInvoiceSequence(ctx context.Context, year int) (int64, error) {
+	query, args, err := sq.Insert("invoice_counters").
+		PlaceholderFormat(sq.Dollar).
+		Columns("year", "last_number").
+		Values(year, 1).
+		Suffix("ON CONFLICT (year) DO UPDATE SET last_number = invoice_counters.last_number + 1 RETURNING last_number").
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var sequence int64
+	if err = txmanager.GetQuerier(ctx, r.db).QueryRow(ctx, query, args...).Scan(&sequence); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// CreateInvoice сохраняет счёт и возвращает его с присвоенным UUID. Если у заказа
+// счёт уже есть, возвращает ErrInvoiceAlreadyExists: существующий счёт не перезаписывается
+func (r *repository) CreateInvoice(ctx context.Context, invoice model.Invoice) (model.Invoice, error) {
+	repoInvoice := converter.InvoiceToRepoModel(invoice)
+
+	query, args, err := sq.Insert("invoices").
+		PlaceholderFormat(sq.Dollar).
+		Columns("order_uuid", "user_uuid", "number", "year", "sequence", "total", "currency", "issued_at", "html", "pdf").
+		Values(
+			repoInvoice.OrderUUID,
+			repoInvoice.UserUUID,
+			repoInvoice.Number,
+			repoInvoice.Year,
+			repoInvoice.Sequence,
+			repoInvoice.Total,
+			repoInvoice.Currency,
+			repoInvoice.IssuedAt,
+			repoInvoice.HTML,
+			repoInvoice.PDF,
+		).
+		Suffix("ON CONFLICT (order_uuid) DO NOTHING RETURNING uuid").
+		ToSql()
+	if err != nil {
+		return model.Invoice{}, err
+	}
+
+	err = txmanager.GetQuerier(ctx, r.db).QueryRow(ctx, query, args...).Scan(&repoInvoice.UUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Invoice{}, model.ErrInvoiceAlreadyExists
+		}
+		return model.Invoice{}, err
+	}
+
+	return converter.InvoiceToModel(repoInvoice), nil
+}
@@ -0,0 +1,19 @@
+package invoice
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/Alexey-step/rocket-factory/order/internal/repository"
+)
+
+var _ def.InvoiceRepository = (*repository)(nil)
+
+type repository struct {
+	db *pgxpool.Pool
+}
+
+func NewInvoiceRepository(db *pgxpool.Pool) *repository {
+	return &repository{
+		db: db,
+	}
+}
@@ -0,0 +1,307 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Alexey-step/rocket-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// IdempotencyRepository is an autogenerated mock type for the IdempotencyRepository type
+type IdempotencyRepository struct {
+	mock.Mock
+}
+
+type IdempotencyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IdempotencyRepository) EXPECT() *IdempotencyRepository_Expecter {
+	return &IdempotencyRepository_Expecter{mock: &_m.Mock}
+}
+
+// AcquireIdempotencyKey provides a mock function with given fields: ctx, key, lockTTL
+func (_m *IdempotencyRepository) AcquireIdempotencyKey(ctx context.Context, key model.IdempotencyKey, lockTTL time.Duration) (bool, error) {
+	ret := _m.Called(ctx, key, lockTTL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcquireIdempotencyKey")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.IdempotencyKey, time.Duration) (bool, error)); ok {
+		return rf(ctx, key, lockTTL)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.IdempotencyKey, time.Duration) bool); ok {
+		r0 = rf(ctx, key, lockTTL)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.IdempotencyKey, time.Duration) error); ok {
+		r1 = rf(ctx, key, lockTTL)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdempotencyRepository_AcquireIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcquireIdempotencyKey'
+type IdempotencyRepository_AcquireIdempotencyKey_Call struct {
+	*mock.Call
+}
+
+// AcquireIdempotencyKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key model.IdempotencyKey
+//   - lockTTL time.Duration
+func (_e *IdempotencyRepository_Expecter) AcquireIdempotencyKey(ctx interface{}, key interface{}, lockTTL interface{}) *IdempotencyRepository_AcquireIdempotencyKey_Call {
+	return &IdempotencyRepository_AcquireIdempotencyKey_Call{Call: _e.mock.On("AcquireIdempotencyKey", ctx, key, lockTTL)}
+}
+
+func (_c *IdempotencyRepository_AcquireIdempotencyKey_Call) Run(run func(ctx context.Context, key model.IdempotencyKey, lockTTL time.Duration)) *IdempotencyRepository_AcquireIdempotencyKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.IdempotencyKey), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_AcquireIdempotencyKey_Call) Return(acquired bool, err error) *IdempotencyRepository_AcquireIdempotencyKey_Call {
+	_c.Call.Return(acquired, err)
+	return _c
+}
+
+func (_c *IdempotencyRepository_AcquireIdempotencyKey_Call) RunAndReturn(run func(context.Context, model.IdempotencyKey, time.Duration) (bool, error)) *IdempotencyRepository_AcquireIdempotencyKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredIdempotencyKeys provides a mock function with given fields: ctx
+func (_m *IdempotencyRepository) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredIdempotencyKeys")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdempotencyRepository_DeleteExpiredIdempotencyKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredIdempotencyKeys'
+type IdempotencyRepository_DeleteExpiredIdempotencyKeys_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredIdempotencyKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IdempotencyRepository_Expecter) DeleteExpiredIdempotencyKeys(ctx interface{}) *IdempotencyRepository_DeleteExpiredIdempotencyKeys_Call {
+	return &IdempotencyRepository_DeleteExpiredIdempotencyKeys_Call{Call: _e.mock.On("DeleteExpiredIdempotencyKeys", ctx)}
+}
+
+func (_c *IdempotencyRepository_DeleteExpiredIdempotencyKeys_Call) Run(run func(ctx context.Context)) *IdempotencyRepository_DeleteExpiredIdempotencyKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_DeleteExpiredIdempotencyKeys_Call) Return(deleted int64, err error) *IdempotencyRepository_DeleteExpiredIdempotencyKeys_Call {
+	_c.Call.Return(deleted, err)
+	return _c
+}
+
+func (_c *IdempotencyRepository_DeleteExpiredIdempotencyKeys_Call) RunAndReturn(run func(context.Context) (int64, error)) *IdempotencyRepository_DeleteExpiredIdempotencyKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteIdempotencyKey provides a mock function with given fields: ctx, key
+func (_m *IdempotencyRepository) DeleteIdempotencyKey(ctx context.Context, key model.IdempotencyKey) error {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteIdempotencyKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.IdempotencyKey) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdempotencyRepository_DeleteIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteIdempotencyKey'
+type IdempotencyRepository_DeleteIdempotencyKey_Call struct {
+	*mock.Call
+}
+
+// DeleteIdempotencyKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key model.IdempotencyKey
+func (_e *IdempotencyRepository_Expecter) DeleteIdempotencyKey(ctx interface{}, key interface{}) *IdempotencyRepository_DeleteIdempotencyKey_Call {
+	return &IdempotencyRepository_DeleteIdempotencyKey_Call{Call: _e.mock.On("DeleteIdempotencyKey", ctx, key)}
+}
+
+func (_c *IdempotencyRepository_DeleteIdempotencyKey_Call) Run(run func(ctx context.Context, key model.IdempotencyKey)) *IdempotencyRepository_DeleteIdempotencyKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.IdempotencyKey))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_DeleteIdempotencyKey_Call) Return(_a0 error) *IdempotencyRepository_DeleteIdempotencyKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdempotencyRepository_DeleteIdempotencyKey_Call) RunAndReturn(run func(context.Context, model.IdempotencyKey) error) *IdempotencyRepository_DeleteIdempotencyKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIdempotencyKey provides a mock function with given fields: ctx, key
+func (_m *IdempotencyRepository) GetIdempotencyKey(ctx context.Context, key model.IdempotencyKey) (model.IdempotencyRecord, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIdempotencyKey")
+	}
+
+	var r0 model.IdempotencyRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.IdempotencyKey) (model.IdempotencyRecord, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.IdempotencyKey) model.IdempotencyRecord); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Get(0).(model.IdempotencyRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.IdempotencyKey) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdempotencyRepository_GetIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIdempotencyKey'
+type IdempotencyRepository_GetIdempotencyKey_Call struct {
+	*mock.Call
+}
+
+// GetIdempotencyKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key model.IdempotencyKey
+func (_e *IdempotencyRepository_Expecter) GetIdempotencyKey(ctx interface{}, key interface{}) *IdempotencyRepository_GetIdempotencyKey_Call {
+	return &IdempotencyRepository_GetIdempotencyKey_Call{Call: _e.mock.On("GetIdempotencyKey", ctx, key)}
+}
+
+func (_c *IdempotencyRepository_GetIdempotencyKey_Call) Run(run func(ctx context.Context, key model.IdempotencyKey)) *IdempotencyRepository_GetIdempotencyKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.IdempotencyKey))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_GetIdempotencyKey_Call) Return(record model.IdempotencyRecord, err error) *IdempotencyRepository_GetIdempotencyKey_Call {
+	_c.Call.Return(record, err)
+	return _c
+}
+
+func (_c *IdempotencyRepository_GetIdempotencyKey_Call) RunAndReturn(run func(context.Context, model.IdempotencyKey) (model.IdempotencyRecord, error)) *IdempotencyRepository_GetIdempotencyKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveIdempotencyResponse provides a mock function with given fields: ctx, key, response
+func (_m *IdempotencyRepository) SaveIdempotencyResponse(ctx context.Context, key model.IdempotencyKey, response []byte) error {
+	ret := _m.Called(ctx, key, response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveIdempotencyResponse")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.IdempotencyKey, []byte) error); ok {
+		r0 = rf(ctx, key, response)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdempotencyRepository_SaveIdempotencyResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveIdempotencyResponse'
+type IdempotencyRepository_SaveIdempotencyResponse_Call struct {
+	*mock.Call
+}
+
+// SaveIdempotencyResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key model.IdempotencyKey
+//   - response []byte
+func (_e *IdempotencyRepository_Expecter) SaveIdempotencyResponse(ctx interface{}, key interface{}, response interface{}) *IdempotencyRepository_SaveIdempotencyResponse_Call {
+	return &IdempotencyRepository_SaveIdempotencyResponse_Call{Call: _e.mock.On("SaveIdempotencyResponse", ctx, key, response)}
+}
+
+func (_c *IdempotencyRepository_SaveIdempotencyResponse_Call) Run(run func(ctx context.Context, key model.IdempotencyKey, response []byte)) *IdempotencyRepository_SaveIdempotencyResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.IdempotencyKey), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_SaveIdempotencyResponse_Call) Return(_a0 error) *IdempotencyRepository_SaveIdempotencyResponse_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdempotencyRepository_SaveIdempotencyResponse_Call) RunAndReturn(run func(context.Context, model.IdempotencyKey, []byte) error) *IdempotencyRepository_SaveIdempotencyResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIdempotencyRepository creates a new instance of IdempotencyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIdempotencyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IdempotencyRepository {
+	mock := &IdempotencyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
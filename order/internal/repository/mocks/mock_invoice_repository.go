@@ -0,0 +1,210 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Alexey-step/rocket-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InvoiceRepository is an autogenerated mock type for the InvoiceRepository type
+type InvoiceRepository struct {
+	mock.Mock
+}
+
+type InvoiceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InvoiceRepository) EXPECT() *InvoiceRepository_Expecter {
+	return &InvoiceRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateInvoice provides a mock function with given fields: ctx, invoice
+func (_m *InvoiceRepository) CreateInvoice(ctx context.Context, invoice model.Invoice) (model.Invoice, error) {
+	ret := _m.Called(ctx, invoice)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInvoice")
+	}
+
+	var r0 model.Invoice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Invoice) (model.Invoice, error)); ok {
+		return rf(ctx, invoice)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Invoice) model.Invoice); ok {
+		r0 = rf(ctx, invoice)
+	} else {
+		r0 = ret.Get(0).(model.Invoice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Invoice) error); ok {
+		r1 = rf(ctx, invoice)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvoiceRepository_CreateInvoice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInvoice'
+type InvoiceRepository_CreateInvoice_Call struct {
+	*mock.Call
+}
+
+// CreateInvoice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invoice model.Invoice
+func (_e *InvoiceRepository_Expecter) CreateInvoice(ctx interface{}, invoice interface{}) *InvoiceRepository_CreateInvoice_Call {
+	return &InvoiceRepository_CreateInvoice_Call{Call: _e.mock.On("CreateInvoice", ctx, invoice)}
+}
+
+func (_c *InvoiceRepository_CreateInvoice_Call) Run(run func(ctx context.Context, invoice model.Invoice)) *InvoiceRepository_CreateInvoice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Invoice))
+	})
+	return _c
+}
+
+func (_c *InvoiceRepository_CreateInvoice_Call) Return(created model.Invoice, err error) *InvoiceRepository_CreateInvoice_Call {
+	_c.Call.Return(created, err)
+	return _c
+}
+
+func (_c *InvoiceRepository_CreateInvoice_Call) RunAndReturn(run func(context.Context, model.Invoice) (model.Invoice, error)) *InvoiceRepository_CreateInvoice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInvoiceByOrder provides a mock function with given fields: ctx, orderUUID
+func (_m *InvoiceRepository) GetInvoiceByOrder(ctx context.Context, orderUUID string) (model.Invoice, error) {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvoiceByOrder")
+	}
+
+	var r0 model.Invoice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Invoice, error)); ok {
+		return rf(ctx, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Invoice); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		r0 = ret.Get(0).(model.Invoice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvoiceRepository_GetInvoiceByOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInvoiceByOrder'
+type InvoiceRepository_GetInvoiceByOrder_Call struct {
+	*mock.Call
+}
+
+// GetInvoiceByOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *InvoiceRepository_Expecter) GetInvoiceByOrder(ctx interface{}, orderUUID interface{}) *InvoiceRepository_GetInvoiceByOrder_Call {
+	return &InvoiceRepository_GetInvoiceByOrder_Call{Call: _e.mock.On("GetInvoiceByOrder", ctx, orderUUID)}
+}
+
+func (_c *InvoiceRepository_GetInvoiceByOrder_Call) Run(run func(ctx context.Context, orderUUID string)) *InvoiceRepository_GetInvoiceByOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InvoiceRepository_GetInvoiceByOrder_Call) Return(invoice model.Invoice, err error) *InvoiceRepository_GetInvoiceByOrder_Call {
+	_c.Call.Return(invoice, err)
+	return _c
+}
+
+func (_c *InvoiceRepository_GetInvoiceByOrder_Call) RunAndReturn(run func(context.Context, string) (model.Invoice, error)) *InvoiceRepository_GetInvoiceByOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NextInvoiceSequence provides a mock function with given fields: ctx, year
+func (_m *InvoiceRepository) NextInvoiceSequence(ctx context.Context, year int) (int64, error) {
+	ret := _m.Called(ctx, year)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NextInvoiceSequence")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int64, error)); ok {
+		return rf(ctx, year)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int64); ok {
+		r0 = rf(ctx, year)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, year)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvoiceRepository_NextInvoiceSequence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NextInvoiceSequence'
+type InvoiceRepository_NextInvoiceSequence_Call struct {
+	*mock.Call
+}
+
+// NextInvoiceSequence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - year int
+func (_e *InvoiceRepository_Expecter) NextInvoiceSequence(ctx interface{}, year interface{}) *InvoiceRepository_NextInvoiceSequence_Call {
+	return &InvoiceRepository_NextInvoiceSequence_Call{Call: _e.mock.On("NextInvoiceSequence", ctx, year)}
+}
+
+func (_c *InvoiceRepository_NextInvoiceSequence_Call) Run(run func(ctx context.Context, year int)) *InvoiceRepository_NextInvoiceSequence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *InvoiceRepository_NextInvoiceSequence_Call) Return(sequence int64, err error) *InvoiceRepository_NextInvoiceSequence_Call {
+	_c.Call.Return(sequence, err)
+	return _c
+}
+
+func (_c *InvoiceRepository_NextInvoiceSequence_Call) RunAndReturn(run func(context.Context, int) (int64, error)) *InvoiceRepository_NextInvoiceSequence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInvoiceRepository creates a new instance of InvoiceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInvoiceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InvoiceRepository {
+	mock := &InvoiceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
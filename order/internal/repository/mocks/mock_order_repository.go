@@ -9,6 +9,8 @@ import (
 
 	model "github.com/Alexey-step/rocket-factory/order/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // OrderRepository is an autogenerated mock type for the OrderRepository type
@@ -24,9 +26,103 @@ func (_m *OrderRepository) EXPECT() *OrderRepository_Expecter {
 	return &OrderRepository_Expecter{mock: &_m.Mock}
 }
 
-// CreateOrder provides a mock function with given fields: ctx, userUUID, parts
-func (_m *OrderRepository) CreateOrder(ctx context.Context, userUUID string, parts []model.Part) (model.OrderCreationInfo, error) {
-	ret := _m.Called(ctx, userUUID, parts)
+// AddStatusHistory provides a mock function with given fields: ctx, record
+func (_m *OrderRepository) AddStatusHistory(ctx context.Context, record model.OrderStatusHistory) error {
+	ret := _m.Called(ctx, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddStatusHistory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderStatusHistory) error); ok {
+		r0 = rf(ctx, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderRepository_AddStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddStatusHistory'
+type OrderRepository_AddStatusHistory_Call struct {
+	*mock.Call
+}
+
+// AddStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - record model.OrderStatusHistory
+func (_e *OrderRepository_Expecter) AddStatusHistory(ctx interface{}, record interface{}) *OrderRepository_AddStatusHistory_Call {
+	return &OrderRepository_AddStatusHistory_Call{Call: _e.mock.On("AddStatusHistory", ctx, record)}
+}
+
+func (_c *OrderRepository_AddStatusHistory_Call) Run(run func(ctx context.Context, record model.OrderStatusHistory)) *OrderRepository_AddStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrderStatusHistory))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_AddStatusHistory_Call) Return(_a0 error) *OrderRepository_AddStatusHistory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderRepository_AddStatusHistory_Call) RunAndReturn(run func(context.Context, model.OrderStatusHistory) error) *OrderRepository_AddStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckoutDraftOrder provides a mock function with given fields: ctx, order
+func (_m *OrderRepository) CheckoutDraftOrder(ctx context.Context, order model.OrderData) error {
+	ret := _m.Called(ctx, order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckoutDraftOrder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderData) error); ok {
+		r0 = rf(ctx, order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderRepository_CheckoutDraftOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckoutDraftOrder'
+type OrderRepository_CheckoutDraftOrder_Call struct {
+	*mock.Call
+}
+
+// CheckoutDraftOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - order model.OrderData
+func (_e *OrderRepository_Expecter) CheckoutDraftOrder(ctx interface{}, order interface{}) *OrderRepository_CheckoutDraftOrder_Call {
+	return &OrderRepository_CheckoutDraftOrder_Call{Call: _e.mock.On("CheckoutDraftOrder", ctx, order)}
+}
+
+func (_c *OrderRepository_CheckoutDraftOrder_Call) Run(run func(ctx context.Context, order model.OrderData)) *OrderRepository_CheckoutDraftOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrderData))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_CheckoutDraftOrder_Call) Return(_a0 error) *OrderRepository_CheckoutDraftOrder_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderRepository_CheckoutDraftOrder_Call) RunAndReturn(run func(context.Context, model.OrderData) error) *OrderRepository_CheckoutDraftOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrder provides a mock function with given fields: ctx, order
+func (_m *OrderRepository) CreateOrder(ctx context.Context, order model.OrderData) (model.OrderCreationInfo, error) {
+	ret := _m.Called(ctx, order)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateOrder")
@@ -34,17 +130,17 @@ func (_m *OrderRepository) CreateOrder(ctx context.Context, userUUID string, par
 
 	var r0 model.OrderCreationInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, []model.Part) (model.OrderCreationInfo, error)); ok {
-		return rf(ctx, userUUID, parts)
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderData) (model.OrderCreationInfo, error)); ok {
+		return rf(ctx, order)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, []model.Part) model.OrderCreationInfo); ok {
-		r0 = rf(ctx, userUUID, parts)
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderData) model.OrderCreationInfo); ok {
+		r0 = rf(ctx, order)
 	} else {
 		r0 = ret.Get(0).(model.OrderCreationInfo)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, []model.Part) error); ok {
-		r1 = rf(ctx, userUUID, parts)
+	if rf, ok := ret.Get(1).(func(context.Context, model.OrderData) error); ok {
+		r1 = rf(ctx, order)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,15 +155,14 @@ type OrderRepository_CreateOrder_Call struct {
 
 // CreateOrder is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userUUID string
-//   - parts []model.Part
-func (_e *OrderRepository_Expecter) CreateOrder(ctx interface{}, userUUID interface{}, parts interface{}) *OrderRepository_CreateOrder_Call {
-	return &OrderRepository_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, userUUID, parts)}
+//   - order model.OrderData
+func (_e *OrderRepository_Expecter) CreateOrder(ctx interface{}, order interface{}) *OrderRepository_CreateOrder_Call {
+	return &OrderRepository_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, order)}
 }
 
-func (_c *OrderRepository_CreateOrder_Call) Run(run func(ctx context.Context, userUUID string, parts []model.Part)) *OrderRepository_CreateOrder_Call {
+func (_c *OrderRepository_CreateOrder_Call) Run(run func(ctx context.Context, order model.OrderData)) *OrderRepository_CreateOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].([]model.Part))
+		run(args[0].(context.Context), args[1].(model.OrderData))
 	})
 	return _c
 }
@@ -77,7 +172,121 @@ func (_c *OrderRepository_CreateOrder_Call) Return(info model.OrderCreationInfo,
 	return _c
 }
 
-func (_c *OrderRepository_CreateOrder_Call) RunAndReturn(run func(context.Context, string, []model.Part) (model.OrderCreationInfo, error)) *OrderRepository_CreateOrder_Call {
+func (_c *OrderRepository_CreateOrder_Call) RunAndReturn(run func(context.Context, model.OrderData) (model.OrderCreationInfo, error)) *OrderRepository_CreateOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnsureDraftOrder provides a mock function with given fields: ctx, draft
+func (_m *OrderRepository) EnsureDraftOrder(ctx context.Context, draft model.OrderData) (string, error) {
+	ret := _m.Called(ctx, draft)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureDraftOrder")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderData) (string, error)); ok {
+		return rf(ctx, draft)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderData) string); ok {
+		r0 = rf(ctx, draft)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.OrderData) error); ok {
+		r1 = rf(ctx, draft)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_EnsureDraftOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnsureDraftOrder'
+type OrderRepository_EnsureDraftOrder_Call struct {
+	*mock.Call
+}
+
+// EnsureDraftOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - draft model.OrderData
+func (_e *OrderRepository_Expecter) EnsureDraftOrder(ctx interface{}, draft interface{}) *OrderRepository_EnsureDraftOrder_Call {
+	return &OrderRepository_EnsureDraftOrder_Call{Call: _e.mock.On("EnsureDraftOrder", ctx, draft)}
+}
+
+func (_c *OrderRepository_EnsureDraftOrder_Call) Run(run func(ctx context.Context, draft model.OrderData)) *OrderRepository_EnsureDraftOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrderData))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_EnsureDraftOrder_Call) Return(orderUUID string, err error) *OrderRepository_EnsureDraftOrder_Call {
+	_c.Call.Return(orderUUID, err)
+	return _c
+}
+
+func (_c *OrderRepository_EnsureDraftOrder_Call) RunAndReturn(run func(context.Context, model.OrderData) (string, error)) *OrderRepository_EnsureDraftOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDraftOrder provides a mock function with given fields: ctx, userUUID
+func (_m *OrderRepository) GetDraftOrder(ctx context.Context, userUUID string) (model.OrderData, error) {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDraftOrder")
+	}
+
+	var r0 model.OrderData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.OrderData, error)); ok {
+		return rf(ctx, userUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.OrderData); ok {
+		r0 = rf(ctx, userUUID)
+	} else {
+		r0 = ret.Get(0).(model.OrderData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_GetDraftOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDraftOrder'
+type OrderRepository_GetDraftOrder_Call struct {
+	*mock.Call
+}
+
+// GetDraftOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+func (_e *OrderRepository_Expecter) GetDraftOrder(ctx interface{}, userUUID interface{}) *OrderRepository_GetDraftOrder_Call {
+	return &OrderRepository_GetDraftOrder_Call{Call: _e.mock.On("GetDraftOrder", ctx, userUUID)}
+}
+
+func (_c *OrderRepository_GetDraftOrder_Call) Run(run func(ctx context.Context, userUUID string)) *OrderRepository_GetDraftOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_GetDraftOrder_Call) Return(order model.OrderData, err error) *OrderRepository_GetDraftOrder_Call {
+	_c.Call.Return(order, err)
+	return _c
+}
+
+func (_c *OrderRepository_GetDraftOrder_Call) RunAndReturn(run func(context.Context, string) (model.OrderData, error)) *OrderRepository_GetDraftOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -139,17 +348,289 @@ func (_c *OrderRepository_GetOrder_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// UpdateOrder provides a mock function with given fields: ctx, orderUUID, orderUpdateInfo
-func (_m *OrderRepository) UpdateOrder(ctx context.Context, orderUUID string, orderUpdateInfo model.OrderUpdateInfo) error {
-	ret := _m.Called(ctx, orderUUID, orderUpdateInfo)
+// GetStatusHistory provides a mock function with given fields: ctx, orderUUID
+func (_m *OrderRepository) GetStatusHistory(ctx context.Context, orderUUID string) ([]model.OrderStatusHistory, error) {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatusHistory")
+	}
+
+	var r0 []model.OrderStatusHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.OrderStatusHistory, error)); ok {
+		return rf(ctx, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.OrderStatusHistory); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OrderStatusHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_GetStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatusHistory'
+type OrderRepository_GetStatusHistory_Call struct {
+	*mock.Call
+}
+
+// GetStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *OrderRepository_Expecter) GetStatusHistory(ctx interface{}, orderUUID interface{}) *OrderRepository_GetStatusHistory_Call {
+	return &OrderRepository_GetStatusHistory_Call{Call: _e.mock.On("GetStatusHistory", ctx, orderUUID)}
+}
+
+func (_c *OrderRepository_GetStatusHistory_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderRepository_GetStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_GetStatusHistory_Call) Return(history []model.OrderStatusHistory, err error) *OrderRepository_GetStatusHistory_Call {
+	_c.Call.Return(history, err)
+	return _c
+}
+
+func (_c *OrderRepository_GetStatusHistory_Call) RunAndReturn(run func(context.Context, string) ([]model.OrderStatusHistory, error)) *OrderRepository_GetStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function with given fields: ctx, filter
+func (_m *OrderRepository) ListOrders(ctx context.Context, filter model.OrdersFilter) (model.OrdersPage, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 model.OrdersPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrdersFilter) (model.OrdersPage, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrdersFilter) model.OrdersPage); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(model.OrdersPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.OrdersFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type OrderRepository_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.OrdersFilter
+func (_e *OrderRepository_Expecter) ListOrders(ctx interface{}, filter interface{}) *OrderRepository_ListOrders_Call {
+	return &OrderRepository_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, filter)}
+}
+
+func (_c *OrderRepository_ListOrders_Call) Run(run func(ctx context.Context, filter model.OrdersFilter)) *OrderRepository_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrdersFilter))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_ListOrders_Call) Return(page model.OrdersPage, err error) *OrderRepository_ListOrders_Call {
+	_c.Call.Return(page, err)
+	return _c
+}
+
+func (_c *OrderRepository_ListOrders_Call) RunAndReturn(run func(context.Context, model.OrdersFilter) (model.OrdersPage, error)) *OrderRepository_ListOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockExpiredOrders provides a mock function with given fields: ctx, createdBefore, limit
+func (_m *OrderRepository) LockExpiredOrders(ctx context.Context, createdBefore time.Time, limit int) ([]model.OrderData, error) {
+	ret := _m.Called(ctx, createdBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockExpiredOrders")
+	}
+
+	var r0 []model.OrderData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]model.OrderData, error)); ok {
+		return rf(ctx, createdBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []model.OrderData); ok {
+		r0 = rf(ctx, createdBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OrderData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, createdBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_LockExpiredOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockExpiredOrders'
+type OrderRepository_LockExpiredOrders_Call struct {
+	*mock.Call
+}
+
+// LockExpiredOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+//   - limit int
+func (_e *OrderRepository_Expecter) LockExpiredOrders(ctx interface{}, createdBefore interface{}, limit interface{}) *OrderRepository_LockExpiredOrders_Call {
+	return &OrderRepository_LockExpiredOrders_Call{Call: _e.mock.On("LockExpiredOrders", ctx, createdBefore, limit)}
+}
+
+func (_c *OrderRepository_LockExpiredOrders_Call) Run(run func(ctx context.Context, createdBefore time.Time, limit int)) *OrderRepository_LockExpiredOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_LockExpiredOrders_Call) Return(orders []model.OrderData, err error) *OrderRepository_LockExpiredOrders_Call {
+	_c.Call.Return(orders, err)
+	return _c
+}
+
+func (_c *OrderRepository_LockExpiredOrders_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]model.OrderData, error)) *OrderRepository_LockExpiredOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveDraftItem provides a mock function with given fields: ctx, orderUUID, partUUID
+func (_m *OrderRepository) RemoveDraftItem(ctx context.Context, orderUUID string, partUUID string) error {
+	ret := _m.Called(ctx, orderUUID, partUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveDraftItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, orderUUID, partUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderRepository_RemoveDraftItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveDraftItem'
+type OrderRepository_RemoveDraftItem_Call struct {
+	*mock.Call
+}
+
+// RemoveDraftItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - partUUID string
+func (_e *OrderRepository_Expecter) RemoveDraftItem(ctx interface{}, orderUUID interface{}, partUUID interface{}) *OrderRepository_RemoveDraftItem_Call {
+	return &OrderRepository_RemoveDraftItem_Call{Call: _e.mock.On("RemoveDraftItem", ctx, orderUUID, partUUID)}
+}
+
+func (_c *OrderRepository_RemoveDraftItem_Call) Run(run func(ctx context.Context, orderUUID string, partUUID string)) *OrderRepository_RemoveDraftItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_RemoveDraftItem_Call) Return(_a0 error) *OrderRepository_RemoveDraftItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderRepository_RemoveDraftItem_Call) RunAndReturn(run func(context.Context, string, string) error) *OrderRepository_RemoveDraftItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDraftItem provides a mock function with given fields: ctx, orderUUID, item
+func (_m *OrderRepository) SetDraftItem(ctx context.Context, orderUUID string, item model.OrderItem) error {
+	ret := _m.Called(ctx, orderUUID, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDraftItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrderItem) error); ok {
+		r0 = rf(ctx, orderUUID, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderRepository_SetDraftItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDraftItem'
+type OrderRepository_SetDraftItem_Call struct {
+	*mock.Call
+}
+
+// SetDraftItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - item model.OrderItem
+func (_e *OrderRepository_Expecter) SetDraftItem(ctx interface{}, orderUUID interface{}, item interface{}) *OrderRepository_SetDraftItem_Call {
+	return &OrderRepository_SetDraftItem_Call{Call: _e.mock.On("SetDraftItem", ctx, orderUUID, item)}
+}
+
+func (_c *OrderRepository_SetDraftItem_Call) Run(run func(ctx context.Context, orderUUID string, item model.OrderItem)) *OrderRepository_SetDraftItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.OrderItem))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_SetDraftItem_Call) Return(_a0 error) *OrderRepository_SetDraftItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderRepository_SetDraftItem_Call) RunAndReturn(run func(context.Context, string, model.OrderItem) error) *OrderRepository_SetDraftItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrder provides a mock function with given fields: ctx, orderUUID, expectedStatus, orderUpdateInfo
+func (_m *OrderRepository) UpdateOrder(ctx context.Context, orderUUID string, expectedStatus model.OrderStatus, orderUpdateInfo model.OrderUpdateInfo) error {
+	ret := _m.Called(ctx, orderUUID, expectedStatus, orderUpdateInfo)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateOrder")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrderUpdateInfo) error); ok {
-		r0 = rf(ctx, orderUUID, orderUpdateInfo)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrderStatus, model.OrderUpdateInfo) error); ok {
+		r0 = rf(ctx, orderUUID, expectedStatus, orderUpdateInfo)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -165,14 +646,15 @@ type OrderRepository_UpdateOrder_Call struct {
 // UpdateOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - orderUUID string
+//   - expectedStatus model.OrderStatus
 //   - orderUpdateInfo model.OrderUpdateInfo
-func (_e *OrderRepository_Expecter) UpdateOrder(ctx interface{}, orderUUID interface{}, orderUpdateInfo interface{}) *OrderRepository_UpdateOrder_Call {
-	return &OrderRepository_UpdateOrder_Call{Call: _e.mock.On("UpdateOrder", ctx, orderUUID, orderUpdateInfo)}
+func (_e *OrderRepository_Expecter) UpdateOrder(ctx interface{}, orderUUID interface{}, expectedStatus interface{}, orderUpdateInfo interface{}) *OrderRepository_UpdateOrder_Call {
+	return &OrderRepository_UpdateOrder_Call{Call: _e.mock.On("UpdateOrder", ctx, orderUUID, expectedStatus, orderUpdateInfo)}
 }
 
-func (_c *OrderRepository_UpdateOrder_Call) Run(run func(ctx context.Context, orderUUID string, orderUpdateInfo model.OrderUpdateInfo)) *OrderRepository_UpdateOrder_Call {
+func (_c *OrderRepository_UpdateOrder_Call) Run(run func(ctx context.Context, orderUUID string, expectedStatus model.OrderStatus, orderUpdateInfo model.OrderUpdateInfo)) *OrderRepository_UpdateOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(model.OrderUpdateInfo))
+		run(args[0].(context.Context), args[1].(string), args[2].(model.OrderStatus), args[3].(model.OrderUpdateInfo))
 	})
 	return _c
 }
@@ -182,7 +664,7 @@ func (_c *OrderRepository_UpdateOrder_Call) Return(_a0 error) *OrderRepository_U
 	return _c
 }
 
-func (_c *OrderRepository_UpdateOrder_Call) RunAndReturn(run func(context.Context, string, model.OrderUpdateInfo) error) *OrderRepository_UpdateOrder_Call {
+func (_c *OrderRepository_UpdateOrder_Call) RunAndReturn(run func(context.Context, string, model.OrderStatus, model.OrderUpdateInfo) error) *OrderRepository_UpdateOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
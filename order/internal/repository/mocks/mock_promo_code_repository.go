@@ -0,0 +1,190 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Alexey-step/rocket-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PromoCodeRepository is an autogenerated mock type for the PromoCodeRepository type
+type PromoCodeRepository struct {
+	mock.Mock
+}
+
+type PromoCodeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PromoCodeRepository) EXPECT() *PromoCodeRepository_Expecter {
+	return &PromoCodeRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetPromoCode provides a mock function with given fields: ctx, code
+func (_m *PromoCodeRepository) GetPromoCode(ctx context.Context, code string) (model.PromoCode, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromoCode")
+	}
+
+	var r0 model.PromoCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.PromoCode, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.PromoCode); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Get(0).(model.PromoCode)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PromoCodeRepository_GetPromoCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPromoCode'
+type PromoCodeRepository_GetPromoCode_Call struct {
+	*mock.Call
+}
+
+// GetPromoCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *PromoCodeRepository_Expecter) GetPromoCode(ctx interface{}, code interface{}) *PromoCodeRepository_GetPromoCode_Call {
+	return &PromoCodeRepository_GetPromoCode_Call{Call: _e.mock.On("GetPromoCode", ctx, code)}
+}
+
+func (_c *PromoCodeRepository_GetPromoCode_Call) Run(run func(ctx context.Context, code string)) *PromoCodeRepository_GetPromoCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PromoCodeRepository_GetPromoCode_Call) Return(promo model.PromoCode, err error) *PromoCodeRepository_GetPromoCode_Call {
+	_c.Call.Return(promo, err)
+	return _c
+}
+
+func (_c *PromoCodeRepository_GetPromoCode_Call) RunAndReturn(run func(context.Context, string) (model.PromoCode, error)) *PromoCodeRepository_GetPromoCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RedeemPromoCode provides a mock function with given fields: ctx, code
+func (_m *PromoCodeRepository) RedeemPromoCode(ctx context.Context, code string) error {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RedeemPromoCode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PromoCodeRepository_RedeemPromoCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RedeemPromoCode'
+type PromoCodeRepository_RedeemPromoCode_Call struct {
+	*mock.Call
+}
+
+// RedeemPromoCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *PromoCodeRepository_Expecter) RedeemPromoCode(ctx interface{}, code interface{}) *PromoCodeRepository_RedeemPromoCode_Call {
+	return &PromoCodeRepository_RedeemPromoCode_Call{Call: _e.mock.On("RedeemPromoCode", ctx, code)}
+}
+
+func (_c *PromoCodeRepository_RedeemPromoCode_Call) Run(run func(ctx context.Context, code string)) *PromoCodeRepository_RedeemPromoCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PromoCodeRepository_RedeemPromoCode_Call) Return(_a0 error) *PromoCodeRepository_RedeemPromoCode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PromoCodeRepository_RedeemPromoCode_Call) RunAndReturn(run func(context.Context, string) error) *PromoCodeRepository_RedeemPromoCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReturnPromoCodeUses provides a mock function with given fields: ctx, orderUUID
+func (_m *PromoCodeRepository) ReturnPromoCodeUses(ctx context.Context, orderUUID string) error {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReturnPromoCodeUses")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PromoCodeRepository_ReturnPromoCodeUses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReturnPromoCodeUses'
+type PromoCodeRepository_ReturnPromoCodeUses_Call struct {
+	*mock.Call
+}
+
+// ReturnPromoCodeUses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *PromoCodeRepository_Expecter) ReturnPromoCodeUses(ctx interface{}, orderUUID interface{}) *PromoCodeRepository_ReturnPromoCodeUses_Call {
+	return &PromoCodeRepository_ReturnPromoCodeUses_Call{Call: _e.mock.On("ReturnPromoCodeUses", ctx, orderUUID)}
+}
+
+func (_c *PromoCodeRepository_ReturnPromoCodeUses_Call) Run(run func(ctx context.Context, orderUUID string)) *PromoCodeRepository_ReturnPromoCodeUses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PromoCodeRepository_ReturnPromoCodeUses_Call) Return(_a0 error) *PromoCodeRepository_ReturnPromoCodeUses_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PromoCodeRepository_ReturnPromoCodeUses_Call) RunAndReturn(run func(context.Context, string) error) *PromoCodeRepository_ReturnPromoCodeUses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPromoCodeRepository creates a new instance of PromoCodeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPromoCodeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PromoCodeRepository {
+	mock := &PromoCodeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
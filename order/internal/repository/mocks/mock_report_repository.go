@@ -0,0 +1,98 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Alexey-step/rocket-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReportRepository is an autogenerated mock type for the ReportRepository type
+type ReportRepository struct {
+	mock.Mock
+}
+
+type ReportRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReportRepository) EXPECT() *ReportRepository_Expecter {
+	return &ReportRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetOrdersReport provides a mock function with given fields: ctx, filter
+func (_m *ReportRepository) GetOrdersReport(ctx context.Context, filter model.OrdersReportFilter) ([]model.OrdersReportRow, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrdersReport")
+	}
+
+	var r0 []model.OrdersReportRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrdersReportFilter) ([]model.OrdersReportRow, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrdersReportFilter) []model.OrdersReportRow); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OrdersReportRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.OrdersReportFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportRepository_GetOrdersReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrdersReport'
+type ReportRepository_GetOrdersReport_Call struct {
+	*mock.Call
+}
+
+// GetOrdersReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.OrdersReportFilter
+func (_e *ReportRepository_Expecter) GetOrdersReport(ctx interface{}, filter interface{}) *ReportRepository_GetOrdersReport_Call {
+	return &ReportRepository_GetOrdersReport_Call{Call: _e.mock.On("GetOrdersReport", ctx, filter)}
+}
+
+func (_c *ReportRepository_GetOrdersReport_Call) Run(run func(ctx context.Context, filter model.OrdersReportFilter)) *ReportRepository_GetOrdersReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrdersReportFilter))
+	})
+	return _c
+}
+
+func (_c *ReportRepository_GetOrdersReport_Call) Return(rows []model.OrdersReportRow, err error) *ReportRepository_GetOrdersReport_Call {
+	_c.Call.Return(rows, err)
+	return _c
+}
+
+func (_c *ReportRepository_GetOrdersReport_Call) RunAndReturn(run func(context.Context, model.OrdersReportFilter) ([]model.OrdersReportRow, error)) *ReportRepository_GetOrdersReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReportRepository creates a new instance of ReportRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReportRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReportRepository {
+	mock := &ReportRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
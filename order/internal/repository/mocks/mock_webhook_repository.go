@@ -0,0 +1,696 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Alexey-step/rocket-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// WebhookRepository is an autogenerated mock type for the WebhookRepository type
+type WebhookRepository struct {
+	mock.Mock
+}
+
+type WebhookRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookRepository) EXPECT() *WebhookRepository_Expecter {
+	return &WebhookRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddWebhookDeliveryAttempt provides a mock function with given fields: ctx, deliveryID, attempt
+func (_m *WebhookRepository) AddWebhookDeliveryAttempt(ctx context.Context, deliveryID int64, attempt model.WebhookDeliveryAttempt) error {
+	ret := _m.Called(ctx, deliveryID, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddWebhookDeliveryAttempt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.WebhookDeliveryAttempt) error); ok {
+		r0 = rf(ctx, deliveryID, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_AddWebhookDeliveryAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddWebhookDeliveryAttempt'
+type WebhookRepository_AddWebhookDeliveryAttempt_Call struct {
+	*mock.Call
+}
+
+// AddWebhookDeliveryAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveryID int64
+//   - attempt model.WebhookDeliveryAttempt
+func (_e *WebhookRepository_Expecter) AddWebhookDeliveryAttempt(ctx interface{}, deliveryID interface{}, attempt interface{}) *WebhookRepository_AddWebhookDeliveryAttempt_Call {
+	return &WebhookRepository_AddWebhookDeliveryAttempt_Call{Call: _e.mock.On("AddWebhookDeliveryAttempt", ctx, deliveryID, attempt)}
+}
+
+func (_c *WebhookRepository_AddWebhookDeliveryAttempt_Call) Run(run func(ctx context.Context, deliveryID int64, attempt model.WebhookDeliveryAttempt)) *WebhookRepository_AddWebhookDeliveryAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.WebhookDeliveryAttempt))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_AddWebhookDeliveryAttempt_Call) Return(_a0 error) *WebhookRepository_AddWebhookDeliveryAttempt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_AddWebhookDeliveryAttempt_Call) RunAndReturn(run func(context.Context, int64, model.WebhookDeliveryAttempt) error) *WebhookRepository_AddWebhookDeliveryAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimPendingWebhookDeliveries provides a mock function with given fields: ctx, limit, leaseUntil
+func (_m *WebhookRepository) ClaimPendingWebhookDeliveries(ctx context.Context, limit int, leaseUntil time.Time) ([]model.PendingWebhookDelivery, error) {
+	ret := _m.Called(ctx, limit, leaseUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimPendingWebhookDeliveries")
+	}
+
+	var r0 []model.PendingWebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) ([]model.PendingWebhookDelivery, error)); ok {
+		return rf(ctx, limit, leaseUntil)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) []model.PendingWebhookDelivery); ok {
+		r0 = rf(ctx, limit, leaseUntil)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PendingWebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, limit, leaseUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ClaimPendingWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimPendingWebhookDeliveries'
+type WebhookRepository_ClaimPendingWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ClaimPendingWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - leaseUntil time.Time
+func (_e *WebhookRepository_Expecter) ClaimPendingWebhookDeliveries(ctx interface{}, limit interface{}, leaseUntil interface{}) *WebhookRepository_ClaimPendingWebhookDeliveries_Call {
+	return &WebhookRepository_ClaimPendingWebhookDeliveries_Call{Call: _e.mock.On("ClaimPendingWebhookDeliveries", ctx, limit, leaseUntil)}
+}
+
+func (_c *WebhookRepository_ClaimPendingWebhookDeliveries_Call) Run(run func(ctx context.Context, limit int, leaseUntil time.Time)) *WebhookRepository_ClaimPendingWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ClaimPendingWebhookDeliveries_Call) Return(deliveries []model.PendingWebhookDelivery, err error) *WebhookRepository_ClaimPendingWebhookDeliveries_Call {
+	_c.Call.Return(deliveries, err)
+	return _c
+}
+
+func (_c *WebhookRepository_ClaimPendingWebhookDeliveries_Call) RunAndReturn(run func(context.Context, int, time.Time) ([]model.PendingWebhookDelivery, error)) *WebhookRepository_ClaimPendingWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebhook provides a mock function with given fields: ctx, webhook
+func (_m *WebhookRepository) CreateWebhook(ctx context.Context, webhook model.Webhook) (model.Webhook, error) {
+	ret := _m.Called(ctx, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 model.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Webhook) (model.Webhook, error)); ok {
+		return rf(ctx, webhook)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Webhook) model.Webhook); ok {
+		r0 = rf(ctx, webhook)
+	} else {
+		r0 = ret.Get(0).(model.Webhook)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Webhook) error); ok {
+		r1 = rf(ctx, webhook)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type WebhookRepository_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook model.Webhook
+func (_e *WebhookRepository_Expecter) CreateWebhook(ctx interface{}, webhook interface{}) *WebhookRepository_CreateWebhook_Call {
+	return &WebhookRepository_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, webhook)}
+}
+
+func (_c *WebhookRepository_CreateWebhook_Call) Run(run func(ctx context.Context, webhook model.Webhook)) *WebhookRepository_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Webhook))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_CreateWebhook_Call) Return(created model.Webhook, err error) *WebhookRepository_CreateWebhook_Call {
+	_c.Call.Return(created, err)
+	return _c
+}
+
+func (_c *WebhookRepository_CreateWebhook_Call) RunAndReturn(run func(context.Context, model.Webhook) (model.Webhook, error)) *WebhookRepository_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function with given fields: ctx, webhookUUID
+func (_m *WebhookRepository) DeleteWebhook(ctx context.Context, webhookUUID string) error {
+	ret := _m.Called(ctx, webhookUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, webhookUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type WebhookRepository_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookUUID string
+func (_e *WebhookRepository_Expecter) DeleteWebhook(ctx interface{}, webhookUUID interface{}) *WebhookRepository_DeleteWebhook_Call {
+	return &WebhookRepository_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, webhookUUID)}
+}
+
+func (_c *WebhookRepository_DeleteWebhook_Call) Run(run func(ctx context.Context, webhookUUID string)) *WebhookRepository_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_DeleteWebhook_Call) Return(_a0 error) *WebhookRepository_DeleteWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_DeleteWebhook_Call) RunAndReturn(run func(context.Context, string) error) *WebhookRepository_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnableWebhook provides a mock function with given fields: ctx, webhookUUID
+func (_m *WebhookRepository) EnableWebhook(ctx context.Context, webhookUUID string) (model.Webhook, error) {
+	ret := _m.Called(ctx, webhookUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableWebhook")
+	}
+
+	var r0 model.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Webhook, error)); ok {
+		return rf(ctx, webhookUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Webhook); ok {
+		r0 = rf(ctx, webhookUUID)
+	} else {
+		r0 = ret.Get(0).(model.Webhook)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, webhookUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_EnableWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableWebhook'
+type WebhookRepository_EnableWebhook_Call struct {
+	*mock.Call
+}
+
+// EnableWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookUUID string
+func (_e *WebhookRepository_Expecter) EnableWebhook(ctx interface{}, webhookUUID interface{}) *WebhookRepository_EnableWebhook_Call {
+	return &WebhookRepository_EnableWebhook_Call{Call: _e.mock.On("EnableWebhook", ctx, webhookUUID)}
+}
+
+func (_c *WebhookRepository_EnableWebhook_Call) Run(run func(ctx context.Context, webhookUUID string)) *WebhookRepository_EnableWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_EnableWebhook_Call) Return(webhook model.Webhook, err error) *WebhookRepository_EnableWebhook_Call {
+	_c.Call.Return(webhook, err)
+	return _c
+}
+
+func (_c *WebhookRepository_EnableWebhook_Call) RunAndReturn(run func(context.Context, string) (model.Webhook, error)) *WebhookRepository_EnableWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueWebhookDeliveries provides a mock function with given fields: ctx, userUUID, delivery
+func (_m *WebhookRepository) EnqueueWebhookDeliveries(ctx context.Context, userUUID string, delivery model.WebhookDelivery) (int, error) {
+	ret := _m.Called(ctx, userUUID, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueWebhookDeliveries")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.WebhookDelivery) (int, error)); ok {
+		return rf(ctx, userUUID, delivery)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.WebhookDelivery) int); ok {
+		r0 = rf(ctx, userUUID, delivery)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.WebhookDelivery) error); ok {
+		r1 = rf(ctx, userUUID, delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_EnqueueWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueWebhookDeliveries'
+type WebhookRepository_EnqueueWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// EnqueueWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - delivery model.WebhookDelivery
+func (_e *WebhookRepository_Expecter) EnqueueWebhookDeliveries(ctx interface{}, userUUID interface{}, delivery interface{}) *WebhookRepository_EnqueueWebhookDeliveries_Call {
+	return &WebhookRepository_EnqueueWebhookDeliveries_Call{Call: _e.mock.On("EnqueueWebhookDeliveries", ctx, userUUID, delivery)}
+}
+
+func (_c *WebhookRepository_EnqueueWebhookDeliveries_Call) Run(run func(ctx context.Context, userUUID string, delivery model.WebhookDelivery)) *WebhookRepository_EnqueueWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.WebhookDelivery))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_EnqueueWebhookDeliveries_Call) Return(enqueued int, err error) *WebhookRepository_EnqueueWebhookDeliveries_Call {
+	_c.Call.Return(enqueued, err)
+	return _c
+}
+
+func (_c *WebhookRepository_EnqueueWebhookDeliveries_Call) RunAndReturn(run func(context.Context, string, model.WebhookDelivery) (int, error)) *WebhookRepository_EnqueueWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhook provides a mock function with given fields: ctx, webhookUUID
+func (_m *WebhookRepository) GetWebhook(ctx context.Context, webhookUUID string) (model.Webhook, error) {
+	ret := _m.Called(ctx, webhookUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhook")
+	}
+
+	var r0 model.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Webhook, error)); ok {
+		return rf(ctx, webhookUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Webhook); ok {
+		r0 = rf(ctx, webhookUUID)
+	} else {
+		r0 = ret.Get(0).(model.Webhook)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, webhookUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_GetWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhook'
+type WebhookRepository_GetWebhook_Call struct {
+	*mock.Call
+}
+
+// GetWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookUUID string
+func (_e *WebhookRepository_Expecter) GetWebhook(ctx interface{}, webhookUUID interface{}) *WebhookRepository_GetWebhook_Call {
+	return &WebhookRepository_GetWebhook_Call{Call: _e.mock.On("GetWebhook", ctx, webhookUUID)}
+}
+
+func (_c *WebhookRepository_GetWebhook_Call) Run(run func(ctx context.Context, webhookUUID string)) *WebhookRepository_GetWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_GetWebhook_Call) Return(webhook model.Webhook, err error) *WebhookRepository_GetWebhook_Call {
+	_c.Call.Return(webhook, err)
+	return _c
+}
+
+func (_c *WebhookRepository_GetWebhook_Call) RunAndReturn(run func(context.Context, string) (model.Webhook, error)) *WebhookRepository_GetWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhookDeliveries provides a mock function with given fields: ctx, webhookUUID, limit
+func (_m *WebhookRepository) ListWebhookDeliveries(ctx context.Context, webhookUUID string, limit int) ([]model.WebhookDelivery, error) {
+	ret := _m.Called(ctx, webhookUUID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhookDeliveries")
+	}
+
+	var r0 []model.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]model.WebhookDelivery, error)); ok {
+		return rf(ctx, webhookUUID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []model.WebhookDelivery); ok {
+		r0 = rf(ctx, webhookUUID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, webhookUUID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ListWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookDeliveries'
+type WebhookRepository_ListWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookUUID string
+//   - limit int
+func (_e *WebhookRepository_Expecter) ListWebhookDeliveries(ctx interface{}, webhookUUID interface{}, limit interface{}) *WebhookRepository_ListWebhookDeliveries_Call {
+	return &WebhookRepository_ListWebhookDeliveries_Call{Call: _e.mock.On("ListWebhookDeliveries", ctx, webhookUUID, limit)}
+}
+
+func (_c *WebhookRepository_ListWebhookDeliveries_Call) Run(run func(ctx context.Context, webhookUUID string, limit int)) *WebhookRepository_ListWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ListWebhookDeliveries_Call) Return(deliveries []model.WebhookDelivery, err error) *WebhookRepository_ListWebhookDeliveries_Call {
+	_c.Call.Return(deliveries, err)
+	return _c
+}
+
+func (_c *WebhookRepository_ListWebhookDeliveries_Call) RunAndReturn(run func(context.Context, string, int) ([]model.WebhookDelivery, error)) *WebhookRepository_ListWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhooks provides a mock function with given fields: ctx, userUUID
+func (_m *WebhookRepository) ListWebhooks(ctx context.Context, userUUID string) ([]model.Webhook, error) {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhooks")
+	}
+
+	var r0 []model.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.Webhook, error)); ok {
+		return rf(ctx, userUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Webhook); ok {
+		r0 = rf(ctx, userUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ListWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhooks'
+type WebhookRepository_ListWebhooks_Call struct {
+	*mock.Call
+}
+
+// ListWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+func (_e *WebhookRepository_Expecter) ListWebhooks(ctx interface{}, userUUID interface{}) *WebhookRepository_ListWebhooks_Call {
+	return &WebhookRepository_ListWebhooks_Call{Call: _e.mock.On("ListWebhooks", ctx, userUUID)}
+}
+
+func (_c *WebhookRepository_ListWebhooks_Call) Run(run func(ctx context.Context, userUUID string)) *WebhookRepository_ListWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ListWebhooks_Call) Return(webhooks []model.Webhook, err error) *WebhookRepository_ListWebhooks_Call {
+	_c.Call.Return(webhooks, err)
+	return _c
+}
+
+func (_c *WebhookRepository_ListWebhooks_Call) RunAndReturn(run func(context.Context, string) ([]model.Webhook, error)) *WebhookRepository_ListWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordWebhookFailure provides a mock function with given fields: ctx, webhookUUID, disableAfter
+func (_m *WebhookRepository) RecordWebhookFailure(ctx context.Context, webhookUUID string, disableAfter int) (model.Webhook, error) {
+	ret := _m.Called(ctx, webhookUUID, disableAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordWebhookFailure")
+	}
+
+	var r0 model.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) (model.Webhook, error)); ok {
+		return rf(ctx, webhookUUID, disableAfter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) model.Webhook); ok {
+		r0 = rf(ctx, webhookUUID, disableAfter)
+	} else {
+		r0 = ret.Get(0).(model.Webhook)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, webhookUUID, disableAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_RecordWebhookFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordWebhookFailure'
+type WebhookRepository_RecordWebhookFailure_Call struct {
+	*mock.Call
+}
+
+// RecordWebhookFailure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookUUID string
+//   - disableAfter int
+func (_e *WebhookRepository_Expecter) RecordWebhookFailure(ctx interface{}, webhookUUID interface{}, disableAfter interface{}) *WebhookRepository_RecordWebhookFailure_Call {
+	return &WebhookRepository_RecordWebhookFailure_Call{Call: _e.mock.On("RecordWebhookFailure", ctx, webhookUUID, disableAfter)}
+}
+
+func (_c *WebhookRepository_RecordWebhookFailure_Call) Run(run func(ctx context.Context, webhookUUID string, disableAfter int)) *WebhookRepository_RecordWebhookFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_RecordWebhookFailure_Call) Return(webhook model.Webhook, err error) *WebhookRepository_RecordWebhookFailure_Call {
+	_c.Call.Return(webhook, err)
+	return _c
+}
+
+func (_c *WebhookRepository_RecordWebhookFailure_Call) RunAndReturn(run func(context.Context, string, int) (model.Webhook, error)) *WebhookRepository_RecordWebhookFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetWebhookFailures provides a mock function with given fields: ctx, webhookUUID
+func (_m *WebhookRepository) ResetWebhookFailures(ctx context.Context, webhookUUID string) error {
+	ret := _m.Called(ctx, webhookUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetWebhookFailures")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, webhookUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_ResetWebhookFailures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetWebhookFailures'
+type WebhookRepository_ResetWebhookFailures_Call struct {
+	*mock.Call
+}
+
+// ResetWebhookFailures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookUUID string
+func (_e *WebhookRepository_Expecter) ResetWebhookFailures(ctx interface{}, webhookUUID interface{}) *WebhookRepository_ResetWebhookFailures_Call {
+	return &WebhookRepository_ResetWebhookFailures_Call{Call: _e.mock.On("ResetWebhookFailures", ctx, webhookUUID)}
+}
+
+func (_c *WebhookRepository_ResetWebhookFailures_Call) Run(run func(ctx context.Context, webhookUUID string)) *WebhookRepository_ResetWebhookFailures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ResetWebhookFailures_Call) Return(_a0 error) *WebhookRepository_ResetWebhookFailures_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_ResetWebhookFailures_Call) RunAndReturn(run func(context.Context, string) error) *WebhookRepository_ResetWebhookFailures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhookDelivery provides a mock function with given fields: ctx, delivery
+func (_m *WebhookRepository) UpdateWebhookDelivery(ctx context.Context, delivery model.WebhookDelivery) error {
+	ret := _m.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhookDelivery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.WebhookDelivery) error); ok {
+		r0 = rf(ctx, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_UpdateWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhookDelivery'
+type WebhookRepository_UpdateWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery model.WebhookDelivery
+func (_e *WebhookRepository_Expecter) UpdateWebhookDelivery(ctx interface{}, delivery interface{}) *WebhookRepository_UpdateWebhookDelivery_Call {
+	return &WebhookRepository_UpdateWebhookDelivery_Call{Call: _e.mock.On("UpdateWebhookDelivery", ctx, delivery)}
+}
+
+func (_c *WebhookRepository_UpdateWebhookDelivery_Call) Run(run func(ctx context.Context, delivery model.WebhookDelivery)) *WebhookRepository_UpdateWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.WebhookDelivery))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_UpdateWebhookDelivery_Call) Return(_a0 error) *WebhookRepository_UpdateWebhookDelivery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_UpdateWebhookDelivery_Call) RunAndReturn(run func(context.Context, model.WebhookDelivery) error) *WebhookRepository_UpdateWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookRepository creates a new instance of WebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookRepository {
+	mock := &WebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+type Invoice struct {
+	UUID      string
+	OrderUUID string
+	UserUUID  string
+	Number    string
+	Year      int
+	Sequence  int64
+	Total     int64 // В минимальных единицах валюты
+	Currency  string
+	IssuedAt  time.Time
+	HTML      []byte
+	PDF       []byte
+}
@@ -3,22 +3,47 @@ package model
 import "time"
 
 type OrderData struct {
-	UUID            string         `json:"uuid"`
-	UserUUID        string         `json:"user_uuid"`
-	PartUuids       []string       `json:"part_uuids"`
-	TotalPrice      float64        `json:"total_price"`
-	TransactionUUID *string        `json:"transaction_uuid,omitempty"`
-	PaymentMethod   *PaymentMethod `json:"payment_method,omitempty"`
-	Status          OrderStatus    `json:"status"`
-	CreatedAt       time.Time      `json:"created_at"`
-	UpdatedAt       *time.Time     `json:"updated_at,omitempty"`
+	ID                  int64           `json:"-"`
+	UUID                string          `json:"uuid"`
+	UserUUID            string          `json:"user_uuid"`
+	Items               []OrderItem     `json:"items"`
+	Discounts           []OrderDiscount `json:"discounts,omitempty"`
+	TotalPrice          int64           `json:"total_price"` // В минимальных единицах валюты
+	Currency            string          `json:"currency"`
+	BaseCurrency        string          `json:"base_currency"`
+	ExchangeRate        int64           `json:"exchange_rate"` // Курс base_currency -> currency, умноженный на money.RateScale
+	TransactionUUID     *string         `json:"transaction_uuid,omitempty"`
+	PaymentMethod       *PaymentMethod  `json:"payment_method,omitempty"`
+	Status              OrderStatus     `json:"status"`
+	CancelReason        *string         `json:"cancel_reason,omitempty"`
+	CreatedAt           time.Time       `json:"created_at"`
+	UpdatedAt           *time.Time      `json:"updated_at,omitempty"`
+	StockReservationKey *string         `json:"stock_reservation_key,omitempty"`
+}
+
+type OrderItem struct {
+	PartUUID  string `json:"part_uuid"`
+	Name      string `json:"name"`
+	Category  string `json:"category"`
+	Quantity  int64  `json:"quantity"`
+	UnitPrice int64  `json:"unit_price"` // В минимальных единицах валюты
+	Currency  string `json:"currency"`
+}
+
+type OrderDiscount struct {
+	PromoCode   string `json:"promo_code"`
+	Description string `json:"description"`
+	Amount      int64  `json:"amount"` // В минимальных единицах валюты
+	Currency    string `json:"currency"`
 }
 
 type OrderUpdateInfo struct {
-	TotalPrice      *float64
+	TotalPrice      *int64
+	Currency        *string
 	TransactionUUID *string
 	PaymentMethod   *PaymentMethod
 	Status          *OrderStatus
+	CancelReason    *string
 }
 
 type PaymentMethod string
@@ -36,11 +61,32 @@ type OrderStatus string
 const (
 	OrderStatusPendingPayment OrderStatus = "PENDING_PAYMENT"
 	OrderStatusPaid           OrderStatus = "PAID"
+	OrderStatusAssembling     OrderStatus = "ASSEMBLING"
 	OrderStatusCanceled       OrderStatus = "CANCELED"
 	OrderStatusCompleted      OrderStatus = "COMPLETED"
+	OrderStatusRefunding      OrderStatus = "REFUNDING"
+	OrderStatusRefunded       OrderStatus = "REFUNDED"
 )
 
 type OrderCreationInfo struct {
 	OrderUUID  string
-	TotalPrice float64
+	TotalPrice int64
+	Currency   string
+}
+
+// OrdersCursor - позиция keyset-пагинации: значение поля сортировки и id последней строки страницы
+type OrdersCursor struct {
+	SortBy string `json:"sort_by"`
+	Value  string `json:"value"`
+	ID     int64  `json:"id"`
+}
+
+type OrderStatusHistory struct {
+	OrderUUID  string
+	FromStatus *string
+	ToStatus   string
+	ActorType  string
+	ActorID    string
+	Reason     string
+	CreatedAt  time.Time
 }
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+type PromoCode struct {
+	Code           string
+	Description    string
+	DiscountType   string
+	Percent        int64
+	Amount         int64 // В минимальных единицах валюты
+	Currency       string
+	Category       *string
+	Manufacturer   *string
+	MinOrderAmount *int64 // В минимальных единицах валюты
+	ValidFrom      time.Time
+	ValidTo        *time.Time
+	UsageLimit     *int64
+	UsedCount      int64
+}
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// OrdersReportRow - строка агрегатов отчёта по заказам. Колонки измерений, по
+// которым группировка не запрошена, приходят из запроса как NULL
+type OrdersReportRow struct {
+	PeriodStart       *time.Time
+	Status            *OrderStatus
+	PaymentMethod     *PaymentMethod
+	Currency          string
+	OrdersCount       int64
+	PaidOrdersCount   int64
+	Revenue           int64 // В минимальных единицах валюты
+	AverageOrderValue int64 // В минимальных единицах валюты
+	CancelledCount    int64
+	RefundedCount     int64
+	CancellationRate  float64
+}
@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+type Webhook struct {
+	UUID                string
+	UserUUID            string
+	URL                 string
+	Secret              string
+	EventTypes          []string
+	Enabled             bool
+	ConsecutiveFailures int
+	DisabledAt          *time.Time
+	CreatedAt           time.Time
+}
+
+type WebhookDelivery struct {
+	ID            int64
+	WebhookUUID   string
+	EventUUID     string
+	EventType     string
+	Payload       []byte
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+}
+
+type WebhookDeliveryAttempt struct {
+	DeliveryID  int64
+	Attempt     int
+	StatusCode  *int
+	Error       *string
+	DurationMs  int64
+	AttemptedAt time.Time
+}
@@ -6,40 +6,44 @@ import (
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
 	"github.com/Alexey-step/rocket-factory/order/internal/repository/converter"
 	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
 )
 
-func (r *repository) CreateOrder(ctx context.Context, userUUID string, parts []model.Part) (info model.OrderCreationInfo, err error) {
-	var partUUIDs []string
-	var totPrice float64
-	for _, part := range parts {
-		partUUIDs = append(partUUIDs, part.UUID)
-		totPrice += part.Price
-	}
-
-	order := repoModel.OrderData{
-		UserUUID:   userUUID,
-		PartUuids:  partUUIDs,
-		TotalPrice: totPrice,
-		Status:     repoModel.OrderStatusPendingPayment,
-		CreatedAt:  time.Now(),
-	}
+// CreateOrder сохраняет заказ с позициями и скидками. Стоимость считает сервис, репозиторий её только записывает
+func (r *repository) CreateOrder(ctx context.Context, orderData model.OrderData) (info model.OrderCreationInfo, err error) {
+	order := converter.OrderDataToRepoModel(orderData)
+	order.CreatedAt = time.Now()
 
 	query, args, err := sq.Insert("orders").
 		PlaceholderFormat(sq.Dollar).
-		Columns("user_uuid", "part_uuids", "total_price", "status", "created_at").
-		Values(order.UserUUID, order.PartUuids, order.TotalPrice, order.Status, order.CreatedAt).
-		Suffix("RETURNING uuid, total_price").
+		Columns("uuid", "user_uuid", "total_price", "currency", "base_currency", "exchange_rate", "status", "created_at").
+		Values(order.UUID, order.UserUUID, order.TotalPrice, order.Currency, order.BaseCurrency, order.ExchangeRate, order.Status, order.CreatedAt).
+		Suffix("RETURNING uuid, total_price, currency").
 		ToSql()
 	if err != nil {
 		return model.OrderCreationInfo{}, err
 	}
 
 	var creationInfo repoModel.OrderCreationInfo
-	err = r.db.QueryRow(ctx, query, args...).Scan(&creationInfo.OrderUUID, &creationInfo.TotalPrice)
+	// Заказ, его позиции и скидки записываются в одной транзакции (вложенной, если она уже открыта в контексте)
+	err = pgx.BeginFunc(ctx, txmanager.GetQuerier(ctx, r.db), func(tx pgx.Tx) error {
+		txErr := tx.QueryRow(ctx, query, args...).Scan(&creationInfo.OrderUUID, &creationInfo.TotalPrice, &creationInfo.Currency)
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = insertOrderItems(ctx, tx, creationInfo.OrderUUID, order.Items)
+		if txErr != nil {
+			return txErr
+		}
+
+		return insertOrderDiscounts(ctx, tx, creationInfo.OrderUUID, order.Discounts)
+	})
 	if err != nil {
 		return model.OrderCreationInfo{}, err
 	}
@@ -48,12 +52,55 @@ func (r *repository) CreateOrder(ctx context.Context, userUUID string, parts []m
 💳 [Order Created]
 • 🆔 Order UUID: %s
 • 👤 User UUID: %s
-• 💰 Part UUIDs: %v
-• 💰 Total Price: %f
+• 💰 Items: %v
+• 💰 Discounts: %v
+• 💰 Total Price: %s
 • 💰 Status: %s
 • 💰 CreatedAt: %v
-`, order.UUID, order.UserUUID, order.PartUuids, order.TotalPrice, order.Status, order.CreatedAt,
+`, creationInfo.OrderUUID, order.UserUUID, order.Items, order.Discounts, orderData.TotalPrice, order.Status, order.CreatedAt,
 	)
 
 	return converter.OrderCreateInfoToModel(creationInfo), nil
 }
+
+func insertOrderItems(ctx context.Context, tx pgx.Tx, orderUUID string, items []repoModel.OrderItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	itemsBuilder := sq.Insert("order_items").
+		PlaceholderFormat(sq.Dollar).
+		Columns("order_uuid", "part_uuid", "name", "category", "quantity", "unit_price", "currency")
+	for _, item := range items {
+		itemsBuilder = itemsBuilder.Values(orderUUID, item.PartUUID, item.Name, item.Category, item.Quantity, item.UnitPrice, item.Currency)
+	}
+
+	query, args, err := itemsBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
+
+func insertOrderDiscounts(ctx context.Context, tx pgx.Tx, orderUUID string, discounts []repoModel.OrderDiscount) error {
+	if len(discounts) == 0 {
+		return nil
+	}
+
+	discountsBuilder := sq.Insert("order_discounts").
+		PlaceholderFormat(sq.Dollar).
+		Columns("order_uuid", "promo_code", "description", "amount", "currency")
+	for _, discount := range discounts {
+		discountsBuilder = discountsBuilder.Values(orderUUID, discount.PromoCode, discount.Description, discount.Amount, discount.Currency)
+	}
+
+	query, args, err := discountsBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
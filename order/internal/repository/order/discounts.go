@@ -0,0 +1,62 @@
+package order
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
+)
+
+// getOrderDiscounts возвращает скидки заказов, сгруппированные по UUID заказа
+func (r *repository) getOrderDiscounts(ctx context.Context, orderUUIDs []string) (map[string][]repoModel.OrderDiscount, error) {
+	discounts := make(map[string][]repoModel.OrderDiscount, len(orderUUIDs))
+	if len(orderUUIDs) == 0 {
+		return discounts, nil
+	}
+
+	query, args, err := sq.Select(
+		"order_uuid",
+		"promo_code",
+		"description",
+		"amount",
+		"currency").
+		From("order_discounts").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"order_uuid": orderUUIDs}).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := txmanager.GetQuerier(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			orderUUID string
+			discount  repoModel.OrderDiscount
+		)
+		err = rows.Scan(
+			&orderUUID,
+			&discount.PromoCode,
+			&discount.Description,
+			&discount.Amount,
+			&discount.Currency,
+		)
+		if err != nil {
+			return nil, err
+		}
+		discounts[orderUUID] = append(discounts[orderUUID], discount)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return discounts, nil
+}
@@ -0,0 +1,220 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/converter"
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
+)
+
+// EnsureDraftOrder создаёт черновик пользователя, если его ещё нет, и возвращает UUID активного черновика.
+// Черновик у пользователя один - это гарантирует частичный уникальный индекс orders_user_draft_idx
+func (r *repository) EnsureDraftOrder(ctx context.Context, draft model.OrderData) (string, error) {
+	order := converter.OrderDataToRepoModel(draft)
+
+	insertQuery, insertArgs, err := sq.Insert("orders").
+		PlaceholderFormat(sq.Dollar).
+		Columns("uuid", "user_uuid", "total_price", "currency", "base_currency", "exchange_rate", "status", "created_at").
+		Values(order.UUID, order.UserUUID, order.TotalPrice, order.Currency, order.BaseCurrency, order.ExchangeRate, model.OrderStatusDraft, time.Now()).
+		Suffix("ON CONFLICT (user_uuid) WHERE status = 'DRAFT' DO NOTHING").
+		ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	db := txmanager.GetQuerier(ctx, r.db)
+	_, err = db.Exec(ctx, insertQuery, insertArgs...)
+	if err != nil {
+		return "", err
+	}
+
+	return r.getDraftOrderUUID(ctx, db, draft.UserUUID)
+}
+
+// GetDraftOrder возвращает активный черновик пользователя вместе с позициями. Строка черновика
+// блокируется до конца транзакции, чтобы параллельные изменения корзины выполнялись по очереди
+func (r *repository) GetDraftOrder(ctx context.Context, userUUID string) (model.OrderData, error) {
+	query, args, err := sq.Select(
+		"uuid",
+		"user_uuid",
+		"total_price",
+		"currency",
+		"base_currency",
+		"exchange_rate",
+		"status",
+		"created_at",
+		"updated_at").
+		From("orders").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"user_uuid": userUUID, "status": model.OrderStatusDraft}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return model.OrderData{}, err
+	}
+
+	var outOrder repoModel.OrderData
+	err = txmanager.GetQuerier(ctx, r.db).QueryRow(ctx, query, args...).Scan(
+		&outOrder.UUID,
+		&outOrder.UserUUID,
+		&outOrder.TotalPrice,
+		&outOrder.Currency,
+		&outOrder.BaseCurrency,
+		&outOrder.ExchangeRate,
+		&outOrder.Status,
+		&outOrder.CreatedAt,
+		&outOrder.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.OrderData{}, model.ErrDraftNotFound
+		}
+		return model.OrderData{}, err
+	}
+
+	items, err := r.getOrderItems(ctx, []string{outOrder.UUID})
+	if err != nil {
+		return model.OrderData{}, err
+	}
+	outOrder.Items = items[outOrder.UUID]
+
+	return converter.OrderDataToModel(outOrder), nil
+}
+
+// SetDraftItem добавляет позицию в черновик или заменяет существующую позицию с той же деталью
+func (r *repository) SetDraftItem(ctx context.Context, orderUUID string, item model.OrderItem) error {
+	repoItem := converter.OrderItemsToRepoModel([]model.OrderItem{item})[0]
+
+	query, args, err := sq.Insert("order_items").
+		PlaceholderFormat(sq.Dollar).
+		Columns("order_uuid", "part_uuid", "name", "category", "quantity", "unit_price", "currency").
+		Values(orderUUID, repoItem.PartUUID, repoItem.Name, repoItem.Category, repoItem.Quantity, repoItem.UnitPrice, repoItem.Currency).
+		Suffix(`ON CONFLICT (order_uuid, part_uuid) DO UPDATE SET
+			name = excluded.name,
+			category = excluded.category,
+			quantity = excluded.quantity,
+			unit_price = excluded.unit_price,
+			currency = excluded.currency`).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = txmanager.GetQuerier(ctx, r.db).Exec(ctx, query, args...)
+	return err
+}
+
+// RemoveDraftItem удаляет позицию из черновика
+func (r *repository) RemoveDraftItem(ctx context.Context, orderUUID, partUUID string) error {
+	query, args, err := sq.Delete("order_items").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"order_uuid": orderUUID, "part_uuid": partUUID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := txmanager.GetQuerier(ctx, r.db).Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrDraftItemNotFound
+	}
+
+	return nil
+}
+
+// CheckoutDraftOrder переводит черновик в PENDING_PAYMENT, заменяя позиции и скидки пересчитанными.
+// Дата создания сдвигается на момент оформления: от неё отсчитывается срок оплаты заказа.
+// Если черновик уже оформлен или отменён, возвращает *model.InvalidStatusTransitionError
+func (r *repository) CheckoutDraftOrder(ctx context.Context, orderData model.OrderData) error {
+	order := converter.OrderDataToRepoModel(orderData)
+	now := time.Now()
+
+	updateQuery, updateArgs, err := sq.Update("orders").
+		PlaceholderFormat(sq.Dollar).
+		Set("total_price", order.TotalPrice).
+		Set("currency", order.Currency).
+		Set("base_currency", order.BaseCurrency).
+		Set("exchange_rate", order.ExchangeRate).
+		Set("status", model.OrderStatusPendingPayment).
+		Set("stock_reservation_key", order.StockReservationKey).
+		Set("created_at", now).
+		Set("updated_at", now).
+		Where(sq.Eq{"uuid": order.UUID, "status": model.OrderStatusDraft}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	deleteQuery, deleteArgs, err := sq.Delete("order_items").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"order_uuid": order.UUID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	db := txmanager.GetQuerier(ctx, r.db)
+	return pgx.BeginFunc(ctx, db, func(tx pgx.Tx) error {
+		tag, txErr := tx.Exec(ctx, updateQuery, updateArgs...)
+		if txErr != nil {
+			return txErr
+		}
+
+		if tag.RowsAffected() == 0 {
+			currentStatus, statusErr := r.getOrderStatus(ctx, tx, order.UUID)
+			if statusErr != nil {
+				return statusErr
+			}
+
+			return &model.InvalidStatusTransitionError{
+				From: currentStatus,
+				To:   model.OrderStatusPendingPayment,
+			}
+		}
+
+		_, txErr = tx.Exec(ctx, deleteQuery, deleteArgs...)
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = insertOrderItems(ctx, tx, order.UUID, order.Items)
+		if txErr != nil {
+			return txErr
+		}
+
+		return insertOrderDiscounts(ctx, tx, order.UUID, order.Discounts)
+	})
+}
+
+func (r *repository) getDraftOrderUUID(ctx context.Context, db txmanager.Querier, userUUID string) (string, error) {
+	query, args, err := sq.Select("uuid").
+		From("orders").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"user_uuid": userUUID, "status": model.OrderStatusDraft}).
+		ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	var orderUUID string
+	err = db.QueryRow(ctx, query, args...).Scan(&orderUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", model.ErrDraftNotFound
+		}
+		return "", err
+	}
+
+	return orderUUID, nil
+}
@@ -0,0 +1,72 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/converter"
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
+)
+
+// LockExpiredOrders выбирает и блокирует до limit неоплаченных заказов, созданных раньше createdBefore.
+// Должен вызываться внутри транзакции: строки, заблокированные другой репликой, пропускаются
+// (FOR UPDATE SKIP LOCKED), поэтому несколько реплик разбирают разные заказы.
+// Позиции заказов не загружаются.
+func (r *repository) LockExpiredOrders(ctx context.Context, createdBefore time.Time, limit int) ([]model.OrderData, error) {
+	query, args, err := sq.Select(
+		"uuid",
+		"user_uuid",
+		"total_price",
+		"currency",
+		"base_currency",
+		"exchange_rate",
+		"status",
+		"created_at",
+		"stock_reservation_key").
+		From("orders").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"status": model.OrderStatusPendingPayment}).
+		Where(sq.Lt{"created_at": createdBefore}).
+		OrderBy("created_at", "id").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := txmanager.GetQuerier(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	orders := make([]model.OrderData, 0, limit)
+	for rows.Next() {
+		var outOrder repoModel.OrderData
+		err = rows.Scan(
+			&outOrder.UUID,
+			&outOrder.UserUUID,
+			&outOrder.TotalPrice,
+			&outOrder.Currency,
+			&outOrder.BaseCurrency,
+			&outOrder.ExchangeRate,
+			&outOrder.Status,
+			&outOrder.CreatedAt,
+			&outOrder.StockReservationKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, converter.OrderDataToModel(outOrder))
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
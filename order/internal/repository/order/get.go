@@ -14,13 +14,17 @@ func (r *repository) GetOrder(ctx context.Context, orderUUID string) (model.Orde
 	query, args, err := sq.Select(
 		"uuid",
 		"user_uuid",
-		"part_uuids",
 		"total_price",
+		"currency",
+		"base_currency",
+		"exchange_rate",
 		"transaction_uuid",
 		"payment_method",
 		"status",
+		"cancel_reason",
 		"created_at",
-		"updated_at").
+		"updated_at",
+		"stock_reservation_key").
 		From("orders").
 		PlaceholderFormat(sq.Dollar).
 		Where(sq.Eq{"uuid": orderUUID}).
@@ -33,17 +37,33 @@ func (r *repository) GetOrder(ctx context.Context, orderUUID string) (model.Orde
 	err = r.db.QueryRow(ctx, query, args...).Scan(
 		&outOrder.UUID,
 		&outOrder.UserUUID,
-		&outOrder.PartUuids,
 		&outOrder.TotalPrice,
+		&outOrder.Currency,
+		&outOrder.BaseCurrency,
+		&outOrder.ExchangeRate,
 		&outOrder.TransactionUUID,
 		&outOrder.PaymentMethod,
 		&outOrder.Status,
+		&outOrder.CancelReason,
 		&outOrder.CreatedAt,
 		&outOrder.UpdatedAt,
+		&outOrder.StockReservationKey,
 	)
 	if err != nil {
 		return model.OrderData{}, err
 	}
 
+	items, err := r.getOrderItems(ctx, []string{outOrder.UUID})
+	if err != nil {
+		return model.OrderData{}, err
+	}
+	outOrder.Items = items[outOrder.UUID]
+
+	discounts, err := r.getOrderDiscounts(ctx, []string{outOrder.UUID})
+	if err != nil {
+		return model.OrderData{}, err
+	}
+	outOrder.Discounts = discounts[outOrder.UUID]
+
 	return converter.OrderDataToModel(outOrder), nil
 }
@@ -0,0 +1,71 @@
+package order
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/converter"
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
+)
+
+// AddStatusHistory пишет запись в историю статусов в транзакции из контекста, если она открыта
+func (r *repository) AddStatusHistory(ctx context.Context, record model.OrderStatusHistory) error {
+	history := converter.OrderStatusHistoryToRepoModel(record)
+
+	query, args, err := sq.Insert("order_status_history").
+		PlaceholderFormat(sq.Dollar).
+		Columns("order_uuid", "from_status", "to_status", "actor_type", "actor_id", "reason").
+		Values(history.OrderUUID, history.FromStatus, history.ToStatus, history.ActorType, history.ActorID, history.Reason).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = txmanager.GetQuerier(ctx, r.db).Exec(ctx, query, args...)
+	return err
+}
+
+func (r *repository) GetStatusHistory(ctx context.Context, orderUUID string) ([]model.OrderStatusHistory, error) {
+	query, args, err := sq.Select("order_uuid", "from_status", "to_status", "actor_type", "actor_id", "reason", "created_at").
+		From("order_status_history").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"order_uuid": orderUUID}).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var history []repoModel.OrderStatusHistory
+	for rows.Next() {
+		var record repoModel.OrderStatusHistory
+		err = rows.Scan(
+			&record.OrderUUID,
+			&record.FromStatus,
+			&record.ToStatus,
+			&record.ActorType,
+			&record.ActorID,
+			&record.Reason,
+			&record.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, record)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return converter.OrderStatusHistoryToModel(history), nil
+}
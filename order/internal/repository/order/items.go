@@ -0,0 +1,66 @@
+package order
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
+)
+
+// getOrderItems возвращает позиции заказов, сгруппированные по UUID заказа
+func (r *repository) getOrderItems(ctx context.Context, orderUUIDs []string) (map[string][]repoModel.OrderItem, error) {
+	items := make(map[string][]repoModel.OrderItem, len(orderUUIDs))
+	if len(orderUUIDs) == 0 {
+		return items, nil
+	}
+
+	query, args, err := sq.Select(
+		"order_uuid",
+		"part_uuid",
+		"name",
+		"category",
+		"quantity",
+		"unit_price",
+		"currency").
+		From("order_items").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"order_uuid": orderUUIDs}).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := txmanager.GetQuerier(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			orderUUID string
+			item      repoModel.OrderItem
+		)
+		err = rows.Scan(
+			&orderUUID,
+			&item.PartUUID,
+			&item.Name,
+			&item.Category,
+			&item.Quantity,
+			&item.UnitPrice,
+			&item.Currency,
+		)
+		if err != nil {
+			return nil, err
+		}
+		items[orderUUID] = append(items[orderUUID], item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,187 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/converter"
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+)
+
+// ordersSortColumns - соответствие полей сортировки колонкам таблицы orders
+var ordersSortColumns = map[model.OrdersSortBy]string{
+	model.OrdersSortByCreatedAt:  "created_at",
+	model.OrdersSortByTotalPrice: "total_price",
+}
+
+func (r *repository) ListOrders(ctx context.Context, filter model.OrdersFilter) (model.OrdersPage, error) {
+	query, args, err := listOrdersQuery(filter)
+	if err != nil {
+		return model.OrdersPage{}, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return model.OrdersPage{}, err
+	}
+	defer rows.Close()
+
+	orders := make([]repoModel.OrderData, 0, filter.Limit+1)
+	for rows.Next() {
+		var outOrder repoModel.OrderData
+		err = rows.Scan(
+			&outOrder.ID,
+			&outOrder.UUID,
+			&outOrder.UserUUID,
+			&outOrder.TotalPrice,
+			&outOrder.Currency,
+			&outOrder.BaseCurrency,
+			&outOrder.ExchangeRate,
+			&outOrder.TransactionUUID,
+			&outOrder.PaymentMethod,
+			&outOrder.Status,
+			&outOrder.CancelReason,
+			&outOrder.CreatedAt,
+			&outOrder.UpdatedAt,
+		)
+		if err != nil {
+			return model.OrdersPage{}, err
+		}
+		orders = append(orders, outOrder)
+	}
+	if err = rows.Err(); err != nil {
+		return model.OrdersPage{}, err
+	}
+
+	var page model.OrdersPage
+	if len(orders) > filter.Limit {
+		orders = orders[:filter.Limit]
+
+		last := orders[len(orders)-1]
+		nextCursor, err := converter.OrdersCursorToString(repoModel.OrdersCursor{
+			SortBy: string(filter.SortBy),
+			Value:  formatCursorValue(filter.SortBy, last),
+			ID:     last.ID,
+		})
+		if err != nil {
+			return model.OrdersPage{}, err
+		}
+		page.NextCursor = &nextCursor
+	}
+
+	orderUUIDs := make([]string, 0, len(orders))
+	for _, order := range orders {
+		orderUUIDs = append(orderUUIDs, order.UUID)
+	}
+
+	items, err := r.getOrderItems(ctx, orderUUIDs)
+	if err != nil {
+		return model.OrdersPage{}, err
+	}
+
+	discounts, err := r.getOrderDiscounts(ctx, orderUUIDs)
+	if err != nil {
+		return model.OrdersPage{}, err
+	}
+
+	page.Orders = make([]model.OrderData, 0, len(orders))
+	for _, order := range orders {
+		order.Items = items[order.UUID]
+		order.Discounts = discounts[order.UUID]
+		page.Orders = append(page.Orders, converter.OrderDataToModel(order))
+	}
+
+	return page, nil
+}
+
+// listOrdersQuery собирает запрос страницы заказов пользователя
+func listOrdersQuery(filter model.OrdersFilter) (string, []any, error) {
+	sortColumn, ok := ordersSortColumns[filter.SortBy]
+	if !ok || filter.Limit <= 0 {
+		return "", nil, model.ErrOrdersInvalidFilter
+	}
+
+	direction, comparison := "DESC", "<"
+	if filter.SortOrder == model.SortOrderAsc {
+		direction, comparison = "ASC", ">"
+	}
+
+	builder := sq.Select(
+		"id",
+		"uuid",
+		"user_uuid",
+		"total_price",
+		"currency",
+		"base_currency",
+		"exchange_rate",
+		"transaction_uuid",
+		"payment_method",
+		"status",
+		"cancel_reason",
+		"created_at",
+		"updated_at").
+		From("orders").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"user_uuid": filter.UserUUID})
+
+	// Черновики - ещё не оформленные заказы: в списке они только по явному запросу
+	if len(filter.Statuses) > 0 {
+		builder = builder.Where(sq.Eq{"status": filter.Statuses})
+	} else {
+		builder = builder.Where(sq.NotEq{"status": model.OrderStatusDraft})
+	}
+	if len(filter.PaymentMethods) > 0 {
+		builder = builder.Where(sq.Eq{"payment_method": filter.PaymentMethods})
+	}
+	if filter.CreatedFrom != nil {
+		builder = builder.Where(sq.GtOrEq{"created_at": *filter.CreatedFrom})
+	}
+	if filter.CreatedTo != nil {
+		builder = builder.Where(sq.Lt{"created_at": *filter.CreatedTo})
+	}
+
+	if filter.Cursor != nil {
+		cursor, err := converter.StringToOrdersCursor(*filter.Cursor)
+		if err != nil {
+			return "", nil, err
+		}
+		if cursor.SortBy != string(filter.SortBy) {
+			return "", nil, model.ErrOrdersInvalidCursor
+		}
+
+		value, err := parseCursorValue(filter.SortBy, cursor.Value)
+		if err != nil {
+			return "", nil, model.ErrOrdersInvalidCursor
+		}
+
+		// Строки, идущие строго после последней строки предыдущей страницы
+		builder = builder.Where(sq.Expr(fmt.Sprintf("(%s, id) %s (?, ?)", sortColumn, comparison), value, cursor.ID))
+	}
+
+	// Запрашиваем на одну строку больше, чтобы понять, есть ли следующая страница
+	return builder.
+		OrderBy(sortColumn+" "+direction, "id "+direction).
+		Limit(uint64(filter.Limit) + 1).
+		ToSql()
+}
+
+func formatCursorValue(sortBy model.OrdersSortBy, order repoModel.OrderData) string {
+	if sortBy == model.OrdersSortByTotalPrice {
+		return strconv.FormatInt(order.TotalPrice, 10)
+	}
+
+	return order.CreatedAt.UTC().Format(time.RFC3339Nano)
+}
+
+func parseCursorValue(sortBy model.OrdersSortBy, value string) (any, error) {
+	if sortBy == model.OrdersSortByTotalPrice {
+		return strconv.ParseInt(value, 10, 64)
+	}
+
+	return time.Parse(time.RFC3339Nano, value)
+}
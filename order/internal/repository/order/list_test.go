@@ -0,0 +1,87 @@
+package order
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+)
+
+func TestListOrdersQueryExcludesDrafts(t *testing.T) {
+	userUUID := gofakeit.UUID()
+
+	query, args, err := listOrdersQuery(model.OrdersFilter{
+		UserUUID: userUUID,
+		SortBy:   model.OrdersSortByCreatedAt,
+		Limit:    20,
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, query, "status <> $2")
+	assert.Equal(t, []any{userUUID, model.OrderStatusDraft}, args)
+}
+
+func TestListOrdersQueryDraftsOnRequest(t *testing.T) {
+	userUUID := gofakeit.UUID()
+
+	query, args, err := listOrdersQuery(model.OrdersFilter{
+		UserUUID: userUUID,
+		Statuses: []model.OrderStatus{model.OrderStatusDraft, model.OrderStatusPaid},
+		SortBy:   model.OrdersSortByCreatedAt,
+		Limit:    20,
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, query, "status IN ($2,$3)")
+	assert.NotContains(t, query, "<>")
+	assert.Equal(t, []any{userUUID, model.OrderStatusDraft, model.OrderStatusPaid}, args)
+}
+
+func TestListOrdersQueryStatusesWithoutDraft(t *testing.T) {
+	query, args, err := listOrdersQuery(model.OrdersFilter{
+		UserUUID: gofakeit.UUID(),
+		Statuses: []model.OrderStatus{model.OrderStatusPaid},
+		SortBy:   model.OrdersSortByCreatedAt,
+		Limit:    20,
+	})
+	require.NoError(t, err)
+
+	assert.NotContains(t, query, "<>")
+	assert.NotContains(t, args, model.OrderStatusDraft)
+}
+
+func TestListOrdersQueryCreatedAtInAnyTimeZone(t *testing.T) {
+	zone := time.FixedZone("UTC+3", 3*60*60)
+	createdFrom := time.Date(2025, 10, 1, 0, 30, 0, 0, zone)
+	createdTo := createdFrom.Add(24 * time.Hour)
+
+	_, args, err := listOrdersQuery(model.OrdersFilter{
+		UserUUID:    gofakeit.UUID(),
+		CreatedFrom: &createdFrom,
+		CreatedTo:   &createdTo,
+		SortBy:      model.OrdersSortByCreatedAt,
+		Limit:       20,
+	})
+	require.NoError(t, err)
+
+	// created_at - timestamptz, поэтому границы передаются как есть, без приведения пояса
+	assert.Contains(t, args, createdFrom)
+	assert.Contains(t, args, createdTo)
+}
+
+func TestOrdersCursorCreatedAtRoundTrip(t *testing.T) {
+	createdAt := time.Date(2025, 10, 1, 0, 30, 0, 123_456_000, time.FixedZone("UTC+3", 3*60*60))
+
+	value := formatCursorValue(model.OrdersSortByCreatedAt, repoModel.OrderData{CreatedAt: createdAt})
+	parsed, err := parseCursorValue(model.OrdersSortByCreatedAt, value)
+	require.NoError(t, err)
+
+	parsedTime, ok := parsed.(time.Time)
+	require.True(t, ok)
+	assert.True(t, createdAt.Equal(parsedTime))
+}
@@ -2,15 +2,20 @@ package order
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/samber/lo"
 
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
 )
 
-func (r *repository) UpdateOrder(ctx context.Context, orderUUID string, orderUpdateInfo model.OrderUpdateInfo) error {
+// UpdateOrder обновляет заказ, только если его статус всё ещё равен expectedStatus.
+// Если статус успел измениться, возвращает *model.InvalidStatusTransitionError с текущим статусом.
+func (r *repository) UpdateOrder(ctx context.Context, orderUUID string, expectedStatus model.OrderStatus, orderUpdateInfo model.OrderUpdateInfo) error {
 	updateBuilder := sq.Update("orders").
 		PlaceholderFormat(sq.Dollar).
 		Set("updated_at", time.Now())
@@ -25,25 +30,70 @@ func (r *repository) UpdateOrder(ctx context.Context, orderUUID string, orderUpd
 	}
 
 	if orderUpdateInfo.TotalPrice != nil {
-		updateBuilder = updateBuilder.Set("total_price", *orderUpdateInfo.TotalPrice)
+		updateBuilder = updateBuilder.
+			Set("total_price", orderUpdateInfo.TotalPrice.Amount).
+			Set("currency", orderUpdateInfo.TotalPrice.Currency)
 	}
 
 	if orderUpdateInfo.TransactionUUID != nil {
 		updateBuilder = updateBuilder.Set("transaction_uuid", orderUpdateInfo.TransactionUUID)
 	}
 
-	updateBuilder.Where(sq.Eq{"uuid": orderUUID})
+	if orderUpdateInfo.CancelReason != nil {
+		updateBuilder = updateBuilder.Set("cancel_reason", string(*orderUpdateInfo.CancelReason))
+	}
+
+	updateBuilder = updateBuilder.Where(sq.Eq{
+		"uuid":   orderUUID,
+		"status": expectedStatus,
+	})
 
 	query, args, err := updateBuilder.ToSql()
 	if err != nil {
 		return err
 	}
 
-	// Выполняем запрос через пул соединений
-	_, execErr := r.db.Exec(ctx, query, args...)
+	// Выполняем запрос в транзакции из контекста, если она открыта
+	db := txmanager.GetQuerier(ctx, r.db)
+	tag, execErr := db.Exec(ctx, query, args...)
 	if execErr != nil {
 		return execErr
 	}
 
-	return nil
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+
+	// Ничего не обновили: заказа нет или его статус уже другой
+	currentStatus, err := r.getOrderStatus(ctx, db, orderUUID)
+	if err != nil {
+		return err
+	}
+
+	return &model.InvalidStatusTransitionError{
+		From: currentStatus,
+		To:   lo.FromPtrOr(orderUpdateInfo.Status, expectedStatus),
+	}
+}
+
+func (r *repository) getOrderStatus(ctx context.Context, db txmanager.Querier, orderUUID string) (model.OrderStatus, error) {
+	query, args, err := sq.Select("status").
+		From("orders").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"uuid": orderUUID}).
+		ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	var status string
+	err = db.QueryRow(ctx, query, args...).Scan(&status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", model.ErrOrderNotFound
+		}
+		return "", err
+	}
+
+	return model.OrderStatus(status), nil
 }
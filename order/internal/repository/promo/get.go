@@ -0,0 +1,62 @@
+package promo
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/converter"
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+)
+
+func (r *repository) GetPromoCode(ctx context.Context, code string) (model.PromoCode, error) {
+	query, args, err := sq.Select(
+		"code",
+		"description",
+		"discount_type",
+		"percent",
+		"amount",
+		"currency",
+		"category",
+		"manufacturer",
+		"min_order_amount",
+		"valid_from",
+		"valid_to",
+		"usage_limit",
+		"used_count").
+		From("promo_codes").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"code": code}).
+		ToSql()
+	if err != nil {
+		return model.PromoCode{}, err
+	}
+
+	var promo repoModel.PromoCode
+	err = r.db.QueryRow(ctx, query, args...).Scan(
+		&promo.Code,
+		&promo.Description,
+		&promo.DiscountType,
+		&promo.Percent,
+		&promo.Amount,
+		&promo.Currency,
+		&promo.Category,
+		&promo.Manufacturer,
+		&promo.MinOrderAmount,
+		&promo.ValidFrom,
+		&promo.ValidTo,
+		&promo.UsageLimit,
+		&promo.UsedCount,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.PromoCode{}, model.ErrPromoCodeNotFound
+		}
+		return model.PromoCode{}, err
+	}
+
+	return converter.PromoCodeToModel(promo), nil
+}
@@ -0,0 +1,55 @@
+package promo
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
+)
+
+// RedeemPromoCode засчитывает использование промокода. Лимит проверяется тем же запросом,
+// поэтому параллельные заказы не превысят его; исчерпанный промокод - model.ErrPromoCodeExhausted
+func (r *repository) RedeemPromoCode(ctx context.Context, code string) error {
+	query, args, err := sq.Update("promo_codes").
+		PlaceholderFormat(sq.Dollar).
+		Set("used_count", sq.Expr("used_count + 1")).
+		Where(sq.Eq{"code": code}).
+		Where(sq.Or{
+			sq.Eq{"usage_limit": nil},
+			sq.Expr("used_count < usage_limit"),
+		}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := txmanager.GetQuerier(ctx, r.db).Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrPromoCodeExhausted
+	}
+
+	return nil
+}
+
+// ReturnPromoCodeUses возвращает использования промокодов, засчитанные отменённому заказу.
+// Вызывается в транзакции отмены, поэтому использование не вернётся дважды
+func (r *repository) ReturnPromoCodeUses(ctx context.Context, orderUUID string) error {
+	query, args, err := sq.Update("promo_codes").
+		PlaceholderFormat(sq.Dollar).
+		Set("used_count", sq.Expr("used_count - 1")).
+		Where(sq.Expr("code IN (SELECT promo_code FROM order_discounts WHERE order_uuid = ?)", orderUUID)).
+		Where(sq.Gt{"used_count": 0}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = txmanager.GetQuerier(ctx, r.db).Exec(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,19 @@
+package promo
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/Alexey-step/rocket-factory/order/internal/repository"
+)
+
+var _ def.PromoCodeRepository = (*repository)(nil)
+
+type repository struct {
+	db *pgxpool.Pool
+}
+
+func NewPromoCodeRepository(db *pgxpool.Pool) *repository {
+	return &repository{
+		db: db,
+	}
+}
@@ -0,0 +1,118 @@
+package report
+
+import (
+	"context"
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/converter"
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+)
+
+// reportPeriodUnits - соответствие группировок по периоду единицам date_trunc
+var reportPeriodUnits = map[model.ReportGroupBy]string{
+	model.ReportGroupByDay:   "day",
+	model.ReportGroupByWeek:  "week",
+	model.ReportGroupByMonth: "month",
+}
+
+// Оплаченные заказы, которые не были отменены: по ним считаются выручка и средний чек
+const paidFilter = "filter (where status in ('PAID', 'ASSEMBLING', 'COMPLETED'))"
+
+// GetOrdersReport считает агрегаты одним запросом в Postgres. Запрос выполняется
+// в read-only транзакции с ограничением по времени: отчёт за большой период не
+// должен держать соединение и мешать записи в orders
+func (r *repository) GetOrdersReport(ctx context.Context, filter model.OrdersReportFilter) ([]model.OrdersReportRow, error) {
+	periodColumn, statusColumn, paymentMethodColumn := "null::timestamptz", "null::text", "null::text"
+	groupBy := make([]string, 0, len(filter.GroupBy)+1)
+	for _, group := range filter.GroupBy {
+		switch group {
+		case model.ReportGroupByDay, model.ReportGroupByWeek, model.ReportGroupByMonth:
+			// Периоды считаются в UTC, а не в поясе сессии Postgres
+			periodColumn = "date_trunc('" + reportPeriodUnits[group] + "', created_at, 'UTC')"
+			groupBy = append(groupBy, periodColumn)
+		case model.ReportGroupByStatus:
+			statusColumn = "status"
+			groupBy = append(groupBy, statusColumn)
+		case model.ReportGroupByPaymentMethod:
+			paymentMethodColumn = "payment_method"
+			groupBy = append(groupBy, paymentMethodColumn)
+		default:
+			return nil, model.ErrReportInvalid
+		}
+	}
+	// Суммы в разных валютах не складываются, поэтому валюта - всегда часть группы
+	groupBy = append(groupBy, "currency")
+
+	query, args, err := sq.Select(
+		periodColumn+" as period_start",
+		statusColumn+" as status",
+		paymentMethodColumn+" as payment_method",
+		"currency",
+		"count(*) as orders_count",
+		"count(*) "+paidFilter+" as paid_orders_count",
+		"coalesce(sum(total_price) "+paidFilter+", 0)::bigint as revenue",
+		"coalesce(round(avg(total_price) "+paidFilter+"), 0)::bigint as average_order_value",
+		"count(*) filter (where status = 'CANCELED') as cancelled_count",
+		"count(*) filter (where status in ('REFUNDING', 'REFUNDED')) as refunded_count",
+		"round(count(*) filter (where status = 'CANCELED')::numeric / count(*), 4)::float8 as cancellation_rate",
+	).
+		From("orders").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.NotEq{"status": "DRAFT"}).
+		Where(sq.GtOrEq{"created_at": filter.From}).
+		Where(sq.Lt{"created_at": filter.To}).
+		GroupBy(groupBy...).
+		OrderBy(groupBy...).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []model.OrdersReportRow
+	err = pgx.BeginTxFunc(ctx, r.db, pgx.TxOptions{AccessMode: pgx.ReadOnly}, func(tx pgx.Tx) error {
+		// Третий аргумент true ограничивает настройку текущей транзакцией
+		_, err := tx.Exec(ctx, "select set_config('statement_timeout', $1, true)",
+			strconv.FormatInt(r.statementTimeout.Milliseconds(), 10))
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.Query(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		defer result.Close()
+
+		for result.Next() {
+			var row repoModel.OrdersReportRow
+			err = result.Scan(
+				&row.PeriodStart,
+				&row.Status,
+				&row.PaymentMethod,
+				&row.Currency,
+				&row.OrdersCount,
+				&row.PaidOrdersCount,
+				&row.Revenue,
+				&row.AverageOrderValue,
+				&row.CancelledCount,
+				&row.RefundedCount,
+				&row.CancellationRate,
+			)
+			if err != nil {
+				return err
+			}
+			rows = append(rows, converter.OrdersReportRowToModel(row))
+		}
+
+		return result.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,23 @@
+package report
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/Alexey-step/rocket-factory/order/internal/repository"
+)
+
+var _ def.ReportRepository = (*repository)(nil)
+
+type repository struct {
+	db               *pgxpool.Pool
+	statementTimeout time.Duration
+}
+
+func NewReportRepository(db *pgxpool.Pool, statementTimeout time.Duration) *repository {
+	return &repository{
+		db:               db,
+		statementTimeout: statementTimeout,
+	}
+}
@@ -2,12 +2,61 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
 )
 
 type OrderRepository interface {
-	CreateOrder(ctx context.Context, userUUID string, parts []model.Part) (info model.OrderCreationInfo, err error)
+	CreateOrder(ctx context.Context, order model.OrderData) (info model.OrderCreationInfo, err error)
 	GetOrder(ctx context.Context, orderUUID string) (order model.OrderData, err error)
-	UpdateOrder(ctx context.Context, orderUUID string, orderUpdateInfo model.OrderUpdateInfo) error
+	ListOrders(ctx context.Context, filter model.OrdersFilter) (page model.OrdersPage, err error)
+	UpdateOrder(ctx context.Context, orderUUID string, expectedStatus model.OrderStatus, orderUpdateInfo model.OrderUpdateInfo) error
+	AddStatusHistory(ctx context.Context, record model.OrderStatusHistory) error
+	GetStatusHistory(ctx context.Context, orderUUID string) (history []model.OrderStatusHistory, err error)
+	LockExpiredOrders(ctx context.Context, createdBefore time.Time, limit int) (orders []model.OrderData, err error)
+	EnsureDraftOrder(ctx context.Context, draft model.OrderData) (orderUUID string, err error)
+	GetDraftOrder(ctx context.Context, userUUID string) (order model.OrderData, err error)
+	SetDraftItem(ctx context.Context, orderUUID string, item model.OrderItem) error
+	RemoveDraftItem(ctx context.Context, orderUUID, partUUID string) error
+	CheckoutDraftOrder(ctx context.Context, order model.OrderData) error
+}
+
+type PromoCodeRepository interface {
+	GetPromoCode(ctx context.Context, code string) (promo model.PromoCode, err error)
+	RedeemPromoCode(ctx context.Context, code string) error
+	ReturnPromoCodeUses(ctx context.Context, orderUUID string) error
+}
+
+type IdempotencyRepository interface {
+	AcquireIdempotencyKey(ctx context.Context, key model.IdempotencyKey, lockTTL time.Duration) (acquired bool, err error)
+	GetIdempotencyKey(ctx context.Context, key model.IdempotencyKey) (record model.IdempotencyRecord, err error)
+	SaveIdempotencyResponse(ctx context.Context, key model.IdempotencyKey, response []byte) error
+	DeleteIdempotencyKey(ctx context.Context, key model.IdempotencyKey) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (deleted int64, err error)
+}
+
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook model.Webhook) (created model.Webhook, err error)
+	GetWebhook(ctx context.Context, webhookUUID string) (webhook model.Webhook, err error)
+	ListWebhooks(ctx context.Context, userUUID string) (webhooks []model.Webhook, err error)
+	DeleteWebhook(ctx context.Context, webhookUUID string) error
+	EnableWebhook(ctx context.Context, webhookUUID string) (webhook model.Webhook, err error)
+	RecordWebhookFailure(ctx context.Context, webhookUUID string, disableAfter int) (webhook model.Webhook, err error)
+	ResetWebhookFailures(ctx context.Context, webhookUUID string) error
+	EnqueueWebhookDeliveries(ctx context.Context, userUUID string, delivery model.WebhookDelivery) (enqueued int, err error)
+	ClaimPendingWebhookDeliveries(ctx context.Context, limit int, leaseUntil time.Time) (deliveries []model.PendingWebhookDelivery, err error)
+	UpdateWebhookDelivery(ctx context.Context, delivery model.WebhookDelivery) error
+	AddWebhookDeliveryAttempt(ctx context.Context, deliveryID int64, attempt model.WebhookDeliveryAttempt) error
+	ListWebhookDeliveries(ctx context.Context, webhookUUID string, limit int) (deliveries []model.WebhookDelivery, err error)
+}
+
+type ReportRepository interface {
+	GetOrdersReport(ctx context.Context, filter model.OrdersReportFilter) (rows []model.OrdersReportRow, err error)
+}
+
+type InvoiceRepository interface {
+	GetInvoiceByOrder(ctx context.Context, orderUUID string) (invoice model.Invoice, err error)
+	NextInvoiceSequence(ctx context.Context, year int) (sequence int64, err error)
+	CreateInvoice(ctx context.Context, invoice model.Invoice) (created model.Invoice, err error)
 }
@@ -0,0 +1,261 @@
+package webhook
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/converter"
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
+)
+
+// EnqueueWebhookDeliveries создаёт доставку события на каждый вебхук пользователя, подписанный
+// на его тип, в транзакции из контекста. Отключённые вебхуки тоже получают доставку:
+// она уйдёт, когда вебхук включат снова
+func (r *repository) EnqueueWebhookDeliveries(ctx context.Context, userUUID string, delivery model.WebhookDelivery) (int, error) {
+	subscribers := sq.Select("uuid").
+		Column("?::uuid", delivery.EventUUID).
+		Column("?::text", string(delivery.EventType)).
+		Column("?::jsonb", string(delivery.Payload)).
+		From("webhooks").
+		Where(sq.Eq{"user_uuid": userUUID}).
+		Where("?::text = ANY(event_types)", string(delivery.EventType))
+
+	query, args, err := sq.Insert("webhook_deliveries").
+		PlaceholderFormat(sq.Dollar).
+		Columns("webhook_uuid", "event_uuid", "event_type", "payload").
+		Select(subscribers).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := txmanager.GetQuerier(ctx, r.db).Exec(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// ClaimPendingWebhookDeliveries закрепляет за вызывающим до limit доставок, которым пора уходить,
+// у включённых вебхуков: их next_attempt_at одним запросом сдвигается на leaseUntil, поэтому
+// другие реплики их не выберут, пока аренда не истечёт. Блокировки держатся только на время
+// этого запроса. Возвращается исходный next_attempt_at: по нему неотправленную доставку
+// можно вернуть в очередь
+func (r *repository) ClaimPendingWebhookDeliveries(ctx context.Context, limit int, leaseUntil time.Time) ([]model.PendingWebhookDelivery, error) {
+	claimed := sq.Select("d.id", "d.next_attempt_at", "w.url", "w.secret").
+		From("webhook_deliveries d").
+		Join("webhooks w ON w.uuid = d.webhook_uuid").
+		Where(sq.Eq{"d.status": model.WebhookDeliveryPending}).
+		Where(sq.LtOrEq{"d.next_attempt_at": time.Now()}).
+		Where(sq.Eq{"w.enabled": true}).
+		OrderBy("d.id").
+		Limit(uint64(limit)). //nolint:gosec // limit задаётся конфигом и всегда положительный
+		Suffix("FOR UPDATE OF d SKIP LOCKED")
+
+	query, args, err := sq.Update("webhook_deliveries d").
+		PlaceholderFormat(sq.Dollar).
+		Set("next_attempt_at", leaseUntil).
+		FromSelect(claimed, "c").
+		Where("d.id = c.id").
+		Suffix(`RETURNING d.id, d.webhook_uuid, d.event_uuid, d.event_type, d.payload, d.status, d.attempts,
+			c.next_attempt_at, d.created_at, c.url, c.secret`).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := txmanager.GetQuerier(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []model.PendingWebhookDelivery
+	for rows.Next() {
+		var (
+			delivery    repoModel.WebhookDelivery
+			url, secret string
+		)
+		err = rows.Scan(
+			&delivery.ID,
+			&delivery.WebhookUUID,
+			&delivery.EventUUID,
+			&delivery.EventType,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptAt,
+			&delivery.CreatedAt,
+			&url,
+			&secret,
+		)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, model.PendingWebhookDelivery{
+			WebhookDelivery: converter.WebhookDeliveryToModel(delivery, nil),
+			URL:             url,
+			Secret:          secret,
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// RETURNING не сохраняет порядок подзапроса, а события одного вебхука уходят по порядку
+	slices.SortFunc(deliveries, func(a, b model.PendingWebhookDelivery) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return deliveries, nil
+}
+
+func (r *repository) UpdateWebhookDelivery(ctx context.Context, delivery model.WebhookDelivery) error {
+	query, args, err := sq.Update("webhook_deliveries").
+		PlaceholderFormat(sq.Dollar).
+		Set("status", string(delivery.Status)).
+		Set("attempts", delivery.Attempts).
+		Set("next_attempt_at", delivery.NextAttemptAt).
+		Where(sq.Eq{"id": delivery.ID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = txmanager.GetQuerier(ctx, r.db).Exec(ctx, query, args...)
+	return err
+}
+
+func (r *repository) AddWebhookDeliveryAttempt(ctx context.Context, deliveryID int64, attempt model.WebhookDeliveryAttempt) error {
+	query, args, err := sq.Insert("webhook_delivery_attempts").
+		PlaceholderFormat(sq.Dollar).
+		Columns("delivery_id", "attempt", "status_code", "error", "duration_ms", "attempted_at").
+		Values(deliveryID, attempt.Attempt, attempt.StatusCode, attempt.Error, attempt.Duration.Milliseconds(), attempt.AttemptedAt).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = txmanager.GetQuerier(ctx, r.db).Exec(ctx, query, args...)
+	return err
+}
+
+// ListWebhookDeliveries возвращает последние limit доставок вебхука, от новых к старым,
+// вместе с журналом попыток
+func (r *repository) ListWebhookDeliveries(ctx context.Context, webhookUUID string, limit int) ([]model.WebhookDelivery, error) {
+	query, args, err := sq.Select(
+		"id",
+		"webhook_uuid",
+		"event_uuid",
+		"event_type",
+		"payload",
+		"status",
+		"attempts",
+		"next_attempt_at",
+		"created_at").
+		From("webhook_deliveries").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"webhook_uuid": webhookUUID}).
+		OrderBy("id DESC").
+		Limit(uint64(limit)). //nolint:gosec // limit проверяется в сервисе
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		deliveries []repoModel.WebhookDelivery
+		ids        []int64
+	)
+	for rows.Next() {
+		var delivery repoModel.WebhookDelivery
+		err = rows.Scan(
+			&delivery.ID,
+			&delivery.WebhookUUID,
+			&delivery.EventUUID,
+			&delivery.EventType,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptAt,
+			&delivery.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+		ids = append(ids, delivery.ID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	logs, err := r.getDeliveryAttempts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]model.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		out = append(out, converter.WebhookDeliveryToModel(delivery, logs[delivery.ID]))
+	}
+
+	return out, nil
+}
+
+func (r *repository) getDeliveryAttempts(ctx context.Context, deliveryIDs []int64) (map[int64][]repoModel.WebhookDeliveryAttempt, error) {
+	logs := make(map[int64][]repoModel.WebhookDeliveryAttempt, len(deliveryIDs))
+	if len(deliveryIDs) == 0 {
+		return logs, nil
+	}
+
+	query, args, err := sq.Select("delivery_id", "attempt", "status_code", "error", "duration_ms", "attempted_at").
+		From("webhook_delivery_attempts").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"delivery_id": deliveryIDs}).
+		OrderBy("delivery_id", "attempt").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var attempt repoModel.WebhookDeliveryAttempt
+		err = rows.Scan(
+			&attempt.DeliveryID,
+			&attempt.Attempt,
+			&attempt.StatusCode,
+			&attempt.Error,
+			&attempt.DurationMs,
+			&attempt.AttemptedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		logs[attempt.DeliveryID] = append(logs[attempt.DeliveryID], attempt)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
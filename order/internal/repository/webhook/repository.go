@@ -0,0 +1,19 @@
+package webhook
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/Alexey-step/rocket-factory/order/internal/repository"
+)
+
+var _ def.WebhookRepository = (*repository)(nil)
+
+type repository struct {
+	db *pgxpool.Pool
+}
+
+func NewWebhookRepository(db *pgxpool.Pool) *repository {
+	return &repository{
+		db: db,
+	}
+}
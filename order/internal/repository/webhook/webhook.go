@@ -0,0 +1,181 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/converter"
+	repoModel "github.com/Alexey-step/rocket-factory/order/internal/repository/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
+)
+
+var webhookColumns = []string{
+	"uuid",
+	"user_uuid",
+	"url",
+	"secret",
+	"event_types",
+	"enabled",
+	"consecutive_failures",
+	"disabled_at",
+	"created_at",
+}
+
+func (r *repository) CreateWebhook(ctx context.Context, webhook model.Webhook) (model.Webhook, error) {
+	query, args, err := sq.Insert("webhooks").
+		PlaceholderFormat(sq.Dollar).
+		Columns("user_uuid", "url", "secret", "event_types").
+		Values(webhook.UserUUID, webhook.URL, webhook.Secret, converter.WebhookEventTypesToRepoModel(webhook.EventTypes)).
+		Suffix("RETURNING " + strings.Join(webhookColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return model.Webhook{}, err
+	}
+
+	return scanWebhook(r.db.QueryRow(ctx, query, args...))
+}
+
+func (r *repository) GetWebhook(ctx context.Context, webhookUUID string) (model.Webhook, error) {
+	query, args, err := sq.Select(webhookColumns...).
+		From("webhooks").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"uuid": webhookUUID}).
+		ToSql()
+	if err != nil {
+		return model.Webhook{}, err
+	}
+
+	return scanWebhook(r.db.QueryRow(ctx, query, args...))
+}
+
+func (r *repository) ListWebhooks(ctx context.Context, userUUID string) ([]model.Webhook, error) {
+	query, args, err := sq.Select(webhookColumns...).
+		From("webhooks").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"user_uuid": userUUID}).
+		OrderBy("created_at", "uuid").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var webhooks []model.Webhook
+	for rows.Next() {
+		webhook, scanErr := scanWebhook(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook удаляет вебхук; доставки и журнал удаляются каскадно
+func (r *repository) DeleteWebhook(ctx context.Context, webhookUUID string) error {
+	query, args, err := sq.Delete("webhooks").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"uuid": webhookUUID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// EnableWebhook включает вебхук и обнуляет счётчик ошибок
+func (r *repository) EnableWebhook(ctx context.Context, webhookUUID string) (model.Webhook, error) {
+	query, args, err := sq.Update("webhooks").
+		PlaceholderFormat(sq.Dollar).
+		Set("enabled", true).
+		Set("consecutive_failures", 0).
+		Set("disabled_at", nil).
+		Where(sq.Eq{"uuid": webhookUUID}).
+		Suffix("RETURNING " + strings.Join(webhookColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return model.Webhook{}, err
+	}
+
+	return scanWebhook(r.db.QueryRow(ctx, query, args...))
+}
+
+// RecordWebhookFailure увеличивает счётчик ошибок подряд и отключает вебхук,
+// когда счётчик достигает disableAfter. Возвращает вебхук после обновления
+func (r *repository) RecordWebhookFailure(ctx context.Context, webhookUUID string, disableAfter int) (model.Webhook, error) {
+	// В SET справа видны значения до обновления, поэтому счётчик везде берётся с +1
+	query, args, err := sq.Update("webhooks").
+		PlaceholderFormat(sq.Dollar).
+		Set("consecutive_failures", sq.Expr("consecutive_failures + 1")).
+		Set("enabled", sq.Expr("enabled AND consecutive_failures + 1 < ?", disableAfter)).
+		Set("disabled_at", sq.Expr("CASE WHEN enabled AND consecutive_failures + 1 >= ? THEN now() ELSE disabled_at END", disableAfter)).
+		Where(sq.Eq{"uuid": webhookUUID}).
+		Suffix("RETURNING " + strings.Join(webhookColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return model.Webhook{}, err
+	}
+
+	return scanWebhook(txmanager.GetQuerier(ctx, r.db).QueryRow(ctx, query, args...))
+}
+
+func (r *repository) ResetWebhookFailures(ctx context.Context, webhookUUID string) error {
+	query, args, err := sq.Update("webhooks").
+		PlaceholderFormat(sq.Dollar).
+		Set("consecutive_failures", 0).
+		Where(sq.Eq{"uuid": webhookUUID}).
+		Where(sq.Gt{"consecutive_failures": 0}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = txmanager.GetQuerier(ctx, r.db).Exec(ctx, query, args...)
+	return err
+}
+
+func scanWebhook(row pgx.Row) (model.Webhook, error) {
+	var webhook repoModel.Webhook
+	err := row.Scan(
+		&webhook.UUID,
+		&webhook.UserUUID,
+		&webhook.URL,
+		&webhook.Secret,
+		&webhook.EventTypes,
+		&webhook.Enabled,
+		&webhook.ConsecutiveFailures,
+		&webhook.DisabledAt,
+		&webhook.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Webhook{}, model.ErrWebhookNotFound
+		}
+		return model.Webhook{}, err
+	}
+
+	return converter.WebhookToModel(webhook), nil
+}
@@ -0,0 +1,76 @@
+package bom
+
+import (
+	"slices"
+
+	"github.com/samber/lo"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+// CategoryLimit - сколько деталей категории нужно для сборки
+type CategoryLimit struct {
+	Min int64
+	Max *int64 // nil - без ограничения сверху
+}
+
+// categoryRule проверяет количество деталей по категориям
+type categoryRule struct {
+	limits map[model.Category]CategoryLimit
+}
+
+// NewCategoryRule создаёт правило по лимитам категорий. Категории без лимита в сборке
+// не участвуют: детали таких категорий считаются лишними
+func NewCategoryRule(limits map[model.Category]CategoryLimit) *categoryRule {
+	return &categoryRule{
+		limits: limits,
+	}
+}
+
+func (r *categoryRule) Check(items []model.BOMItem) []model.BOMViolation {
+	counts := make(map[model.Category]int64, len(r.limits))
+	for _, item := range items {
+		counts[item.Part.Category] += item.Quantity
+	}
+
+	// Сортируем категории, чтобы нарушения всегда шли в одном порядке
+	categories := lo.Uniq(append(lo.Keys(r.limits), lo.Keys(counts)...))
+	slices.Sort(categories)
+
+	var violations []model.BOMViolation
+	for _, category := range categories {
+		actual := counts[category]
+
+		limit, ok := r.limits[category]
+		if !ok {
+			violations = append(violations, model.BOMViolation{
+				Category: category,
+				Kind:     model.BOMViolationExcess,
+				Max:      lo.ToPtr(int64(0)),
+				Actual:   actual,
+			})
+			continue
+		}
+
+		switch {
+		case actual < limit.Min:
+			violations = append(violations, model.BOMViolation{
+				Category: category,
+				Kind:     model.BOMViolationMissing,
+				Min:      limit.Min,
+				Max:      limit.Max,
+				Actual:   actual,
+			})
+		case limit.Max != nil && actual > *limit.Max:
+			violations = append(violations, model.BOMViolation{
+				Category: category,
+				Kind:     model.BOMViolationExcess,
+				Min:      limit.Min,
+				Max:      limit.Max,
+				Actual:   actual,
+			})
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,98 @@
+package bom
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+func TestCategoryRuleCheck(t *testing.T) {
+	rule := NewCategoryRule(map[model.Category]CategoryLimit{
+		model.CategoryEngine:   {Min: 1},
+		model.CategoryFuel:     {Min: 1},
+		model.CategoryWing:     {Min: 2, Max: lo.ToPtr(int64(4))},
+		model.CategoryPorthole: {Min: 0},
+	})
+
+	tests := []struct {
+		name     string
+		items    []model.BOMItem
+		expected []model.BOMViolation
+	}{
+		{
+			name: "complete rocket",
+			items: []model.BOMItem{
+				bomItem(model.CategoryEngine, 1),
+				bomItem(model.CategoryFuel, 3),
+				bomItem(model.CategoryWing, 2),
+				bomItem(model.CategoryPorthole, 6),
+			},
+		},
+		{
+			name: "portholes only",
+			items: []model.BOMItem{
+				bomItem(model.CategoryPorthole, 5),
+			},
+			expected: []model.BOMViolation{
+				{Category: model.CategoryEngine, Kind: model.BOMViolationMissing, Min: 1},
+				{Category: model.CategoryFuel, Kind: model.BOMViolationMissing, Min: 1},
+				{Category: model.CategoryWing, Kind: model.BOMViolationMissing, Min: 2, Max: lo.ToPtr(int64(4))},
+			},
+		},
+		{
+			name: "wings from two parts are counted together",
+			items: []model.BOMItem{
+				bomItem(model.CategoryEngine, 1),
+				bomItem(model.CategoryFuel, 1),
+				bomItem(model.CategoryWing, 3),
+				bomItem(model.CategoryWing, 2),
+			},
+			expected: []model.BOMViolation{
+				{Category: model.CategoryWing, Kind: model.BOMViolationExcess, Min: 2, Max: lo.ToPtr(int64(4)), Actual: 5},
+			},
+		},
+		{
+			name: "category without limit",
+			items: []model.BOMItem{
+				bomItem(model.CategoryEngine, 1),
+				bomItem(model.CategoryFuel, 1),
+				bomItem(model.CategoryWing, 2),
+				bomItem(model.CategoryUnspecified, 1),
+			},
+			expected: []model.BOMViolation{
+				{Category: model.CategoryUnspecified, Kind: model.BOMViolationExcess, Max: lo.ToPtr(int64(0)), Actual: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, rule.Check(tt.items))
+		})
+	}
+}
+
+func TestValidatorCollectsViolations(t *testing.T) {
+	validator := NewValidator(NewCategoryRule(map[model.Category]CategoryLimit{
+		model.CategoryEngine: {Min: 1},
+	}))
+
+	err := validator.Validate([]model.BOMItem{bomItem(model.CategoryFuel, 2)})
+
+	var bomErr *model.BOMValidationError
+	assert.ErrorAs(t, err, &bomErr)
+	assert.ErrorIs(t, err, model.ErrBOMInvalid)
+	assert.Len(t, bomErr.Violations, 2)
+
+	assert.NoError(t, NewValidator().Validate([]model.BOMItem{bomItem(model.CategoryFuel, 2)}))
+}
+
+func bomItem(category model.Category, quantity int64) model.BOMItem {
+	return model.BOMItem{
+		Part:     model.Part{Category: category},
+		Quantity: quantity,
+	}
+}
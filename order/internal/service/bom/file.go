@@ -0,0 +1,64 @@
+package bom
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+// rulesFile - формат файла правил сборки: лимиты количества деталей по категориям
+//
+//	{"categories": {"ENGINE": {"min": 1}, "WING": {"min": 2, "max": 4}, "PORTHOLE": {"min": 0}}}
+type rulesFile struct {
+	Categories map[model.Category]categoryLimitFile `json:"categories"`
+}
+
+type categoryLimitFile struct {
+	Min int64  `json:"min"`
+	Max *int64 `json:"max"`
+}
+
+var knownCategories = []model.Category{
+	model.CategoryEngine,
+	model.CategoryFuel,
+	model.CategoryPorthole,
+	model.CategoryWing,
+}
+
+// LoadRules читает правила сборки из файла. Пустой список категорий отключает проверку комплектности
+func LoadRules(path string) ([]Rule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read bom rules file: %w", err)
+	}
+
+	var file rulesFile
+	if err = json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse bom rules file: %w", err)
+	}
+
+	if len(file.Categories) == 0 {
+		return nil, nil
+	}
+
+	limits := make(map[model.Category]CategoryLimit, len(file.Categories))
+	for category, limit := range file.Categories {
+		if !slices.Contains(knownCategories, category) {
+			return nil, fmt.Errorf("bom rules file %s: unknown category %q", path, category)
+		}
+
+		if limit.Min < 0 || (limit.Max != nil && *limit.Max < limit.Min) {
+			return nil, fmt.Errorf("bom rules file %s: invalid limit for %s", path, category)
+		}
+
+		limits[category] = CategoryLimit{
+			Min: limit.Min,
+			Max: limit.Max,
+		}
+	}
+
+	return []Rule{NewCategoryRule(limits)}, nil
+}
@@ -0,0 +1,39 @@
+package bom
+
+import (
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	def "github.com/Alexey-step/rocket-factory/order/internal/service"
+)
+
+var _ def.BOMValidator = (*validator)(nil)
+
+// Rule - правило сборки ракеты. Проверки совместимости по габаритам и весу добавляются
+// новыми реализациями Rule без изменения валидатора
+type Rule interface {
+	Check(items []model.BOMItem) []model.BOMViolation
+}
+
+// validator проверяет, что детали заказа складываются в ракету, по всем правилам сразу
+type validator struct {
+	rules []Rule
+}
+
+func NewValidator(rules ...Rule) *validator {
+	return &validator{
+		rules: rules,
+	}
+}
+
+// Validate возвращает *model.BOMValidationError со всеми нарушениями, если хотя бы одно правило не выполнено
+func (v *validator) Validate(items []model.BOMItem) error {
+	var violations []model.BOMViolation
+	for _, rule := range v.rules {
+		violations = append(violations, rule.Check(items)...)
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &model.BOMValidationError{Violations: violations}
+}
@@ -12,20 +12,28 @@ import (
 )
 
 type service struct {
-	orderShipAssembledDecoder kafkaConverter.OrderAssembledDecoder
-	orderConsumer             kafka.Consumer
-	orderService              serviceOrder.OrderService
+	orderShipAssembledDecoder   kafkaConverter.OrderAssembledDecoder
+	orderAssemblyStartedDecoder kafkaConverter.OrderAssemblyStartedDecoder
+	assemblyStartedTopic        string
+	orderConsumer               kafka.Consumer
+	orderService                serviceOrder.OrderService
 }
 
+// NewService создаёт консьюмер событий сборки; сообщения из assemblyStartedTopic
+// декодируются как ShipAssemblyStarted, из остальных топиков - как ShipAssembled
 func NewService(
 	orderShipAssembledDecoder kafkaConverter.OrderAssembledDecoder,
+	orderAssemblyStartedDecoder kafkaConverter.OrderAssemblyStartedDecoder,
+	assemblyStartedTopic string,
 	orderConsumer kafka.Consumer,
 	orderService serviceOrder.OrderService,
 ) *service {
 	return &service{
-		orderShipAssembledDecoder: orderShipAssembledDecoder,
-		orderConsumer:             orderConsumer,
-		orderService:              orderService,
+		orderShipAssembledDecoder:   orderShipAssembledDecoder,
+		orderAssemblyStartedDecoder: orderAssemblyStartedDecoder,
+		assemblyStartedTopic:        assemblyStartedTopic,
+		orderConsumer:               orderConsumer,
+		orderService:                orderService,
 	}
 }
 
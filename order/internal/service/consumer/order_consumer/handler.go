@@ -2,6 +2,7 @@ package order_consumer
 
 import (
 	"context"
+	"errors"
 
 	"go.uber.org/zap"
 
@@ -11,6 +12,40 @@ import (
 )
 
 func (s *service) OrderHandler(ctx context.Context, msg kafka.Message) error {
+	if msg.Topic == s.assemblyStartedTopic {
+		return s.handleAssemblyStarted(ctx, msg)
+	}
+
+	return s.handleShipAssembled(ctx, msg)
+}
+
+func (s *service) handleAssemblyStarted(ctx context.Context, msg kafka.Message) error {
+	event, err := s.orderAssemblyStartedDecoder.Decode(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode ship assembly started event", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "Processing message",
+		zap.String("topic", msg.Topic),
+		zap.Any("partition", msg.Partition),
+		zap.Any("offset", msg.Offset),
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("event_uuid", event.EventUUID),
+	)
+
+	err = s.orderService.UpdateStatus(ctx, event.OrderUUID, model.OrderStatusAssembling, model.StatusChange{
+		Actor:  model.EventActor(event.EventUUID),
+		Reason: model.StatusReasonAssemblyStarted,
+	})
+	if err != nil {
+		return skipStaleEvent(ctx, event.OrderUUID, err)
+	}
+
+	return nil
+}
+
+func (s *service) handleShipAssembled(ctx context.Context, msg kafka.Message) error {
 	event, err := s.orderShipAssembledDecoder.Decode(msg.Value)
 	if err != nil {
 		logger.Error(ctx, "Failed to decode ship assembled event", zap.Error(err))
@@ -26,14 +61,41 @@ func (s *service) OrderHandler(ctx context.Context, msg kafka.Message) error {
 		zap.Int64("build_time_sec", event.BuildTimeSec),
 	)
 
-	err = s.orderService.UpdateStatus(ctx, event.OrderUUID, model.OrderStatusCompleted)
+	// События начала и окончания сборки идут разными топиками, поэтому начало сборки
+	// может прийти позже окончания: в таком случае сначала переводим заказ в ASSEMBLING
+	err = s.orderService.UpdateStatus(ctx, event.OrderUUID, model.OrderStatusAssembling, model.StatusChange{
+		Actor:  model.EventActor(event.EventUUID),
+		Reason: model.StatusReasonAssemblyStarted,
+	})
+	if err != nil && !errors.Is(err, model.ErrOrderInvalidTransition) {
+		return err
+	}
+
+	err = s.orderService.UpdateStatus(ctx, event.OrderUUID, model.OrderStatusCompleted, model.StatusChange{
+		Actor:  model.EventActor(event.EventUUID),
+		Reason: model.StatusReasonAssemblyFinished,
+	})
 	if err != nil {
-		logger.Error(ctx, "Failed to update order status to completed",
-			zap.String("order_uuid", event.OrderUUID),
+		return skipStaleEvent(ctx, event.OrderUUID, err)
+	}
+
+	return nil
+}
+
+// skipStaleEvent подтверждает событие, которое уже неприменимо к заказу (например, заказ отменён),
+// чтобы консьюмер не пытался обработать его повторно
+func skipStaleEvent(ctx context.Context, orderUUID string, err error) error {
+	if errors.Is(err, model.ErrOrderInvalidTransition) {
+		logger.Warn(ctx, "Skipping event for order in incompatible status",
+			zap.String("order_uuid", orderUUID),
 			zap.Error(err),
 		)
-		return err
+		return nil
 	}
 
-	return nil
+	logger.Error(ctx, "Failed to update order status",
+		zap.String("order_uuid", orderUUID),
+		zap.Error(err),
+	)
+	return err
 }
@@ -0,0 +1,60 @@
+package webhook_dispatcher
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	def "github.com/Alexey-step/rocket-factory/order/internal/service"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+type service struct {
+	webhookService def.WebhookService
+	interval       time.Duration
+	batchSize      int
+}
+
+// NewService создаёт фоновую отправку событий на вебхуки
+func NewService(webhookService def.WebhookService, interval time.Duration, batchSize int) *service {
+	return &service{
+		webhookService: webhookService,
+		interval:       interval,
+		batchSize:      batchSize,
+	}
+}
+
+// RunDispatcher раз в interval отправляет накопившиеся доставки пачками, пока не разберёт все.
+// Возвращается только при отмене контекста: ошибки отдельного прохода логируются,
+// а неотправленные доставки достанутся следующему проходу.
+func (s *service) RunDispatcher(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.dispatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *service) dispatch(ctx context.Context) {
+	for ctx.Err() == nil {
+		processed, err := s.webhookService.DeliverPending(ctx, s.batchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Error(ctx, "Failed to deliver webhooks", zap.Error(err))
+			}
+			return
+		}
+
+		if processed < s.batchSize {
+			return
+		}
+	}
+}
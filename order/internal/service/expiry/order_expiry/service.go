@@ -0,0 +1,91 @@
+package order_expiry
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	serviceOrder "github.com/Alexey-step/rocket-factory/order/internal/service"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+type service struct {
+	orderService          serviceOrder.OrderService
+	idempotencyRepository serviceOrder.IdempotencyRepository
+	ttl                   time.Duration
+	interval              time.Duration
+	batchSize             int
+}
+
+// NewService создаёт фоновую отмену заказов, которые не оплатили в течение ttl.
+// Тот же проход удаляет истёкшие ключи идемпотентности
+func NewService(
+	orderService serviceOrder.OrderService,
+	idempotencyRepository serviceOrder.IdempotencyRepository,
+	ttl, interval time.Duration,
+	batchSize int,
+) *service {
+	return &service{
+		orderService:          orderService,
+		idempotencyRepository: idempotencyRepository,
+		ttl:                   ttl,
+		interval:              interval,
+		batchSize:             batchSize,
+	}
+}
+
+// RunSweeper раз в interval отменяет просроченные заказы пачками, пока не разберёт все,
+// и удаляет истёкшие ключи идемпотентности.
+// Возвращается только при отмене контекста: ошибки отдельного прохода логируются,
+// а необработанные заказы и ключи достанутся следующему проходу.
+func (s *service) RunSweeper(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.sweep(ctx)
+		s.deleteExpiredIdempotencyKeys(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *service) sweep(ctx context.Context) {
+	createdBefore := time.Now().Add(-s.ttl)
+
+	for ctx.Err() == nil {
+		expired, err := s.orderService.ExpireOrders(ctx, createdBefore, s.batchSize)
+		if err != nil {
+			logger.Error(ctx, "Failed to expire unpaid orders", zap.Error(err))
+			return
+		}
+
+		if expired > 0 {
+			logger.Info(ctx, "Unpaid orders expired",
+				zap.Int("count", expired),
+				zap.Time("created_before", createdBefore),
+			)
+		}
+
+		if expired < s.batchSize {
+			return
+		}
+	}
+}
+
+func (s *service) deleteExpiredIdempotencyKeys(ctx context.Context) {
+	deleted, err := s.idempotencyRepository.DeleteExpiredIdempotencyKeys(ctx)
+	if err != nil {
+		logger.Error(ctx, "Failed to delete expired idempotency keys", zap.Error(err))
+		return
+	}
+
+	if deleted > 0 {
+		logger.Info(ctx, "Expired idempotency keys deleted", zap.Int64("count", deleted))
+	}
+}
@@ -0,0 +1,112 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+// GetInvoice возвращает счёт по заказу пользователя. Счёт выставляется при первом
+// запросе оплаченного заказа; дальше отдаётся сохранённый, даже если заказ потом
+// вернули - бухгалтерский документ не переписывается
+func (s *service) GetInvoice(ctx context.Context, userUUID, orderUUID string) (model.Invoice, error) {
+	order, err := s.orderRepository.GetOrder(ctx, orderUUID)
+	if err != nil {
+		return model.Invoice{}, err
+	}
+	if order.UserUUID != userUUID {
+		return model.Invoice{}, model.ErrOrderForbidden
+	}
+
+	invoice, err := s.invoiceRepository.GetInvoiceByOrder(ctx, orderUUID)
+	if err == nil {
+		return invoice, nil
+	}
+	if !errors.Is(err, model.ErrInvoiceNotFound) {
+		return model.Invoice{}, err
+	}
+
+	if !isPaid(order) {
+		return model.Invoice{}, model.ErrInvoiceNotAvailable
+	}
+
+	partUUIDs := lo.Map(order.Items, func(item model.OrderItem, _ int) string {
+		return item.PartUUID
+	})
+	parts, err := s.inventoryClient.ListParts(ctx, model.PartsFilter{Uuids: partUUIDs})
+	if err != nil {
+		return model.Invoice{}, err
+	}
+
+	invoice, err = s.issueInvoice(ctx, order, parts, time.Now().UTC().Truncate(time.Second))
+	if errors.Is(err, model.ErrInvoiceAlreadyExists) {
+		// Параллельный запрос выставил счёт раньше, его номер и документы и отдаём
+		return s.invoiceRepository.GetInvoiceByOrder(ctx, orderUUID)
+	}
+	if err != nil {
+		return model.Invoice{}, err
+	}
+
+	return invoice, nil
+}
+
+// issueInvoice присваивает номер, рендерит документы и сохраняет счёт в одной
+// транзакции. Если сохранить не удалось, номер возвращается в счётчик вместе с
+// откатом, поэтому в нумерации не остаётся пропусков
+func (s *service) issueInvoice(ctx context.Context, order model.OrderData, parts []model.Part, issuedAt time.Time) (model.Invoice, error) {
+	var invoice model.Invoice
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		sequence, err := s.invoiceRepository.NextInvoiceSequence(ctx, issuedAt.Year())
+		if err != nil {
+			return err
+		}
+
+		invoice = model.Invoice{
+			OrderUUID: order.UUID,
+			UserUUID:  order.UserUUID,
+			Number:    fmt.Sprintf("%s-%d-%06d", s.cfg.NumberPrefix, issuedAt.Year(), sequence),
+			Year:      issuedAt.Year(),
+			Sequence:  sequence,
+			Total:     order.TotalPrice,
+			IssuedAt:  issuedAt,
+		}
+
+		document, err := newInvoiceDocument(s.cfg, invoice, order, parts)
+		if err != nil {
+			return err
+		}
+
+		invoice.HTML, err = renderHTML(document)
+		if err != nil {
+			return err
+		}
+		invoice.PDF = renderPDF(document)
+
+		invoice, err = s.invoiceRepository.CreateInvoice(ctx, invoice)
+		return err
+	})
+	if err != nil {
+		return model.Invoice{}, err
+	}
+
+	return invoice, nil
+}
+
+// isPaid - деньги за заказ получены и не возвращены
+func isPaid(order model.OrderData) bool {
+	if order.TransactionUUID == nil {
+		return false
+	}
+
+	switch order.Status {
+	case model.OrderStatusPaid, model.OrderStatusAssembling, model.OrderStatusCompleted:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,219 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	grpcClientMocks "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	orderServiceMocks "github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
+)
+
+var testConfig = Config{
+	SellerName:    "Rocket Factory",
+	SellerAddress: "Baikonur, site 1",
+	NumberPrefix:  "INV",
+}
+
+func TestGetInvoiceIssuesNew(t *testing.T) {
+	ctx := context.Background()
+	order := getMockedPaidOrder()
+	parts := []model.Part{
+		{
+			UUID:         order.Items[0].PartUUID,
+			Name:         "Renamed in catalog",
+			Manufacturer: model.Manufacturer{Name: "Energomash", Country: "Russia"},
+		},
+	}
+	created := model.Invoice{UUID: gofakeit.UUID(), OrderUUID: order.UUID}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	invoiceRepository := mocks.NewInvoiceRepository(t)
+	inventoryClient := grpcClientMocks.NewInventoryClient(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+	invoiceService := NewService(orderRepository, invoiceRepository, inventoryClient, txManager, testConfig)
+
+	orderRepository.On("GetOrder", ctx, order.UUID).Return(order, nil).Once()
+	invoiceRepository.On("GetInvoiceByOrder", ctx, order.UUID).Return(model.Invoice{}, model.ErrInvoiceNotFound).Once()
+	inventoryClient.On("ListParts", ctx, model.PartsFilter{
+		Uuids: []string{order.Items[0].PartUUID, order.Items[1].PartUUID},
+	}).Return(parts, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	invoiceRepository.On("NextInvoiceSequence", ctx, time.Now().UTC().Year()).Return(int64(42), nil).Once()
+	invoiceRepository.On("CreateInvoice", ctx, mock.MatchedBy(func(invoice model.Invoice) bool {
+		year := time.Now().UTC().Year()
+		return invoice.OrderUUID == order.UUID &&
+			invoice.UserUUID == order.UserUUID &&
+			invoice.Number == "INV-"+fmtInt(int64(year))+"-000042" &&
+			invoice.Year == year &&
+			invoice.Sequence == 42 &&
+			invoice.Total == order.TotalPrice &&
+			len(invoice.HTML) > 0 &&
+			len(invoice.PDF) > 0
+	})).Return(created, nil).Once()
+
+	invoice, err := invoiceService.GetInvoice(ctx, order.UserUUID, order.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, created, invoice)
+}
+
+func TestGetInvoiceReturnsStored(t *testing.T) {
+	ctx := context.Background()
+	// Заказ уже возвращён, но выставленный раньше счёт остаётся доступным
+	order := getMockedPaidOrder()
+	order.Status = model.OrderStatusRefunded
+	stored := model.Invoice{
+		UUID:      gofakeit.UUID(),
+		OrderUUID: order.UUID,
+		UserUUID:  order.UserUUID,
+		Number:    "INV-2026-000007",
+		HTML:      []byte("<html></html>"),
+		PDF:       []byte("%PDF-1.4"),
+	}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	invoiceRepository := mocks.NewInvoiceRepository(t)
+	invoiceService := NewService(orderRepository, invoiceRepository,
+		grpcClientMocks.NewInventoryClient(t), orderServiceMocks.NewTxManager(t), testConfig)
+
+	orderRepository.On("GetOrder", ctx, order.UUID).Return(order, nil).Once()
+	invoiceRepository.On("GetInvoiceByOrder", ctx, order.UUID).Return(stored, nil).Once()
+
+	invoice, err := invoiceService.GetInvoice(ctx, order.UserUUID, order.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, stored, invoice)
+}
+
+func TestGetInvoiceForbidden(t *testing.T) {
+	ctx := context.Background()
+	order := getMockedPaidOrder()
+
+	orderRepository := mocks.NewOrderRepository(t)
+	invoiceService := NewService(orderRepository, mocks.NewInvoiceRepository(t),
+		grpcClientMocks.NewInventoryClient(t), orderServiceMocks.NewTxManager(t), testConfig)
+
+	orderRepository.On("GetOrder", ctx, order.UUID).Return(order, nil).Once()
+
+	_, err := invoiceService.GetInvoice(ctx, gofakeit.UUID(), order.UUID)
+	require.ErrorIs(t, err, model.ErrOrderForbidden)
+}
+
+func TestGetInvoiceNotAvailable(t *testing.T) {
+	tests := []struct {
+		name   string
+		status model.OrderStatus
+	}{
+		{name: "pending payment", status: model.OrderStatusPendingPayment},
+		{name: "cancelled", status: model.OrderStatusCanceled},
+		{name: "refunded", status: model.OrderStatusRefunded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			order := getMockedPaidOrder()
+			order.Status = tt.status
+
+			orderRepository := mocks.NewOrderRepository(t)
+			invoiceRepository := mocks.NewInvoiceRepository(t)
+			invoiceService := NewService(orderRepository, invoiceRepository,
+				grpcClientMocks.NewInventoryClient(t), orderServiceMocks.NewTxManager(t), testConfig)
+
+			orderRepository.On("GetOrder", ctx, order.UUID).Return(order, nil).Once()
+			invoiceRepository.On("GetInvoiceByOrder", ctx, order.UUID).Return(model.Invoice{}, model.ErrInvoiceNotFound).Once()
+
+			_, err := invoiceService.GetInvoice(ctx, order.UserUUID, order.UUID)
+			require.ErrorIs(t, err, model.ErrInvoiceNotAvailable)
+		})
+	}
+}
+
+func TestGetInvoiceConcurrentIssue(t *testing.T) {
+	ctx := context.Background()
+	order := getMockedPaidOrder()
+	stored := model.Invoice{UUID: gofakeit.UUID(), OrderUUID: order.UUID, Number: "INV-2026-000041"}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	invoiceRepository := mocks.NewInvoiceRepository(t)
+	inventoryClient := grpcClientMocks.NewInventoryClient(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+	invoiceService := NewService(orderRepository, invoiceRepository, inventoryClient, txManager, testConfig)
+
+	orderRepository.On("GetOrder", ctx, order.UUID).Return(order, nil).Once()
+	invoiceRepository.On("GetInvoiceByOrder", ctx, order.UUID).Return(model.Invoice{}, model.ErrInvoiceNotFound).Once()
+	inventoryClient.On("ListParts", ctx, mock.AnythingOfType("model.PartsFilter")).Return(nil, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	invoiceRepository.On("NextInvoiceSequence", ctx, mock.AnythingOfType("int")).Return(int64(42), nil).Once()
+	invoiceRepository.On("CreateInvoice", ctx, mock.AnythingOfType("model.Invoice")).Return(model.Invoice{}, model.ErrInvoiceAlreadyExists).Once()
+	invoiceRepository.On("GetInvoiceByOrder", ctx, order.UUID).Return(stored, nil).Once()
+
+	invoice, err := invoiceService.GetInvoice(ctx, order.UserUUID, order.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, stored, invoice)
+}
+
+func TestGetInvoiceInventoryError(t *testing.T) {
+	ctx := context.Background()
+	order := getMockedPaidOrder()
+	inventoryErr := errors.New("inventory unavailable")
+
+	orderRepository := mocks.NewOrderRepository(t)
+	invoiceRepository := mocks.NewInvoiceRepository(t)
+	inventoryClient := grpcClientMocks.NewInventoryClient(t)
+	invoiceService := NewService(orderRepository, invoiceRepository, inventoryClient,
+		orderServiceMocks.NewTxManager(t), testConfig)
+
+	orderRepository.On("GetOrder", ctx, order.UUID).Return(order, nil).Once()
+	invoiceRepository.On("GetInvoiceByOrder", ctx, order.UUID).Return(model.Invoice{}, model.ErrInvoiceNotFound).Once()
+	inventoryClient.On("ListParts", ctx, mock.AnythingOfType("model.PartsFilter")).Return(nil, inventoryErr).Once()
+
+	_, err := invoiceService.GetInvoice(ctx, order.UserUUID, order.UUID)
+	require.ErrorIs(t, err, inventoryErr)
+}
+
+// runInTx выполняет функцию без реальной транзакции
+func runInTx(ctx context.Context, fn txmanager.Handler) error {
+	return fn(ctx)
+}
+
+func getMockedPaidOrder() model.OrderData {
+	return model.OrderData{
+		UUID:     gofakeit.UUID(),
+		UserUUID: gofakeit.UUID(),
+		Items: []model.OrderItem{
+			{
+				PartUUID:  gofakeit.UUID(),
+				Name:      "RD-180",
+				Category:  model.CategoryEngine,
+				Quantity:  2,
+				UnitPrice: money.New(1_500_000, money.RUB),
+			},
+			{
+				PartUUID:  gofakeit.UUID(),
+				Name:      "Иллюминатор",
+				Category:  model.CategoryPorthole,
+				Quantity:  1,
+				UnitPrice: money.New(250_000, money.RUB),
+			},
+		},
+		Discounts: []model.OrderDiscount{
+			{PromoCode: "SPACE10", Description: "10% off", Amount: money.New(325_000, money.RUB)},
+		},
+		TotalPrice:      money.New(2_925_000, money.RUB),
+		TransactionUUID: lo.ToPtr(gofakeit.UUID()),
+		PaymentMethod:   lo.ToPtr(model.PaymentMethodCard),
+		Status:          model.OrderStatusPaid,
+		CreatedAt:       time.Now().Add(-time.Hour),
+	}
+}
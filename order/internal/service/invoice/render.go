@@ -0,0 +1,234 @@
+package invoice
+
+import (
+	"bytes"
+	"embed"
+	"html/template"
+	"strconv"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/pdf"
+)
+
+//go:embed templates/invoice.html.tmpl
+var invoiceTemplateFS embed.FS
+
+var invoiceTemplate = template.Must(template.ParseFS(invoiceTemplateFS, "templates/invoice.html.tmpl"))
+
+// invoiceDocument - содержимое счёта, общее для HTML и PDF
+type invoiceDocument struct {
+	Number          string
+	IssuedAt        time.Time
+	SellerName      string
+	SellerAddress   string
+	OrderUUID       string
+	CustomerUUID    string
+	PaymentMethod   string
+	TransactionUUID string
+	Lines           []invoiceLine
+	Subtotal        money.Money
+	Discounts       []model.OrderDiscount
+	Total           money.Money
+}
+
+type invoiceLine struct {
+	Position     int
+	Name         string
+	Manufacturer string
+	PartUUID     string
+	Quantity     int64
+	UnitPrice    money.Money
+	Amount       money.Money
+}
+
+// newInvoiceDocument собирает счёт из заказа. Название и цена берутся из снимка
+// позиции на момент оформления, из inventory - только производитель: цена детали
+// в каталоге с тех пор могла измениться
+func newInvoiceDocument(cfg Config, invoice model.Invoice, order model.OrderData, parts []model.Part) (invoiceDocument, error) {
+	partsByUUID := lo.KeyBy(parts, func(part model.Part) string {
+		return part.UUID
+	})
+
+	document := invoiceDocument{
+		Number:          invoice.Number,
+		IssuedAt:        invoice.IssuedAt,
+		SellerName:      cfg.SellerName,
+		SellerAddress:   cfg.SellerAddress,
+		OrderUUID:       order.UUID,
+		CustomerUUID:    order.UserUUID,
+		PaymentMethod:   string(lo.FromPtr(order.PaymentMethod)),
+		TransactionUUID: lo.FromPtr(order.TransactionUUID),
+		Lines:           make([]invoiceLine, 0, len(order.Items)),
+		Subtotal:        money.Zero(order.TotalPrice.Currency),
+		Discounts:       order.Discounts,
+		Total:           order.TotalPrice,
+	}
+
+	for i, item := range order.Items {
+		line := invoiceLine{
+			Position:  i + 1,
+			Name:      item.Name,
+			PartUUID:  item.PartUUID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+			Amount:    item.UnitPrice.Mul(item.Quantity),
+		}
+		if part, ok := partsByUUID[item.PartUUID]; ok {
+			line.Manufacturer = part.Manufacturer.Name
+			if part.Manufacturer.Country != "" {
+				line.Manufacturer += ", " + part.Manufacturer.Country
+			}
+		}
+
+		var err error
+		document.Subtotal, err = document.Subtotal.Add(line.Amount)
+		if err != nil {
+			return invoiceDocument{}, err
+		}
+		document.Lines = append(document.Lines, line)
+	}
+
+	return document, nil
+}
+
+func renderHTML(document invoiceDocument) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := invoiceTemplate.Execute(&buf, document); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Разметка PDF-счёта в пунктах
+const (
+	marginLeft   = 50.0
+	marginRight  = pdf.PageWidth - 50
+	marginTop    = 60.0
+	marginBottom = pdf.PageHeight - 60
+
+	fontSize   = 9.0
+	lineHeight = 14.0
+
+	columnPosition  = marginLeft
+	columnName      = marginLeft + 25
+	columnQuantity  = 370.0 // Правый край колонки
+	columnUnitPrice = 455.0 // Правый край колонки
+)
+
+// pdfWriter выводит строки сверху вниз и начинает новую страницу, когда текущая закончилась
+type pdfWriter struct {
+	doc *pdf.Document
+	y   float64
+}
+
+func renderPDF(document invoiceDocument) []byte {
+	w := &pdfWriter{doc: pdf.New("Invoice "+document.Number, document.IssuedAt)}
+	w.newPage()
+
+	w.doc.Text(marginLeft, w.y, pdf.Bold, 18, "INVOICE")
+	w.textRight(marginRight, pdf.Bold, 12, "No. "+document.Number)
+	w.y += lineHeight
+	w.textRight(marginRight, pdf.Regular, fontSize, "Issued "+document.IssuedAt.Format("2006-01-02 15:04 MST"))
+	w.y += 2 * lineHeight
+
+	w.field("Seller", document.SellerName)
+	if document.SellerAddress != "" {
+		w.field("", document.SellerAddress)
+	}
+	w.field("Customer", document.CustomerUUID)
+	w.field("Order", document.OrderUUID)
+	w.field("Payment", document.PaymentMethod)
+	w.field("Transaction", document.TransactionUUID)
+	w.y += lineHeight
+
+	w.tableHeader()
+	for _, line := range document.Lines {
+		w.ensureSpace(2 * lineHeight)
+		w.doc.Text(columnPosition, w.y, pdf.Regular, fontSize, fmtInt(int64(line.Position)))
+		w.doc.Text(columnName, w.y, pdf.Regular, fontSize, fit(line.Name, columnQuantity-columnName-40))
+		w.textRight(columnQuantity, pdf.Regular, fontSize, fmtInt(line.Quantity))
+		w.textRight(columnUnitPrice, pdf.Regular, fontSize, line.UnitPrice.String())
+		w.textRight(marginRight, pdf.Regular, fontSize, line.Amount.String())
+		w.y += lineHeight
+		if line.Manufacturer != "" {
+			w.doc.Text(columnName, w.y, pdf.Regular, fontSize-1, fit(line.Manufacturer, columnQuantity-columnName-40))
+			w.y += lineHeight
+		}
+	}
+
+	w.ensureSpace(float64(len(document.Discounts)+3) * lineHeight)
+	w.doc.Line(marginLeft, w.y-lineHeight/2, marginRight, w.y-lineHeight/2, 0.5)
+	w.y += lineHeight / 2
+	w.total(pdf.Regular, "Subtotal", document.Subtotal.String())
+	for _, discount := range document.Discounts {
+		w.total(pdf.Regular, "Discount "+discount.PromoCode, "-"+discount.Amount.String())
+	}
+	w.total(pdf.Bold, "Total paid", document.Total.String())
+
+	return w.doc.Bytes()
+}
+
+func (w *pdfWriter) newPage() {
+	w.doc.AddPage()
+	w.y = marginTop
+}
+
+// ensureSpace переносит вывод на новую страницу, если до нижнего поля меньше height
+func (w *pdfWriter) ensureSpace(height float64) {
+	if w.y+height <= marginBottom {
+		return
+	}
+
+	w.newPage()
+	w.tableHeader()
+}
+
+func (w *pdfWriter) tableHeader() {
+	w.doc.Text(columnPosition, w.y, pdf.Bold, fontSize, "#")
+	w.doc.Text(columnName, w.y, pdf.Bold, fontSize, "Item")
+	w.textRight(columnQuantity, pdf.Bold, fontSize, "Qty")
+	w.textRight(columnUnitPrice, pdf.Bold, fontSize, "Unit price")
+	w.textRight(marginRight, pdf.Bold, fontSize, "Amount")
+	w.doc.Line(marginLeft, w.y+lineHeight/2-2, marginRight, w.y+lineHeight/2-2, 0.5)
+	w.y += lineHeight + 4
+}
+
+func (w *pdfWriter) field(label, value string) {
+	if label != "" {
+		w.doc.Text(marginLeft, w.y, pdf.Bold, fontSize, label)
+	}
+	w.doc.Text(marginLeft+80, w.y, pdf.Regular, fontSize, value)
+	w.y += lineHeight
+}
+
+func (w *pdfWriter) total(font pdf.Font, label, value string) {
+	w.textRight(columnUnitPrice, font, fontSize, label)
+	w.textRight(marginRight, font, fontSize, value)
+	w.y += lineHeight
+}
+
+func (w *pdfWriter) textRight(right float64, font pdf.Font, size float64, text string) {
+	w.doc.Text(right-pdf.TextWidth(size, text), w.y, font, size, text)
+}
+
+// fit обрезает строку до ширины width, чтобы она не налезала на соседнюю колонку
+func fit(text string, width float64) string {
+	if pdf.TextWidth(fontSize, text) <= width {
+		return text
+	}
+
+	runes := []rune(text)
+	for len(runes) > 0 && pdf.TextWidth(fontSize, string(runes)+"...") > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
+
+func fmtInt(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
@@ -0,0 +1,96 @@
+package invoice
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+var testIssuedAt = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+
+func TestNewInvoiceDocument(t *testing.T) {
+	order := getMockedPaidOrder()
+	parts := []model.Part{
+		{UUID: order.Items[0].PartUUID, Manufacturer: model.Manufacturer{Name: "Energomash", Country: "Russia"}},
+	}
+	invoice := model.Invoice{Number: "INV-2026-000001", IssuedAt: testIssuedAt}
+
+	document, err := newInvoiceDocument(testConfig, invoice, order, parts)
+	require.NoError(t, err)
+
+	require.Len(t, document.Lines, 2)
+	assert.Equal(t, "Energomash, Russia", document.Lines[0].Manufacturer)
+	assert.Equal(t, money.New(3_000_000, money.RUB), document.Lines[0].Amount)
+	// Детали нет в каталоге: позиция остаётся из снимка заказа, без производителя
+	assert.Empty(t, document.Lines[1].Manufacturer)
+	assert.Equal(t, money.New(3_250_000, money.RUB), document.Subtotal)
+	assert.Equal(t, order.TotalPrice, document.Total)
+	assert.Equal(t, string(model.PaymentMethodCard), document.PaymentMethod)
+	assert.Equal(t, *order.TransactionUUID, document.TransactionUUID)
+}
+
+func TestRenderHTML(t *testing.T) {
+	order := getMockedPaidOrder()
+	order.Items[0].Name = `<script>alert("x")</script>`
+	invoice := model.Invoice{Number: "INV-2026-000001", IssuedAt: testIssuedAt}
+
+	document, err := newInvoiceDocument(testConfig, invoice, order, nil)
+	require.NoError(t, err)
+
+	html, err := renderHTML(document)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(html), "Invoice No. INV-2026-000001")
+	assert.Contains(t, string(html), "Иллюминатор")
+	assert.Contains(t, string(html), "29250.00 RUB")
+	assert.Contains(t, string(html), *order.TransactionUUID)
+	assert.NotContains(t, string(html), "<script>")
+
+	again, err := renderHTML(document)
+	require.NoError(t, err)
+	assert.Equal(t, html, again)
+}
+
+func TestRenderPDF(t *testing.T) {
+	order := getMockedPaidOrder()
+	invoice := model.Invoice{Number: "INV-2026-000001", IssuedAt: testIssuedAt}
+
+	document, err := newInvoiceDocument(testConfig, invoice, order, nil)
+	require.NoError(t, err)
+
+	out := renderPDF(document)
+	assert.True(t, bytes.HasPrefix(out, []byte("%PDF-")))
+	assert.Contains(t, string(out), "(No. INV-2026-000001)")
+	assert.Contains(t, string(out), "(Illyuminator)")
+	assert.Contains(t, string(out), "(29250.00 RUB)")
+	assert.Contains(t, string(out), "/Count 1")
+	assert.Equal(t, out, renderPDF(document))
+}
+
+func TestRenderPDFPageBreak(t *testing.T) {
+	order := getMockedPaidOrder()
+	order.Items = nil
+	for i := range 120 {
+		order.Items = append(order.Items, model.OrderItem{
+			PartUUID:  fmt.Sprintf("part-%d", i),
+			Name:      fmt.Sprintf("Part %d with a name long enough to be cut at the quantity column", i),
+			Quantity:  1,
+			UnitPrice: money.New(100, money.RUB),
+		})
+	}
+	invoice := model.Invoice{Number: "INV-2026-000001", IssuedAt: testIssuedAt}
+
+	document, err := newInvoiceDocument(testConfig, invoice, order, nil)
+	require.NoError(t, err)
+
+	out := renderPDF(document)
+	assert.Contains(t, string(out), "/Count 3")
+	assert.Contains(t, string(out), "(Part 119 with a name long enough to be cut a...)")
+}
@@ -0,0 +1,39 @@
+package invoice
+
+import (
+	grpcClient "github.com/Alexey-step/rocket-factory/order/internal/client/grpc"
+	def "github.com/Alexey-step/rocket-factory/order/internal/service"
+)
+
+var _ def.InvoiceService = (*service)(nil)
+
+// Config - реквизиты продавца и формат номера счёта
+type Config struct {
+	SellerName    string
+	SellerAddress string
+	NumberPrefix  string
+}
+
+type service struct {
+	orderRepository   def.OrderRepository
+	invoiceRepository def.InvoiceRepository
+	inventoryClient   grpcClient.InventoryClient
+	txManager         def.TxManager
+	cfg               Config
+}
+
+func NewService(
+	orderRepository def.OrderRepository,
+	invoiceRepository def.InvoiceRepository,
+	inventoryClient grpcClient.InventoryClient,
+	txManager def.TxManager,
+	cfg Config,
+) *service {
+	return &service{
+		orderRepository:   orderRepository,
+		invoiceRepository: invoiceRepository,
+		inventoryClient:   inventoryClient,
+		txManager:         txManager,
+		cfg:               cfg,
+	}
+}
@@ -0,0 +1,97 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Alexey-step/rocket-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InvoiceService is an autogenerated mock type for the InvoiceService type
+type InvoiceService struct {
+	mock.Mock
+}
+
+type InvoiceService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InvoiceService) EXPECT() *InvoiceService_Expecter {
+	return &InvoiceService_Expecter{mock: &_m.Mock}
+}
+
+// GetInvoice provides a mock function with given fields: ctx, userUUID, orderUUID
+func (_m *InvoiceService) GetInvoice(ctx context.Context, userUUID string, orderUUID string) (model.Invoice, error) {
+	ret := _m.Called(ctx, userUUID, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvoice")
+	}
+
+	var r0 model.Invoice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (model.Invoice, error)); ok {
+		return rf(ctx, userUUID, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) model.Invoice); ok {
+		r0 = rf(ctx, userUUID, orderUUID)
+	} else {
+		r0 = ret.Get(0).(model.Invoice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userUUID, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvoiceService_GetInvoice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInvoice'
+type InvoiceService_GetInvoice_Call struct {
+	*mock.Call
+}
+
+// GetInvoice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - orderUUID string
+func (_e *InvoiceService_Expecter) GetInvoice(ctx interface{}, userUUID interface{}, orderUUID interface{}) *InvoiceService_GetInvoice_Call {
+	return &InvoiceService_GetInvoice_Call{Call: _e.mock.On("GetInvoice", ctx, userUUID, orderUUID)}
+}
+
+func (_c *InvoiceService_GetInvoice_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string)) *InvoiceService_GetInvoice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *InvoiceService_GetInvoice_Call) Return(invoice model.Invoice, err error) *InvoiceService_GetInvoice_Call {
+	_c.Call.Return(invoice, err)
+	return _c
+}
+
+func (_c *InvoiceService_GetInvoice_Call) RunAndReturn(run func(context.Context, string, string) (model.Invoice, error)) *InvoiceService_GetInvoice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInvoiceService creates a new instance of InvoiceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInvoiceService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InvoiceService {
+	mock := &InvoiceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
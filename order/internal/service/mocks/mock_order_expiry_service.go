@@ -0,0 +1,84 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrderExpiryService is an autogenerated mock type for the OrderExpiryService type
+type OrderExpiryService struct {
+	mock.Mock
+}
+
+type OrderExpiryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderExpiryService) EXPECT() *OrderExpiryService_Expecter {
+	return &OrderExpiryService_Expecter{mock: &_m.Mock}
+}
+
+// RunSweeper provides a mock function with given fields: ctx
+func (_m *OrderExpiryService) RunSweeper(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunSweeper")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderExpiryService_RunSweeper_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunSweeper'
+type OrderExpiryService_RunSweeper_Call struct {
+	*mock.Call
+}
+
+// RunSweeper is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OrderExpiryService_Expecter) RunSweeper(ctx interface{}) *OrderExpiryService_RunSweeper_Call {
+	return &OrderExpiryService_RunSweeper_Call{Call: _e.mock.On("RunSweeper", ctx)}
+}
+
+func (_c *OrderExpiryService_RunSweeper_Call) Run(run func(ctx context.Context)) *OrderExpiryService_RunSweeper_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OrderExpiryService_RunSweeper_Call) Return(_a0 error) *OrderExpiryService_RunSweeper_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderExpiryService_RunSweeper_Call) RunAndReturn(run func(context.Context) error) *OrderExpiryService_RunSweeper_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderExpiryService creates a new instance of OrderExpiryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderExpiryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderExpiryService {
+	mock := &OrderExpiryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
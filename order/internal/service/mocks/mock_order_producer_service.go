@@ -24,6 +24,194 @@ func (_m *OrderProducerService) EXPECT() *OrderProducerService_Expecter {
 	return &OrderProducerService_Expecter{mock: &_m.Mock}
 }
 
+// ProduceOrderCancelled provides a mock function with given fields: ctx, event
+func (_m *OrderProducerService) ProduceOrderCancelled(ctx context.Context, event model.OrderCancelled) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceOrderCancelled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderCancelled) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderProducerService_ProduceOrderCancelled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProduceOrderCancelled'
+type OrderProducerService_ProduceOrderCancelled_Call struct {
+	*mock.Call
+}
+
+// ProduceOrderCancelled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.OrderCancelled
+func (_e *OrderProducerService_Expecter) ProduceOrderCancelled(ctx interface{}, event interface{}) *OrderProducerService_ProduceOrderCancelled_Call {
+	return &OrderProducerService_ProduceOrderCancelled_Call{Call: _e.mock.On("ProduceOrderCancelled", ctx, event)}
+}
+
+func (_c *OrderProducerService_ProduceOrderCancelled_Call) Run(run func(ctx context.Context, event model.OrderCancelled)) *OrderProducerService_ProduceOrderCancelled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrderCancelled))
+	})
+	return _c
+}
+
+func (_c *OrderProducerService_ProduceOrderCancelled_Call) Return(_a0 error) *OrderProducerService_ProduceOrderCancelled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderProducerService_ProduceOrderCancelled_Call) RunAndReturn(run func(context.Context, model.OrderCancelled) error) *OrderProducerService_ProduceOrderCancelled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProduceOrderCompleted provides a mock function with given fields: ctx, event
+func (_m *OrderProducerService) ProduceOrderCompleted(ctx context.Context, event model.OrderCompleted) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceOrderCompleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderCompleted) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderProducerService_ProduceOrderCompleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProduceOrderCompleted'
+type OrderProducerService_ProduceOrderCompleted_Call struct {
+	*mock.Call
+}
+
+// ProduceOrderCompleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.OrderCompleted
+func (_e *OrderProducerService_Expecter) ProduceOrderCompleted(ctx interface{}, event interface{}) *OrderProducerService_ProduceOrderCompleted_Call {
+	return &OrderProducerService_ProduceOrderCompleted_Call{Call: _e.mock.On("ProduceOrderCompleted", ctx, event)}
+}
+
+func (_c *OrderProducerService_ProduceOrderCompleted_Call) Run(run func(ctx context.Context, event model.OrderCompleted)) *OrderProducerService_ProduceOrderCompleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrderCompleted))
+	})
+	return _c
+}
+
+func (_c *OrderProducerService_ProduceOrderCompleted_Call) Return(_a0 error) *OrderProducerService_ProduceOrderCompleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderProducerService_ProduceOrderCompleted_Call) RunAndReturn(run func(context.Context, model.OrderCompleted) error) *OrderProducerService_ProduceOrderCompleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProduceOrderCreated provides a mock function with given fields: ctx, event
+func (_m *OrderProducerService) ProduceOrderCreated(ctx context.Context, event model.OrderCreated) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceOrderCreated")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderCreated) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderProducerService_ProduceOrderCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProduceOrderCreated'
+type OrderProducerService_ProduceOrderCreated_Call struct {
+	*mock.Call
+}
+
+// ProduceOrderCreated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.OrderCreated
+func (_e *OrderProducerService_Expecter) ProduceOrderCreated(ctx interface{}, event interface{}) *OrderProducerService_ProduceOrderCreated_Call {
+	return &OrderProducerService_ProduceOrderCreated_Call{Call: _e.mock.On("ProduceOrderCreated", ctx, event)}
+}
+
+func (_c *OrderProducerService_ProduceOrderCreated_Call) Run(run func(ctx context.Context, event model.OrderCreated)) *OrderProducerService_ProduceOrderCreated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrderCreated))
+	})
+	return _c
+}
+
+func (_c *OrderProducerService_ProduceOrderCreated_Call) Return(_a0 error) *OrderProducerService_ProduceOrderCreated_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderProducerService_ProduceOrderCreated_Call) RunAndReturn(run func(context.Context, model.OrderCreated) error) *OrderProducerService_ProduceOrderCreated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProduceOrderExpired provides a mock function with given fields: ctx, event
+func (_m *OrderProducerService) ProduceOrderExpired(ctx context.Context, event model.OrderExpired) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceOrderExpired")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderExpired) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderProducerService_ProduceOrderExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProduceOrderExpired'
+type OrderProducerService_ProduceOrderExpired_Call struct {
+	*mock.Call
+}
+
+// ProduceOrderExpired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.OrderExpired
+func (_e *OrderProducerService_Expecter) ProduceOrderExpired(ctx interface{}, event interface{}) *OrderProducerService_ProduceOrderExpired_Call {
+	return &OrderProducerService_ProduceOrderExpired_Call{Call: _e.mock.On("ProduceOrderExpired", ctx, event)}
+}
+
+func (_c *OrderProducerService_ProduceOrderExpired_Call) Run(run func(ctx context.Context, event model.OrderExpired)) *OrderProducerService_ProduceOrderExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrderExpired))
+	})
+	return _c
+}
+
+func (_c *OrderProducerService_ProduceOrderExpired_Call) Return(_a0 error) *OrderProducerService_ProduceOrderExpired_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderProducerService_ProduceOrderExpired_Call) RunAndReturn(run func(context.Context, model.OrderExpired) error) *OrderProducerService_ProduceOrderExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ProduceOrderPaid provides a mock function with given fields: ctx, event
 func (_m *OrderProducerService) ProduceOrderPaid(ctx context.Context, event model.OrderPaid) error {
 	ret := _m.Called(ctx, event)
@@ -71,6 +259,53 @@ func (_c *OrderProducerService_ProduceOrderPaid_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// ProduceOrderRefunded provides a mock function with given fields: ctx, event
+func (_m *OrderProducerService) ProduceOrderRefunded(ctx context.Context, event model.OrderRefunded) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceOrderRefunded")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderRefunded) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderProducerService_ProduceOrderRefunded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProduceOrderRefunded'
+type OrderProducerService_ProduceOrderRefunded_Call struct {
+	*mock.Call
+}
+
+// ProduceOrderRefunded is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.OrderRefunded
+func (_e *OrderProducerService_Expecter) ProduceOrderRefunded(ctx interface{}, event interface{}) *OrderProducerService_ProduceOrderRefunded_Call {
+	return &OrderProducerService_ProduceOrderRefunded_Call{Call: _e.mock.On("ProduceOrderRefunded", ctx, event)}
+}
+
+func (_c *OrderProducerService_ProduceOrderRefunded_Call) Run(run func(ctx context.Context, event model.OrderRefunded)) *OrderProducerService_ProduceOrderRefunded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrderRefunded))
+	})
+	return _c
+}
+
+func (_c *OrderProducerService_ProduceOrderRefunded_Call) Return(_a0 error) *OrderProducerService_ProduceOrderRefunded_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderProducerService_ProduceOrderRefunded_Call) RunAndReturn(run func(context.Context, model.OrderRefunded) error) *OrderProducerService_ProduceOrderRefunded_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewOrderProducerService creates a new instance of OrderProducerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOrderProducerService(t interface {
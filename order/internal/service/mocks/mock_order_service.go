@@ -9,6 +9,8 @@ import (
 
 	model "github.com/Alexey-step/rocket-factory/order/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // OrderService is an autogenerated mock type for the OrderService type
@@ -24,17 +26,75 @@ func (_m *OrderService) EXPECT() *OrderService_Expecter {
 	return &OrderService_Expecter{mock: &_m.Mock}
 }
 
-// CancelOrder provides a mock function with given fields: ctx, orderUUID
-func (_m *OrderService) CancelOrder(ctx context.Context, orderUUID string) error {
-	ret := _m.Called(ctx, orderUUID)
+// AddDraftItem provides a mock function with given fields: ctx, userUUID, item
+func (_m *OrderService) AddDraftItem(ctx context.Context, userUUID string, item model.OrderItemInfo) (model.OrderData, error) {
+	ret := _m.Called(ctx, userUUID, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDraftItem")
+	}
+
+	var r0 model.OrderData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrderItemInfo) (model.OrderData, error)); ok {
+		return rf(ctx, userUUID, item)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrderItemInfo) model.OrderData); ok {
+		r0 = rf(ctx, userUUID, item)
+	} else {
+		r0 = ret.Get(0).(model.OrderData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.OrderItemInfo) error); ok {
+		r1 = rf(ctx, userUUID, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_AddDraftItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDraftItem'
+type OrderService_AddDraftItem_Call struct {
+	*mock.Call
+}
+
+// AddDraftItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - item model.OrderItemInfo
+func (_e *OrderService_Expecter) AddDraftItem(ctx interface{}, userUUID interface{}, item interface{}) *OrderService_AddDraftItem_Call {
+	return &OrderService_AddDraftItem_Call{Call: _e.mock.On("AddDraftItem", ctx, userUUID, item)}
+}
+
+func (_c *OrderService_AddDraftItem_Call) Run(run func(ctx context.Context, userUUID string, item model.OrderItemInfo)) *OrderService_AddDraftItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.OrderItemInfo))
+	})
+	return _c
+}
+
+func (_c *OrderService_AddDraftItem_Call) Return(draft model.OrderData, err error) *OrderService_AddDraftItem_Call {
+	_c.Call.Return(draft, err)
+	return _c
+}
+
+func (_c *OrderService_AddDraftItem_Call) RunAndReturn(run func(context.Context, string, model.OrderItemInfo) (model.OrderData, error)) *OrderService_AddDraftItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelOrder provides a mock function with given fields: ctx, userUUID, orderUUID
+func (_m *OrderService) CancelOrder(ctx context.Context, userUUID string, orderUUID string) error {
+	ret := _m.Called(ctx, userUUID, orderUUID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CancelOrder")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, orderUUID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userUUID, orderUUID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -49,14 +109,15 @@ type OrderService_CancelOrder_Call struct {
 
 // CancelOrder is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userUUID string
 //   - orderUUID string
-func (_e *OrderService_Expecter) CancelOrder(ctx interface{}, orderUUID interface{}) *OrderService_CancelOrder_Call {
-	return &OrderService_CancelOrder_Call{Call: _e.mock.On("CancelOrder", ctx, orderUUID)}
+func (_e *OrderService_Expecter) CancelOrder(ctx interface{}, userUUID interface{}, orderUUID interface{}) *OrderService_CancelOrder_Call {
+	return &OrderService_CancelOrder_Call{Call: _e.mock.On("CancelOrder", ctx, userUUID, orderUUID)}
 }
 
-func (_c *OrderService_CancelOrder_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderService_CancelOrder_Call {
+func (_c *OrderService_CancelOrder_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string)) *OrderService_CancelOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -66,14 +127,73 @@ func (_c *OrderService_CancelOrder_Call) Return(_a0 error) *OrderService_CancelO
 	return _c
 }
 
-func (_c *OrderService_CancelOrder_Call) RunAndReturn(run func(context.Context, string) error) *OrderService_CancelOrder_Call {
+func (_c *OrderService_CancelOrder_Call) RunAndReturn(run func(context.Context, string, string) error) *OrderService_CancelOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckoutDraft provides a mock function with given fields: ctx, userUUID, checkout, idempotencyKey
+func (_m *OrderService) CheckoutDraft(ctx context.Context, userUUID string, checkout model.DraftCheckout, idempotencyKey string) (model.OrderCreationInfo, error) {
+	ret := _m.Called(ctx, userUUID, checkout, idempotencyKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckoutDraft")
+	}
+
+	var r0 model.OrderCreationInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.DraftCheckout, string) (model.OrderCreationInfo, error)); ok {
+		return rf(ctx, userUUID, checkout, idempotencyKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.DraftCheckout, string) model.OrderCreationInfo); ok {
+		r0 = rf(ctx, userUUID, checkout, idempotencyKey)
+	} else {
+		r0 = ret.Get(0).(model.OrderCreationInfo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.DraftCheckout, string) error); ok {
+		r1 = rf(ctx, userUUID, checkout, idempotencyKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_CheckoutDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckoutDraft'
+type OrderService_CheckoutDraft_Call struct {
+	*mock.Call
+}
+
+// CheckoutDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - checkout model.DraftCheckout
+//   - idempotencyKey string
+func (_e *OrderService_Expecter) CheckoutDraft(ctx interface{}, userUUID interface{}, checkout interface{}, idempotencyKey interface{}) *OrderService_CheckoutDraft_Call {
+	return &OrderService_CheckoutDraft_Call{Call: _e.mock.On("CheckoutDraft", ctx, userUUID, checkout, idempotencyKey)}
+}
+
+func (_c *OrderService_CheckoutDraft_Call) Run(run func(ctx context.Context, userUUID string, checkout model.DraftCheckout, idempotencyKey string)) *OrderService_CheckoutDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.DraftCheckout), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *OrderService_CheckoutDraft_Call) Return(info model.OrderCreationInfo, err error) *OrderService_CheckoutDraft_Call {
+	_c.Call.Return(info, err)
+	return _c
+}
+
+func (_c *OrderService_CheckoutDraft_Call) RunAndReturn(run func(context.Context, string, model.DraftCheckout, string) (model.OrderCreationInfo, error)) *OrderService_CheckoutDraft_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateOrder provides a mock function with given fields: ctx, userUUID, partsUUIDs
-func (_m *OrderService) CreateOrder(ctx context.Context, userUUID string, partsUUIDs []string) (model.OrderCreationInfo, error) {
-	ret := _m.Called(ctx, userUUID, partsUUIDs)
+// CreateOrder provides a mock function with given fields: ctx, userUUID, request, idempotencyKey
+func (_m *OrderService) CreateOrder(ctx context.Context, userUUID string, request model.OrderRequest, idempotencyKey string) (model.OrderCreationInfo, error) {
+	ret := _m.Called(ctx, userUUID, request, idempotencyKey)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateOrder")
@@ -81,17 +201,17 @@ func (_m *OrderService) CreateOrder(ctx context.Context, userUUID string, partsU
 
 	var r0 model.OrderCreationInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string) (model.OrderCreationInfo, error)); ok {
-		return rf(ctx, userUUID, partsUUIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrderRequest, string) (model.OrderCreationInfo, error)); ok {
+		return rf(ctx, userUUID, request, idempotencyKey)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string) model.OrderCreationInfo); ok {
-		r0 = rf(ctx, userUUID, partsUUIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrderRequest, string) model.OrderCreationInfo); ok {
+		r0 = rf(ctx, userUUID, request, idempotencyKey)
 	} else {
 		r0 = ret.Get(0).(model.OrderCreationInfo)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
-		r1 = rf(ctx, userUUID, partsUUIDs)
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.OrderRequest, string) error); ok {
+		r1 = rf(ctx, userUUID, request, idempotencyKey)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -107,14 +227,15 @@ type OrderService_CreateOrder_Call struct {
 // CreateOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userUUID string
-//   - partsUUIDs []string
-func (_e *OrderService_Expecter) CreateOrder(ctx interface{}, userUUID interface{}, partsUUIDs interface{}) *OrderService_CreateOrder_Call {
-	return &OrderService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, userUUID, partsUUIDs)}
+//   - request model.OrderRequest
+//   - idempotencyKey string
+func (_e *OrderService_Expecter) CreateOrder(ctx interface{}, userUUID interface{}, request interface{}, idempotencyKey interface{}) *OrderService_CreateOrder_Call {
+	return &OrderService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, userUUID, request, idempotencyKey)}
 }
 
-func (_c *OrderService_CreateOrder_Call) Run(run func(ctx context.Context, userUUID string, partsUUIDs []string)) *OrderService_CreateOrder_Call {
+func (_c *OrderService_CreateOrder_Call) Run(run func(ctx context.Context, userUUID string, request model.OrderRequest, idempotencyKey string)) *OrderService_CreateOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+		run(args[0].(context.Context), args[1].(string), args[2].(model.OrderRequest), args[3].(string))
 	})
 	return _c
 }
@@ -124,32 +245,147 @@ func (_c *OrderService_CreateOrder_Call) Return(info model.OrderCreationInfo, er
 	return _c
 }
 
-func (_c *OrderService_CreateOrder_Call) RunAndReturn(run func(context.Context, string, []string) (model.OrderCreationInfo, error)) *OrderService_CreateOrder_Call {
+func (_c *OrderService_CreateOrder_Call) RunAndReturn(run func(context.Context, string, model.OrderRequest, string) (model.OrderCreationInfo, error)) *OrderService_CreateOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrder provides a mock function with given fields: ctx, orderUUID
-func (_m *OrderService) GetOrder(ctx context.Context, orderUUID string) (model.OrderData, error) {
-	ret := _m.Called(ctx, orderUUID)
+// ExpireOrders provides a mock function with given fields: ctx, createdBefore, limit
+func (_m *OrderService) ExpireOrders(ctx context.Context, createdBefore time.Time, limit int) (int, error) {
+	ret := _m.Called(ctx, createdBefore, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrder")
+		panic("no return value specified for ExpireOrders")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (int, error)); ok {
+		return rf(ctx, createdBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) int); ok {
+		r0 = rf(ctx, createdBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, createdBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_ExpireOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireOrders'
+type OrderService_ExpireOrders_Call struct {
+	*mock.Call
+}
+
+// ExpireOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+//   - limit int
+func (_e *OrderService_Expecter) ExpireOrders(ctx interface{}, createdBefore interface{}, limit interface{}) *OrderService_ExpireOrders_Call {
+	return &OrderService_ExpireOrders_Call{Call: _e.mock.On("ExpireOrders", ctx, createdBefore, limit)}
+}
+
+func (_c *OrderService_ExpireOrders_Call) Run(run func(ctx context.Context, createdBefore time.Time, limit int)) *OrderService_ExpireOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *OrderService_ExpireOrders_Call) Return(expired int, err error) *OrderService_ExpireOrders_Call {
+	_c.Call.Return(expired, err)
+	return _c
+}
+
+func (_c *OrderService_ExpireOrders_Call) RunAndReturn(run func(context.Context, time.Time, int) (int, error)) *OrderService_ExpireOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDraft provides a mock function with given fields: ctx, userUUID
+func (_m *OrderService) GetDraft(ctx context.Context, userUUID string) (model.OrderData, error) {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDraft")
 	}
 
 	var r0 model.OrderData
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) (model.OrderData, error)); ok {
-		return rf(ctx, orderUUID)
+		return rf(ctx, userUUID)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, string) model.OrderData); ok {
-		r0 = rf(ctx, orderUUID)
+		r0 = rf(ctx, userUUID)
 	} else {
 		r0 = ret.Get(0).(model.OrderData)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, orderUUID)
+		r1 = rf(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_GetDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDraft'
+type OrderService_GetDraft_Call struct {
+	*mock.Call
+}
+
+// GetDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+func (_e *OrderService_Expecter) GetDraft(ctx interface{}, userUUID interface{}) *OrderService_GetDraft_Call {
+	return &OrderService_GetDraft_Call{Call: _e.mock.On("GetDraft", ctx, userUUID)}
+}
+
+func (_c *OrderService_GetDraft_Call) Run(run func(ctx context.Context, userUUID string)) *OrderService_GetDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrderService_GetDraft_Call) Return(draft model.OrderData, err error) *OrderService_GetDraft_Call {
+	_c.Call.Return(draft, err)
+	return _c
+}
+
+func (_c *OrderService_GetDraft_Call) RunAndReturn(run func(context.Context, string) (model.OrderData, error)) *OrderService_GetDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrder provides a mock function with given fields: ctx, userUUID, orderUUID
+func (_m *OrderService) GetOrder(ctx context.Context, userUUID string, orderUUID string) (model.OrderData, error) {
+	ret := _m.Called(ctx, userUUID, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrder")
+	}
+
+	var r0 model.OrderData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (model.OrderData, error)); ok {
+		return rf(ctx, userUUID, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) model.OrderData); ok {
+		r0 = rf(ctx, userUUID, orderUUID)
+	} else {
+		r0 = ret.Get(0).(model.OrderData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userUUID, orderUUID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -164,14 +400,15 @@ type OrderService_GetOrder_Call struct {
 
 // GetOrder is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userUUID string
 //   - orderUUID string
-func (_e *OrderService_Expecter) GetOrder(ctx interface{}, orderUUID interface{}) *OrderService_GetOrder_Call {
-	return &OrderService_GetOrder_Call{Call: _e.mock.On("GetOrder", ctx, orderUUID)}
+func (_e *OrderService_Expecter) GetOrder(ctx interface{}, userUUID interface{}, orderUUID interface{}) *OrderService_GetOrder_Call {
+	return &OrderService_GetOrder_Call{Call: _e.mock.On("GetOrder", ctx, userUUID, orderUUID)}
 }
 
-func (_c *OrderService_GetOrder_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderService_GetOrder_Call {
+func (_c *OrderService_GetOrder_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string)) *OrderService_GetOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -181,14 +418,131 @@ func (_c *OrderService_GetOrder_Call) Return(order model.OrderData, err error) *
 	return _c
 }
 
-func (_c *OrderService_GetOrder_Call) RunAndReturn(run func(context.Context, string) (model.OrderData, error)) *OrderService_GetOrder_Call {
+func (_c *OrderService_GetOrder_Call) RunAndReturn(run func(context.Context, string, string) (model.OrderData, error)) *OrderService_GetOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderHistory provides a mock function with given fields: ctx, userUUID, orderUUID
+func (_m *OrderService) GetOrderHistory(ctx context.Context, userUUID string, orderUUID string) ([]model.OrderStatusHistory, error) {
+	ret := _m.Called(ctx, userUUID, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderHistory")
+	}
+
+	var r0 []model.OrderStatusHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]model.OrderStatusHistory, error)); ok {
+		return rf(ctx, userUUID, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []model.OrderStatusHistory); ok {
+		r0 = rf(ctx, userUUID, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OrderStatusHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userUUID, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_GetOrderHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderHistory'
+type OrderService_GetOrderHistory_Call struct {
+	*mock.Call
+}
+
+// GetOrderHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - orderUUID string
+func (_e *OrderService_Expecter) GetOrderHistory(ctx interface{}, userUUID interface{}, orderUUID interface{}) *OrderService_GetOrderHistory_Call {
+	return &OrderService_GetOrderHistory_Call{Call: _e.mock.On("GetOrderHistory", ctx, userUUID, orderUUID)}
+}
+
+func (_c *OrderService_GetOrderHistory_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string)) *OrderService_GetOrderHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OrderService_GetOrderHistory_Call) Return(history []model.OrderStatusHistory, err error) *OrderService_GetOrderHistory_Call {
+	_c.Call.Return(history, err)
+	return _c
+}
+
+func (_c *OrderService_GetOrderHistory_Call) RunAndReturn(run func(context.Context, string, string) ([]model.OrderStatusHistory, error)) *OrderService_GetOrderHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function with given fields: ctx, filter
+func (_m *OrderService) ListOrders(ctx context.Context, filter model.OrdersFilter) (model.OrdersPage, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 model.OrdersPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrdersFilter) (model.OrdersPage, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrdersFilter) model.OrdersPage); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(model.OrdersPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.OrdersFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type OrderService_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.OrdersFilter
+func (_e *OrderService_Expecter) ListOrders(ctx interface{}, filter interface{}) *OrderService_ListOrders_Call {
+	return &OrderService_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, filter)}
+}
+
+func (_c *OrderService_ListOrders_Call) Run(run func(ctx context.Context, filter model.OrdersFilter)) *OrderService_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrdersFilter))
+	})
+	return _c
+}
+
+func (_c *OrderService_ListOrders_Call) Return(page model.OrdersPage, err error) *OrderService_ListOrders_Call {
+	_c.Call.Return(page, err)
+	return _c
+}
+
+func (_c *OrderService_ListOrders_Call) RunAndReturn(run func(context.Context, model.OrdersFilter) (model.OrdersPage, error)) *OrderService_ListOrders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PayOrder provides a mock function with given fields: ctx, orderUUID, paymentMethod
-func (_m *OrderService) PayOrder(ctx context.Context, orderUUID string, paymentMethod string) (string, error) {
-	ret := _m.Called(ctx, orderUUID, paymentMethod)
+// PayOrder provides a mock function with given fields: ctx, userUUID, orderUUID, paymentMethod, idempotencyKey
+func (_m *OrderService) PayOrder(ctx context.Context, userUUID string, orderUUID string, paymentMethod string, idempotencyKey string) (string, error) {
+	ret := _m.Called(ctx, userUUID, orderUUID, paymentMethod, idempotencyKey)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
@@ -196,17 +550,17 @@ func (_m *OrderService) PayOrder(ctx context.Context, orderUUID string, paymentM
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
-		return rf(ctx, orderUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (string, error)); ok {
+		return rf(ctx, userUUID, orderUUID, paymentMethod, idempotencyKey)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
-		r0 = rf(ctx, orderUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) string); ok {
+		r0 = rf(ctx, userUUID, orderUUID, paymentMethod, idempotencyKey)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, orderUUID, paymentMethod)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, userUUID, orderUUID, paymentMethod, idempotencyKey)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -221,15 +575,17 @@ type OrderService_PayOrder_Call struct {
 
 // PayOrder is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userUUID string
 //   - orderUUID string
 //   - paymentMethod string
-func (_e *OrderService_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, paymentMethod interface{}) *OrderService_PayOrder_Call {
-	return &OrderService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, paymentMethod)}
+//   - idempotencyKey string
+func (_e *OrderService_Expecter) PayOrder(ctx interface{}, userUUID interface{}, orderUUID interface{}, paymentMethod interface{}, idempotencyKey interface{}) *OrderService_PayOrder_Call {
+	return &OrderService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, userUUID, orderUUID, paymentMethod, idempotencyKey)}
 }
 
-func (_c *OrderService_PayOrder_Call) Run(run func(ctx context.Context, orderUUID string, paymentMethod string)) *OrderService_PayOrder_Call {
+func (_c *OrderService_PayOrder_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string, paymentMethod string, idempotencyKey string)) *OrderService_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
 	})
 	return _c
 }
@@ -239,22 +595,196 @@ func (_c *OrderService_PayOrder_Call) Return(transactionUUID string, err error)
 	return _c
 }
 
-func (_c *OrderService_PayOrder_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *OrderService_PayOrder_Call {
+func (_c *OrderService_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, string, string) (string, error)) *OrderService_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QuoteOrder provides a mock function with given fields: ctx, request
+func (_m *OrderService) QuoteOrder(ctx context.Context, request model.OrderRequest) (model.OrderQuote, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QuoteOrder")
+	}
+
+	var r0 model.OrderQuote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderRequest) (model.OrderQuote, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderRequest) model.OrderQuote); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Get(0).(model.OrderQuote)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.OrderRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_QuoteOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QuoteOrder'
+type OrderService_QuoteOrder_Call struct {
+	*mock.Call
+}
+
+// QuoteOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request model.OrderRequest
+func (_e *OrderService_Expecter) QuoteOrder(ctx interface{}, request interface{}) *OrderService_QuoteOrder_Call {
+	return &OrderService_QuoteOrder_Call{Call: _e.mock.On("QuoteOrder", ctx, request)}
+}
+
+func (_c *OrderService_QuoteOrder_Call) Run(run func(ctx context.Context, request model.OrderRequest)) *OrderService_QuoteOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrderRequest))
+	})
+	return _c
+}
+
+func (_c *OrderService_QuoteOrder_Call) Return(quote model.OrderQuote, err error) *OrderService_QuoteOrder_Call {
+	_c.Call.Return(quote, err)
+	return _c
+}
+
+func (_c *OrderService_QuoteOrder_Call) RunAndReturn(run func(context.Context, model.OrderRequest) (model.OrderQuote, error)) *OrderService_QuoteOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveDraftItem provides a mock function with given fields: ctx, userUUID, partUUID
+func (_m *OrderService) RemoveDraftItem(ctx context.Context, userUUID string, partUUID string) (model.OrderData, error) {
+	ret := _m.Called(ctx, userUUID, partUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveDraftItem")
+	}
+
+	var r0 model.OrderData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (model.OrderData, error)); ok {
+		return rf(ctx, userUUID, partUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) model.OrderData); ok {
+		r0 = rf(ctx, userUUID, partUUID)
+	} else {
+		r0 = ret.Get(0).(model.OrderData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userUUID, partUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_RemoveDraftItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveDraftItem'
+type OrderService_RemoveDraftItem_Call struct {
+	*mock.Call
+}
+
+// RemoveDraftItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - partUUID string
+func (_e *OrderService_Expecter) RemoveDraftItem(ctx interface{}, userUUID interface{}, partUUID interface{}) *OrderService_RemoveDraftItem_Call {
+	return &OrderService_RemoveDraftItem_Call{Call: _e.mock.On("RemoveDraftItem", ctx, userUUID, partUUID)}
+}
+
+func (_c *OrderService_RemoveDraftItem_Call) Run(run func(ctx context.Context, userUUID string, partUUID string)) *OrderService_RemoveDraftItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OrderService_RemoveDraftItem_Call) Return(draft model.OrderData, err error) *OrderService_RemoveDraftItem_Call {
+	_c.Call.Return(draft, err)
+	return _c
+}
+
+func (_c *OrderService_RemoveDraftItem_Call) RunAndReturn(run func(context.Context, string, string) (model.OrderData, error)) *OrderService_RemoveDraftItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDraftItemQuantity provides a mock function with given fields: ctx, userUUID, partUUID, quantity
+func (_m *OrderService) SetDraftItemQuantity(ctx context.Context, userUUID string, partUUID string, quantity int64) (model.OrderData, error) {
+	ret := _m.Called(ctx, userUUID, partUUID, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDraftItemQuantity")
+	}
+
+	var r0 model.OrderData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) (model.OrderData, error)); ok {
+		return rf(ctx, userUUID, partUUID, quantity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) model.OrderData); ok {
+		r0 = rf(ctx, userUUID, partUUID, quantity)
+	} else {
+		r0 = ret.Get(0).(model.OrderData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64) error); ok {
+		r1 = rf(ctx, userUUID, partUUID, quantity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_SetDraftItemQuantity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDraftItemQuantity'
+type OrderService_SetDraftItemQuantity_Call struct {
+	*mock.Call
+}
+
+// SetDraftItemQuantity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - partUUID string
+//   - quantity int64
+func (_e *OrderService_Expecter) SetDraftItemQuantity(ctx interface{}, userUUID interface{}, partUUID interface{}, quantity interface{}) *OrderService_SetDraftItemQuantity_Call {
+	return &OrderService_SetDraftItemQuantity_Call{Call: _e.mock.On("SetDraftItemQuantity", ctx, userUUID, partUUID, quantity)}
+}
+
+func (_c *OrderService_SetDraftItemQuantity_Call) Run(run func(ctx context.Context, userUUID string, partUUID string, quantity int64)) *OrderService_SetDraftItemQuantity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *OrderService_SetDraftItemQuantity_Call) Return(draft model.OrderData, err error) *OrderService_SetDraftItemQuantity_Call {
+	_c.Call.Return(draft, err)
+	return _c
+}
+
+func (_c *OrderService_SetDraftItemQuantity_Call) RunAndReturn(run func(context.Context, string, string, int64) (model.OrderData, error)) *OrderService_SetDraftItemQuantity_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateStatus provides a mock function with given fields: ctx, orderUUID, status
-func (_m *OrderService) UpdateStatus(ctx context.Context, orderUUID string, status model.OrderStatus) error {
-	ret := _m.Called(ctx, orderUUID, status)
+// UpdateStatus provides a mock function with given fields: ctx, orderUUID, status, change
+func (_m *OrderService) UpdateStatus(ctx context.Context, orderUUID string, status model.OrderStatus, change model.StatusChange) error {
+	ret := _m.Called(ctx, orderUUID, status, change)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateStatus")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrderStatus) error); ok {
-		r0 = rf(ctx, orderUUID, status)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrderStatus, model.StatusChange) error); ok {
+		r0 = rf(ctx, orderUUID, status, change)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -271,13 +801,14 @@ type OrderService_UpdateStatus_Call struct {
 //   - ctx context.Context
 //   - orderUUID string
 //   - status model.OrderStatus
-func (_e *OrderService_Expecter) UpdateStatus(ctx interface{}, orderUUID interface{}, status interface{}) *OrderService_UpdateStatus_Call {
-	return &OrderService_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, orderUUID, status)}
+//   - change model.StatusChange
+func (_e *OrderService_Expecter) UpdateStatus(ctx interface{}, orderUUID interface{}, status interface{}, change interface{}) *OrderService_UpdateStatus_Call {
+	return &OrderService_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, orderUUID, status, change)}
 }
 
-func (_c *OrderService_UpdateStatus_Call) Run(run func(ctx context.Context, orderUUID string, status model.OrderStatus)) *OrderService_UpdateStatus_Call {
+func (_c *OrderService_UpdateStatus_Call) Run(run func(ctx context.Context, orderUUID string, status model.OrderStatus, change model.StatusChange)) *OrderService_UpdateStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(model.OrderStatus))
+		run(args[0].(context.Context), args[1].(string), args[2].(model.OrderStatus), args[3].(model.StatusChange))
 	})
 	return _c
 }
@@ -287,7 +818,7 @@ func (_c *OrderService_UpdateStatus_Call) Return(_a0 error) *OrderService_Update
 	return _c
 }
 
-func (_c *OrderService_UpdateStatus_Call) RunAndReturn(run func(context.Context, string, model.OrderStatus) error) *OrderService_UpdateStatus_Call {
+func (_c *OrderService_UpdateStatus_Call) RunAndReturn(run func(context.Context, string, model.OrderStatus, model.StatusChange) error) *OrderService_UpdateStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
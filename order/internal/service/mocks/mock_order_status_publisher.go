@@ -0,0 +1,86 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Alexey-step/rocket-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrderStatusPublisher is an autogenerated mock type for the OrderStatusPublisher type
+type OrderStatusPublisher struct {
+	mock.Mock
+}
+
+type OrderStatusPublisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderStatusPublisher) EXPECT() *OrderStatusPublisher_Expecter {
+	return &OrderStatusPublisher_Expecter{mock: &_m.Mock}
+}
+
+// PublishStatusChange provides a mock function with given fields: ctx, change
+func (_m *OrderStatusPublisher) PublishStatusChange(ctx context.Context, change model.OrderStatusChange) error {
+	ret := _m.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishStatusChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderStatusChange) error); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderStatusPublisher_PublishStatusChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishStatusChange'
+type OrderStatusPublisher_PublishStatusChange_Call struct {
+	*mock.Call
+}
+
+// PublishStatusChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - change model.OrderStatusChange
+func (_e *OrderStatusPublisher_Expecter) PublishStatusChange(ctx interface{}, change interface{}) *OrderStatusPublisher_PublishStatusChange_Call {
+	return &OrderStatusPublisher_PublishStatusChange_Call{Call: _e.mock.On("PublishStatusChange", ctx, change)}
+}
+
+func (_c *OrderStatusPublisher_PublishStatusChange_Call) Run(run func(ctx context.Context, change model.OrderStatusChange)) *OrderStatusPublisher_PublishStatusChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrderStatusChange))
+	})
+	return _c
+}
+
+func (_c *OrderStatusPublisher_PublishStatusChange_Call) Return(_a0 error) *OrderStatusPublisher_PublishStatusChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderStatusPublisher_PublishStatusChange_Call) RunAndReturn(run func(context.Context, model.OrderStatusChange) error) *OrderStatusPublisher_PublishStatusChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderStatusPublisher creates a new instance of OrderStatusPublisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderStatusPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderStatusPublisher {
+	mock := &OrderStatusPublisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
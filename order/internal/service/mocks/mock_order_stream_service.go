@@ -0,0 +1,181 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Alexey-step/rocket-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrderStreamService is an autogenerated mock type for the OrderStreamService type
+type OrderStreamService struct {
+	mock.Mock
+}
+
+type OrderStreamService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderStreamService) EXPECT() *OrderStreamService_Expecter {
+	return &OrderStreamService_Expecter{mock: &_m.Mock}
+}
+
+// PublishStatusChange provides a mock function with given fields: ctx, change
+func (_m *OrderStreamService) PublishStatusChange(ctx context.Context, change model.OrderStatusChange) error {
+	ret := _m.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishStatusChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderStatusChange) error); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderStreamService_PublishStatusChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishStatusChange'
+type OrderStreamService_PublishStatusChange_Call struct {
+	*mock.Call
+}
+
+// PublishStatusChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - change model.OrderStatusChange
+func (_e *OrderStreamService_Expecter) PublishStatusChange(ctx interface{}, change interface{}) *OrderStreamService_PublishStatusChange_Call {
+	return &OrderStreamService_PublishStatusChange_Call{Call: _e.mock.On("PublishStatusChange", ctx, change)}
+}
+
+func (_c *OrderStreamService_PublishStatusChange_Call) Run(run func(ctx context.Context, change model.OrderStatusChange)) *OrderStreamService_PublishStatusChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrderStatusChange))
+	})
+	return _c
+}
+
+func (_c *OrderStreamService_PublishStatusChange_Call) Return(_a0 error) *OrderStreamService_PublishStatusChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderStreamService_PublishStatusChange_Call) RunAndReturn(run func(context.Context, model.OrderStatusChange) error) *OrderStreamService_PublishStatusChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunSubscriber provides a mock function with given fields: ctx
+func (_m *OrderStreamService) RunSubscriber(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunSubscriber")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderStreamService_RunSubscriber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunSubscriber'
+type OrderStreamService_RunSubscriber_Call struct {
+	*mock.Call
+}
+
+// RunSubscriber is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OrderStreamService_Expecter) RunSubscriber(ctx interface{}) *OrderStreamService_RunSubscriber_Call {
+	return &OrderStreamService_RunSubscriber_Call{Call: _e.mock.On("RunSubscriber", ctx)}
+}
+
+func (_c *OrderStreamService_RunSubscriber_Call) Run(run func(ctx context.Context)) *OrderStreamService_RunSubscriber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OrderStreamService_RunSubscriber_Call) Return(_a0 error) *OrderStreamService_RunSubscriber_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderStreamService_RunSubscriber_Call) RunAndReturn(run func(context.Context) error) *OrderStreamService_RunSubscriber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: ctx, orderUUID
+func (_m *OrderStreamService) Subscribe(ctx context.Context, orderUUID string) <-chan model.OrderStatusChange {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan model.OrderStatusChange
+	if rf, ok := ret.Get(0).(func(context.Context, string) <-chan model.OrderStatusChange); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan model.OrderStatusChange)
+		}
+	}
+
+	return r0
+}
+
+// OrderStreamService_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type OrderStreamService_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *OrderStreamService_Expecter) Subscribe(ctx interface{}, orderUUID interface{}) *OrderStreamService_Subscribe_Call {
+	return &OrderStreamService_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, orderUUID)}
+}
+
+func (_c *OrderStreamService_Subscribe_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderStreamService_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrderStreamService_Subscribe_Call) Return(_a0 <-chan model.OrderStatusChange) *OrderStreamService_Subscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderStreamService_Subscribe_Call) RunAndReturn(run func(context.Context, string) <-chan model.OrderStatusChange) *OrderStreamService_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderStreamService creates a new instance of OrderStreamService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderStreamService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderStreamService {
+	mock := &OrderStreamService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Alexey-step/rocket-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReportService is an autogenerated mock type for the ReportService type
+type ReportService struct {
+	mock.Mock
+}
+
+type ReportService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReportService) EXPECT() *ReportService_Expecter {
+	return &ReportService_Expecter{mock: &_m.Mock}
+}
+
+// GetOrdersReport provides a mock function with given fields: ctx, userUUID, filter
+func (_m *ReportService) GetOrdersReport(ctx context.Context, userUUID string, filter model.OrdersReportFilter) (model.OrdersReport, error) {
+	ret := _m.Called(ctx, userUUID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrdersReport")
+	}
+
+	var r0 model.OrdersReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrdersReportFilter) (model.OrdersReport, error)); ok {
+		return rf(ctx, userUUID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrdersReportFilter) model.OrdersReport); ok {
+		r0 = rf(ctx, userUUID, filter)
+	} else {
+		r0 = ret.Get(0).(model.OrdersReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.OrdersReportFilter) error); ok {
+		r1 = rf(ctx, userUUID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportService_GetOrdersReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrdersReport'
+type ReportService_GetOrdersReport_Call struct {
+	*mock.Call
+}
+
+// GetOrdersReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - filter model.OrdersReportFilter
+func (_e *ReportService_Expecter) GetOrdersReport(ctx interface{}, userUUID interface{}, filter interface{}) *ReportService_GetOrdersReport_Call {
+	return &ReportService_GetOrdersReport_Call{Call: _e.mock.On("GetOrdersReport", ctx, userUUID, filter)}
+}
+
+func (_c *ReportService_GetOrdersReport_Call) Run(run func(ctx context.Context, userUUID string, filter model.OrdersReportFilter)) *ReportService_GetOrdersReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.OrdersReportFilter))
+	})
+	return _c
+}
+
+func (_c *ReportService_GetOrdersReport_Call) Return(report model.OrdersReport, err error) *ReportService_GetOrdersReport_Call {
+	_c.Call.Return(report, err)
+	return _c
+}
+
+func (_c *ReportService_GetOrdersReport_Call) RunAndReturn(run func(context.Context, string, model.OrdersReportFilter) (model.OrdersReport, error)) *ReportService_GetOrdersReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReportService creates a new instance of ReportService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReportService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReportService {
+	mock := &ReportService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
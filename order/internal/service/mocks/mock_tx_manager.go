@@ -0,0 +1,87 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	txmanager "github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
+)
+
+// TxManager is an autogenerated mock type for the TxManager type
+type TxManager struct {
+	mock.Mock
+}
+
+type TxManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TxManager) EXPECT() *TxManager_Expecter {
+	return &TxManager_Expecter{mock: &_m.Mock}
+}
+
+// ReadCommitted provides a mock function with given fields: ctx, fn
+func (_m *TxManager) ReadCommitted(ctx context.Context, fn txmanager.Handler) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadCommitted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, txmanager.Handler) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TxManager_ReadCommitted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadCommitted'
+type TxManager_ReadCommitted_Call struct {
+	*mock.Call
+}
+
+// ReadCommitted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn txmanager.Handler
+func (_e *TxManager_Expecter) ReadCommitted(ctx interface{}, fn interface{}) *TxManager_ReadCommitted_Call {
+	return &TxManager_ReadCommitted_Call{Call: _e.mock.On("ReadCommitted", ctx, fn)}
+}
+
+func (_c *TxManager_ReadCommitted_Call) Run(run func(ctx context.Context, fn txmanager.Handler)) *TxManager_ReadCommitted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(txmanager.Handler))
+	})
+	return _c
+}
+
+func (_c *TxManager_ReadCommitted_Call) Return(_a0 error) *TxManager_ReadCommitted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TxManager_ReadCommitted_Call) RunAndReturn(run func(context.Context, txmanager.Handler) error) *TxManager_ReadCommitted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTxManager creates a new instance of TxManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTxManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TxManager {
+	mock := &TxManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
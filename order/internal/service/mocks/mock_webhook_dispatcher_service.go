@@ -0,0 +1,84 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookDispatcherService is an autogenerated mock type for the WebhookDispatcherService type
+type WebhookDispatcherService struct {
+	mock.Mock
+}
+
+type WebhookDispatcherService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookDispatcherService) EXPECT() *WebhookDispatcherService_Expecter {
+	return &WebhookDispatcherService_Expecter{mock: &_m.Mock}
+}
+
+// RunDispatcher provides a mock function with given fields: ctx
+func (_m *WebhookDispatcherService) RunDispatcher(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunDispatcher")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookDispatcherService_RunDispatcher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunDispatcher'
+type WebhookDispatcherService_RunDispatcher_Call struct {
+	*mock.Call
+}
+
+// RunDispatcher is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WebhookDispatcherService_Expecter) RunDispatcher(ctx interface{}) *WebhookDispatcherService_RunDispatcher_Call {
+	return &WebhookDispatcherService_RunDispatcher_Call{Call: _e.mock.On("RunDispatcher", ctx)}
+}
+
+func (_c *WebhookDispatcherService_RunDispatcher_Call) Run(run func(ctx context.Context)) *WebhookDispatcherService_RunDispatcher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookDispatcherService_RunDispatcher_Call) Return(_a0 error) *WebhookDispatcherService_RunDispatcher_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookDispatcherService_RunDispatcher_Call) RunAndReturn(run func(context.Context) error) *WebhookDispatcherService_RunDispatcher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookDispatcherService creates a new instance of WebhookDispatcherService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookDispatcherService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookDispatcherService {
+	mock := &WebhookDispatcherService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
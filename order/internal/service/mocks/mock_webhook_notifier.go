@@ -0,0 +1,86 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Alexey-step/rocket-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookNotifier is an autogenerated mock type for the WebhookNotifier type
+type WebhookNotifier struct {
+	mock.Mock
+}
+
+type WebhookNotifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookNotifier) EXPECT() *WebhookNotifier_Expecter {
+	return &WebhookNotifier_Expecter{mock: &_m.Mock}
+}
+
+// NotifyStatusChange provides a mock function with given fields: ctx, change
+func (_m *WebhookNotifier) NotifyStatusChange(ctx context.Context, change model.OrderStatusChange) error {
+	ret := _m.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyStatusChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderStatusChange) error); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookNotifier_NotifyStatusChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyStatusChange'
+type WebhookNotifier_NotifyStatusChange_Call struct {
+	*mock.Call
+}
+
+// NotifyStatusChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - change model.OrderStatusChange
+func (_e *WebhookNotifier_Expecter) NotifyStatusChange(ctx interface{}, change interface{}) *WebhookNotifier_NotifyStatusChange_Call {
+	return &WebhookNotifier_NotifyStatusChange_Call{Call: _e.mock.On("NotifyStatusChange", ctx, change)}
+}
+
+func (_c *WebhookNotifier_NotifyStatusChange_Call) Run(run func(ctx context.Context, change model.OrderStatusChange)) *WebhookNotifier_NotifyStatusChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrderStatusChange))
+	})
+	return _c
+}
+
+func (_c *WebhookNotifier_NotifyStatusChange_Call) Return(_a0 error) *WebhookNotifier_NotifyStatusChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookNotifier_NotifyStatusChange_Call) RunAndReturn(run func(context.Context, model.OrderStatusChange) error) *WebhookNotifier_NotifyStatusChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookNotifier creates a new instance of WebhookNotifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookNotifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookNotifier {
+	mock := &WebhookNotifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,427 @@
+// Code generated for Alexey-step service
+// © Alexey-step 2025.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Alexey-step/rocket-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookService is an autogenerated mock type for the WebhookService type
+type WebhookService struct {
+	mock.Mock
+}
+
+type WebhookService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookService) EXPECT() *WebhookService_Expecter {
+	return &WebhookService_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function with given fields: ctx, userUUID, request
+func (_m *WebhookService) CreateWebhook(ctx context.Context, userUUID string, request model.WebhookRequest) (model.Webhook, error) {
+	ret := _m.Called(ctx, userUUID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 model.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.WebhookRequest) (model.Webhook, error)); ok {
+		return rf(ctx, userUUID, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.WebhookRequest) model.Webhook); ok {
+		r0 = rf(ctx, userUUID, request)
+	} else {
+		r0 = ret.Get(0).(model.Webhook)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.WebhookRequest) error); ok {
+		r1 = rf(ctx, userUUID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type WebhookService_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - request model.WebhookRequest
+func (_e *WebhookService_Expecter) CreateWebhook(ctx interface{}, userUUID interface{}, request interface{}) *WebhookService_CreateWebhook_Call {
+	return &WebhookService_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, userUUID, request)}
+}
+
+func (_c *WebhookService_CreateWebhook_Call) Run(run func(ctx context.Context, userUUID string, request model.WebhookRequest)) *WebhookService_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.WebhookRequest))
+	})
+	return _c
+}
+
+func (_c *WebhookService_CreateWebhook_Call) Return(webhook model.Webhook, err error) *WebhookService_CreateWebhook_Call {
+	_c.Call.Return(webhook, err)
+	return _c
+}
+
+func (_c *WebhookService_CreateWebhook_Call) RunAndReturn(run func(context.Context, string, model.WebhookRequest) (model.Webhook, error)) *WebhookService_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function with given fields: ctx, userUUID, webhookUUID
+func (_m *WebhookService) DeleteWebhook(ctx context.Context, userUUID string, webhookUUID string) error {
+	ret := _m.Called(ctx, userUUID, webhookUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userUUID, webhookUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookService_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type WebhookService_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - webhookUUID string
+func (_e *WebhookService_Expecter) DeleteWebhook(ctx interface{}, userUUID interface{}, webhookUUID interface{}) *WebhookService_DeleteWebhook_Call {
+	return &WebhookService_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, userUUID, webhookUUID)}
+}
+
+func (_c *WebhookService_DeleteWebhook_Call) Run(run func(ctx context.Context, userUUID string, webhookUUID string)) *WebhookService_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookService_DeleteWebhook_Call) Return(_a0 error) *WebhookService_DeleteWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookService_DeleteWebhook_Call) RunAndReturn(run func(context.Context, string, string) error) *WebhookService_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeliverPending provides a mock function with given fields: ctx, limit
+func (_m *WebhookService) DeliverPending(ctx context.Context, limit int) (int, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeliverPending")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_DeliverPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeliverPending'
+type WebhookService_DeliverPending_Call struct {
+	*mock.Call
+}
+
+// DeliverPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *WebhookService_Expecter) DeliverPending(ctx interface{}, limit interface{}) *WebhookService_DeliverPending_Call {
+	return &WebhookService_DeliverPending_Call{Call: _e.mock.On("DeliverPending", ctx, limit)}
+}
+
+func (_c *WebhookService_DeliverPending_Call) Run(run func(ctx context.Context, limit int)) *WebhookService_DeliverPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *WebhookService_DeliverPending_Call) Return(processed int, err error) *WebhookService_DeliverPending_Call {
+	_c.Call.Return(processed, err)
+	return _c
+}
+
+func (_c *WebhookService_DeliverPending_Call) RunAndReturn(run func(context.Context, int) (int, error)) *WebhookService_DeliverPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnableWebhook provides a mock function with given fields: ctx, userUUID, webhookUUID
+func (_m *WebhookService) EnableWebhook(ctx context.Context, userUUID string, webhookUUID string) (model.Webhook, error) {
+	ret := _m.Called(ctx, userUUID, webhookUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableWebhook")
+	}
+
+	var r0 model.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (model.Webhook, error)); ok {
+		return rf(ctx, userUUID, webhookUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) model.Webhook); ok {
+		r0 = rf(ctx, userUUID, webhookUUID)
+	} else {
+		r0 = ret.Get(0).(model.Webhook)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userUUID, webhookUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_EnableWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableWebhook'
+type WebhookService_EnableWebhook_Call struct {
+	*mock.Call
+}
+
+// EnableWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - webhookUUID string
+func (_e *WebhookService_Expecter) EnableWebhook(ctx interface{}, userUUID interface{}, webhookUUID interface{}) *WebhookService_EnableWebhook_Call {
+	return &WebhookService_EnableWebhook_Call{Call: _e.mock.On("EnableWebhook", ctx, userUUID, webhookUUID)}
+}
+
+func (_c *WebhookService_EnableWebhook_Call) Run(run func(ctx context.Context, userUUID string, webhookUUID string)) *WebhookService_EnableWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookService_EnableWebhook_Call) Return(webhook model.Webhook, err error) *WebhookService_EnableWebhook_Call {
+	_c.Call.Return(webhook, err)
+	return _c
+}
+
+func (_c *WebhookService_EnableWebhook_Call) RunAndReturn(run func(context.Context, string, string) (model.Webhook, error)) *WebhookService_EnableWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhookDeliveries provides a mock function with given fields: ctx, userUUID, webhookUUID, limit
+func (_m *WebhookService) ListWebhookDeliveries(ctx context.Context, userUUID string, webhookUUID string, limit int) ([]model.WebhookDelivery, error) {
+	ret := _m.Called(ctx, userUUID, webhookUUID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhookDeliveries")
+	}
+
+	var r0 []model.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) ([]model.WebhookDelivery, error)); ok {
+		return rf(ctx, userUUID, webhookUUID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) []model.WebhookDelivery); ok {
+		r0 = rf(ctx, userUUID, webhookUUID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = rf(ctx, userUUID, webhookUUID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_ListWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookDeliveries'
+type WebhookService_ListWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - webhookUUID string
+//   - limit int
+func (_e *WebhookService_Expecter) ListWebhookDeliveries(ctx interface{}, userUUID interface{}, webhookUUID interface{}, limit interface{}) *WebhookService_ListWebhookDeliveries_Call {
+	return &WebhookService_ListWebhookDeliveries_Call{Call: _e.mock.On("ListWebhookDeliveries", ctx, userUUID, webhookUUID, limit)}
+}
+
+func (_c *WebhookService_ListWebhookDeliveries_Call) Run(run func(ctx context.Context, userUUID string, webhookUUID string, limit int)) *WebhookService_ListWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *WebhookService_ListWebhookDeliveries_Call) Return(deliveries []model.WebhookDelivery, err error) *WebhookService_ListWebhookDeliveries_Call {
+	_c.Call.Return(deliveries, err)
+	return _c
+}
+
+func (_c *WebhookService_ListWebhookDeliveries_Call) RunAndReturn(run func(context.Context, string, string, int) ([]model.WebhookDelivery, error)) *WebhookService_ListWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhooks provides a mock function with given fields: ctx, userUUID
+func (_m *WebhookService) ListWebhooks(ctx context.Context, userUUID string) ([]model.Webhook, error) {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhooks")
+	}
+
+	var r0 []model.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.Webhook, error)); ok {
+		return rf(ctx, userUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Webhook); ok {
+		r0 = rf(ctx, userUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_ListWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhooks'
+type WebhookService_ListWebhooks_Call struct {
+	*mock.Call
+}
+
+// ListWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+func (_e *WebhookService_Expecter) ListWebhooks(ctx interface{}, userUUID interface{}) *WebhookService_ListWebhooks_Call {
+	return &WebhookService_ListWebhooks_Call{Call: _e.mock.On("ListWebhooks", ctx, userUUID)}
+}
+
+func (_c *WebhookService_ListWebhooks_Call) Run(run func(ctx context.Context, userUUID string)) *WebhookService_ListWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookService_ListWebhooks_Call) Return(webhooks []model.Webhook, err error) *WebhookService_ListWebhooks_Call {
+	_c.Call.Return(webhooks, err)
+	return _c
+}
+
+func (_c *WebhookService_ListWebhooks_Call) RunAndReturn(run func(context.Context, string) ([]model.Webhook, error)) *WebhookService_ListWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyStatusChange provides a mock function with given fields: ctx, change
+func (_m *WebhookService) NotifyStatusChange(ctx context.Context, change model.OrderStatusChange) error {
+	ret := _m.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyStatusChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderStatusChange) error); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookService_NotifyStatusChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyStatusChange'
+type WebhookService_NotifyStatusChange_Call struct {
+	*mock.Call
+}
+
+// NotifyStatusChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - change model.OrderStatusChange
+func (_e *WebhookService_Expecter) NotifyStatusChange(ctx interface{}, change interface{}) *WebhookService_NotifyStatusChange_Call {
+	return &WebhookService_NotifyStatusChange_Call{Call: _e.mock.On("NotifyStatusChange", ctx, change)}
+}
+
+func (_c *WebhookService_NotifyStatusChange_Call) Run(run func(ctx context.Context, change model.OrderStatusChange)) *WebhookService_NotifyStatusChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrderStatusChange))
+	})
+	return _c
+}
+
+func (_c *WebhookService_NotifyStatusChange_Call) Return(_a0 error) *WebhookService_NotifyStatusChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookService_NotifyStatusChange_Call) RunAndReturn(run func(context.Context, model.OrderStatusChange) error) *WebhookService_NotifyStatusChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookService creates a new instance of WebhookService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookService {
+	mock := &WebhookService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,42 +3,59 @@ package order
 import (
 	"context"
 
+	"github.com/samber/lo"
+
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
 )
 
-func (s *service) CancelOrder(ctx context.Context, userUUID string) error {
-	order, err := s.orderRepository.GetOrder(ctx, userUUID)
+func (s *service) CancelOrder(ctx context.Context, userUUID, orderUUID string) error {
+	order, err := s.getUserOrder(ctx, userUUID, orderUUID)
 	if err != nil {
 		return err
 	}
 
-	if err = statusToError(order.Status); err != nil {
-		return err
+	// Оплаченный заказ, который ещё не собран, отменяется с возвратом денег.
+	// Заказ в REFUNDING уже отменён, но возврат не завершился: повторяем его
+	if order.Status == model.OrderStatusRefunding || order.Status.CanTransitionTo(model.OrderStatusRefunding) {
+		return s.refundOrder(ctx, userUUID, order)
 	}
 
-	status := model.OrderStatusCanceled
-	err = s.orderRepository.UpdateOrder(ctx, order.UUID, model.OrderUpdateInfo{
-		Status: &status,
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		txErr := s.transitionOrder(ctx, order, model.OrderStatusCanceled, model.OrderUpdateInfo{
+			CancelReason: lo.ToPtr(model.CancelReasonCustomer),
+		}, model.StatusChange{
+			Actor:  model.UserActor(userUUID),
+			Reason: model.StatusReasonOrderCancelled,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		// Черновик ещё не был заказом, поэтому его удаление событием не публикуется
+		if order.Status == model.OrderStatusDraft {
+			return nil
+		}
+
+		// Промокод засчитывается при оформлении заказа, поэтому при отмене использование возвращается
+		txErr = s.promoCodeRepository.ReturnPromoCodeUses(ctx, order.UUID)
+		if txErr != nil {
+			return txErr
+		}
+
+		return s.orderProducerService.ProduceOrderCancelled(ctx, model.OrderCancelled{
+			Envelope:  newEventEnvelope(userUUID),
+			OrderUUID: order.UUID,
+			UserUUID:  order.UserUUID,
+			Reason:    model.CancelReasonCustomer,
+		})
 	})
 	if err != nil {
 		return err
 	}
 
-	return nil
-}
-
-func statusToError(status model.OrderStatus) error {
-	statusToError := map[model.OrderStatus]error{
-		model.OrderStatusPaid:     model.ErrOrderConflict,
-		model.OrderStatusCanceled: model.ErrOrderConflict,
-	}
-
-	if err, ok := statusToError[status]; ok {
-		return err
-	}
-
-	if status != model.OrderStatusPendingPayment {
-		return model.ErrOrderInternalError
+	// Под черновик детали не резервируются
+	if order.Status != model.OrderStatusDraft {
+		s.releaseStock(ctx, order.ReservationKey())
 	}
 
 	return nil
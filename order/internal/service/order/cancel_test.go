@@ -2,15 +2,19 @@ package order
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
+	exchangeMocks "github.com/Alexey-step/rocket-factory/order/internal/client/exchange/mocks"
 	clientMocks "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/mocks"
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
 	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/bom"
 	orderServiceMocks "github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
 	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
 )
@@ -21,49 +25,91 @@ func TestCancelOrderSuccess(t *testing.T) {
 	order := getMockOrderWithStatus(orderUUID, model.OrderStatusPendingPayment)
 
 	orderUpdateInfo := model.OrderUpdateInfo{
-		Status: lo.ToPtr(model.OrderStatusCanceled),
+		Status:       lo.ToPtr(model.OrderStatusCanceled),
+		CancelReason: lo.ToPtr(model.CancelReasonCustomer),
 	}
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
-	orderRepository.On("UpdateOrder", ctx, orderUUID, orderUpdateInfo).Return(nil).Once()
-	err := orderService.CancelOrder(ctx, orderUUID)
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Twice()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusPendingPayment, orderUpdateInfo).Return(nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderRepository.On("AddStatusHistory", ctx, mock.AnythingOfType("model.OrderStatusHistory")).Return(nil).Once()
+	promoCodeRepository.On("ReturnPromoCodeUses", ctx, orderUUID).Return(nil).Once()
+	orderProducer.On("ProduceOrderCancelled", ctx, mock.MatchedBy(func(event model.OrderCancelled) bool {
+		return event.OrderUUID == orderUUID &&
+			event.UserUUID == order.UserUUID &&
+			event.Reason == model.CancelReasonCustomer &&
+			event.Envelope.CausationID == order.UserUUID &&
+			event.Envelope.SchemaVersion == model.EventSchemaVersion &&
+			event.Envelope.EventUUID != ""
+	})).Return(nil).Once()
+	inventoryClient.On("ReleaseStock", mock.Anything, orderUUID).Return(nil).Once()
+	err := orderService.CancelOrder(ctx, order.UserUUID, orderUUID)
 	assert.NoError(t, err)
 }
 
 func TestCancelOrderFail(t *testing.T) {
 	ctx := context.Background()
 	orderUUID := gofakeit.UUID()
-	expectedErr := model.ErrOrderConflict
+	expectedErr := &model.InvalidStatusTransitionError{From: model.OrderStatusCompleted, To: model.OrderStatusCanceled}
 	logger.SetNopLogger()
 
-	order := getMockOrderWithStatus(orderUUID, model.OrderStatusPaid)
+	order := getMockOrderWithStatus(orderUUID, model.OrderStatusCompleted)
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
-	err := orderService.CancelOrder(ctx, orderUUID)
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	err := orderService.CancelOrder(ctx, order.UserUUID, orderUUID)
 	assert.Error(t, err)
 	assert.Equal(t, expectedErr, err)
 }
@@ -71,25 +117,39 @@ func TestCancelOrderFail(t *testing.T) {
 func TestCancelOrderConflictFail(t *testing.T) {
 	ctx := context.Background()
 	orderUUID := gofakeit.UUID()
-	expectedErr := model.ErrOrderConflict
+	expectedErr := &model.InvalidStatusTransitionError{From: model.OrderStatusCanceled, To: model.OrderStatusCanceled}
 	logger.SetNopLogger()
 
 	order := getMockOrderWithStatus(orderUUID, model.OrderStatusCanceled)
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
-	err := orderService.CancelOrder(ctx, orderUUID)
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	err := orderService.CancelOrder(ctx, order.UserUUID, orderUUID)
 	assert.Error(t, err)
 	assert.Equal(t, expectedErr, err)
 }
@@ -101,19 +161,32 @@ func TestCancelOrderInternalError(t *testing.T) {
 	logger.SetNopLogger()
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(model.OrderData{}, expectedErr).Once()
-	err := orderService.CancelOrder(ctx, orderUUID)
+	err := orderService.CancelOrder(ctx, gofakeit.UUID(), orderUUID)
 	assert.Error(t, err)
 	assert.Equal(t, expectedErr, err)
 }
@@ -125,19 +198,32 @@ func TestCancelOrderNotFoundFail(t *testing.T) {
 	logger.SetNopLogger()
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(model.OrderData{}, expectedErr).Once()
-	err := orderService.CancelOrder(ctx, orderUUID)
+	err := orderService.CancelOrder(ctx, gofakeit.UUID(), orderUUID)
 	assert.Error(t, err)
 	assert.Equal(t, expectedErr, err)
 }
@@ -151,50 +237,164 @@ func TestCancelOrderUpdateError(t *testing.T) {
 	order := getMockOrderWithStatus(orderUUID, model.OrderStatusPendingPayment)
 
 	orderUpdateInfo := model.OrderUpdateInfo{
-		Status: lo.ToPtr(model.OrderStatusCanceled),
+		Status:       lo.ToPtr(model.OrderStatusCanceled),
+		CancelReason: lo.ToPtr(model.CancelReasonCustomer),
 	}
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
-	orderRepository.On("UpdateOrder", ctx, orderUUID, orderUpdateInfo).Return(expectedErr).Once()
-	err := orderService.CancelOrder(ctx, orderUUID)
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Twice()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusPendingPayment, orderUpdateInfo).Return(expectedErr).Once()
+	err := orderService.CancelOrder(ctx, order.UserUUID, orderUUID)
 	assert.Error(t, err)
 	assert.Equal(t, expectedErr, err)
 }
 
+func TestCancelOrderReturnPromoCodeUsesError(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	expectedErr := errors.New("promo update failed")
+	logger.SetNopLogger()
+
+	order := getMockOrderWithStatus(orderUUID, model.OrderStatusPendingPayment)
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	// Использование промокода не вернулось - отмена откатывается целиком, резерв не снимается
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Twice()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusPendingPayment, mock.AnythingOfType("model.OrderUpdateInfo")).Return(nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderRepository.On("AddStatusHistory", ctx, mock.AnythingOfType("model.OrderStatusHistory")).Return(nil).Once()
+	promoCodeRepository.On("ReturnPromoCodeUses", ctx, orderUUID).Return(expectedErr).Once()
+
+	err := orderService.CancelOrder(ctx, order.UserUUID, orderUUID)
+	assert.ErrorIs(t, err, expectedErr)
+}
+
 func TestCancelOrderInternalErr(t *testing.T) {
 	ctx := context.Background()
 	orderUUID := gofakeit.UUID()
-	expectedErr := model.ErrOrderInternalError
+	expectedErr := &model.InvalidStatusTransitionError{From: "UNKNOWN_STATUS", To: model.OrderStatusCanceled}
 	logger.SetNopLogger()
 
 	order := getMockOrderWithStatus(orderUUID, "UNKNOWN_STATUS")
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	err := orderService.CancelOrder(ctx, order.UserUUID, orderUUID)
+	assert.Error(t, err)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestCancelOrderForbiddenErr(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	expectedErr := model.ErrOrderForbidden
+	logger.SetNopLogger()
+
+	order := getMockOrderWithStatus(orderUUID, model.OrderStatusPendingPayment)
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
-	err := orderService.CancelOrder(ctx, orderUUID)
+	err := orderService.CancelOrder(ctx, gofakeit.UUID(), orderUUID)
 	assert.Error(t, err)
 	assert.Equal(t, expectedErr, err)
 }
@@ -203,8 +403,8 @@ func getMockOrderWithStatus(orderUUID string, status model.OrderStatus) model.Or
 	return model.OrderData{
 		UUID:          orderUUID,
 		UserUUID:      gofakeit.UUID(),
-		PartUuids:     []string{gofakeit.UUID()},
-		TotalPrice:    gofakeit.Price(100, 1000),
+		Items:         []model.OrderItem{getMockedOrderItem()},
+		TotalPrice:    getMockedPrice(),
 		PaymentMethod: lo.ToPtr(model.PaymentMethod("CREDIT_CARD")),
 		Status:        status,
 		CreatedAt:     gofakeit.Date(),
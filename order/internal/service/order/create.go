@@ -2,26 +2,77 @@ package order
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/google/uuid"
 
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
 )
 
-func (s *service) CreateOrder(ctx context.Context, userUUID string, partsUUIDs []string) (info model.OrderCreationInfo, error error) {
-	filter := model.PartsFilter{
-		Uuids: partsUUIDs,
+func (s *service) CreateOrder(ctx context.Context, userUUID string, request model.OrderRequest, idempotencyKey string) (model.OrderCreationInfo, error) {
+	key, err := newIdempotencyKey(userUUID, model.IdempotencyOperationCreateOrder, idempotencyKey, request)
+	if err != nil {
+		return model.OrderCreationInfo{}, err
 	}
 
-	partsList, err := s.inventoryClient.ListParts(ctx, filter)
+	return runIdempotent(ctx, s.idempotencyRepository, key, func() (model.OrderCreationInfo, error) {
+		return s.createOrder(ctx, userUUID, request)
+	})
+}
+
+func (s *service) createOrder(ctx context.Context, userUUID string, request model.OrderRequest) (model.OrderCreationInfo, error) {
+	pricing, err := s.priceOrder(ctx, request)
 	if err != nil {
 		return model.OrderCreationInfo{}, err
 	}
 
-	if len(partsList) != len(partsUUIDs) {
-		return model.OrderCreationInfo{}, model.ErrOrderConflict
+	// UUID заказа генерируем заранее: под него резервируются детали ещё до записи заказа
+	order := model.OrderData{
+		UUID:         uuid.NewString(),
+		UserUUID:     userUUID,
+		Items:        pricing.items,
+		TotalPrice:   pricing.total,
+		Discounts:    pricing.discounts,
+		ExchangeRate: pricing.rate,
+		Status:       model.OrderStatusPendingPayment,
+	}
+
+	orderUUID := order.UUID
+	err = s.reserveStock(ctx, orderUUID, pricing.items)
+	if err != nil {
+		return model.OrderCreationInfo{}, err
 	}
 
-	orderInfo, createOrderErr := s.orderRepository.CreateOrder(ctx, userUUID, partsList)
+	var orderInfo model.OrderCreationInfo
+	createOrderErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		// Использование промокода засчитывается вместе с заказом: при откате лимит не расходуется
+		if pricing.promo != nil {
+			if txErr := s.promoCodeRepository.RedeemPromoCode(ctx, pricing.promo.Code); txErr != nil {
+				return txErr
+			}
+		}
+
+		var txErr error
+		orderInfo, txErr = s.orderRepository.CreateOrder(ctx, order)
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = s.addStatusHistory(ctx, userUUID, model.OrderStatusHistory{
+			OrderUUID: orderUUID,
+			ToStatus:  model.OrderStatusPendingPayment,
+			Actor:     model.UserActor(userUUID),
+			Reason:    model.StatusReasonOrderCreated,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		return s.produceOrderCreated(ctx, order)
+	})
 	if createOrderErr != nil {
+		s.releaseStock(ctx, orderUUID)
 		return model.OrderCreationInfo{}, createOrderErr
 	}
 
@@ -30,3 +81,168 @@ func (s *service) CreateOrder(ctx context.Context, userUUID string, partsUUIDs [
 		TotalPrice: orderInfo.TotalPrice,
 	}, nil
 }
+
+// produceOrderCreated публикует OrderCreated в той же транзакции, в которой заказ
+// переходит в PENDING_PAYMENT: при создании напрямую и при оформлении черновика
+func (s *service) produceOrderCreated(ctx context.Context, order model.OrderData) error {
+	return s.orderProducerService.ProduceOrderCreated(ctx, model.OrderCreated{
+		Envelope:   newEventEnvelope(order.UserUUID),
+		OrderUUID:  order.UUID,
+		UserUUID:   order.UserUUID,
+		TotalPrice: order.TotalPrice,
+	})
+}
+
+// orderPricing - цены заказа, посчитанные по деталям из каталога, курсу и промокоду
+type orderPricing struct {
+	parts     map[string]model.Part
+	items     []model.OrderItem
+	rate      money.Rate
+	subtotal  money.Money
+	promo     *model.PromoCode
+	discounts []model.OrderDiscount
+	total     money.Money
+}
+
+// priceOrder проверяет позиции запроса и считает стоимость заказа. Ничего не сохраняет и не
+// резервирует, поэтому используется и при создании заказа, и для предварительного расчёта
+func (s *service) priceOrder(ctx context.Context, request model.OrderRequest) (orderPricing, error) {
+	partsUUIDs, quantities, err := groupOrderItems(request.Items)
+	if err != nil {
+		return orderPricing{}, err
+	}
+
+	filter := model.PartsFilter{
+		Uuids: partsUUIDs,
+	}
+
+	partsList, err := s.inventoryClient.ListParts(ctx, filter)
+	if err != nil {
+		return orderPricing{}, err
+	}
+
+	if len(partsList) > len(partsUUIDs) {
+		return orderPricing{}, model.ErrOrderConflict
+	}
+
+	parts := make(map[string]model.Part, len(partsList))
+	for _, part := range partsList {
+		parts[part.UUID] = part
+	}
+
+	bomItems := make([]model.BOMItem, 0, len(partsUUIDs))
+	for _, partUUID := range partsUUIDs {
+		part, ok := parts[partUUID]
+		if !ok {
+			return orderPricing{}, fmt.Errorf("%w: %s", model.ErrPartsNotFound, partUUID)
+		}
+
+		bomItems = append(bomItems, model.BOMItem{
+			Part:     part,
+			Quantity: quantities[partUUID],
+		})
+	}
+
+	// Заказ должен описывать ракету целиком, иначе сборка соберёт то, что прислали
+	if err = s.bomValidator.Validate(bomItems); err != nil {
+		return orderPricing{}, err
+	}
+
+	// Детали оценены в базовой валюте каталога, курс к валюте заказа фиксируется один раз на весь заказ
+	rate, err := s.exchangeRate(ctx, partsList[0].Price.Currency, request.Currency)
+	if err != nil {
+		return orderPricing{}, err
+	}
+
+	// Фиксируем название, категорию и цену детали на момент оформления заказа
+	orderItems := make([]model.OrderItem, 0, len(partsUUIDs))
+	for _, partUUID := range partsUUIDs {
+		part := parts[partUUID]
+
+		unitPrice, err := rate.Convert(part.Price)
+		if err != nil {
+			return orderPricing{}, err
+		}
+
+		orderItems = append(orderItems, model.OrderItem{
+			PartUUID:  part.UUID,
+			Name:      part.Name,
+			Category:  part.Category,
+			Quantity:  quantities[partUUID],
+			UnitPrice: unitPrice,
+		})
+	}
+
+	subtotal, err := orderTotal(orderItems, rate.To)
+	if err != nil {
+		return orderPricing{}, err
+	}
+
+	promo, discounts, err := s.orderDiscounts(ctx, request.PromoCode, parts, orderItems, subtotal, rate)
+	if err != nil {
+		return orderPricing{}, err
+	}
+
+	total := subtotal
+	for _, discount := range discounts {
+		total, err = total.Sub(discount.Amount)
+		if err != nil {
+			return orderPricing{}, err
+		}
+	}
+
+	return orderPricing{
+		parts:     parts,
+		items:     orderItems,
+		rate:      rate,
+		subtotal:  subtotal,
+		promo:     promo,
+		discounts: discounts,
+		total:     total,
+	}, nil
+}
+
+// exchangeRate возвращает курс из валюты каталога в валюту заказа; без выбранной валюты заказ оформляется в валюте каталога
+func (s *service) exchangeRate(ctx context.Context, baseCurrency, currency string) (money.Rate, error) {
+	if currency == "" || currency == baseCurrency {
+		return money.Identity(baseCurrency), nil
+	}
+
+	return s.rateProvider.GetRate(ctx, baseCurrency, currency)
+}
+
+// orderTotal считает стоимость позиций в целых минимальных единицах валюты заказа
+func orderTotal(items []model.OrderItem, currency string) (money.Money, error) {
+	total := money.Zero(currency)
+	for _, item := range items {
+		var err error
+		total, err = total.Add(item.UnitPrice.Mul(item.Quantity))
+		if err != nil {
+			return money.Money{}, err
+		}
+	}
+
+	return total, nil
+}
+
+// groupOrderItems схлопывает повторяющиеся детали в одну позицию, сохраняя порядок из запроса
+func groupOrderItems(items []model.OrderItemInfo) ([]string, map[string]int64, error) {
+	if len(items) == 0 {
+		return nil, nil, model.ErrPartsInvalidRequest
+	}
+
+	partsUUIDs := make([]string, 0, len(items))
+	quantities := make(map[string]int64, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, nil, model.ErrPartsInvalidRequest
+		}
+
+		if _, ok := quantities[item.PartUUID]; !ok {
+			partsUUIDs = append(partsUUIDs, item.PartUUID)
+		}
+		quantities[item.PartUUID] += item.Quantity
+	}
+
+	return partsUUIDs, quantities, nil
+}
@@ -8,57 +8,227 @@ import (
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	exchangeMocks "github.com/Alexey-step/rocket-factory/order/internal/client/exchange/mocks"
 	clientMocks "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/mocks"
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
 	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/bom"
 	orderServiceMocks "github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
 )
 
 func TestCreateOrderSuccess(t *testing.T) {
 	ctx := context.Background()
 	userUUID := gofakeit.UUID()
 	orderUUID := gofakeit.UUID()
-	partUUIDs := []string{gofakeit.UUID()}
-	price := gofakeit.Price(100, 1000)
+	partUUID := gofakeit.UUID()
+	price := getMockedPrice()
 
-	part := getMockedPart(orderUUID, price)
+	part := getMockedPart(partUUID, price)
+
+	// Одинаковые детали из запроса схлопываются в одну позицию
+	items := []model.OrderItemInfo{
+		{PartUUID: partUUID, Quantity: 2},
+		{PartUUID: partUUID, Quantity: 1},
+	}
+	orderItems := []model.OrderItem{
+		{
+			PartUUID:  partUUID,
+			Name:      part.Name,
+			Category:  part.Category,
+			Quantity:  3,
+			UnitPrice: price,
+		},
+	}
 
 	info := model.OrderCreationInfo{
 		OrderUUID:  orderUUID,
-		TotalPrice: price,
+		TotalPrice: price.Mul(3),
 	}
 
 	filter := model.PartsFilter{
-		Uuids: partUUIDs,
+		Uuids: []string{partUUID},
 	}
 
 	listParts := []model.Part{part}
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	inventoryClient.On("ListParts", ctx, filter).Return(listParts, nil).Once()
-	orderRepository.On("CreateOrder", ctx, userUUID, listParts).Return(info, nil).Once()
-	resp, err := orderService.CreateOrder(ctx, userUUID, partUUIDs)
+	inventoryClient.On("ReserveStock", ctx, mock.AnythingOfType("string"), orderItems).Return(nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	orderRepository.On("CreateOrder", ctx, matchOrder(userUUID, orderItems, price.Mul(3), money.Identity(money.RUB), nil)).Return(info, nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderRepository.On("AddStatusHistory", ctx, mock.AnythingOfType("model.OrderStatusHistory")).Return(nil).Once()
+	orderProducer.On("ProduceOrderCreated", ctx, mock.MatchedBy(func(event model.OrderCreated) bool {
+		return event.OrderUUID != "" &&
+			event.UserUUID == userUUID &&
+			event.TotalPrice == price.Mul(3) &&
+			event.Envelope.CausationID == userUUID &&
+			event.Envelope.SchemaVersion == model.EventSchemaVersion &&
+			!event.Envelope.OccurredAt.IsZero()
+	})).Return(nil).Once()
+	resp, err := orderService.CreateOrder(ctx, userUUID, model.OrderRequest{Items: items}, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, info, resp)
+}
+
+func TestCreateOrderInForeignCurrency(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+
+	part := getMockedPart(partUUID, money.New(123_456, money.RUB))
+	rate := money.Rate{From: money.RUB, To: "USD", Value: 10_800}
+
+	request := model.OrderRequest{
+		Items:    []model.OrderItemInfo{{PartUUID: partUUID, Quantity: 2}},
+		Currency: "USD",
+	}
+	// 1234.56 ₽ по курсу 0.0108 - 13.33 $, цена пересчитывается до умножения на количество
+	orderItems := []model.OrderItem{
+		{
+			PartUUID:  partUUID,
+			Name:      part.Name,
+			Category:  part.Category,
+			Quantity:  2,
+			UnitPrice: money.New(1_333, "USD"),
+		},
+	}
+
+	info := model.OrderCreationInfo{
+		OrderUUID:  orderUUID,
+		TotalPrice: money.New(2_666, "USD"),
+	}
+
+	filter := model.PartsFilter{
+		Uuids: []string{partUUID},
+	}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	inventoryClient.On("ListParts", ctx, filter).Return([]model.Part{part}, nil).Once()
+	rateProvider.On("GetRate", ctx, money.RUB, "USD").Return(rate, nil).Once()
+	inventoryClient.On("ReserveStock", ctx, mock.AnythingOfType("string"), orderItems).Return(nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	orderRepository.On("CreateOrder", ctx, matchOrder(userUUID, orderItems, info.TotalPrice, rate, nil)).Return(info, nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderRepository.On("AddStatusHistory", ctx, mock.AnythingOfType("model.OrderStatusHistory")).Return(nil).Once()
+	orderProducer.On("ProduceOrderCreated", ctx, mock.AnythingOfType("model.OrderCreated")).Return(nil).Once()
+	resp, err := orderService.CreateOrder(ctx, userUUID, request, "")
 
 	assert.NoError(t, err)
 	assert.Equal(t, info, resp)
 }
 
+func TestCreateOrderCurrencyNotSupported(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+
+	part := getMockedPart(partUUID, getMockedPrice())
+
+	request := model.OrderRequest{
+		Items:    []model.OrderItemInfo{{PartUUID: partUUID, Quantity: 1}},
+		Currency: "XYZ",
+	}
+
+	filter := model.PartsFilter{
+		Uuids: []string{partUUID},
+	}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	inventoryClient.On("ListParts", ctx, filter).Return([]model.Part{part}, nil).Once()
+	rateProvider.On("GetRate", ctx, money.RUB, "XYZ").Return(money.Rate{}, model.ErrCurrencyNotSupported).Once()
+	resp, err := orderService.CreateOrder(ctx, userUUID, request, "")
+
+	assert.ErrorIs(t, err, model.ErrCurrencyNotSupported)
+	assert.Empty(t, resp)
+}
+
 func TestCreateOrderListPartsFail(t *testing.T) {
 	ctx := context.Background()
 	userUUID := gofakeit.UUID()
 	partUUIDs := []string{gofakeit.UUID()}
+	items := []model.OrderItemInfo{{PartUUID: partUUIDs[0], Quantity: 1}}
 
 	filter := model.PartsFilter{
 		Uuids: partUUIDs,
@@ -67,19 +237,32 @@ func TestCreateOrderListPartsFail(t *testing.T) {
 	expectedListPartsError := gofakeit.Error()
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	inventoryClient.On("ListParts", ctx, filter).Return(nil, expectedListPartsError).Once()
-	resp, err := orderService.CreateOrder(ctx, userUUID, partUUIDs)
+	resp, err := orderService.CreateOrder(ctx, userUUID, model.OrderRequest{Items: items}, "")
 
 	assert.Error(t, err)
 	assert.Empty(t, resp)
@@ -91,12 +274,14 @@ func TestCreateOrderBadRequest(t *testing.T) {
 	userUUID := gofakeit.UUID()
 	orderUUID := gofakeit.UUID()
 	partUUIDs := []string{gofakeit.UUID()}
-	price := gofakeit.Price(100, 1000)
+	price := getMockedPrice()
 
 	part := getMockedPart(orderUUID, price)
 
 	part2 := getMockedPart(orderUUID, price)
 
+	items := []model.OrderItemInfo{{PartUUID: partUUIDs[0], Quantity: 1}}
+
 	filter := model.PartsFilter{
 		Uuids: partUUIDs,
 	}
@@ -105,19 +290,32 @@ func TestCreateOrderBadRequest(t *testing.T) {
 	expectedErr := model.ErrOrderConflict
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	inventoryClient.On("ListParts", ctx, filter).Return(listParts, nil).Once()
-	resp, err := orderService.CreateOrder(ctx, userUUID, partUUIDs)
+	resp, err := orderService.CreateOrder(ctx, userUUID, model.OrderRequest{Items: items}, "")
 
 	assert.Error(t, err)
 	assert.Empty(t, resp)
@@ -127,11 +325,21 @@ func TestCreateOrderBadRequest(t *testing.T) {
 func TestCreateOrderRepoErr(t *testing.T) {
 	ctx := context.Background()
 	userUUID := gofakeit.UUID()
-	orderUUID := gofakeit.UUID()
 	partUUIDs := []string{gofakeit.UUID()}
-	price := gofakeit.Price(100, 1000)
-
-	part := getMockedPart(orderUUID, price)
+	price := getMockedPrice()
+
+	part := getMockedPart(partUUIDs[0], price)
+
+	items := []model.OrderItemInfo{{PartUUID: partUUIDs[0], Quantity: 1}}
+	orderItems := []model.OrderItem{
+		{
+			PartUUID:  part.UUID,
+			Name:      part.Name,
+			Category:  part.Category,
+			Quantity:  1,
+			UnitPrice: price,
+		},
+	}
 
 	filter := model.PartsFilter{
 		Uuids: partUUIDs,
@@ -141,27 +349,288 @@ func TestCreateOrderRepoErr(t *testing.T) {
 	expectedErr := gofakeit.Error()
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	inventoryClient.On("ListParts", ctx, filter).Return(listParts, nil).Once()
-	orderRepository.On("CreateOrder", ctx, userUUID, listParts).Return(model.OrderCreationInfo{}, expectedErr).Once()
-	resp, err := orderService.CreateOrder(ctx, userUUID, partUUIDs)
+	inventoryClient.On("ReserveStock", ctx, mock.AnythingOfType("string"), orderItems).Return(nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	orderRepository.On("CreateOrder", ctx, mock.AnythingOfType("model.OrderData")).Return(model.OrderCreationInfo{}, expectedErr).Once()
+	// Резерв снимается, если заказ не удалось сохранить
+	inventoryClient.On("ReleaseStock", mock.Anything, mock.AnythingOfType("string")).Return(nil).Once()
+	resp, err := orderService.CreateOrder(ctx, userUUID, model.OrderRequest{Items: items}, "")
 
 	assert.Error(t, err)
 	assert.Empty(t, resp)
 	assert.Equal(t, err, expectedErr)
 }
 
-func getMockedPart(uuid string, price float64) model.Part {
+func TestCreateOrderInsufficientStock(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+	price := getMockedPrice()
+
+	part := getMockedPart(partUUID, price)
+
+	items := []model.OrderItemInfo{{PartUUID: partUUID, Quantity: 5}}
+	orderItems := []model.OrderItem{
+		{
+			PartUUID:  partUUID,
+			Name:      part.Name,
+			Category:  part.Category,
+			Quantity:  5,
+			UnitPrice: price,
+		},
+	}
+
+	filter := model.PartsFilter{
+		Uuids: []string{partUUID},
+	}
+
+	expectedErr := &model.InsufficientStockError{
+		Shortages: []model.StockShortage{
+			{PartUUID: partUUID, Requested: 5, Available: 2},
+		},
+	}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	inventoryClient.On("ListParts", ctx, filter).Return([]model.Part{part}, nil).Once()
+	inventoryClient.On("ReserveStock", ctx, mock.AnythingOfType("string"), orderItems).Return(expectedErr).Once()
+	resp, err := orderService.CreateOrder(ctx, userUUID, model.OrderRequest{Items: items}, "")
+
+	assert.ErrorIs(t, err, model.ErrInsufficientStock)
+	assert.Empty(t, resp)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestCreateOrderReserveStockTimeout(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+	price := getMockedPrice()
+
+	part := getMockedPart(partUUID, price)
+
+	items := []model.OrderItemInfo{{PartUUID: partUUID, Quantity: 5}}
+	orderItems := []model.OrderItem{
+		{
+			PartUUID:  partUUID,
+			Name:      part.Name,
+			Category:  part.Category,
+			Quantity:  5,
+			UnitPrice: price,
+		},
+	}
+
+	filter := model.PartsFilter{
+		Uuids: []string{partUUID},
+	}
+
+	expectedErr := status.Error(codes.DeadlineExceeded, "context deadline exceeded")
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	inventoryClient.On("ListParts", ctx, filter).Return([]model.Part{part}, nil).Once()
+	var orderUUID string
+	inventoryClient.On("ReserveStock", ctx, mock.AnythingOfType("string"), orderItems).Return(expectedErr).Run(func(args mock.Arguments) {
+		orderUUID = args.String(1)
+	}).Once()
+	// Резерв мог создаться, хотя ответ не дошёл: снимаем его под тем же UUID заказа
+	inventoryClient.On("ReleaseStock", mock.Anything, mock.MatchedBy(func(uuid string) bool {
+		return uuid == orderUUID
+	})).Return(nil).Once()
+	resp, err := orderService.CreateOrder(ctx, userUUID, model.OrderRequest{Items: items}, "")
+
+	assert.Equal(t, expectedErr, err)
+	assert.Empty(t, resp)
+}
+
+func TestCreateOrderInvalidQuantity(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	items := []model.OrderItemInfo{{PartUUID: gofakeit.UUID(), Quantity: 0}}
+	expectedErr := model.ErrPartsInvalidRequest
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	resp, err := orderService.CreateOrder(ctx, userUUID, model.OrderRequest{Items: items}, "")
+
+	assert.Error(t, err)
+	assert.Empty(t, resp)
+	assert.Equal(t, err, expectedErr)
+}
+
+func TestCreateOrderIncompleteRocket(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+
+	part := getMockedPart(partUUID, getMockedPrice())
+	part.Category = model.CategoryPorthole
+
+	items := []model.OrderItemInfo{{PartUUID: partUUID, Quantity: 5}}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	bomValidator := bom.NewValidator(bom.NewCategoryRule(map[model.Category]bom.CategoryLimit{
+		model.CategoryEngine:   {Min: 1},
+		model.CategoryPorthole: {Min: 0},
+	}))
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bomValidator,
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	// Детали не резервируются, если из них не собрать ракету
+	inventoryClient.On("ListParts", ctx, model.PartsFilter{Uuids: []string{partUUID}}).Return([]model.Part{part}, nil).Once()
+	resp, err := orderService.CreateOrder(ctx, userUUID, model.OrderRequest{Items: items}, "")
+
+	var bomErr *model.BOMValidationError
+	assert.ErrorAs(t, err, &bomErr)
+	assert.Equal(t, []model.BOMViolation{
+		{Category: model.CategoryEngine, Kind: model.BOMViolationMissing, Min: 1},
+	}, bomErr.Violations)
+	assert.Empty(t, resp)
+}
+
+func getMockedOrderItem() model.OrderItem {
+	return model.OrderItem{
+		PartUUID:  gofakeit.UUID(),
+		Name:      gofakeit.Name(),
+		Category:  model.CategoryEngine,
+		Quantity:  int64(gofakeit.Number(1, 5)),
+		UnitPrice: getMockedPrice(),
+	}
+}
+
+// matchOrder сверяет записываемый заказ, кроме сгенерированного UUID
+func matchOrder(userUUID string, items []model.OrderItem, total money.Money, rate money.Rate, discounts []model.OrderDiscount) any {
+	return mock.MatchedBy(func(order model.OrderData) bool {
+		return order.UUID != "" &&
+			order.UserUUID == userUUID &&
+			order.Status == model.OrderStatusPendingPayment &&
+			order.TotalPrice == total &&
+			order.ExchangeRate == rate &&
+			assert.ObjectsAreEqual(items, order.Items) &&
+			assert.ObjectsAreEqual(discounts, order.Discounts)
+	})
+}
+
+func getMockedPrice() money.Money {
+	return money.New(int64(gofakeit.Number(10_000, 100_000)), money.RUB)
+}
+
+func getMockedPart(uuid string, price money.Money) model.Part {
 	var (
 		name          = gofakeit.Name()
 		description   = gofakeit.Paragraph(3, 5, 5, " ")
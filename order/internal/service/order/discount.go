@@ -0,0 +1,110 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+// orderDiscounts находит промокод и считает скидку по нему. Без промокода скидок нет
+func (s *service) orderDiscounts(
+	ctx context.Context,
+	code string,
+	parts map[string]model.Part,
+	items []model.OrderItem,
+	subtotal money.Money,
+	rate money.Rate,
+) (*model.PromoCode, []model.OrderDiscount, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return nil, nil, nil
+	}
+
+	promo, err := s.promoCodeRepository.GetPromoCode(ctx, code)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	discount, err := calculateDiscount(promo, parts, items, subtotal, rate, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &promo, []model.OrderDiscount{discount}, nil
+}
+
+// calculateDiscount применяет правило промокода к позициям заказа. Суммы промокода заданы
+// в базовой валюте каталога и пересчитываются в валюту заказа по зафиксированному курсу
+func calculateDiscount(
+	promo model.PromoCode,
+	parts map[string]model.Part,
+	items []model.OrderItem,
+	subtotal money.Money,
+	rate money.Rate,
+	now time.Time,
+) (model.OrderDiscount, error) {
+	if !promo.IsActive(now) {
+		return model.OrderDiscount{}, model.ErrPromoCodeInactive
+	}
+
+	if promo.UsageLimit != nil && promo.UsedCount >= *promo.UsageLimit {
+		return model.OrderDiscount{}, model.ErrPromoCodeExhausted
+	}
+
+	if promo.MinOrderAmount != nil {
+		minOrderAmount, err := rate.Convert(*promo.MinOrderAmount)
+		if err != nil {
+			return model.OrderDiscount{}, err
+		}
+
+		if subtotal.Amount < minOrderAmount.Amount {
+			return model.OrderDiscount{}, model.ErrPromoCodeNotApplicable
+		}
+	}
+
+	// Скидка считается только от позиций, подходящих под условия промокода
+	eligible := money.Zero(subtotal.Currency)
+	for _, item := range items {
+		if !promo.AppliesTo(parts[item.PartUUID]) {
+			continue
+		}
+
+		var err error
+		eligible, err = eligible.Add(item.UnitPrice.Mul(item.Quantity))
+		if err != nil {
+			return model.OrderDiscount{}, err
+		}
+	}
+
+	var amount money.Money
+	switch promo.Type {
+	case model.DiscountTypePercent:
+		amount = eligible.Percent(promo.Percent)
+	case model.DiscountTypeFixed:
+		fixed, err := rate.Convert(promo.Amount)
+		if err != nil {
+			return model.OrderDiscount{}, err
+		}
+
+		amount, err = fixed.Min(eligible)
+		if err != nil {
+			return model.OrderDiscount{}, err
+		}
+	default:
+		return model.OrderDiscount{}, fmt.Errorf("promo code %s: unknown discount type %q", promo.Code, promo.Type)
+	}
+
+	if amount.Amount <= 0 {
+		return model.OrderDiscount{}, model.ErrPromoCodeNotApplicable
+	}
+
+	return model.OrderDiscount{
+		PromoCode:   promo.Code,
+		Description: promo.Description,
+		Amount:      amount,
+	}, nil
+}
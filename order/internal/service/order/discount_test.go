@@ -0,0 +1,334 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	exchangeMocks "github.com/Alexey-step/rocket-factory/order/internal/client/exchange/mocks"
+	clientMocks "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/bom"
+	orderServiceMocks "github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+func TestCalculateDiscount(t *testing.T) {
+	now := time.Now()
+
+	wing := getMockedPart(gofakeit.UUID(), money.New(2_000_000, money.RUB))
+	wing.Category = model.CategoryWing
+	engine := getMockedPart(gofakeit.UUID(), money.New(4_000_000, money.RUB))
+	engine.Category = model.CategoryEngine
+	// Производители случайные и могут совпасть, а скидка по производителю должна касаться только двигателя
+	wing.Manufacturer.Name = "Wing Works"
+	engine.Manufacturer.Name = "Engine Works"
+
+	parts := map[string]model.Part{wing.UUID: wing, engine.UUID: engine}
+	items := []model.OrderItem{
+		{PartUUID: wing.UUID, Category: wing.Category, Quantity: 2, UnitPrice: wing.Price},
+		{PartUUID: engine.UUID, Category: engine.Category, Quantity: 1, UnitPrice: engine.Price},
+	}
+	subtotal := money.New(8_000_000, money.RUB)
+
+	basePromo := func() model.PromoCode {
+		return model.PromoCode{
+			Code:      "PROMO",
+			ValidFrom: now.Add(-time.Hour),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		promo    func() model.PromoCode
+		rate     money.Rate
+		expected money.Money
+		err      error
+	}{
+		{
+			name: "процент на категорию",
+			promo: func() model.PromoCode {
+				p := basePromo()
+				p.Type = model.DiscountTypePercent
+				p.Percent = 10
+				p.Category = lo.ToPtr(model.CategoryWing)
+				return p
+			},
+			rate:     money.Identity(money.RUB),
+			expected: money.New(400_000, money.RUB),
+		},
+		{
+			name: "фиксированная скидка от суммы заказа",
+			promo: func() model.PromoCode {
+				p := basePromo()
+				p.Type = model.DiscountTypeFixed
+				p.Amount = money.New(500_000, money.RUB)
+				p.MinOrderAmount = lo.ToPtr(money.New(5_000_000, money.RUB))
+				return p
+			},
+			rate:     money.Identity(money.RUB),
+			expected: money.New(500_000, money.RUB),
+		},
+		{
+			name: "фиксированная скидка пересчитывается в валюту заказа",
+			promo: func() model.PromoCode {
+				p := basePromo()
+				p.Type = model.DiscountTypeFixed
+				p.Amount = money.New(500_000, money.RUB)
+				return p
+			},
+			rate:     money.Rate{From: money.RUB, To: "USD", Value: 10_800},
+			expected: money.New(5_400, "USD"),
+		},
+		{
+			name: "фиксированная скидка не больше стоимости подходящих деталей",
+			promo: func() model.PromoCode {
+				p := basePromo()
+				p.Type = model.DiscountTypeFixed
+				p.Amount = money.New(10_000_000, money.RUB)
+				p.Manufacturer = lo.ToPtr(engine.Manufacturer.Name)
+				return p
+			},
+			rate:     money.Identity(money.RUB),
+			expected: money.New(4_000_000, money.RUB),
+		},
+		{
+			name: "сумма заказа меньше минимальной",
+			promo: func() model.PromoCode {
+				p := basePromo()
+				p.Type = model.DiscountTypeFixed
+				p.Amount = money.New(500_000, money.RUB)
+				p.MinOrderAmount = lo.ToPtr(money.New(10_000_000, money.RUB))
+				return p
+			},
+			rate: money.Identity(money.RUB),
+			err:  model.ErrPromoCodeNotApplicable,
+		},
+		{
+			name: "нет деталей подходящей категории",
+			promo: func() model.PromoCode {
+				p := basePromo()
+				p.Type = model.DiscountTypePercent
+				p.Percent = 10
+				p.Category = lo.ToPtr(model.CategoryPorthole)
+				return p
+			},
+			rate: money.Identity(money.RUB),
+			err:  model.ErrPromoCodeNotApplicable,
+		},
+		{
+			name: "срок действия истёк",
+			promo: func() model.PromoCode {
+				p := basePromo()
+				p.Type = model.DiscountTypePercent
+				p.Percent = 10
+				p.ValidTo = lo.ToPtr(now.Add(-time.Minute))
+				return p
+			},
+			rate: money.Identity(money.RUB),
+			err:  model.ErrPromoCodeInactive,
+		},
+		{
+			name: "ещё не начал действовать",
+			promo: func() model.PromoCode {
+				p := basePromo()
+				p.Type = model.DiscountTypePercent
+				p.Percent = 10
+				p.ValidFrom = now.Add(time.Hour)
+				return p
+			},
+			rate: money.Identity(money.RUB),
+			err:  model.ErrPromoCodeInactive,
+		},
+		{
+			name: "лимит использований исчерпан",
+			promo: func() model.PromoCode {
+				p := basePromo()
+				p.Type = model.DiscountTypePercent
+				p.Percent = 10
+				p.UsageLimit = lo.ToPtr(int64(100))
+				p.UsedCount = 100
+				return p
+			},
+			rate: money.Identity(money.RUB),
+			err:  model.ErrPromoCodeExhausted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderItems := items
+			orderSubtotal := subtotal
+			if tt.rate.To != tt.rate.From {
+				orderItems = make([]model.OrderItem, 0, len(items))
+				for _, item := range items {
+					converted, err := tt.rate.Convert(item.UnitPrice)
+					require.NoError(t, err)
+					item.UnitPrice = converted
+					orderItems = append(orderItems, item)
+				}
+
+				var err error
+				orderSubtotal, err = orderTotal(orderItems, tt.rate.To)
+				require.NoError(t, err)
+			}
+
+			discount, err := calculateDiscount(tt.promo(), parts, orderItems, orderSubtotal, tt.rate, now)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "PROMO", discount.PromoCode)
+			assert.Equal(t, tt.expected, discount.Amount)
+		})
+	}
+}
+
+func TestCreateOrderWithPromoCode(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+	price := money.New(1_000_000, money.RUB)
+
+	part := getMockedPart(partUUID, price)
+	part.Category = model.CategoryWing
+
+	promo := model.PromoCode{
+		Code:        "WING10",
+		Description: "10% на крылья",
+		Type:        model.DiscountTypePercent,
+		Percent:     10,
+		Category:    lo.ToPtr(model.CategoryWing),
+		ValidFrom:   time.Now().Add(-time.Hour),
+	}
+
+	request := model.OrderRequest{
+		Items:     []model.OrderItemInfo{{PartUUID: partUUID, Quantity: 2}},
+		PromoCode: " wing10 ",
+	}
+	orderItems := []model.OrderItem{
+		{
+			PartUUID:  partUUID,
+			Name:      part.Name,
+			Category:  part.Category,
+			Quantity:  2,
+			UnitPrice: price,
+		},
+	}
+	discounts := []model.OrderDiscount{
+		{PromoCode: promo.Code, Description: promo.Description, Amount: money.New(200_000, money.RUB)},
+	}
+
+	info := model.OrderCreationInfo{
+		OrderUUID:  orderUUID,
+		TotalPrice: money.New(1_800_000, money.RUB),
+	}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	inventoryClient.On("ListParts", ctx, model.PartsFilter{Uuids: []string{partUUID}}).Return([]model.Part{part}, nil).Once()
+	promoCodeRepository.On("GetPromoCode", ctx, "WING10").Return(promo, nil).Once()
+	inventoryClient.On("ReserveStock", ctx, mock.AnythingOfType("string"), orderItems).Return(nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	promoCodeRepository.On("RedeemPromoCode", ctx, "WING10").Return(nil).Once()
+	orderRepository.On("CreateOrder", ctx, matchOrder(userUUID, orderItems, info.TotalPrice, money.Identity(money.RUB), discounts)).Return(info, nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderRepository.On("AddStatusHistory", ctx, mock.AnythingOfType("model.OrderStatusHistory")).Return(nil).Once()
+	orderProducer.On("ProduceOrderCreated", ctx, mock.AnythingOfType("model.OrderCreated")).Return(nil).Once()
+	resp, err := orderService.CreateOrder(ctx, userUUID, request, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, info, resp)
+}
+
+func TestCreateOrderPromoCodeExhausted(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+
+	part := getMockedPart(partUUID, getMockedPrice())
+
+	promo := model.PromoCode{
+		Code:       "LAST",
+		Type:       model.DiscountTypePercent,
+		Percent:    5,
+		ValidFrom:  time.Now().Add(-time.Hour),
+		UsageLimit: lo.ToPtr(int64(1)),
+	}
+
+	request := model.OrderRequest{
+		Items:     []model.OrderItemInfo{{PartUUID: partUUID, Quantity: 1}},
+		PromoCode: promo.Code,
+	}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	// Промокод успел израсходовать параллельный заказ: резерв снимается, заказ не создаётся
+	inventoryClient.On("ListParts", ctx, model.PartsFilter{Uuids: []string{partUUID}}).Return([]model.Part{part}, nil).Once()
+	promoCodeRepository.On("GetPromoCode", ctx, promo.Code).Return(promo, nil).Once()
+	inventoryClient.On("ReserveStock", ctx, mock.AnythingOfType("string"), mock.Anything).Return(nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	promoCodeRepository.On("RedeemPromoCode", ctx, promo.Code).Return(model.ErrPromoCodeExhausted).Once()
+	inventoryClient.On("ReleaseStock", mock.Anything, mock.AnythingOfType("string")).Return(nil).Once()
+	resp, err := orderService.CreateOrder(ctx, userUUID, request, "")
+
+	assert.ErrorIs(t, err, model.ErrPromoCodeExhausted)
+	assert.Empty(t, resp)
+}
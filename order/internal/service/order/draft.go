@@ -0,0 +1,289 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+func (s *service) GetDraft(ctx context.Context, userUUID string) (model.OrderData, error) {
+	return s.orderRepository.GetDraftOrder(ctx, userUUID)
+}
+
+// AddDraftItem добавляет деталь в черновик пользователя, создавая черновик при первом добавлении.
+// Если деталь уже есть в черновике, количество увеличивается
+func (s *service) AddDraftItem(ctx context.Context, userUUID string, item model.OrderItemInfo) (model.OrderData, error) {
+	if item.Quantity <= 0 {
+		return model.OrderData{}, model.ErrPartsInvalidRequest
+	}
+
+	part, err := s.draftPart(ctx, item.PartUUID)
+	if err != nil {
+		return model.OrderData{}, err
+	}
+
+	var draft model.OrderData
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		_, txErr := s.orderRepository.EnsureDraftOrder(ctx, model.OrderData{
+			UUID:         uuid.NewString(),
+			UserUUID:     userUUID,
+			TotalPrice:   money.Zero(part.Price.Currency),
+			ExchangeRate: money.Identity(part.Price.Currency),
+			Status:       model.OrderStatusDraft,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		current, txErr := s.orderRepository.GetDraftOrder(ctx, userUUID)
+		if txErr != nil {
+			return txErr
+		}
+
+		quantity := item.Quantity
+		if existing, ok := findDraftItem(current, item.PartUUID); ok {
+			quantity += existing.Quantity
+		}
+
+		txErr = s.orderRepository.SetDraftItem(ctx, current.UUID, draftItem(part, quantity))
+		if txErr != nil {
+			return txErr
+		}
+
+		draft, txErr = s.refreshDraftTotal(ctx, userUUID)
+		return txErr
+	})
+	if err != nil {
+		return model.OrderData{}, err
+	}
+
+	return draft, nil
+}
+
+// SetDraftItemQuantity меняет количество детали в черновике; нулевое количество убирает деталь
+func (s *service) SetDraftItemQuantity(ctx context.Context, userUUID, partUUID string, quantity int64) (model.OrderData, error) {
+	if quantity < 0 {
+		return model.OrderData{}, model.ErrPartsInvalidRequest
+	}
+
+	if quantity == 0 {
+		return s.RemoveDraftItem(ctx, userUUID, partUUID)
+	}
+
+	part, err := s.draftPart(ctx, partUUID)
+	if err != nil {
+		return model.OrderData{}, err
+	}
+
+	var draft model.OrderData
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		current, txErr := s.orderRepository.GetDraftOrder(ctx, userUUID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if _, ok := findDraftItem(current, partUUID); !ok {
+			return model.ErrDraftItemNotFound
+		}
+
+		txErr = s.orderRepository.SetDraftItem(ctx, current.UUID, draftItem(part, quantity))
+		if txErr != nil {
+			return txErr
+		}
+
+		draft, txErr = s.refreshDraftTotal(ctx, userUUID)
+		return txErr
+	})
+	if err != nil {
+		return model.OrderData{}, err
+	}
+
+	return draft, nil
+}
+
+func (s *service) RemoveDraftItem(ctx context.Context, userUUID, partUUID string) (model.OrderData, error) {
+	var draft model.OrderData
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		current, txErr := s.orderRepository.GetDraftOrder(ctx, userUUID)
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = s.orderRepository.RemoveDraftItem(ctx, current.UUID, partUUID)
+		if txErr != nil {
+			return txErr
+		}
+
+		draft, txErr = s.refreshDraftTotal(ctx, userUUID)
+		return txErr
+	})
+	if err != nil {
+		return model.OrderData{}, err
+	}
+
+	return draft, nil
+}
+
+// CheckoutDraft оформляет черновик в заказ. Цены, курс и промокод считаются заново по текущему
+// каталогу, наличие проверяется резервированием деталей, как при CreateOrder
+func (s *service) CheckoutDraft(ctx context.Context, userUUID string, checkout model.DraftCheckout, idempotencyKey string) (model.OrderCreationInfo, error) {
+	key, err := newIdempotencyKey(userUUID, model.IdempotencyOperationCheckout, idempotencyKey, checkout)
+	if err != nil {
+		return model.OrderCreationInfo{}, err
+	}
+
+	return runIdempotent(ctx, s.idempotencyRepository, key, func() (model.OrderCreationInfo, error) {
+		return s.checkoutDraft(ctx, userUUID, checkout)
+	})
+}
+
+func (s *service) checkoutDraft(ctx context.Context, userUUID string, checkout model.DraftCheckout) (model.OrderCreationInfo, error) {
+	draft, err := s.orderRepository.GetDraftOrder(ctx, userUUID)
+	if err != nil {
+		return model.OrderCreationInfo{}, err
+	}
+
+	if len(draft.Items) == 0 {
+		return model.OrderCreationInfo{}, model.ErrDraftEmpty
+	}
+
+	request := model.OrderRequest{
+		Items:     make([]model.OrderItemInfo, 0, len(draft.Items)),
+		Currency:  checkout.Currency,
+		PromoCode: checkout.PromoCode,
+	}
+	for _, item := range draft.Items {
+		request.Items = append(request.Items, model.OrderItemInfo{
+			PartUUID: item.PartUUID,
+			Quantity: item.Quantity,
+		})
+	}
+
+	pricing, err := s.priceOrder(ctx, request)
+	if err != nil {
+		return model.OrderCreationInfo{}, err
+	}
+
+	reservationKey := uuid.NewString()
+
+	// Каждая попытка оформления резервирует детали под своим ключом. Резерв, снятый
+	// после неудачной попытки, inventory под тем же ключом уже не создаст, а при
+	// параллельном оформлении проигравший снимает только свой резерв
+	order := model.OrderData{
+		UUID:                draft.UUID,
+		UserUUID:            userUUID,
+		Items:               pricing.items,
+		TotalPrice:          pricing.total,
+		Discounts:           pricing.discounts,
+		ExchangeRate:        pricing.rate,
+		Status:              model.OrderStatusPendingPayment,
+		StockReservationKey: &reservationKey,
+	}
+
+	err = s.reserveStock(ctx, order.ReservationKey(), pricing.items)
+	if err != nil {
+		return model.OrderCreationInfo{}, err
+	}
+
+	checkoutErr := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if pricing.promo != nil {
+			if txErr := s.promoCodeRepository.RedeemPromoCode(ctx, pricing.promo.Code); txErr != nil {
+				return txErr
+			}
+		}
+
+		txErr := s.orderRepository.CheckoutDraftOrder(ctx, order)
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = s.addStatusHistory(ctx, order.UserUUID, model.OrderStatusHistory{
+			OrderUUID:  order.UUID,
+			FromStatus: &draft.Status,
+			ToStatus:   model.OrderStatusPendingPayment,
+			Actor:      model.UserActor(userUUID),
+			Reason:     model.StatusReasonDraftCheckedOut,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		return s.produceOrderCreated(ctx, order)
+	})
+	if checkoutErr != nil {
+		s.releaseStock(ctx, order.ReservationKey())
+		return model.OrderCreationInfo{}, checkoutErr
+	}
+
+	return model.OrderCreationInfo{
+		OrderUUID:  order.UUID,
+		TotalPrice: order.TotalPrice,
+	}, nil
+}
+
+// draftPart находит деталь в каталоге: в черновик нельзя положить несуществующую деталь
+func (s *service) draftPart(ctx context.Context, partUUID string) (model.Part, error) {
+	parts, err := s.inventoryClient.ListParts(ctx, model.PartsFilter{
+		Uuids: []string{partUUID},
+	})
+	if err != nil {
+		return model.Part{}, err
+	}
+
+	for _, part := range parts {
+		if part.UUID == partUUID {
+			return part, nil
+		}
+	}
+
+	return model.Part{}, fmt.Errorf("%w: %s", model.ErrPartsNotFound, partUUID)
+}
+
+// refreshDraftTotal пересчитывает стоимость черновика по ценам позиций. Цены в черновике
+// ориентировочные - окончательная стоимость считается при оформлении
+func (s *service) refreshDraftTotal(ctx context.Context, userUUID string) (model.OrderData, error) {
+	draft, err := s.orderRepository.GetDraftOrder(ctx, userUUID)
+	if err != nil {
+		return model.OrderData{}, err
+	}
+
+	total, err := orderTotal(draft.Items, draft.TotalPrice.Currency)
+	if err != nil {
+		return model.OrderData{}, err
+	}
+
+	err = s.orderRepository.UpdateOrder(ctx, draft.UUID, model.OrderStatusDraft, model.OrderUpdateInfo{
+		TotalPrice: &total,
+	})
+	if err != nil {
+		return model.OrderData{}, err
+	}
+
+	draft.TotalPrice = total
+	return draft, nil
+}
+
+// draftItem - позиция черновика по текущим данным детали из каталога, в валюте каталога
+func draftItem(part model.Part, quantity int64) model.OrderItem {
+	return model.OrderItem{
+		PartUUID:  part.UUID,
+		Name:      part.Name,
+		Category:  part.Category,
+		Quantity:  quantity,
+		UnitPrice: part.Price,
+	}
+}
+
+func findDraftItem(draft model.OrderData, partUUID string) (model.OrderItem, bool) {
+	for _, item := range draft.Items {
+		if item.PartUUID == partUUID {
+			return item, true
+		}
+	}
+
+	return model.OrderItem{}, false
+}
@@ -0,0 +1,362 @@
+package order
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	exchangeMocks "github.com/Alexey-step/rocket-factory/order/internal/client/exchange/mocks"
+	clientMocks "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/bom"
+	orderServiceMocks "github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+func TestAddDraftItemIncreasesQuantity(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	draftUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+	price := money.New(50_000, money.RUB)
+
+	part := getMockedPart(partUUID, price)
+
+	current := getMockedDraft(draftUUID, userUUID, model.OrderItem{
+		PartUUID:  partUUID,
+		Name:      part.Name,
+		Category:  part.Category,
+		Quantity:  2,
+		UnitPrice: price,
+	})
+	updatedItem := model.OrderItem{
+		PartUUID:  partUUID,
+		Name:      part.Name,
+		Category:  part.Category,
+		Quantity:  5,
+		UnitPrice: price,
+	}
+	updated := getMockedDraft(draftUUID, userUUID, updatedItem)
+
+	orderRepository := mocks.NewOrderRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		mocks.NewIdempotencyRepository(t),
+		mocks.NewPromoCodeRepository(t),
+		inventoryClient,
+		clientMocks.NewPaymentClient(t),
+		exchangeMocks.NewRateProvider(t),
+		bom.NewValidator(),
+		orderServiceMocks.NewOrderProducerService(t),
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	inventoryClient.On("ListParts", ctx, model.PartsFilter{Uuids: []string{partUUID}}).Return([]model.Part{part}, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	orderRepository.On("EnsureDraftOrder", ctx, mock.MatchedBy(func(draft model.OrderData) bool {
+		return draft.UserUUID == userUUID && draft.Status == model.OrderStatusDraft && draft.TotalPrice == money.Zero(money.RUB)
+	})).Return(draftUUID, nil).Once()
+	orderRepository.On("GetDraftOrder", ctx, userUUID).Return(current, nil).Once()
+	orderRepository.On("SetDraftItem", ctx, draftUUID, updatedItem).Return(nil).Once()
+	orderRepository.On("GetDraftOrder", ctx, userUUID).Return(updated, nil).Once()
+	orderRepository.On("UpdateOrder", ctx, draftUUID, model.OrderStatusDraft, model.OrderUpdateInfo{
+		TotalPrice: lo.ToPtr(money.New(250_000, money.RUB)),
+	}).Return(nil).Once()
+	draft, err := orderService.AddDraftItem(ctx, userUUID, model.OrderItemInfo{PartUUID: partUUID, Quantity: 3})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []model.OrderItem{updatedItem}, draft.Items)
+	assert.Equal(t, money.New(250_000, money.RUB), draft.TotalPrice)
+}
+
+func TestSetDraftItemQuantityItemNotFound(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	draftUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+
+	part := getMockedPart(partUUID, getMockedPrice())
+
+	orderRepository := mocks.NewOrderRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		mocks.NewIdempotencyRepository(t),
+		mocks.NewPromoCodeRepository(t),
+		inventoryClient,
+		clientMocks.NewPaymentClient(t),
+		exchangeMocks.NewRateProvider(t),
+		bom.NewValidator(),
+		orderServiceMocks.NewOrderProducerService(t),
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	inventoryClient.On("ListParts", ctx, model.PartsFilter{Uuids: []string{partUUID}}).Return([]model.Part{part}, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	orderRepository.On("GetDraftOrder", ctx, userUUID).Return(getMockedDraft(draftUUID, userUUID, getMockedOrderItem()), nil).Once()
+	draft, err := orderService.SetDraftItemQuantity(ctx, userUUID, partUUID, 4)
+
+	assert.ErrorIs(t, err, model.ErrDraftItemNotFound)
+	assert.Empty(t, draft)
+}
+
+func TestCheckoutDraftRepricesItems(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	draftUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+
+	// Цена в каталоге выросла после того, как деталь положили в черновик
+	oldPrice := money.New(40_000, money.RUB)
+	newPrice := money.New(45_000, money.RUB)
+	part := getMockedPart(partUUID, newPrice)
+
+	draft := getMockedDraft(draftUUID, userUUID, model.OrderItem{
+		PartUUID:  partUUID,
+		Name:      part.Name,
+		Category:  part.Category,
+		Quantity:  2,
+		UnitPrice: oldPrice,
+	})
+	orderItems := []model.OrderItem{
+		{
+			PartUUID:  partUUID,
+			Name:      part.Name,
+			Category:  part.Category,
+			Quantity:  2,
+			UnitPrice: newPrice,
+		},
+	}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		mocks.NewIdempotencyRepository(t),
+		mocks.NewPromoCodeRepository(t),
+		inventoryClient,
+		clientMocks.NewPaymentClient(t),
+		exchangeMocks.NewRateProvider(t),
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	orderRepository.On("GetDraftOrder", ctx, userUUID).Return(draft, nil).Once()
+	inventoryClient.On("ListParts", ctx, model.PartsFilter{Uuids: []string{partUUID}}).Return([]model.Part{part}, nil).Once()
+	var reservationKey string
+	inventoryClient.On("ReserveStock", ctx, mock.AnythingOfType("string"), orderItems).
+		Run(func(args mock.Arguments) { reservationKey = args.String(1) }).
+		Return(nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	orderRepository.On("CheckoutDraftOrder", ctx, mock.MatchedBy(func(order model.OrderData) bool {
+		return assert.ObjectsAreEqual(model.OrderData{
+			UUID:                draftUUID,
+			UserUUID:            userUUID,
+			Items:               orderItems,
+			TotalPrice:          money.New(90_000, money.RUB),
+			ExchangeRate:        money.Identity(money.RUB),
+			Status:              model.OrderStatusPendingPayment,
+			StockReservationKey: &reservationKey,
+		}, order)
+	})).Return(nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderRepository.On("AddStatusHistory", ctx, model.OrderStatusHistory{
+		OrderUUID:  draftUUID,
+		FromStatus: lo.ToPtr(model.OrderStatusDraft),
+		ToStatus:   model.OrderStatusPendingPayment,
+		Actor:      model.UserActor(userUUID),
+		Reason:     model.StatusReasonDraftCheckedOut,
+	}).Return(nil).Once()
+	orderProducer.On("ProduceOrderCreated", ctx, mock.MatchedBy(func(event model.OrderCreated) bool {
+		return event.OrderUUID == draftUUID && event.TotalPrice == money.New(90_000, money.RUB)
+	})).Return(nil).Once()
+	info, err := orderService.CheckoutDraft(ctx, userUUID, model.DraftCheckout{}, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, model.OrderCreationInfo{OrderUUID: draftUUID, TotalPrice: money.New(90_000, money.RUB)}, info)
+	assert.NotEqual(t, draftUUID, reservationKey)
+}
+
+func TestCheckoutDraftRetryAfterFailedCheckout(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	draftUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+	price := getMockedPrice()
+
+	part := getMockedPart(partUUID, price)
+	item := model.OrderItem{
+		PartUUID:  partUUID,
+		Name:      part.Name,
+		Category:  part.Category,
+		Quantity:  1,
+		UnitPrice: price,
+	}
+	draft := getMockedDraft(draftUUID, userUUID, item)
+	checkoutErr := gofakeit.Error()
+
+	orderRepository := mocks.NewOrderRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		mocks.NewIdempotencyRepository(t),
+		mocks.NewPromoCodeRepository(t),
+		inventoryClient,
+		clientMocks.NewPaymentClient(t),
+		exchangeMocks.NewRateProvider(t),
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	var reservationKeys []string
+	orderRepository.On("GetDraftOrder", ctx, userUUID).Return(draft, nil).Twice()
+	inventoryClient.On("ListParts", ctx, model.PartsFilter{Uuids: []string{partUUID}}).Return([]model.Part{part}, nil).Twice()
+	inventoryClient.On("ReserveStock", ctx, mock.AnythingOfType("string"), []model.OrderItem{item}).
+		Run(func(args mock.Arguments) { reservationKeys = append(reservationKeys, args.String(1)) }).
+		Return(nil).Twice()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Twice()
+
+	// Первая попытка падает после резерва: резерв снимается под её собственным ключом
+	orderRepository.On("CheckoutDraftOrder", ctx, mock.AnythingOfType("model.OrderData")).Return(checkoutErr).Once()
+	inventoryClient.On("ReleaseStock", mock.Anything, mock.MatchedBy(func(key string) bool {
+		return len(reservationKeys) == 1 && key == reservationKeys[0]
+	})).Return(nil).Once()
+
+	_, err := orderService.CheckoutDraft(ctx, userUUID, model.DraftCheckout{}, "")
+	assert.ErrorIs(t, err, checkoutErr)
+
+	// Повтор резервирует детали заново под новым ключом и сохраняет его в заказе
+	orderRepository.On("CheckoutDraftOrder", ctx, mock.MatchedBy(func(order model.OrderData) bool {
+		return len(reservationKeys) == 2 && order.ReservationKey() == reservationKeys[1]
+	})).Return(nil).Once()
+	orderRepository.On("AddStatusHistory", ctx, mock.AnythingOfType("model.OrderStatusHistory")).Return(nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderProducer.On("ProduceOrderCreated", ctx, mock.AnythingOfType("model.OrderCreated")).Return(nil).Once()
+
+	info, err := orderService.CheckoutDraft(ctx, userUUID, model.DraftCheckout{}, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, draftUUID, info.OrderUUID)
+	assert.Len(t, reservationKeys, 2)
+	assert.NotEqual(t, reservationKeys[0], reservationKeys[1])
+}
+
+func TestCheckoutDraftInsufficientStock(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	draftUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+	price := getMockedPrice()
+
+	part := getMockedPart(partUUID, price)
+	item := model.OrderItem{
+		PartUUID:  partUUID,
+		Name:      part.Name,
+		Category:  part.Category,
+		Quantity:  7,
+		UnitPrice: price,
+	}
+	stockErr := &model.InsufficientStockError{
+		Shortages: []model.StockShortage{{PartUUID: partUUID, Requested: 7, Available: 2}},
+	}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+
+	orderService := NewService(
+		orderRepository,
+		mocks.NewIdempotencyRepository(t),
+		mocks.NewPromoCodeRepository(t),
+		inventoryClient,
+		clientMocks.NewPaymentClient(t),
+		exchangeMocks.NewRateProvider(t),
+		bom.NewValidator(),
+		orderServiceMocks.NewOrderProducerService(t),
+		orderServiceMocks.NewWebhookNotifier(t),
+		orderServiceMocks.NewOrderStatusPublisher(t),
+		orderServiceMocks.NewTxManager(t),
+	)
+
+	orderRepository.On("GetDraftOrder", ctx, userUUID).Return(getMockedDraft(draftUUID, userUUID, item), nil).Once()
+	inventoryClient.On("ListParts", ctx, model.PartsFilter{Uuids: []string{partUUID}}).Return([]model.Part{part}, nil).Once()
+	inventoryClient.On("ReserveStock", ctx, mock.AnythingOfType("string"), []model.OrderItem{item}).Return(stockErr).Once()
+	info, err := orderService.CheckoutDraft(ctx, userUUID, model.DraftCheckout{}, "")
+
+	assert.ErrorIs(t, err, model.ErrInsufficientStock)
+	assert.Empty(t, info)
+}
+
+func TestCheckoutDraftEmpty(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+
+	orderRepository := mocks.NewOrderRepository(t)
+
+	orderService := NewService(
+		orderRepository,
+		mocks.NewIdempotencyRepository(t),
+		mocks.NewPromoCodeRepository(t),
+		clientMocks.NewInventoryClient(t),
+		clientMocks.NewPaymentClient(t),
+		exchangeMocks.NewRateProvider(t),
+		bom.NewValidator(),
+		orderServiceMocks.NewOrderProducerService(t),
+		orderServiceMocks.NewWebhookNotifier(t),
+		orderServiceMocks.NewOrderStatusPublisher(t),
+		orderServiceMocks.NewTxManager(t),
+	)
+
+	orderRepository.On("GetDraftOrder", ctx, userUUID).Return(getMockedDraft(gofakeit.UUID(), userUUID), nil).Once()
+	info, err := orderService.CheckoutDraft(ctx, userUUID, model.DraftCheckout{}, "")
+
+	assert.ErrorIs(t, err, model.ErrDraftEmpty)
+	assert.Empty(t, info)
+}
+
+func getMockedDraft(draftUUID, userUUID string, items ...model.OrderItem) model.OrderData {
+	return model.OrderData{
+		UUID:         draftUUID,
+		UserUUID:     userUUID,
+		Items:        items,
+		TotalPrice:   money.Zero(money.RUB),
+		ExchangeRate: money.Identity(money.RUB),
+		Status:       model.OrderStatusDraft,
+		CreatedAt:    gofakeit.Date(),
+	}
+}
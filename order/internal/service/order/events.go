@@ -0,0 +1,20 @@
+package order
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+// newEventEnvelope заполняет конверт события заказа. causationID - ID инициатора
+// перехода из истории статусов: UUID пользователя, UUID входящего события или имя фонового процесса
+func newEventEnvelope(causationID string) model.EventEnvelope {
+	return model.EventEnvelope{
+		EventUUID:     uuid.NewString(),
+		OccurredAt:    time.Now(),
+		CausationID:   causationID,
+		SchemaVersion: model.EventSchemaVersion,
+	}
+}
@@ -0,0 +1,63 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+// ExpireOrders отменяет до limit заказов, не оплаченных до createdBefore, и возвращает их количество.
+// Заказы блокируются и отменяются в одной транзакции вместе с историей и событием в outbox,
+// поэтому реплики, запущенные параллельно, разбирают разные заказы.
+func (s *service) ExpireOrders(ctx context.Context, createdBefore time.Time, limit int) (int, error) {
+	var expired []model.OrderData
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		orders, err := s.orderRepository.LockExpiredOrders(ctx, createdBefore, limit)
+		if err != nil {
+			return err
+		}
+
+		for _, order := range orders {
+			err = s.transitionOrder(ctx, order, model.OrderStatusCanceled, model.OrderUpdateInfo{
+				CancelReason: lo.ToPtr(model.CancelReasonPaymentTimeout),
+			}, model.StatusChange{
+				Actor:  model.SweeperActor(),
+				Reason: model.StatusReasonOrderExpired,
+			})
+			if err != nil {
+				return err
+			}
+
+			err = s.promoCodeRepository.ReturnPromoCodeUses(ctx, order.UUID)
+			if err != nil {
+				return err
+			}
+
+			err = s.orderProducerService.ProduceOrderExpired(ctx, model.OrderExpired{
+				Envelope:  newEventEnvelope(model.SweeperActor().ID),
+				OrderUUID: order.UUID,
+				UserUUID:  order.UserUUID,
+				Reason:    model.CancelReasonPaymentTimeout,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		expired = orders
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// Резерв снимаем после коммита, как и при отмене пользователем
+	for _, order := range expired {
+		s.releaseStock(ctx, order.ReservationKey())
+	}
+
+	return len(expired), nil
+}
@@ -0,0 +1,176 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	exchangeMocks "github.com/Alexey-step/rocket-factory/order/internal/client/exchange/mocks"
+	clientMocks "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/bom"
+	orderServiceMocks "github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+)
+
+func TestExpireOrdersSuccess(t *testing.T) {
+	ctx := context.Background()
+	createdBefore := time.Now().Add(-30 * time.Minute)
+	limit := 10
+
+	orders := []model.OrderData{
+		getMockOrderWithStatus(gofakeit.UUID(), model.OrderStatusPendingPayment),
+		getMockOrderWithStatus(gofakeit.UUID(), model.OrderStatusPendingPayment),
+	}
+
+	orderUpdateInfo := model.OrderUpdateInfo{
+		Status:       lo.ToPtr(model.OrderStatusCanceled),
+		CancelReason: lo.ToPtr(model.CancelReasonPaymentTimeout),
+	}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	orderRepository.On("LockExpiredOrders", ctx, createdBefore, limit).Return(orders, nil).Once()
+	for _, order := range orders {
+		txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+		orderRepository.On("UpdateOrder", ctx, order.UUID, model.OrderStatusPendingPayment, orderUpdateInfo).Return(nil).Once()
+		webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+		orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+		orderRepository.On("AddStatusHistory", ctx, model.OrderStatusHistory{
+			OrderUUID:  order.UUID,
+			FromStatus: lo.ToPtr(model.OrderStatusPendingPayment),
+			ToStatus:   model.OrderStatusCanceled,
+			Actor:      model.SweeperActor(),
+			Reason:     model.StatusReasonOrderExpired,
+		}).Return(nil).Once()
+		promoCodeRepository.On("ReturnPromoCodeUses", ctx, order.UUID).Return(nil).Once()
+		orderProducer.On("ProduceOrderExpired", ctx, mock.MatchedBy(func(event model.OrderExpired) bool {
+			return event.OrderUUID == order.UUID &&
+				event.UserUUID == order.UserUUID &&
+				event.Reason == model.CancelReasonPaymentTimeout &&
+				event.Envelope.CausationID == model.SweeperActor().ID &&
+				event.Envelope.SchemaVersion == model.EventSchemaVersion
+		})).Return(nil).Once()
+		inventoryClient.On("ReleaseStock", mock.Anything, order.UUID).Return(nil).Once()
+	}
+
+	expired, err := orderService.ExpireOrders(ctx, createdBefore, limit)
+	assert.NoError(t, err)
+	assert.Equal(t, len(orders), expired)
+}
+
+func TestExpireOrdersNothingToExpire(t *testing.T) {
+	ctx := context.Background()
+	createdBefore := time.Now().Add(-30 * time.Minute)
+	limit := 10
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	orderRepository.On("LockExpiredOrders", ctx, createdBefore, limit).Return([]model.OrderData{}, nil).Once()
+
+	expired, err := orderService.ExpireOrders(ctx, createdBefore, limit)
+	assert.NoError(t, err)
+	assert.Zero(t, expired)
+}
+
+func TestExpireOrdersProduceError(t *testing.T) {
+	ctx := context.Background()
+	createdBefore := time.Now().Add(-30 * time.Minute)
+	limit := 10
+	expectedErr := errors.New("outbox error")
+
+	order := getMockOrderWithStatus(gofakeit.UUID(), model.OrderStatusPendingPayment)
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Twice()
+	orderRepository.On("LockExpiredOrders", ctx, createdBefore, limit).Return([]model.OrderData{order}, nil).Once()
+	orderRepository.On("UpdateOrder", ctx, order.UUID, model.OrderStatusPendingPayment, mock.AnythingOfType("model.OrderUpdateInfo")).Return(nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderRepository.On("AddStatusHistory", ctx, mock.AnythingOfType("model.OrderStatusHistory")).Return(nil).Once()
+	promoCodeRepository.On("ReturnPromoCodeUses", ctx, order.UUID).Return(nil).Once()
+	orderProducer.On("ProduceOrderExpired", ctx, mock.AnythingOfType("model.OrderExpired")).Return(expectedErr).Once()
+
+	// Транзакция откатывается, резерв не снимается
+	expired, err := orderService.ExpireOrders(ctx, createdBefore, limit)
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Zero(t, expired)
+}
@@ -6,11 +6,25 @@ import (
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
 )
 
-func (s *service) GetOrder(ctx context.Context, orderUUID string) (order model.OrderData, err error) {
-	outOrder, err := s.orderRepository.GetOrder(ctx, orderUUID)
+func (s *service) GetOrder(ctx context.Context, userUUID, orderUUID string) (order model.OrderData, err error) {
+	outOrder, err := s.getUserOrder(ctx, userUUID, orderUUID)
 	if err != nil {
 		return model.OrderData{}, err
 	}
 
 	return outOrder, nil
 }
+
+// getUserOrder возвращает заказ, только если он принадлежит пользователю текущей сессии
+func (s *service) getUserOrder(ctx context.Context, userUUID, orderUUID string) (model.OrderData, error) {
+	order, err := s.orderRepository.GetOrder(ctx, orderUUID)
+	if err != nil {
+		return model.OrderData{}, err
+	}
+
+	if order.UserUUID != userUUID {
+		return model.OrderData{}, model.ErrOrderForbidden
+	}
+
+	return order, nil
+}
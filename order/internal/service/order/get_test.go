@@ -8,9 +8,11 @@ import (
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 
+	exchangeMocks "github.com/Alexey-step/rocket-factory/order/internal/client/exchange/mocks"
 	clientMocks "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/mocks"
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
 	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/bom"
 	orderServiceMocks "github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
 )
 
@@ -21,19 +23,32 @@ func TestGetOrderSuccess(t *testing.T) {
 	order := getMockedOrder(orderUUID)
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
-	resp, err := orderService.GetOrder(ctx, orderUUID)
+	resp, err := orderService.GetOrder(ctx, order.UserUUID, orderUUID)
 
 	assert.NoError(t, err)
 	assert.Equal(t, order, resp)
@@ -45,19 +60,32 @@ func TestGetOrderNotFoundErr(t *testing.T) {
 	expectedErr := model.ErrOrderNotFound
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(model.OrderData{}, expectedErr).Once()
-	resp, err := orderService.GetOrder(ctx, orderUUID)
+	resp, err := orderService.GetOrder(ctx, gofakeit.UUID(), orderUUID)
 
 	assert.Error(t, err)
 	assert.Equal(t, expectedErr, err)
@@ -70,19 +98,72 @@ func TestGetOrderInternalErr(t *testing.T) {
 	expectedErr := model.ErrOrderInternalError
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(model.OrderData{}, expectedErr).Once()
-	resp, err := orderService.GetOrder(ctx, orderUUID)
+	resp, err := orderService.GetOrder(ctx, gofakeit.UUID(), orderUUID)
+
+	assert.Error(t, err)
+	assert.Equal(t, expectedErr, err)
+	assert.Empty(t, resp)
+}
+
+func TestGetOrderForbiddenErr(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	expectedErr := model.ErrOrderForbidden
+
+	order := getMockedOrder(orderUUID)
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
+	resp, err := orderService.GetOrder(ctx, gofakeit.UUID(), orderUUID)
 
 	assert.Error(t, err)
 	assert.Equal(t, expectedErr, err)
@@ -93,8 +174,8 @@ func getMockedOrder(uuid string) model.OrderData {
 	return model.OrderData{
 		UUID:          uuid,
 		UserUUID:      gofakeit.UUID(),
-		PartUuids:     []string{gofakeit.UUID()},
-		TotalPrice:    gofakeit.Price(100, 1000),
+		Items:         []model.OrderItem{getMockedOrderItem()},
+		TotalPrice:    getMockedPrice(),
 		PaymentMethod: lo.ToPtr(model.PaymentMethod("CREDIT_CARD")),
 		Status:        model.OrderStatusPendingPayment,
 		CreatedAt:     gofakeit.Date(),
@@ -0,0 +1,21 @@
+package order
+
+import (
+	"context"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+func (s *service) GetOrderHistory(ctx context.Context, userUUID, orderUUID string) ([]model.OrderStatusHistory, error) {
+	order, err := s.getUserOrder(ctx, userUUID, orderUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.orderRepository.GetStatusHistory(ctx, order.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
@@ -0,0 +1,166 @@
+package order
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	exchangeMocks "github.com/Alexey-step/rocket-factory/order/internal/client/exchange/mocks"
+	clientMocks "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/bom"
+	orderServiceMocks "github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+)
+
+func TestGetOrderHistorySuccess(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+
+	order := getMockOrderWithStatus(orderUUID, model.OrderStatusPaid)
+	history := []model.OrderStatusHistory{
+		{
+			OrderUUID: orderUUID,
+			ToStatus:  model.OrderStatusPendingPayment,
+			Actor:     model.UserActor(order.UserUUID),
+			Reason:    model.StatusReasonOrderCreated,
+			CreatedAt: gofakeit.Date(),
+		},
+		{
+			OrderUUID:  orderUUID,
+			FromStatus: lo.ToPtr(model.OrderStatusPendingPayment),
+			ToStatus:   model.OrderStatusPaid,
+			Actor:      model.UserActor(order.UserUUID),
+			Reason:     model.StatusReasonOrderPaid,
+			CreatedAt:  gofakeit.Date(),
+		},
+	}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
+	orderRepository.On("GetStatusHistory", ctx, orderUUID).Return(history, nil).Once()
+
+	resp, err := orderService.GetOrderHistory(ctx, order.UserUUID, orderUUID)
+	assert.NoError(t, err)
+	assert.Equal(t, history, resp)
+}
+
+func TestGetOrderHistoryForbiddenErr(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	expectedErr := model.ErrOrderForbidden
+
+	order := getMockOrderWithStatus(orderUUID, model.OrderStatusPaid)
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
+
+	resp, err := orderService.GetOrderHistory(ctx, gofakeit.UUID(), orderUUID)
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Empty(t, resp)
+}
+
+func TestCancelOrderWritesHistory(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+
+	order := getMockOrderWithStatus(orderUUID, model.OrderStatusPendingPayment)
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	record := model.OrderStatusHistory{
+		OrderUUID:  orderUUID,
+		FromStatus: lo.ToPtr(model.OrderStatusPendingPayment),
+		ToStatus:   model.OrderStatusCanceled,
+		Actor:      model.UserActor(order.UserUUID),
+		Reason:     model.StatusReasonOrderCancelled,
+	}
+
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Twice()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusPendingPayment, mock.AnythingOfType("model.OrderUpdateInfo")).Return(nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderRepository.On("AddStatusHistory", ctx, record).Return(nil).Once()
+	promoCodeRepository.On("ReturnPromoCodeUses", ctx, orderUUID).Return(nil).Once()
+	orderProducer.On("ProduceOrderCancelled", ctx, mock.AnythingOfType("model.OrderCancelled")).Return(nil).Once()
+	inventoryClient.On("ReleaseStock", mock.Anything, orderUUID).Return(nil).Once()
+
+	err := orderService.CancelOrder(ctx, order.UserUUID, orderUUID)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,117 @@
+package order
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	def "github.com/Alexey-step/rocket-factory/order/internal/service"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+// idempotencyLockTTL - через сколько незавершённый ключ можно перехватить.
+// Должен быть больше таймаута HTTP-запроса, иначе повтор выполнится параллельно с оригиналом
+const idempotencyLockTTL = time.Minute
+
+// runIdempotent выполняет run один раз для ключа и сохраняет результат;
+// повтор с тем же ключом и телом получает сохранённый результат без повторного выполнения
+func runIdempotent[T any](
+	ctx context.Context,
+	repo def.IdempotencyRepository,
+	key model.IdempotencyKey,
+	run func() (T, error),
+) (T, error) {
+	var empty T
+	if key.Key == "" {
+		return run()
+	}
+
+	acquired, err := repo.AcquireIdempotencyKey(ctx, key, idempotencyLockTTL)
+	if err != nil {
+		return empty, err
+	}
+
+	if !acquired {
+		return replayIdempotent[T](ctx, repo, key)
+	}
+
+	result, err := run()
+	if err != nil {
+		// Ответы с ошибкой не сохраняем: запрос можно повторить с тем же ключом
+		if deleteErr := repo.DeleteIdempotencyKey(context.WithoutCancel(ctx), key); deleteErr != nil {
+			logger.Error(ctx, "Failed to release idempotency key",
+				zap.String("key", key.Key),
+				zap.String("operation", string(key.Operation)),
+				zap.Error(deleteErr),
+			)
+		}
+		return empty, err
+	}
+
+	// Операция уже выполнена, поэтому ошибки сохранения ответа только логируем
+	response, err := json.Marshal(result)
+	if err == nil {
+		err = repo.SaveIdempotencyResponse(context.WithoutCancel(ctx), key, response)
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to save idempotent response",
+			zap.String("key", key.Key),
+			zap.String("operation", string(key.Operation)),
+			zap.Error(err),
+		)
+	}
+
+	return result, nil
+}
+
+func replayIdempotent[T any](ctx context.Context, repo def.IdempotencyRepository, key model.IdempotencyKey) (T, error) {
+	var result T
+
+	record, err := repo.GetIdempotencyKey(ctx, key)
+	if err != nil {
+		// Ключ успел освободиться после ошибки первого запроса - клиенту стоит повторить
+		if errors.Is(err, model.ErrIdempotencyKeyNotFound) {
+			return result, model.ErrIdempotencyKeyInProgress
+		}
+		return result, err
+	}
+
+	switch {
+	case record.RequestHash != key.RequestHash:
+		return result, model.ErrIdempotencyKeyConflict
+	case record.Response == nil:
+		return result, model.ErrIdempotencyKeyInProgress
+	}
+
+	if err = json.Unmarshal(record.Response, &result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// newIdempotencyKey собирает ключ с хэшем тела запроса
+func newIdempotencyKey(userUUID string, operation model.IdempotencyOperation, key string, request any) (model.IdempotencyKey, error) {
+	if key == "" {
+		return model.IdempotencyKey{}, nil
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return model.IdempotencyKey{}, err
+	}
+	hash := sha256.Sum256(body)
+
+	return model.IdempotencyKey{
+		UserUUID:    userUUID,
+		Operation:   operation,
+		Key:         key,
+		RequestHash: hex.EncodeToString(hash[:]),
+	}, nil
+}
@@ -0,0 +1,325 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	exchangeMocks "github.com/Alexey-step/rocket-factory/order/internal/client/exchange/mocks"
+	clientMocks "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/bom"
+	orderServiceMocks "github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+)
+
+func TestPayOrderIdempotentReplay(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+	paymentMethod := "CREDIT_CARD"
+	idempotencyKey := gofakeit.UUID()
+	transactionUUID := gofakeit.UUID()
+
+	key := getPayOrderIdempotencyKey(t, userUUID, orderUUID, paymentMethod, idempotencyKey)
+	response, err := json.Marshal(transactionUUID)
+	assert.NoError(t, err)
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	idempotencyRepository.On("AcquireIdempotencyKey", ctx, key, idempotencyLockTTL).Return(false, nil).Once()
+	idempotencyRepository.On("GetIdempotencyKey", ctx, key).Return(model.IdempotencyRecord{
+		RequestHash: key.RequestHash,
+		Response:    response,
+	}, nil).Once()
+
+	resp, err := orderService.PayOrder(ctx, userUUID, orderUUID, paymentMethod, idempotencyKey)
+	assert.NoError(t, err)
+	assert.Equal(t, transactionUUID, resp)
+}
+
+func TestPayOrderIdempotencyKeyConflict(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+	paymentMethod := "CREDIT_CARD"
+	idempotencyKey := gofakeit.UUID()
+
+	key := getPayOrderIdempotencyKey(t, userUUID, orderUUID, paymentMethod, idempotencyKey)
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	idempotencyRepository.On("AcquireIdempotencyKey", ctx, key, idempotencyLockTTL).Return(false, nil).Once()
+	idempotencyRepository.On("GetIdempotencyKey", ctx, key).Return(model.IdempotencyRecord{
+		RequestHash: gofakeit.UUID(),
+		Response:    []byte(`"` + gofakeit.UUID() + `"`),
+	}, nil).Once()
+
+	_, err := orderService.PayOrder(ctx, userUUID, orderUUID, paymentMethod, idempotencyKey)
+	assert.ErrorIs(t, err, model.ErrIdempotencyKeyConflict)
+}
+
+func TestPayOrderIdempotencyKeyInProgress(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+	paymentMethod := "CREDIT_CARD"
+	idempotencyKey := gofakeit.UUID()
+
+	key := getPayOrderIdempotencyKey(t, userUUID, orderUUID, paymentMethod, idempotencyKey)
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	idempotencyRepository.On("AcquireIdempotencyKey", ctx, key, idempotencyLockTTL).Return(false, nil).Once()
+	idempotencyRepository.On("GetIdempotencyKey", ctx, key).Return(model.IdempotencyRecord{
+		RequestHash: key.RequestHash,
+	}, nil).Once()
+
+	_, err := orderService.PayOrder(ctx, userUUID, orderUUID, paymentMethod, idempotencyKey)
+	assert.ErrorIs(t, err, model.ErrIdempotencyKeyInProgress)
+}
+
+func TestPayOrderIdempotentSavesResponse(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	paymentMethod := "CREDIT_CARD"
+	idempotencyKey := gofakeit.UUID()
+	transactionUUID := gofakeit.UUID()
+
+	order := getMockedPayOrder(orderUUID, model.OrderStatusPendingPayment)
+	key := getPayOrderIdempotencyKey(t, order.UserUUID, orderUUID, paymentMethod, idempotencyKey)
+	response, err := json.Marshal(transactionUUID)
+	assert.NoError(t, err)
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	idempotencyRepository.On("AcquireIdempotencyKey", ctx, key, idempotencyLockTTL).Return(true, nil).Once()
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
+	paymentClient.On("PayOrder", ctx, order.UserUUID, orderUUID, paymentMethod, idempotencyKey).Return(transactionUUID, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusPendingPayment, mock.AnythingOfType("model.OrderUpdateInfo")).Return(nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderRepository.On("AddStatusHistory", ctx, mock.AnythingOfType("model.OrderStatusHistory")).Return(nil).Once()
+	orderProducer.On("ProduceOrderPaid", ctx, mock.AnythingOfType("model.OrderPaid")).Return(nil).Once()
+	inventoryClient.On("CommitStock", mock.Anything, orderUUID).Return(nil).Once()
+	idempotencyRepository.On("SaveIdempotencyResponse", mock.Anything, key, response).Return(nil).Once()
+
+	resp, err := orderService.PayOrder(ctx, order.UserUUID, orderUUID, paymentMethod, idempotencyKey)
+	assert.NoError(t, err)
+	assert.Equal(t, transactionUUID, resp)
+}
+
+func TestPayOrderRetryAfterFailedTxReusesCharge(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	paymentMethod := "CREDIT_CARD"
+	idempotencyKey := gofakeit.UUID()
+	transactionUUID := gofakeit.UUID()
+	txErr := errors.New("outbox write failed")
+
+	order := getMockedPayOrder(orderUUID, model.OrderStatusPendingPayment)
+	key := getPayOrderIdempotencyKey(t, order.UserUUID, orderUUID, paymentMethod, idempotencyKey)
+	response, err := json.Marshal(transactionUUID)
+	assert.NoError(t, err)
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	// Оба запроса уходят в payment с одним ключом, поэтому повтор получает то же списание
+	idempotencyRepository.On("AcquireIdempotencyKey", ctx, key, idempotencyLockTTL).Return(true, nil).Twice()
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Twice()
+	paymentClient.On("PayOrder", ctx, order.UserUUID, orderUUID, paymentMethod, idempotencyKey).Return(transactionUUID, nil).Twice()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Times(4)
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusPendingPayment, mock.AnythingOfType("model.OrderUpdateInfo")).Return(nil).Twice()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Twice()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Twice()
+	orderRepository.On("AddStatusHistory", ctx, mock.AnythingOfType("model.OrderStatusHistory")).Return(nil).Twice()
+	orderProducer.On("ProduceOrderPaid", ctx, mock.AnythingOfType("model.OrderPaid")).Return(txErr).Once()
+	idempotencyRepository.On("DeleteIdempotencyKey", mock.Anything, key).Return(nil).Once()
+	orderProducer.On("ProduceOrderPaid", ctx, mock.AnythingOfType("model.OrderPaid")).Return(nil).Once()
+	inventoryClient.On("CommitStock", mock.Anything, orderUUID).Return(nil).Once()
+	idempotencyRepository.On("SaveIdempotencyResponse", mock.Anything, key, response).Return(nil).Once()
+
+	_, err = orderService.PayOrder(ctx, order.UserUUID, orderUUID, paymentMethod, idempotencyKey)
+	assert.ErrorIs(t, err, txErr)
+
+	resp, err := orderService.PayOrder(ctx, order.UserUUID, orderUUID, paymentMethod, idempotencyKey)
+	assert.NoError(t, err)
+	assert.Equal(t, transactionUUID, resp)
+}
+
+func TestCreateOrderIdempotencyKeyReleasedOnError(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	idempotencyKey := gofakeit.UUID()
+
+	key, err := newIdempotencyKey(userUUID, model.IdempotencyOperationCreateOrder, idempotencyKey, model.OrderRequest{})
+	assert.NoError(t, err)
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	idempotencyRepository.On("AcquireIdempotencyKey", ctx, key, idempotencyLockTTL).Return(true, nil).Once()
+	idempotencyRepository.On("DeleteIdempotencyKey", mock.Anything, key).Return(nil).Once()
+
+	_, err = orderService.CreateOrder(ctx, userUUID, model.OrderRequest{}, idempotencyKey)
+	assert.ErrorIs(t, err, model.ErrPartsInvalidRequest)
+}
+
+func getPayOrderIdempotencyKey(t *testing.T, userUUID, orderUUID, paymentMethod, idempotencyKey string) model.IdempotencyKey {
+	t.Helper()
+
+	key, err := newIdempotencyKey(userUUID, model.IdempotencyOperationPayOrder, idempotencyKey, struct {
+		OrderUUID     string
+		PaymentMethod string
+	}{
+		OrderUUID:     orderUUID,
+		PaymentMethod: paymentMethod,
+	})
+	assert.NoError(t, err)
+
+	return key
+}
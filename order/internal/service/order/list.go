@@ -0,0 +1,43 @@
+package order
+
+import (
+	"context"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+const (
+	defaultOrdersLimit = 20
+	maxOrdersLimit     = 100
+)
+
+func (s *service) ListOrders(ctx context.Context, filter model.OrdersFilter) (page model.OrdersPage, err error) {
+	if filter.UserUUID == "" {
+		return model.OrdersPage{}, model.ErrOrdersInvalidFilter
+	}
+
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return model.OrdersPage{}, model.ErrOrdersInvalidFilter
+	}
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = defaultOrdersLimit
+	case filter.Limit > maxOrdersLimit:
+		filter.Limit = maxOrdersLimit
+	}
+
+	if filter.SortBy == "" {
+		filter.SortBy = model.OrdersSortByCreatedAt
+	}
+	if filter.SortOrder == "" {
+		filter.SortOrder = model.SortOrderDesc
+	}
+
+	page, err = s.orderRepository.ListOrders(ctx, filter)
+	if err != nil {
+		return model.OrdersPage{}, err
+	}
+
+	return page, nil
+}
@@ -0,0 +1,200 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	exchangeMocks "github.com/Alexey-step/rocket-factory/order/internal/client/exchange/mocks"
+	clientMocks "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/bom"
+	orderServiceMocks "github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+)
+
+func TestListOrdersSuccess(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+
+	filter := model.OrdersFilter{
+		UserUUID: userUUID,
+		Statuses: []model.OrderStatus{model.OrderStatusPaid},
+	}
+	expectedFilter := model.OrdersFilter{
+		UserUUID:  userUUID,
+		Statuses:  []model.OrderStatus{model.OrderStatusPaid},
+		SortBy:    model.OrdersSortByCreatedAt,
+		SortOrder: model.SortOrderDesc,
+		Limit:     defaultOrdersLimit,
+	}
+	page := model.OrdersPage{
+		Orders:     []model.OrderData{getMockedOrder(gofakeit.UUID())},
+		NextCursor: lo.ToPtr(gofakeit.UUID()),
+	}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	orderRepository.On("ListOrders", ctx, expectedFilter).Return(page, nil).Once()
+	resp, err := orderService.ListOrders(ctx, filter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, page, resp)
+}
+
+func TestListOrdersLimitCapped(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+
+	filter := model.OrdersFilter{
+		UserUUID:  userUUID,
+		SortBy:    model.OrdersSortByTotalPrice,
+		SortOrder: model.SortOrderAsc,
+		Limit:     maxOrdersLimit + 1,
+	}
+	expectedFilter := filter
+	expectedFilter.Limit = maxOrdersLimit
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	orderRepository.On("ListOrders", ctx, expectedFilter).Return(model.OrdersPage{}, nil).Once()
+	resp, err := orderService.ListOrders(ctx, filter)
+
+	assert.NoError(t, err)
+	assert.Empty(t, resp)
+}
+
+func TestListOrdersInvalidPeriodErr(t *testing.T) {
+	ctx := context.Background()
+	createdFrom := time.Now()
+	createdTo := createdFrom.Add(-time.Hour)
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	resp, err := orderService.ListOrders(ctx, model.OrdersFilter{
+		UserUUID:    gofakeit.UUID(),
+		CreatedFrom: &createdFrom,
+		CreatedTo:   &createdTo,
+	})
+
+	assert.ErrorIs(t, err, model.ErrOrdersInvalidFilter)
+	assert.Empty(t, resp)
+}
+
+func TestListOrdersInvalidCursorErr(t *testing.T) {
+	ctx := context.Background()
+	expectedErr := model.ErrOrdersInvalidCursor
+
+	filter := model.OrdersFilter{
+		UserUUID:  gofakeit.UUID(),
+		SortBy:    model.OrdersSortByCreatedAt,
+		SortOrder: model.SortOrderDesc,
+		Cursor:    lo.ToPtr("broken"),
+		Limit:     10,
+	}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	orderRepository.On("ListOrders", ctx, filter).Return(model.OrdersPage{}, expectedErr).Once()
+	resp, err := orderService.ListOrders(ctx, filter)
+
+	assert.Error(t, err)
+	assert.Equal(t, expectedErr, err)
+	assert.Empty(t, resp)
+}
@@ -2,62 +2,98 @@ package order
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
+	"go.uber.org/zap"
 
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
 )
 
-func (s *service) PayOrder(ctx context.Context, orderUUID, paymentMethod string) (transactionUUID string, err error) {
-	order, err := s.orderRepository.GetOrder(ctx, orderUUID)
+func (s *service) PayOrder(ctx context.Context, userUUID, orderUUID, paymentMethod, idempotencyKey string) (string, error) {
+	request := struct {
+		OrderUUID     string
+		PaymentMethod string
+	}{
+		OrderUUID:     orderUUID,
+		PaymentMethod: paymentMethod,
+	}
+
+	key, err := newIdempotencyKey(userUUID, model.IdempotencyOperationPayOrder, idempotencyKey, request)
 	if err != nil {
 		return "", err
 	}
 
-	if resp, ok := canPayOrder(order); ok {
-		return "", resp
-	}
+	return runIdempotent(ctx, s.idempotencyRepository, key, func() (string, error) {
+		return s.payOrder(ctx, userUUID, orderUUID, paymentMethod, idempotencyKey)
+	})
+}
 
-	transUUID, err := s.paymentClient.PayOrder(ctx, order.UserUUID, orderUUID, paymentMethod)
+func (s *service) payOrder(ctx context.Context, userUUID, orderUUID, paymentMethod, idempotencyKey string) (string, error) {
+	order, err := s.getUserOrder(ctx, userUUID, orderUUID)
 	if err != nil {
 		return "", err
 	}
 
-	orderStatus := model.OrderStatusPaid
-	updateErr := s.orderRepository.UpdateOrder(ctx, order.UUID, model.OrderUpdateInfo{
-		Status:          &orderStatus,
-		PaymentMethod:   lo.ToPtr(model.PaymentMethod(paymentMethod)),
-		TransactionUUID: lo.ToPtr(transUUID),
-	})
+	// Проверяем переход до списания денег; в транзакции он проверяется ещё раз условным UPDATE
+	if err = model.ValidateTransition(order.Status, model.OrderStatusPaid); err != nil {
+		return "", err
+	}
 
-	if updateErr != nil {
-		return "", updateErr
+	// Ключ идемпотентности уходит в payment: если транзакция ниже упадёт, ключ освободится,
+	// и повтор запроса получит уже совершённое списание вместо второго
+	transUUID, err := s.paymentClient.PayOrder(ctx, order.UserUUID, orderUUID, paymentMethod, idempotencyKey)
+	if err != nil {
+		return "", err
 	}
 
-	err = s.orderProducerService.ProduceOrderPaid(ctx, model.OrderPaid{
-		EventUUID:       uuid.NewString(),
-		OrderUUID:       orderUUID,
-		UserUUID:        order.UserUUID,
-		PaymentMethod:   paymentMethod,
-		TransactionUUID: transUUID,
+	// Статус и событие OrderPaid сохраняются в одной транзакции:
+	// в Kafka событие уйдёт из outbox, даже если брокер сейчас недоступен
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		txErr := s.transitionOrder(ctx, order, model.OrderStatusPaid, model.OrderUpdateInfo{
+			PaymentMethod:   lo.ToPtr(model.PaymentMethod(paymentMethod)),
+			TransactionUUID: lo.ToPtr(transUUID),
+		}, model.StatusChange{
+			Actor:  model.UserActor(userUUID),
+			Reason: model.StatusReasonOrderPaid,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		return s.orderProducerService.ProduceOrderPaid(ctx, model.OrderPaid{
+			EventUUID:       uuid.NewString(),
+			OrderUUID:       orderUUID,
+			UserUUID:        order.UserUUID,
+			PaymentMethod:   paymentMethod,
+			TransactionUUID: transUUID,
+		})
 	})
 	if err != nil {
+		// Пока шло списание, заказ успели перевести в другой статус, например отменить.
+		// Оплатить его уже нельзя, поэтому списанные деньги возвращаются
+		if errors.Is(err, model.ErrOrderInvalidTransition) {
+			s.refundCharge(ctx, order, transUUID)
+		}
 		return "", err
 	}
 
+	s.commitStock(ctx, order.ReservationKey())
+
 	return transUUID, nil
 }
 
-func canPayOrder(order model.OrderData) (error, bool) {
-	switch order.Status {
-	case model.OrderStatusPaid:
-		return model.ErrPaymentConflict, true
-	case model.OrderStatusCanceled:
-		return model.ErrPaymentConflict, true
-	case model.OrderStatusPendingPayment:
-		return nil, false
-	default:
-		return model.ErrPaymentInternalError, true
+// refundCharge возвращает оплату, которую не удалось привязать к заказу.
+// Ошибка только логируется: клиенту важнее узнать, почему заказ не оплачен
+func (s *service) refundCharge(ctx context.Context, order model.OrderData, transactionUUID string) {
+	_, err := s.paymentClient.RefundPayment(context.WithoutCancel(ctx), order.UserUUID, order.UUID, transactionUUID)
+	if err != nil {
+		logger.Error(ctx, "Failed to refund payment of unpaid order",
+			zap.String("order_uuid", order.UUID),
+			zap.String("transaction_uuid", transactionUUID),
+			zap.Error(err),
+		)
 	}
 }
@@ -2,6 +2,7 @@ package order
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v7"
@@ -9,10 +10,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	exchangeMocks "github.com/Alexey-step/rocket-factory/order/internal/client/exchange/mocks"
 	clientMocks "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/mocks"
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
 	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/bom"
 	orderServiceMocks "github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
 )
 
 func TestPayOrderSuccess(t *testing.T) {
@@ -30,23 +34,42 @@ func TestPayOrderSuccess(t *testing.T) {
 	}
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
-	paymentClient.On("PayOrder", ctx, order.UserUUID, orderUUID, paymentMethod).Return(transactionUUID, nil).Once()
+	paymentClient.On("PayOrder", ctx, order.UserUUID, orderUUID, paymentMethod, "").Return(transactionUUID, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusPendingPayment, orderInfo).Return(nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderRepository.On("AddStatusHistory", ctx, mock.AnythingOfType("model.OrderStatusHistory")).Return(nil).Once()
 	orderProducer.On("ProduceOrderPaid", ctx, mock.AnythingOfType("model.OrderPaid")).Return(nil).Once()
-	orderRepository.On("UpdateOrder", ctx, orderUUID, orderInfo).Return(nil).Once()
+	inventoryClient.On("CommitStock", mock.Anything, orderUUID).Return(nil).Once()
 
-	resp, err := orderService.PayOrder(ctx, orderUUID, paymentMethod)
+	resp, err := orderService.PayOrder(ctx, order.UserUUID, orderUUID, paymentMethod, "")
 	assert.NoError(t, err)
 	assert.Equal(t, transactionUUID, resp)
 }
@@ -58,20 +81,33 @@ func TestPayOrderFailGetOrder(t *testing.T) {
 	expectedErr := model.ErrOrderNotFound
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(model.OrderData{}, expectedErr).Once()
 
-	resp, err := orderService.PayOrder(ctx, orderUUID, paymentMethod)
+	resp, err := orderService.PayOrder(ctx, gofakeit.UUID(), orderUUID, paymentMethod, "")
 	assert.Error(t, err)
 	assert.Equal(t, err, expectedErr)
 	assert.Empty(t, resp)
@@ -81,26 +117,39 @@ func TestPayOrderFail(t *testing.T) {
 	ctx := context.Background()
 	orderUUID := gofakeit.UUID()
 	paymentMethod := "CREDIT_CARD"
-	expectedErr := model.ErrPaymentConflict
+	expectedErr := gofakeit.Error()
 
 	order := getMockedPayOrder(orderUUID, model.OrderStatusPendingPayment)
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
-	paymentClient.On("PayOrder", ctx, order.UserUUID, orderUUID, paymentMethod).Return("", expectedErr).Once()
+	paymentClient.On("PayOrder", ctx, order.UserUUID, orderUUID, paymentMethod, "").Return("", expectedErr).Once()
 
-	resp, err := orderService.PayOrder(ctx, orderUUID, paymentMethod)
+	resp, err := orderService.PayOrder(ctx, order.UserUUID, orderUUID, paymentMethod, "")
 	assert.Error(t, err)
 	assert.Equal(t, err, expectedErr)
 	assert.Empty(t, resp)
@@ -110,26 +159,39 @@ func TestPayOrderInternalErr(t *testing.T) {
 	ctx := context.Background()
 	orderUUID := gofakeit.UUID()
 	paymentMethod := "CREDIT_CARD"
-	expectedErr := model.ErrPaymentInternalError
+	expectedErr := gofakeit.Error()
 
 	order := getMockedPayOrder(orderUUID, model.OrderStatusPendingPayment)
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
-	paymentClient.On("PayOrder", ctx, order.UserUUID, orderUUID, paymentMethod).Return("", expectedErr).Once()
+	paymentClient.On("PayOrder", ctx, order.UserUUID, orderUUID, paymentMethod, "").Return("", expectedErr).Once()
 
-	resp, err := orderService.PayOrder(ctx, orderUUID, paymentMethod)
+	resp, err := orderService.PayOrder(ctx, order.UserUUID, orderUUID, paymentMethod, "")
 	assert.Error(t, err)
 	assert.Equal(t, err, expectedErr)
 	assert.Empty(t, resp)
@@ -144,21 +206,34 @@ func TestPayOrderNotFoundErr(t *testing.T) {
 	order := getMockedPayOrder(orderUUID, model.OrderStatusPendingPayment)
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
-	paymentClient.On("PayOrder", ctx, order.UserUUID, orderUUID, paymentMethod).Return("", expectedErr).Once()
+	paymentClient.On("PayOrder", ctx, order.UserUUID, orderUUID, paymentMethod, "").Return("", expectedErr).Once()
 
-	resp, err := orderService.PayOrder(ctx, orderUUID, paymentMethod)
+	resp, err := orderService.PayOrder(ctx, order.UserUUID, orderUUID, paymentMethod, "")
 	assert.Error(t, err)
 	assert.Equal(t, err, expectedErr)
 	assert.Empty(t, resp)
@@ -168,25 +243,38 @@ func TestPayOrderConflictOrderStatusPaidErr(t *testing.T) {
 	ctx := context.Background()
 	orderUUID := gofakeit.UUID()
 	paymentMethod := "CREDIT_CARD"
-	expectedErr := model.ErrPaymentConflict
+	expectedErr := &model.InvalidStatusTransitionError{From: model.OrderStatusPaid, To: model.OrderStatusPaid}
 
 	order := getMockedPayOrder(orderUUID, model.OrderStatusPaid)
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
 
-	resp, err := orderService.PayOrder(ctx, orderUUID, paymentMethod)
+	resp, err := orderService.PayOrder(ctx, order.UserUUID, orderUUID, paymentMethod, "")
 	assert.Error(t, err)
 	assert.Equal(t, err, expectedErr)
 	assert.Empty(t, resp)
@@ -196,25 +284,38 @@ func TestPayOrderConflictOrderStatusCanceledErr(t *testing.T) {
 	ctx := context.Background()
 	orderUUID := gofakeit.UUID()
 	paymentMethod := "CREDIT_CARD"
-	expectedErr := model.ErrPaymentConflict
+	expectedErr := &model.InvalidStatusTransitionError{From: model.OrderStatusCanceled, To: model.OrderStatusPaid}
 
 	order := getMockedPayOrder(orderUUID, model.OrderStatusCanceled)
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
 
-	resp, err := orderService.PayOrder(ctx, orderUUID, paymentMethod)
+	resp, err := orderService.PayOrder(ctx, order.UserUUID, orderUUID, paymentMethod, "")
 	assert.Error(t, err)
 	assert.Equal(t, err, expectedErr)
 	assert.Empty(t, resp)
@@ -224,25 +325,38 @@ func TestPayOrderConflictOrderStatusUnknownErr(t *testing.T) {
 	ctx := context.Background()
 	orderUUID := gofakeit.UUID()
 	paymentMethod := "CREDIT_CARD"
-	expectedErr := model.ErrPaymentInternalError
+	expectedErr := &model.InvalidStatusTransitionError{From: "UNKNOWN", To: model.OrderStatusPaid}
 
 	order := getMockedPayOrder(orderUUID, "UNKNOWN")
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
 
-	resp, err := orderService.PayOrder(ctx, orderUUID, paymentMethod)
+	resp, err := orderService.PayOrder(ctx, order.UserUUID, orderUUID, paymentMethod, "")
 	assert.Error(t, err)
 	assert.Equal(t, err, expectedErr)
 	assert.Empty(t, resp)
@@ -264,32 +378,161 @@ func TestPayOrderUpdateErr(t *testing.T) {
 	}
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
-	paymentClient.On("PayOrder", ctx, order.UserUUID, orderUUID, paymentMethod).Return(transactionUUID, nil).Once()
-	orderRepository.On("UpdateOrder", ctx, orderUUID, orderInfo).Return(expectedErr).Once()
+	paymentClient.On("PayOrder", ctx, order.UserUUID, orderUUID, paymentMethod, "").Return(transactionUUID, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusPendingPayment, orderInfo).Return(expectedErr).Once()
 
-	_, err := orderService.PayOrder(ctx, orderUUID, paymentMethod)
+	_, err := orderService.PayOrder(ctx, order.UserUUID, orderUUID, paymentMethod, "")
 	assert.Error(t, err)
 	assert.Equal(t, err, expectedErr)
 }
 
+func TestPayOrderCanceledDuringChargeRefunds(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	paymentMethod := "CREDIT_CARD"
+	transactionUUID := gofakeit.UUID()
+	expectedErr := &model.InvalidStatusTransitionError{
+		From: model.OrderStatusCanceled,
+		To:   model.OrderStatusPaid,
+	}
+
+	order := getMockedPayOrder(orderUUID, model.OrderStatusPendingPayment)
+
+	orderInfo := model.OrderUpdateInfo{
+		Status:          lo.ToPtr(model.OrderStatusPaid),
+		PaymentMethod:   lo.ToPtr(model.PaymentMethod(paymentMethod)),
+		TransactionUUID: lo.ToPtr(transactionUUID),
+	}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	// Заказ отменили, пока шло списание: условный UPDATE не находит заказ в PENDING_PAYMENT
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
+	paymentClient.On("PayOrder", ctx, order.UserUUID, orderUUID, paymentMethod, "").Return(transactionUUID, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusPendingPayment, orderInfo).Return(expectedErr).Once()
+	paymentClient.On("RefundPayment", mock.Anything, order.UserUUID, orderUUID, transactionUUID).Return(gofakeit.UUID(), nil).Once()
+
+	_, err := orderService.PayOrder(ctx, order.UserUUID, orderUUID, paymentMethod, "")
+	assert.ErrorIs(t, err, model.ErrOrderInvalidTransition)
+}
+
+func TestPayOrderFailProduceOrderPaid(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	paymentMethod := "CREDIT_CARD"
+	transactionUUID := gofakeit.UUID()
+	expectedErr := errors.New("outbox write failed")
+
+	order := getMockedPayOrder(orderUUID, model.OrderStatusPendingPayment)
+
+	orderInfo := model.OrderUpdateInfo{
+		Status:          lo.ToPtr(model.OrderStatusPaid),
+		PaymentMethod:   lo.ToPtr(model.PaymentMethod(paymentMethod)),
+		TransactionUUID: lo.ToPtr(transactionUUID),
+	}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
+	paymentClient.On("PayOrder", ctx, order.UserUUID, orderUUID, paymentMethod, "").Return(transactionUUID, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusPendingPayment, orderInfo).Return(nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderRepository.On("AddStatusHistory", ctx, mock.AnythingOfType("model.OrderStatusHistory")).Return(nil).Once()
+	orderProducer.On("ProduceOrderPaid", ctx, mock.AnythingOfType("model.OrderPaid")).Return(expectedErr).Once()
+
+	_, err := orderService.PayOrder(ctx, order.UserUUID, orderUUID, paymentMethod, "")
+	assert.ErrorIs(t, err, expectedErr)
+}
+
+// runInTx выполняет функцию без реальной транзакции
+func runInTx(ctx context.Context, fn txmanager.Handler) error {
+	return fn(ctx)
+}
+
 func getMockedPayOrder(orderUUID string, status model.OrderStatus) model.OrderData {
 	return model.OrderData{
 		UUID:          orderUUID,
 		UserUUID:      gofakeit.UUID(),
-		PartUuids:     []string{gofakeit.UUID()},
-		TotalPrice:    gofakeit.Price(100, 1000),
+		Items:         []model.OrderItem{getMockedOrderItem()},
+		TotalPrice:    getMockedPrice(),
 		PaymentMethod: lo.ToPtr(model.PaymentMethod("CREDIT_CARD")),
 		Status:        status,
 		CreatedAt:     gofakeit.Date(),
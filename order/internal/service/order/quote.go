@@ -0,0 +1,36 @@
+package order
+
+import (
+	"context"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+// QuoteOrder считает стоимость заказа по тем же правилам, что и CreateOrder, но не создаёт
+// заказ, не резервирует детали и не расходует промокод
+func (s *service) QuoteOrder(ctx context.Context, request model.OrderRequest) (model.OrderQuote, error) {
+	pricing, err := s.priceOrder(ctx, request)
+	if err != nil {
+		return model.OrderQuote{}, err
+	}
+
+	items := make([]model.QuoteItem, 0, len(pricing.items))
+	for _, item := range pricing.items {
+		available := pricing.parts[item.PartUUID].StockQuantity
+
+		items = append(items, model.QuoteItem{
+			OrderItem:         item,
+			LineTotal:         item.UnitPrice.Mul(item.Quantity),
+			AvailableQuantity: available,
+			InStock:           available >= item.Quantity,
+		})
+	}
+
+	return model.OrderQuote{
+		Items:        items,
+		Subtotal:     pricing.subtotal,
+		Discounts:    pricing.discounts,
+		TotalPrice:   pricing.total,
+		ExchangeRate: pricing.rate,
+	}, nil
+}
@@ -0,0 +1,143 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	exchangeMocks "github.com/Alexey-step/rocket-factory/order/internal/client/exchange/mocks"
+	clientMocks "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/bom"
+	orderServiceMocks "github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+func TestQuoteOrderSuccess(t *testing.T) {
+	ctx := context.Background()
+	wingUUID := gofakeit.UUID()
+	engineUUID := gofakeit.UUID()
+
+	wing := getMockedPart(wingUUID, money.New(1_000_000, money.RUB))
+	wing.Category = model.CategoryWing
+	wing.StockQuantity = 5
+
+	engine := getMockedPart(engineUUID, money.New(300_000, money.RUB))
+	engine.Category = model.CategoryEngine
+	engine.StockQuantity = 1
+
+	promo := model.PromoCode{
+		Code:        "WING10",
+		Description: "10% на крылья",
+		Type:        model.DiscountTypePercent,
+		Percent:     10,
+		Category:    lo.ToPtr(model.CategoryWing),
+		ValidFrom:   time.Now().Add(-time.Hour),
+	}
+
+	request := model.OrderRequest{
+		Items: []model.OrderItemInfo{
+			{PartUUID: wingUUID, Quantity: 2},
+			{PartUUID: engineUUID, Quantity: 3},
+		},
+		PromoCode: "wing10",
+	}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	// Расчёт не резервирует детали, не расходует промокод и не пишет заказ в базу
+	inventoryClient.On("ListParts", ctx, model.PartsFilter{Uuids: []string{wingUUID, engineUUID}}).Return([]model.Part{engine, wing}, nil).Once()
+	promoCodeRepository.On("GetPromoCode", ctx, "WING10").Return(promo, nil).Once()
+	quote, err := orderService.QuoteOrder(ctx, request)
+
+	assert.NoError(t, err)
+	assert.Len(t, quote.Items, 2)
+	assert.Equal(t, wingUUID, quote.Items[0].PartUUID)
+	assert.Equal(t, money.New(2_000_000, money.RUB), quote.Items[0].LineTotal)
+	assert.True(t, quote.Items[0].InStock)
+	assert.Equal(t, engineUUID, quote.Items[1].PartUUID)
+	assert.Equal(t, money.New(900_000, money.RUB), quote.Items[1].LineTotal)
+	assert.Equal(t, int64(1), quote.Items[1].AvailableQuantity)
+	assert.False(t, quote.Items[1].InStock)
+	assert.Equal(t, money.New(2_900_000, money.RUB), quote.Subtotal)
+	assert.Equal(t, []model.OrderDiscount{
+		{PromoCode: promo.Code, Description: promo.Description, Amount: money.New(200_000, money.RUB)},
+	}, quote.Discounts)
+	assert.Equal(t, money.New(2_700_000, money.RUB), quote.TotalPrice)
+	assert.Equal(t, money.Identity(money.RUB), quote.ExchangeRate)
+}
+
+func TestQuoteOrderPartNotFound(t *testing.T) {
+	ctx := context.Background()
+	partUUID := gofakeit.UUID()
+	missingUUID := gofakeit.UUID()
+
+	part := getMockedPart(partUUID, getMockedPrice())
+
+	request := model.OrderRequest{
+		Items: []model.OrderItemInfo{
+			{PartUUID: partUUID, Quantity: 1},
+			{PartUUID: missingUUID, Quantity: 1},
+		},
+	}
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	inventoryClient.On("ListParts", ctx, model.PartsFilter{Uuids: []string{partUUID, missingUUID}}).Return([]model.Part{part}, nil).Once()
+	quote, err := orderService.QuoteOrder(ctx, request)
+
+	assert.ErrorIs(t, err, model.ErrPartsNotFound)
+	assert.ErrorContains(t, err, missingUUID)
+	assert.Empty(t, quote)
+}
@@ -0,0 +1,62 @@
+package order
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+// refundOrder отменяет оплаченный заказ с возвратом денег. Сначала заказ переводится в
+// REFUNDING: после этого сборка уже не может его завершить, и деньги не возвращаются за
+// собранный корабль. Затем оплата возвращается, и заказ переходит в REFUNDED вместе с
+// возвратом промокода и событием OrderRefunded, по которому сборка прерывается.
+// Если возврат или финальный переход не удались, заказ остаётся в REFUNDING, а повторная
+// отмена доводит возврат до конца: payment на повторный запрос возвращает прежний возврат.
+func (s *service) refundOrder(ctx context.Context, userUUID string, order model.OrderData) error {
+	if order.Status != model.OrderStatusRefunding {
+		err := s.transitionOrder(ctx, order, model.OrderStatusRefunding, model.OrderUpdateInfo{
+			CancelReason: lo.ToPtr(model.CancelReasonCustomer),
+		}, model.StatusChange{
+			Actor:  model.UserActor(userUUID),
+			Reason: model.StatusReasonRefundStarted,
+		})
+		if err != nil {
+			return err
+		}
+		order.Status = model.OrderStatusRefunding
+	}
+
+	transactionUUID := lo.FromPtr(order.TransactionUUID)
+
+	refundUUID, err := s.paymentClient.RefundPayment(ctx, order.UserUUID, order.UUID, transactionUUID)
+	if err != nil {
+		return err
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		txErr := s.transitionOrder(ctx, order, model.OrderStatusRefunded, model.OrderUpdateInfo{}, model.StatusChange{
+			Actor:  model.UserActor(userUUID),
+			Reason: model.StatusReasonOrderRefunded,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		// Промокод засчитывается при оформлении заказа, поэтому при возврате использование возвращается
+		txErr = s.promoCodeRepository.ReturnPromoCodeUses(ctx, order.UUID)
+		if txErr != nil {
+			return txErr
+		}
+
+		return s.orderProducerService.ProduceOrderRefunded(ctx, model.OrderRefunded{
+			EventUUID:       uuid.NewString(),
+			OrderUUID:       order.UUID,
+			UserUUID:        order.UserUUID,
+			TransactionUUID: transactionUUID,
+			RefundUUID:      refundUUID,
+		})
+	})
+}
@@ -0,0 +1,282 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	exchangeMocks "github.com/Alexey-step/rocket-factory/order/internal/client/exchange/mocks"
+	clientMocks "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/bom"
+	orderServiceMocks "github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+)
+
+func TestCancelPaidOrderRefundSuccess(t *testing.T) {
+	for _, status := range []model.OrderStatus{model.OrderStatusPaid, model.OrderStatusAssembling} {
+		t.Run(string(status), func(t *testing.T) {
+			ctx := context.Background()
+			orderUUID := gofakeit.UUID()
+			refundUUID := gofakeit.UUID()
+
+			order := getMockOrderWithStatus(orderUUID, status)
+			order.TransactionUUID = lo.ToPtr(gofakeit.UUID())
+
+			orderRepository := mocks.NewOrderRepository(t)
+			idempotencyRepository := mocks.NewIdempotencyRepository(t)
+			promoCodeRepository := mocks.NewPromoCodeRepository(t)
+			inventoryClient := clientMocks.NewInventoryClient(t)
+			paymentClient := clientMocks.NewPaymentClient(t)
+			rateProvider := exchangeMocks.NewRateProvider(t)
+			orderProducer := orderServiceMocks.NewOrderProducerService(t)
+			webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+			orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+			txManager := orderServiceMocks.NewTxManager(t)
+
+			orderService := NewService(
+				orderRepository,
+				idempotencyRepository,
+				promoCodeRepository,
+				inventoryClient,
+				paymentClient,
+				rateProvider,
+				bom.NewValidator(),
+				orderProducer,
+				webhookNotifier,
+				orderStatusPublisher,
+				txManager,
+			)
+
+			orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
+			txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Times(3)
+			webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Twice()
+			orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Twice()
+
+			// Сначала заказ переходит в REFUNDING, и только потом возвращаются деньги
+			orderRepository.On("UpdateOrder", ctx, orderUUID, status, model.OrderUpdateInfo{
+				Status:       lo.ToPtr(model.OrderStatusRefunding),
+				CancelReason: lo.ToPtr(model.CancelReasonCustomer),
+			}).Return(nil).Once()
+			orderRepository.On("AddStatusHistory", ctx, model.OrderStatusHistory{
+				OrderUUID:  orderUUID,
+				FromStatus: lo.ToPtr(status),
+				ToStatus:   model.OrderStatusRefunding,
+				Actor:      model.UserActor(order.UserUUID),
+				Reason:     model.StatusReasonRefundStarted,
+			}).Return(nil).Once()
+			paymentClient.On("RefundPayment", ctx, order.UserUUID, orderUUID, *order.TransactionUUID).Return(refundUUID, nil).Once()
+
+			orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusRefunding, model.OrderUpdateInfo{
+				Status: lo.ToPtr(model.OrderStatusRefunded),
+			}).Return(nil).Once()
+			orderRepository.On("AddStatusHistory", ctx, model.OrderStatusHistory{
+				OrderUUID:  orderUUID,
+				FromStatus: lo.ToPtr(model.OrderStatusRefunding),
+				ToStatus:   model.OrderStatusRefunded,
+				Actor:      model.UserActor(order.UserUUID),
+				Reason:     model.StatusReasonOrderRefunded,
+			}).Return(nil).Once()
+			promoCodeRepository.On("ReturnPromoCodeUses", ctx, orderUUID).Return(nil).Once()
+			orderProducer.On("ProduceOrderRefunded", ctx, mock.MatchedBy(func(event model.OrderRefunded) bool {
+				return event.OrderUUID == orderUUID &&
+					event.TransactionUUID == *order.TransactionUUID &&
+					event.RefundUUID == refundUUID
+			})).Return(nil).Once()
+
+			err := orderService.CancelOrder(ctx, order.UserUUID, orderUUID)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCancelPaidOrderRefundPaymentError(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	expectedErr := errors.New("payment unavailable")
+
+	order := getMockOrderWithStatus(orderUUID, model.OrderStatusPaid)
+	order.TransactionUUID = lo.ToPtr(gofakeit.UUID())
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusPaid, mock.AnythingOfType("model.OrderUpdateInfo")).Return(nil).Once()
+	orderRepository.On("AddStatusHistory", ctx, mock.AnythingOfType("model.OrderStatusHistory")).Return(nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	paymentClient.On("RefundPayment", ctx, order.UserUUID, orderUUID, *order.TransactionUUID).Return("", expectedErr).Once()
+
+	// Деньги не вернулись - заказ остаётся в REFUNDING до повторной отмены
+	err := orderService.CancelOrder(ctx, order.UserUUID, orderUUID)
+	assert.ErrorIs(t, err, expectedErr)
+}
+
+func TestCancelRefundingOrderRetriesRefund(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	refundUUID := gofakeit.UUID()
+
+	order := getMockOrderWithStatus(orderUUID, model.OrderStatusRefunding)
+	order.TransactionUUID = lo.ToPtr(gofakeit.UUID())
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
+	paymentClient.On("RefundPayment", ctx, order.UserUUID, orderUUID, *order.TransactionUUID).Return(refundUUID, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Twice()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusRefunding, model.OrderUpdateInfo{
+		Status: lo.ToPtr(model.OrderStatusRefunded),
+	}).Return(nil).Once()
+	orderRepository.On("AddStatusHistory", ctx, mock.AnythingOfType("model.OrderStatusHistory")).Return(nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	promoCodeRepository.On("ReturnPromoCodeUses", ctx, orderUUID).Return(nil).Once()
+	orderProducer.On("ProduceOrderRefunded", ctx, mock.MatchedBy(func(event model.OrderRefunded) bool {
+		return event.OrderUUID == orderUUID && event.RefundUUID == refundUUID
+	})).Return(nil).Once()
+
+	err := orderService.CancelOrder(ctx, order.UserUUID, orderUUID)
+	assert.NoError(t, err)
+}
+
+func TestCancelPaidOrderCompletedConcurrently(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	order := getMockOrderWithStatus(orderUUID, model.OrderStatusAssembling)
+	order.TransactionUUID = lo.ToPtr(gofakeit.UUID())
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusAssembling, mock.AnythingOfType("model.OrderUpdateInfo")).
+		Return(&model.InvalidStatusTransitionError{From: model.OrderStatusCompleted, To: model.OrderStatusRefunding}).Once()
+
+	// Сборка успела завершиться - деньги за собранный корабль не возвращаются
+	err := orderService.CancelOrder(ctx, order.UserUUID, orderUUID)
+	assert.ErrorIs(t, err, model.ErrOrderInvalidTransition)
+}
+
+func TestCancelPaidOrderRefundProduceError(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	expectedErr := errors.New("outbox error")
+
+	order := getMockOrderWithStatus(orderUUID, model.OrderStatusPaid)
+	order.TransactionUUID = lo.ToPtr(gofakeit.UUID())
+
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
+	paymentClient.On("RefundPayment", ctx, order.UserUUID, orderUUID, *order.TransactionUUID).Return(gofakeit.UUID(), nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Times(3)
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusPaid, mock.AnythingOfType("model.OrderUpdateInfo")).Return(nil).Once()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusRefunding, mock.AnythingOfType("model.OrderUpdateInfo")).Return(nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Twice()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Twice()
+	orderRepository.On("AddStatusHistory", ctx, mock.AnythingOfType("model.OrderStatusHistory")).Return(nil).Twice()
+	promoCodeRepository.On("ReturnPromoCodeUses", ctx, orderUUID).Return(nil).Once()
+	orderProducer.On("ProduceOrderRefunded", ctx, mock.AnythingOfType("model.OrderRefunded")).Return(expectedErr).Once()
+
+	err := orderService.CancelOrder(ctx, order.UserUUID, orderUUID)
+	assert.ErrorIs(t, err, expectedErr)
+}
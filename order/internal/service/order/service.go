@@ -1,6 +1,7 @@
 package order
 
 import (
+	"github.com/Alexey-step/rocket-factory/order/internal/client/exchange"
 	"github.com/Alexey-step/rocket-factory/order/internal/client/grpc"
 	def "github.com/Alexey-step/rocket-factory/order/internal/service"
 )
@@ -8,24 +9,47 @@ import (
 var _ def.OrderService = (*service)(nil)
 
 type service struct {
-	orderRepository def.OrderRepository
+	orderRepository       def.OrderRepository
+	idempotencyRepository def.IdempotencyRepository
+	promoCodeRepository   def.PromoCodeRepository
 
 	inventoryClient grpc.InventoryClient
 	paymentClient   grpc.PaymentClient
 
+	rateProvider exchange.RateProvider
+	bomValidator def.BOMValidator
+
 	orderProducerService def.OrderProducerService
+	webhookNotifier      def.WebhookNotifier
+	orderStatusPublisher def.OrderStatusPublisher
+
+	txManager def.TxManager
 }
 
 func NewService(
 	orderRepository def.OrderRepository,
+	idempotencyRepository def.IdempotencyRepository,
+	promoCodeRepository def.PromoCodeRepository,
 	inventoryClient grpc.InventoryClient,
 	paymentClient grpc.PaymentClient,
+	rateProvider exchange.RateProvider,
+	bomValidator def.BOMValidator,
 	orderProducerService def.OrderProducerService,
+	webhookNotifier def.WebhookNotifier,
+	orderStatusPublisher def.OrderStatusPublisher,
+	txManager def.TxManager,
 ) *service {
 	return &service{
-		orderRepository:      orderRepository,
-		inventoryClient:      inventoryClient,
-		paymentClient:        paymentClient,
-		orderProducerService: orderProducerService,
+		orderRepository:       orderRepository,
+		idempotencyRepository: idempotencyRepository,
+		promoCodeRepository:   promoCodeRepository,
+		inventoryClient:       inventoryClient,
+		paymentClient:         paymentClient,
+		rateProvider:          rateProvider,
+		bomValidator:          bomValidator,
+		orderProducerService:  orderProducerService,
+		webhookNotifier:       webhookNotifier,
+		orderStatusPublisher:  orderStatusPublisher,
+		txManager:             txManager,
 	}
 }
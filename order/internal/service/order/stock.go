@@ -0,0 +1,51 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+// reserveStock резервирует детали под заказ. Нехватка деталей означает, что inventory
+// ничего не зарезервировал. При любой другой ошибке, например по таймауту, резерв мог
+// успеть создаться, поэтому он снимается: иначе детали зависнут под заказом, которого нет
+func (s *service) reserveStock(ctx context.Context, reservationKey string, items []model.OrderItem) error {
+	err := s.inventoryClient.ReserveStock(ctx, reservationKey, items)
+	if err == nil {
+		return nil
+	}
+
+	var stockErr *model.InsufficientStockError
+	if !errors.As(err, &stockErr) {
+		s.releaseStock(ctx, reservationKey)
+	}
+
+	return err
+}
+
+// releaseStock снимает резерв заказа. Ошибка только логируется: статус заказа
+// к этому моменту уже изменён, а повторный вызов ReleaseStock идемпотентен.
+func (s *service) releaseStock(ctx context.Context, reservationKey string) {
+	err := s.inventoryClient.ReleaseStock(context.WithoutCancel(ctx), reservationKey)
+	if err != nil {
+		logger.Error(ctx, "Failed to release reserved stock",
+			zap.String("reservation_key", reservationKey),
+			zap.Error(err),
+		)
+	}
+}
+
+// commitStock окончательно списывает резерв оплаченного заказа
+func (s *service) commitStock(ctx context.Context, reservationKey string) {
+	err := s.inventoryClient.CommitStock(context.WithoutCancel(ctx), reservationKey)
+	if err != nil {
+		logger.Error(ctx, "Failed to commit reserved stock",
+			zap.String("reservation_key", reservationKey),
+			zap.Error(err),
+		)
+	}
+}
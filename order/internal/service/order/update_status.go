@@ -2,16 +2,36 @@ package order
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
 	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
 )
 
-func (s *service) UpdateStatus(ctx context.Context, orderUUID string, status model.OrderStatus) error {
-	err := s.orderRepository.UpdateOrder(ctx, orderUUID, model.OrderUpdateInfo{
-		Status: &status,
+func (s *service) UpdateStatus(ctx context.Context, orderUUID string, status model.OrderStatus, change model.StatusChange) error {
+	order, err := s.orderRepository.GetOrder(ctx, orderUUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		txErr := s.transitionOrder(ctx, order, status, model.OrderUpdateInfo{}, change)
+		if txErr != nil {
+			return txErr
+		}
+
+		if status != model.OrderStatusCompleted {
+			return nil
+		}
+
+		return s.orderProducerService.ProduceOrderCompleted(ctx, model.OrderCompleted{
+			Envelope:  newEventEnvelope(change.Actor.ID),
+			OrderUUID: order.UUID,
+			UserUUID:  order.UserUUID,
+		})
 	})
 	if err != nil {
 		logger.Error(ctx, "update status error",
@@ -24,3 +44,66 @@ func (s *service) UpdateStatus(ctx context.Context, orderUUID string, status mod
 
 	return nil
 }
+
+// transitionOrder проверяет переход по таблице статусов и обновляет заказ,
+// только если его статус не изменился с момента чтения. Вместе с обновлением
+// в той же транзакции пишется запись в историю статусов.
+func (s *service) transitionOrder(
+	ctx context.Context,
+	order model.OrderData,
+	to model.OrderStatus,
+	info model.OrderUpdateInfo,
+	change model.StatusChange,
+) error {
+	if err := model.ValidateTransition(order.Status, to); err != nil {
+		return err
+	}
+
+	info.Status = &to
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		err := s.orderRepository.UpdateOrder(ctx, order.UUID, order.Status, info)
+		if err != nil {
+			return err
+		}
+
+		return s.addStatusHistory(ctx, order.UserUUID, model.OrderStatusHistory{
+			OrderUUID:  order.UUID,
+			FromStatus: &order.Status,
+			ToStatus:   to,
+			Actor:      change.Actor,
+			Reason:     change.Reason,
+		})
+	})
+}
+
+// addStatusHistory пишет запись в историю статусов и ставит в очередь уведомления
+// вебхуков владельца заказа в той же транзакции. В поток статусов смена уходит только
+// после коммита, чтобы подписчики не увидели статус, который откатится
+func (s *service) addStatusHistory(ctx context.Context, userUUID string, record model.OrderStatusHistory) error {
+	if err := s.orderRepository.AddStatusHistory(ctx, record); err != nil {
+		return err
+	}
+
+	change := model.OrderStatusChange{
+		OrderUUID:  record.OrderUUID,
+		UserUUID:   userUUID,
+		FromStatus: record.FromStatus,
+		ToStatus:   record.ToStatus,
+		OccurredAt: time.Now(),
+	}
+	if err := s.webhookNotifier.NotifyStatusChange(ctx, change); err != nil {
+		return err
+	}
+
+	// Ошибка публикации не отменяет смену статуса: клиент получит актуальный статус при переподключении
+	txmanager.AfterCommit(ctx, func(ctx context.Context) {
+		if err := s.orderStatusPublisher.PublishStatusChange(ctx, change); err != nil {
+			logger.Error(ctx, "Failed to publish order status change",
+				zap.String("order_uuid", change.OrderUUID),
+				zap.Error(err),
+			)
+		}
+	})
+
+	return nil
+}
@@ -7,10 +7,13 @@ import (
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
+	exchangeMocks "github.com/Alexey-step/rocket-factory/order/internal/client/exchange/mocks"
 	clientMocks "github.com/Alexey-step/rocket-factory/order/internal/client/grpc/mocks"
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
 	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/service/bom"
 	orderServiceMocks "github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
 	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
 )
@@ -21,24 +24,100 @@ func TestUpdateStatusSuccess(t *testing.T) {
 	status := model.OrderStatusCompleted
 
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderInfo := model.OrderUpdateInfo{
 		Status: lo.ToPtr(model.OrderStatusCompleted),
 	}
 
-	orderRepository.On("UpdateOrder", ctx, orderUUID, orderInfo).Return(nil).Once()
+	order := getMockOrderWithStatus(orderUUID, model.OrderStatusAssembling)
+	eventUUID := gofakeit.UUID()
 
-	err := orderService.UpdateStatus(ctx, orderUUID, status)
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Twice()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusAssembling, orderInfo).Return(nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderRepository.On("AddStatusHistory", ctx, mock.AnythingOfType("model.OrderStatusHistory")).Return(nil).Once()
+	orderProducer.On("ProduceOrderCompleted", ctx, mock.MatchedBy(func(event model.OrderCompleted) bool {
+		return event.OrderUUID == orderUUID &&
+			event.UserUUID == order.UserUUID &&
+			event.Envelope.CausationID == eventUUID &&
+			event.Envelope.SchemaVersion == model.EventSchemaVersion
+	})).Return(nil).Once()
+
+	err := orderService.UpdateStatus(ctx, orderUUID, status, model.StatusChange{
+		Actor:  model.EventActor(eventUUID),
+		Reason: model.StatusReasonAssemblyFinished,
+	})
+	assert.NoError(t, err)
+}
+
+func TestUpdateStatusPublishFailureIgnored(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	order := getMockOrderWithStatus(orderUUID, model.OrderStatusPaid)
+
+	logger.SetNopLogger()
+	orderRepository := mocks.NewOrderRepository(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		mocks.NewIdempotencyRepository(t),
+		mocks.NewPromoCodeRepository(t),
+		clientMocks.NewInventoryClient(t),
+		clientMocks.NewPaymentClient(t),
+		exchangeMocks.NewRateProvider(t),
+		bom.NewValidator(),
+		orderServiceMocks.NewOrderProducerService(t),
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(order, nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Twice()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusPaid, model.OrderUpdateInfo{
+		Status: lo.ToPtr(model.OrderStatusAssembling),
+	}).Return(nil).Once()
+	orderRepository.On("AddStatusHistory", ctx, mock.AnythingOfType("model.OrderStatusHistory")).Return(nil).Once()
+	webhookNotifier.On("NotifyStatusChange", ctx, mock.AnythingOfType("model.OrderStatusChange")).Return(nil).Once()
+	orderStatusPublisher.On("PublishStatusChange", ctx, mock.MatchedBy(func(change model.OrderStatusChange) bool {
+		return change.OrderUUID == orderUUID &&
+			change.UserUUID == order.UserUUID &&
+			*change.FromStatus == model.OrderStatusPaid &&
+			change.ToStatus == model.OrderStatusAssembling
+	})).Return(gofakeit.Error()).Once()
+
+	err := orderService.UpdateStatus(ctx, orderUUID, model.OrderStatusAssembling, model.StatusChange{
+		Actor:  model.EventActor(gofakeit.UUID()),
+		Reason: model.StatusReasonAssemblyStarted,
+	})
 	assert.NoError(t, err)
 }
 
@@ -50,24 +129,132 @@ func TestUpdateStatusFail(t *testing.T) {
 
 	logger.SetNopLogger()
 	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
 	inventoryClient := clientMocks.NewInventoryClient(t)
 	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
 	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
 
 	orderService := NewService(
 		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
 		inventoryClient,
 		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
 		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
 	)
 
 	orderInfo := model.OrderUpdateInfo{
 		Status: lo.ToPtr(model.OrderStatusCompleted),
 	}
 
-	orderRepository.On("UpdateOrder", ctx, orderUUID, orderInfo).Return(expectedErr).Once()
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(getMockOrderWithStatus(orderUUID, model.OrderStatusAssembling), nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Twice()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusAssembling, orderInfo).Return(expectedErr).Once()
 
-	err := orderService.UpdateStatus(ctx, orderUUID, status)
+	err := orderService.UpdateStatus(ctx, orderUUID, status, model.StatusChange{
+		Actor:  model.EventActor(gofakeit.UUID()),
+		Reason: model.StatusReasonAssemblyFinished,
+	})
 	assert.Error(t, err)
 	assert.Equal(t, err, expectedErr)
 }
+
+func TestUpdateStatusInvalidTransition(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	status := model.OrderStatusCompleted
+	expectedErr := &model.InvalidStatusTransitionError{From: model.OrderStatusCanceled, To: model.OrderStatusCompleted}
+
+	logger.SetNopLogger()
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(getMockOrderWithStatus(orderUUID, model.OrderStatusCanceled), nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+
+	err := orderService.UpdateStatus(ctx, orderUUID, status, model.StatusChange{
+		Actor:  model.EventActor(gofakeit.UUID()),
+		Reason: model.StatusReasonAssemblyFinished,
+	})
+	assert.ErrorIs(t, err, model.ErrOrderInvalidTransition)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestUpdateStatusConcurrentChange(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	status := model.OrderStatusAssembling
+	expectedErr := &model.InvalidStatusTransitionError{From: model.OrderStatusCompleted, To: model.OrderStatusAssembling}
+
+	logger.SetNopLogger()
+	orderRepository := mocks.NewOrderRepository(t)
+	idempotencyRepository := mocks.NewIdempotencyRepository(t)
+	promoCodeRepository := mocks.NewPromoCodeRepository(t)
+	inventoryClient := clientMocks.NewInventoryClient(t)
+	paymentClient := clientMocks.NewPaymentClient(t)
+	rateProvider := exchangeMocks.NewRateProvider(t)
+	orderProducer := orderServiceMocks.NewOrderProducerService(t)
+	webhookNotifier := orderServiceMocks.NewWebhookNotifier(t)
+	orderStatusPublisher := orderServiceMocks.NewOrderStatusPublisher(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+
+	orderService := NewService(
+		orderRepository,
+		idempotencyRepository,
+		promoCodeRepository,
+		inventoryClient,
+		paymentClient,
+		rateProvider,
+		bom.NewValidator(),
+		orderProducer,
+		webhookNotifier,
+		orderStatusPublisher,
+		txManager,
+	)
+
+	orderInfo := model.OrderUpdateInfo{
+		Status: lo.ToPtr(model.OrderStatusAssembling),
+	}
+
+	orderRepository.On("GetOrder", ctx, orderUUID).Return(getMockOrderWithStatus(orderUUID, model.OrderStatusPaid), nil).Once()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Twice()
+	orderRepository.On("UpdateOrder", ctx, orderUUID, model.OrderStatusPaid, orderInfo).Return(expectedErr).Once()
+
+	err := orderService.UpdateStatus(ctx, orderUUID, status, model.StatusChange{
+		Actor:  model.EventActor(gofakeit.UUID()),
+		Reason: model.StatusReasonAssemblyFinished,
+	})
+	assert.ErrorIs(t, err, model.ErrOrderInvalidTransition)
+}
@@ -5,41 +5,114 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
-	"github.com/Alexey-step/rocket-factory/platform/pkg/kafka"
 	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/outbox"
 	eventsV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/events/v1"
 )
 
+// Topics - топики Kafka, по одному на каждый тип события заказа
+type Topics struct {
+	OrderPaid      string
+	OrderExpired   string
+	OrderRefunded  string
+	OrderCreated   string
+	OrderCancelled string
+	OrderCompleted string
+}
+
 type service struct {
-	orderProducer kafka.Producer
+	outboxWriter outbox.Writer
+	topics       Topics
 }
 
-func NewService(orderProducer kafka.Producer) *service {
+// NewService создаёт продюсер, который кладёт события в outbox;
+// в Kafka их отправляет outbox.Relay после коммита транзакции
+func NewService(outboxWriter outbox.Writer, topics Topics) *service {
 	return &service{
-		orderProducer: orderProducer,
+		outboxWriter: outboxWriter,
+		topics:       topics,
 	}
 }
 
 func (s *service) ProduceOrderPaid(ctx context.Context, event model.OrderPaid) error {
-	msg := &eventsV1.OrderPaid{
+	return s.produce(ctx, "order paid", s.topics.OrderPaid, event.OrderUUID, event, &eventsV1.OrderPaid{
 		EventUuid:       event.EventUUID,
 		OrderUuid:       event.OrderUUID,
 		UserUuid:        event.UserUUID,
 		PaymentMethod:   event.PaymentMethod,
 		TransactionUuid: event.TransactionUUID,
-	}
+	})
+}
+
+// ProduceOrderExpired заполняет и конверт, и прежние поля event_uuid/expired_at,
+// которые читают консьюмеры, ещё не перешедшие на конверт
+func (s *service) ProduceOrderExpired(ctx context.Context, event model.OrderExpired) error {
+	return s.produce(ctx, "order expired", s.topics.OrderExpired, event.OrderUUID, event, &eventsV1.OrderExpired{
+		Envelope:  envelopeToProto(event.Envelope),
+		EventUuid: event.Envelope.EventUUID,
+		OrderUuid: event.OrderUUID,
+		UserUuid:  event.UserUUID,
+		Reason:    string(event.Reason),
+		ExpiredAt: timestamppb.New(event.Envelope.OccurredAt),
+	})
+}
 
+func (s *service) ProduceOrderRefunded(ctx context.Context, event model.OrderRefunded) error {
+	return s.produce(ctx, "order refunded", s.topics.OrderRefunded, event.OrderUUID, event, &eventsV1.OrderRefunded{
+		EventUuid:       event.EventUUID,
+		OrderUuid:       event.OrderUUID,
+		UserUuid:        event.UserUUID,
+		TransactionUuid: event.TransactionUUID,
+		RefundUuid:      event.RefundUUID,
+	})
+}
+
+func (s *service) ProduceOrderCreated(ctx context.Context, event model.OrderCreated) error {
+	return s.produce(ctx, "order created", s.topics.OrderCreated, event.OrderUUID, event, &eventsV1.OrderCreated{
+		Envelope:   envelopeToProto(event.Envelope),
+		OrderUuid:  event.OrderUUID,
+		UserUuid:   event.UserUUID,
+		TotalPrice: event.TotalPrice.Amount,
+		Currency:   event.TotalPrice.Currency,
+	})
+}
+
+func (s *service) ProduceOrderCancelled(ctx context.Context, event model.OrderCancelled) error {
+	return s.produce(ctx, "order cancelled", s.topics.OrderCancelled, event.OrderUUID, event, &eventsV1.OrderCancelled{
+		Envelope:  envelopeToProto(event.Envelope),
+		OrderUuid: event.OrderUUID,
+		UserUuid:  event.UserUUID,
+		Reason:    string(event.Reason),
+	})
+}
+
+func (s *service) ProduceOrderCompleted(ctx context.Context, event model.OrderCompleted) error {
+	return s.produce(ctx, "order completed", s.topics.OrderCompleted, event.OrderUUID, event, &eventsV1.OrderCompleted{
+		Envelope:  envelopeToProto(event.Envelope),
+		OrderUuid: event.OrderUUID,
+		UserUuid:  event.UserUUID,
+	})
+}
+
+// produce сериализует событие и пишет его в outbox. Ключ сообщения - UUID заказа,
+// чтобы все события одного заказа попадали в одну партицию и читались по порядку
+func (s *service) produce(ctx context.Context, name, topic, orderUUID string, event any, msg proto.Message) error {
 	payload, err := proto.Marshal(msg)
 	if err != nil {
-		logger.Error(ctx, "Failed to marshal order paid", zap.Error(err))
+		logger.Error(ctx, "Failed to marshal "+name, zap.Error(err))
 		return err
 	}
 
-	err = s.orderProducer.Send(ctx, event.OrderUUID, payload)
+	err = s.outboxWriter.Write(ctx, outbox.Message{
+		Topic:   topic,
+		Key:     orderUUID,
+		Payload: payload,
+	})
 	if err != nil {
-		logger.Error(ctx, "Failed to send order paid",
+		logger.Error(ctx, "Failed to write "+name+" to outbox",
 			zap.Any("event", event),
 			zap.Error(err),
 		)
@@ -47,3 +120,12 @@ func (s *service) ProduceOrderPaid(ctx context.Context, event model.OrderPaid) e
 	}
 	return nil
 }
+
+func envelopeToProto(envelope model.EventEnvelope) *eventsV1.EventEnvelope {
+	return &eventsV1.EventEnvelope{
+		EventUuid:     envelope.EventUUID,
+		OccurredAt:    timestamppb.New(envelope.OccurredAt),
+		CausationId:   envelope.CausationID,
+		SchemaVersion: envelope.SchemaVersion,
+	}
+}
@@ -0,0 +1,60 @@
+package report
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+func (s *service) GetOrdersReport(ctx context.Context, userUUID string, filter model.OrdersReportFilter) (model.OrdersReport, error) {
+	if _, ok := s.adminUUIDs[userUUID]; !ok {
+		return model.OrdersReport{}, model.ErrAdminRequired
+	}
+
+	if err := s.validateFilter(filter); err != nil {
+		return model.OrdersReport{}, err
+	}
+
+	rows, err := s.reportRepository.GetOrdersReport(ctx, filter)
+	if err != nil {
+		return model.OrdersReport{}, err
+	}
+
+	return model.OrdersReport{
+		From:    filter.From,
+		To:      filter.To,
+		GroupBy: filter.GroupBy,
+		Rows:    rows,
+	}, nil
+}
+
+func (s *service) validateFilter(filter model.OrdersReportFilter) error {
+	if !filter.From.Before(filter.To) {
+		return fmt.Errorf("%w: from must be before to", model.ErrReportInvalid)
+	}
+	if s.maxRange > 0 && filter.To.Sub(filter.From) > s.maxRange {
+		return fmt.Errorf("%w: range is longer than %s", model.ErrReportInvalid, s.maxRange)
+	}
+
+	seen := make(map[model.ReportGroupBy]struct{}, len(filter.GroupBy))
+	periods := 0
+	for _, group := range filter.GroupBy {
+		if !group.Valid() {
+			return fmt.Errorf("%w: unknown group_by %q", model.ErrReportInvalid, group)
+		}
+		if _, ok := seen[group]; ok {
+			return fmt.Errorf("%w: duplicate group_by %q", model.ErrReportInvalid, group)
+		}
+		seen[group] = struct{}{}
+
+		if group.IsPeriod() {
+			periods++
+		}
+	}
+	if periods > 1 {
+		return fmt.Errorf("%w: only one of DAY, WEEK, MONTH can be used", model.ErrReportInvalid)
+	}
+
+	return nil
+}
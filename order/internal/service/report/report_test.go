@@ -0,0 +1,142 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/money"
+)
+
+const testMaxRange = 366 * 24 * time.Hour
+
+func TestGetOrdersReportSuccess(t *testing.T) {
+	ctx := context.Background()
+	adminUUID := gofakeit.UUID()
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	filter := model.OrdersReportFilter{
+		From:    from,
+		To:      from.AddDate(0, 1, 0),
+		GroupBy: []model.ReportGroupBy{model.ReportGroupByDay, model.ReportGroupByPaymentMethod},
+	}
+	rows := []model.OrdersReportRow{
+		{
+			PeriodStart:       lo.ToPtr(from),
+			PaymentMethod:     lo.ToPtr(model.PaymentMethodCard),
+			Currency:          "RUB",
+			OrdersCount:       4,
+			PaidOrdersCount:   3,
+			Revenue:           money.New(90000, "RUB"),
+			AverageOrderValue: money.New(30000, "RUB"),
+			CancelledCount:    1,
+			CancellationRate:  0.25,
+		},
+	}
+
+	reportRepository := mocks.NewReportRepository(t)
+	reportService := NewService(reportRepository, []string{gofakeit.UUID(), adminUUID}, testMaxRange)
+
+	reportRepository.On("GetOrdersReport", ctx, filter).Return(rows, nil).Once()
+
+	report, err := reportService.GetOrdersReport(ctx, adminUUID, filter)
+	require.NoError(t, err)
+	assert.Equal(t, model.OrdersReport{
+		From:    filter.From,
+		To:      filter.To,
+		GroupBy: filter.GroupBy,
+		Rows:    rows,
+	}, report)
+}
+
+func TestGetOrdersReportAdminRequired(t *testing.T) {
+	ctx := context.Background()
+	from := time.Now().Add(-24 * time.Hour)
+
+	reportService := NewService(mocks.NewReportRepository(t), []string{gofakeit.UUID()}, testMaxRange)
+
+	_, err := reportService.GetOrdersReport(ctx, gofakeit.UUID(), model.OrdersReportFilter{
+		From: from,
+		To:   from.Add(time.Hour),
+	})
+	require.ErrorIs(t, err, model.ErrAdminRequired)
+}
+
+func TestGetOrdersReportInvalidFilter(t *testing.T) {
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		filter model.OrdersReportFilter
+	}{
+		{
+			name:   "to before from",
+			filter: model.OrdersReportFilter{From: from, To: from.Add(-time.Hour)},
+		},
+		{
+			name:   "empty range",
+			filter: model.OrdersReportFilter{From: from, To: from},
+		},
+		{
+			name:   "range too long",
+			filter: model.OrdersReportFilter{From: from, To: from.Add(testMaxRange + time.Hour)},
+		},
+		{
+			name: "unknown group by",
+			filter: model.OrdersReportFilter{
+				From:    from,
+				To:      from.Add(time.Hour),
+				GroupBy: []model.ReportGroupBy{"YEAR"},
+			},
+		},
+		{
+			name: "duplicate group by",
+			filter: model.OrdersReportFilter{
+				From:    from,
+				To:      from.Add(time.Hour),
+				GroupBy: []model.ReportGroupBy{model.ReportGroupByStatus, model.ReportGroupByStatus},
+			},
+		},
+		{
+			name: "two periods",
+			filter: model.OrdersReportFilter{
+				From:    from,
+				To:      from.Add(time.Hour),
+				GroupBy: []model.ReportGroupBy{model.ReportGroupByDay, model.ReportGroupByMonth},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adminUUID := gofakeit.UUID()
+			reportService := NewService(mocks.NewReportRepository(t), []string{adminUUID}, testMaxRange)
+
+			_, err := reportService.GetOrdersReport(context.Background(), adminUUID, tt.filter)
+			require.ErrorIs(t, err, model.ErrReportInvalid)
+		})
+	}
+}
+
+func TestGetOrdersReportRepositoryError(t *testing.T) {
+	ctx := context.Background()
+	adminUUID := gofakeit.UUID()
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	filter := model.OrdersReportFilter{From: from, To: from.AddDate(0, 0, 7)}
+	repoErr := errors.New("canceling statement due to statement timeout")
+
+	reportRepository := mocks.NewReportRepository(t)
+	reportService := NewService(reportRepository, []string{adminUUID}, testMaxRange)
+
+	reportRepository.On("GetOrdersReport", ctx, filter).Return(nil, repoErr).Once()
+
+	_, err := reportService.GetOrdersReport(ctx, adminUUID, filter)
+	require.ErrorIs(t, err, repoErr)
+}
@@ -0,0 +1,32 @@
+package report
+
+import (
+	"time"
+
+	def "github.com/Alexey-step/rocket-factory/order/internal/service"
+)
+
+var _ def.ReportService = (*service)(nil)
+
+type service struct {
+	reportRepository def.ReportRepository
+	adminUUIDs       map[string]struct{}
+	maxRange         time.Duration
+}
+
+func NewService(
+	reportRepository def.ReportRepository,
+	adminUUIDs []string,
+	maxRange time.Duration,
+) *service {
+	admins := make(map[string]struct{}, len(adminUUIDs))
+	for _, userUUID := range adminUUIDs {
+		admins[userUUID] = struct{}{}
+	}
+
+	return &service{
+		reportRepository: reportRepository,
+		adminUUIDs:       admins,
+		maxRange:         maxRange,
+	}
+}
@@ -2,28 +2,143 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
 )
 
 type OrderService interface {
-	CreateOrder(ctx context.Context, userUUID string, partsUUIDs []string) (info model.OrderCreationInfo, err error)
-	GetOrder(ctx context.Context, orderUUID string) (order model.OrderData, err error)
-	CancelOrder(ctx context.Context, orderUUID string) error
-	PayOrder(ctx context.Context, orderUUID, paymentMethod string) (transactionUUID string, err error)
-	UpdateStatus(ctx context.Context, orderUUID string, status model.OrderStatus) error
+	CreateOrder(ctx context.Context, userUUID string, request model.OrderRequest, idempotencyKey string) (info model.OrderCreationInfo, err error)
+	QuoteOrder(ctx context.Context, request model.OrderRequest) (quote model.OrderQuote, err error)
+	GetOrder(ctx context.Context, userUUID, orderUUID string) (order model.OrderData, err error)
+	ListOrders(ctx context.Context, filter model.OrdersFilter) (page model.OrdersPage, err error)
+	CancelOrder(ctx context.Context, userUUID, orderUUID string) error
+	PayOrder(ctx context.Context, userUUID, orderUUID, paymentMethod, idempotencyKey string) (transactionUUID string, err error)
+	GetOrderHistory(ctx context.Context, userUUID, orderUUID string) (history []model.OrderStatusHistory, err error)
+	GetDraft(ctx context.Context, userUUID string) (draft model.OrderData, err error)
+	AddDraftItem(ctx context.Context, userUUID string, item model.OrderItemInfo) (draft model.OrderData, err error)
+	SetDraftItemQuantity(ctx context.Context, userUUID, partUUID string, quantity int64) (draft model.OrderData, err error)
+	RemoveDraftItem(ctx context.Context, userUUID, partUUID string) (draft model.OrderData, err error)
+	CheckoutDraft(ctx context.Context, userUUID string, checkout model.DraftCheckout, idempotencyKey string) (info model.OrderCreationInfo, err error)
+	UpdateStatus(ctx context.Context, orderUUID string, status model.OrderStatus, change model.StatusChange) error
+	ExpireOrders(ctx context.Context, createdBefore time.Time, limit int) (expired int, err error)
 }
 
 type OrderRepository interface {
-	CreateOrder(ctx context.Context, userUUID string, parts []model.Part) (info model.OrderCreationInfo, err error)
+	CreateOrder(ctx context.Context, order model.OrderData) (info model.OrderCreationInfo, err error)
 	GetOrder(ctx context.Context, orderUUID string) (order model.OrderData, err error)
-	UpdateOrder(ctx context.Context, orderUUID string, orderUpdateInfo model.OrderUpdateInfo) error
+	ListOrders(ctx context.Context, filter model.OrdersFilter) (page model.OrdersPage, err error)
+	UpdateOrder(ctx context.Context, orderUUID string, expectedStatus model.OrderStatus, orderUpdateInfo model.OrderUpdateInfo) error
+	AddStatusHistory(ctx context.Context, record model.OrderStatusHistory) error
+	GetStatusHistory(ctx context.Context, orderUUID string) (history []model.OrderStatusHistory, err error)
+	LockExpiredOrders(ctx context.Context, createdBefore time.Time, limit int) (orders []model.OrderData, err error)
+	EnsureDraftOrder(ctx context.Context, draft model.OrderData) (orderUUID string, err error)
+	GetDraftOrder(ctx context.Context, userUUID string) (order model.OrderData, err error)
+	SetDraftItem(ctx context.Context, orderUUID string, item model.OrderItem) error
+	RemoveDraftItem(ctx context.Context, orderUUID, partUUID string) error
+	CheckoutDraftOrder(ctx context.Context, order model.OrderData) error
+}
+
+type PromoCodeRepository interface {
+	GetPromoCode(ctx context.Context, code string) (promo model.PromoCode, err error)
+	RedeemPromoCode(ctx context.Context, code string) error
+	ReturnPromoCodeUses(ctx context.Context, orderUUID string) error
+}
+
+type IdempotencyRepository interface {
+	AcquireIdempotencyKey(ctx context.Context, key model.IdempotencyKey, lockTTL time.Duration) (acquired bool, err error)
+	GetIdempotencyKey(ctx context.Context, key model.IdempotencyKey) (record model.IdempotencyRecord, err error)
+	SaveIdempotencyResponse(ctx context.Context, key model.IdempotencyKey, response []byte) error
+	DeleteIdempotencyKey(ctx context.Context, key model.IdempotencyKey) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (deleted int64, err error)
+}
+
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook model.Webhook) (created model.Webhook, err error)
+	GetWebhook(ctx context.Context, webhookUUID string) (webhook model.Webhook, err error)
+	ListWebhooks(ctx context.Context, userUUID string) (webhooks []model.Webhook, err error)
+	DeleteWebhook(ctx context.Context, webhookUUID string) error
+	EnableWebhook(ctx context.Context, webhookUUID string) (webhook model.Webhook, err error)
+	RecordWebhookFailure(ctx context.Context, webhookUUID string, disableAfter int) (webhook model.Webhook, err error)
+	ResetWebhookFailures(ctx context.Context, webhookUUID string) error
+	EnqueueWebhookDeliveries(ctx context.Context, userUUID string, delivery model.WebhookDelivery) (enqueued int, err error)
+	ClaimPendingWebhookDeliveries(ctx context.Context, limit int, leaseUntil time.Time) (deliveries []model.PendingWebhookDelivery, err error)
+	UpdateWebhookDelivery(ctx context.Context, delivery model.WebhookDelivery) error
+	AddWebhookDeliveryAttempt(ctx context.Context, deliveryID int64, attempt model.WebhookDeliveryAttempt) error
+	ListWebhookDeliveries(ctx context.Context, webhookUUID string, limit int) (deliveries []model.WebhookDelivery, err error)
+}
+
+type InvoiceRepository interface {
+	GetInvoiceByOrder(ctx context.Context, orderUUID string) (invoice model.Invoice, err error)
+	NextInvoiceSequence(ctx context.Context, year int) (sequence int64, err error)
+	CreateInvoice(ctx context.Context, invoice model.Invoice) (created model.Invoice, err error)
+}
+
+type ReportRepository interface {
+	GetOrdersReport(ctx context.Context, filter model.OrdersReportFilter) (rows []model.OrdersReportRow, err error)
 }
 
 type OrderProducerService interface {
 	ProduceOrderPaid(ctx context.Context, event model.OrderPaid) error
+	ProduceOrderExpired(ctx context.Context, event model.OrderExpired) error
+	ProduceOrderRefunded(ctx context.Context, event model.OrderRefunded) error
+	ProduceOrderCreated(ctx context.Context, event model.OrderCreated) error
+	ProduceOrderCancelled(ctx context.Context, event model.OrderCancelled) error
+	ProduceOrderCompleted(ctx context.Context, event model.OrderCompleted) error
+}
+
+type WebhookService interface {
+	CreateWebhook(ctx context.Context, userUUID string, request model.WebhookRequest) (webhook model.Webhook, err error)
+	ListWebhooks(ctx context.Context, userUUID string) (webhooks []model.Webhook, err error)
+	DeleteWebhook(ctx context.Context, userUUID, webhookUUID string) error
+	EnableWebhook(ctx context.Context, userUUID, webhookUUID string) (webhook model.Webhook, err error)
+	ListWebhookDeliveries(ctx context.Context, userUUID, webhookUUID string, limit int) (deliveries []model.WebhookDelivery, err error)
+	DeliverPending(ctx context.Context, limit int) (processed int, err error)
+	WebhookNotifier
+}
+
+type ReportService interface {
+	GetOrdersReport(ctx context.Context, userUUID string, filter model.OrdersReportFilter) (report model.OrdersReport, err error)
+}
+
+type InvoiceService interface {
+	GetInvoice(ctx context.Context, userUUID, orderUUID string) (invoice model.Invoice, err error)
+}
+
+// WebhookNotifier ставит в очередь уведомления вебхуков о смене статуса заказа
+// в транзакции из контекста
+type WebhookNotifier interface {
+	NotifyStatusChange(ctx context.Context, change model.OrderStatusChange) error
+}
+
+type WebhookDispatcherService interface {
+	RunDispatcher(ctx context.Context) error
+}
+
+// OrderStatusPublisher рассылает смену статуса заказа по всем репликам сервиса
+type OrderStatusPublisher interface {
+	PublishStatusChange(ctx context.Context, change model.OrderStatusChange) error
+}
+
+type OrderStreamService interface {
+	OrderStatusPublisher
+	Subscribe(ctx context.Context, orderUUID string) <-chan model.OrderStatusChange
+	RunSubscriber(ctx context.Context) error
 }
 
 type OrderConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
+
+type OrderExpiryService interface {
+	RunSweeper(ctx context.Context) error
+}
+
+type BOMValidator interface {
+	Validate(items []model.BOMItem) error
+}
+
+type TxManager interface {
+	ReadCommitted(ctx context.Context, fn txmanager.Handler) error
+}
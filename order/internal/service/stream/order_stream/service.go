@@ -0,0 +1,163 @@
+package order_stream
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	def "github.com/Alexey-step/rocket-factory/order/internal/service"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/cache"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+var _ def.OrderStreamService = (*service)(nil)
+
+// resubscribeDelay - пауза перед повторной подпиской после потери соединения с Redis
+const resubscribeDelay = time.Second
+
+// statusMessage - смена статуса в канале Redis
+type statusMessage struct {
+	OrderUUID  string             `json:"order_uuid"`
+	UserUUID   string             `json:"user_uuid"`
+	FromStatus *model.OrderStatus `json:"from_status,omitempty"`
+	ToStatus   model.OrderStatus  `json:"to_status"`
+	OccurredAt time.Time          `json:"occurred_at"`
+}
+
+type service struct {
+	pubSub     cache.PubSub
+	channel    string
+	bufferSize int
+
+	mu          sync.Mutex
+	subscribers map[string]map[chan model.OrderStatusChange]struct{}
+}
+
+// NewService создаёт поток статусов заказов. Все реплики публикуют смены статусов в один канал
+// Redis и читают его целиком, а подписчикам раздают только события их заказа.
+func NewService(pubSub cache.PubSub, channel string, bufferSize int) *service {
+	return &service{
+		pubSub:      pubSub,
+		channel:     channel,
+		bufferSize:  bufferSize,
+		subscribers: make(map[string]map[chan model.OrderStatusChange]struct{}),
+	}
+}
+
+func (s *service) PublishStatusChange(ctx context.Context, change model.OrderStatusChange) error {
+	message, err := json.Marshal(statusMessage(change))
+	if err != nil {
+		return err
+	}
+
+	return s.pubSub.Publish(ctx, s.channel, message)
+}
+
+// Subscribe возвращает смены статуса заказа до отмены ctx. Канал закрывается раньше, если
+// подписчик не успевает читать события или пропала подписка на Redis: события могли потеряться,
+// и клиенту нужно переподключиться и заново прочитать текущий статус
+func (s *service) Subscribe(ctx context.Context, orderUUID string) <-chan model.OrderStatusChange {
+	events := make(chan model.OrderStatusChange, s.bufferSize)
+
+	s.mu.Lock()
+	if s.subscribers[orderUUID] == nil {
+		s.subscribers[orderUUID] = make(map[chan model.OrderStatusChange]struct{})
+	}
+	s.subscribers[orderUUID][events] = struct{}{}
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		s.unsubscribe(orderUUID, events)
+	}()
+
+	return events
+}
+
+// RunSubscriber читает канал Redis и раздаёт события локальным подписчикам.
+// При потере соединения переподписывается; возвращается только при отмене ctx
+func (s *service) RunSubscriber(ctx context.Context) error {
+	defer s.closeAll()
+
+	for {
+		messages, err := s.pubSub.Subscribe(ctx, s.channel)
+		if err != nil {
+			logger.Error(ctx, "Failed to subscribe to order status channel", zap.Error(err))
+		} else {
+			for message := range messages {
+				s.dispatch(ctx, message)
+			}
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		// Пока подписки не было, события могли пройти мимо
+		s.closeAll()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(resubscribeDelay):
+		}
+	}
+}
+
+func (s *service) dispatch(ctx context.Context, message []byte) {
+	var change statusMessage
+	if err := json.Unmarshal(message, &change); err != nil {
+		logger.Error(ctx, "Failed to decode order status message", zap.Error(err))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for events := range s.subscribers[change.OrderUUID] {
+		select {
+		case events <- model.OrderStatusChange(change):
+		default:
+			s.removeLocked(change.OrderUUID, events)
+		}
+	}
+}
+
+func (s *service) unsubscribe(orderUUID string, events chan model.OrderStatusChange) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeLocked(orderUUID, events)
+}
+
+func (s *service) closeAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for orderUUID, subscribers := range s.subscribers {
+		for events := range subscribers {
+			s.removeLocked(orderUUID, events)
+		}
+	}
+}
+
+// removeLocked закрывает канал подписчика, если он ещё не закрыт. Вызывается под s.mu
+func (s *service) removeLocked(orderUUID string, events chan model.OrderStatusChange) {
+	subscribers, ok := s.subscribers[orderUUID]
+	if !ok {
+		return
+	}
+	if _, ok = subscribers[events]; !ok {
+		return
+	}
+
+	delete(subscribers, events)
+	close(events)
+	if len(subscribers) == 0 {
+		delete(s.subscribers, orderUUID)
+	}
+}
@@ -0,0 +1,160 @@
+package order_stream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+const testChannel = "order:status"
+
+// memoryPubSub - канал Redis в памяти. Как и настоящая подписка, канал сообщений
+// закрывается при отмене контекста или обрыве соединения (drop)
+type memoryPubSub struct {
+	subscribed chan struct{}
+	messages   chan []byte
+	dropped    chan struct{}
+}
+
+func newMemoryPubSub() *memoryPubSub {
+	return &memoryPubSub{
+		subscribed: make(chan struct{}, 1),
+		messages:   make(chan []byte, 16),
+	}
+}
+
+func (p *memoryPubSub) Publish(_ context.Context, _ string, message []byte) error {
+	p.messages <- message
+	return nil
+}
+
+func (p *memoryPubSub) Subscribe(ctx context.Context, _ string) (<-chan []byte, error) {
+	dropped := make(chan struct{})
+	p.dropped = dropped
+	out := make(chan []byte)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-dropped:
+				return
+			case message := <-p.messages:
+				out <- message
+			}
+		}
+	}()
+
+	p.subscribed <- struct{}{}
+	return out, nil
+}
+
+// drop имитирует обрыв соединения с Redis
+func (p *memoryPubSub) drop() {
+	close(p.dropped)
+}
+
+func runSubscriber(t *testing.T, s *service, pubSub *memoryPubSub) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = s.RunSubscriber(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	select {
+	case <-pubSub.subscribed:
+	case <-time.After(time.Second):
+		t.Fatal("subscriber did not subscribe")
+	}
+}
+
+func receive(t *testing.T, events <-chan model.OrderStatusChange) (model.OrderStatusChange, bool) {
+	select {
+	case change, ok := <-events:
+		return change, ok
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return model.OrderStatusChange{}, false
+	}
+}
+
+func TestSubscribeReceivesOwnOrderChanges(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	pubSub := newMemoryPubSub()
+	s := NewService(pubSub, testChannel, 4)
+	runSubscriber(t, s, pubSub)
+
+	events := s.Subscribe(ctx, orderUUID)
+
+	change := model.OrderStatusChange{
+		OrderUUID:  orderUUID,
+		UserUUID:   gofakeit.UUID(),
+		FromStatus: lo.ToPtr(model.OrderStatusPaid),
+		ToStatus:   model.OrderStatusAssembling,
+		OccurredAt: time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, s.PublishStatusChange(ctx, model.OrderStatusChange{
+		OrderUUID: gofakeit.UUID(),
+		ToStatus:  model.OrderStatusPaid,
+	}))
+	require.NoError(t, s.PublishStatusChange(ctx, change))
+
+	received, ok := receive(t, events)
+	require.True(t, ok)
+	assert.Equal(t, change, received)
+}
+
+func TestSubscribeClosesSlowSubscriber(t *testing.T) {
+	ctx := context.Background()
+	orderUUID := gofakeit.UUID()
+	s := NewService(newMemoryPubSub(), testChannel, 1)
+
+	events := s.Subscribe(ctx, orderUUID)
+	message := []byte(`{"order_uuid":"` + orderUUID + `","to_status":"PAID"}`)
+	s.dispatch(ctx, message)
+	s.dispatch(ctx, message)
+
+	_, ok := <-events
+	assert.True(t, ok)
+	_, ok = <-events
+	assert.False(t, ok)
+}
+
+func TestSubscriptionLossClosesSubscribers(t *testing.T) {
+	logger.SetNopLogger()
+	pubSub := newMemoryPubSub()
+	s := NewService(pubSub, testChannel, 4)
+	runSubscriber(t, s, pubSub)
+
+	events := s.Subscribe(context.Background(), gofakeit.UUID())
+	pubSub.drop()
+
+	_, ok := receive(t, events)
+	assert.False(t, ok)
+}
+
+func TestSubscribeStopsOnContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := NewService(newMemoryPubSub(), testChannel, 4)
+
+	events := s.Subscribe(ctx, gofakeit.UUID())
+	cancel()
+
+	_, ok := receive(t, events)
+	assert.False(t, ok)
+}
@@ -0,0 +1,160 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+// DeliverPending отправляет до limit доставок, которым пора уходить, и возвращает их количество.
+// Доставки сначала закрепляются за репликой на ClaimLease коротким запросом, поэтому реплики,
+// запущенные параллельно, отправляют разные доставки. Отправка идёт вне транзакции, а каждая
+// попытка записывается в своей. Если реплика упадёт посреди пачки, неотправленные доставки
+// уйдут после окончания аренды.
+func (s *service) DeliverPending(ctx context.Context, limit int) (int, error) {
+	deliveries, err := s.webhookRepository.ClaimPendingWebhookDeliveries(ctx, limit, time.Now().Add(s.cfg.ClaimLease))
+	if err != nil {
+		return 0, err
+	}
+
+	// После неудачи остальные доставки того же вебхука в этом проходе не отправляем:
+	// получатель, скорее всего, недоступен, и каждая попытка только увеличит счётчик ошибок.
+	// Такие доставки сразу возвращаются в очередь, не дожидаясь конца аренды
+	failedWebhooks := make(map[string]struct{})
+	for _, delivery := range deliveries {
+		if _, failed := failedWebhooks[delivery.WebhookUUID]; failed {
+			if err = s.webhookRepository.UpdateWebhookDelivery(ctx, delivery.WebhookDelivery); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		delivered, err := s.deliver(ctx, delivery)
+		if err != nil {
+			return 0, err
+		}
+		if !delivered {
+			failedWebhooks[delivery.WebhookUUID] = struct{}{}
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+// deliver делает одну попытку доставки и записывает её в журнал. Ошибка возвращается,
+// только если результат не удалось сохранить или контекст отменён посреди отправки
+func (s *service) deliver(ctx context.Context, delivery model.PendingWebhookDelivery) (bool, error) {
+	resp, sendErr := s.webhookClient.Send(ctx, model.WebhookMessage{
+		URL:       delivery.URL,
+		Secret:    delivery.Secret,
+		EventUUID: delivery.EventUUID,
+		EventType: delivery.EventType,
+		Payload:   delivery.Payload,
+	})
+	if ctx.Err() != nil {
+		// Сервис останавливается: попытку не засчитываем, доставка уйдёт после окончания аренды
+		return false, ctx.Err()
+	}
+
+	delivered := sendErr == nil && resp.StatusCode >= 200 && resp.StatusCode < 300
+
+	var webhook model.Webhook
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var txErr error
+		webhook, txErr = s.recordAttempt(ctx, delivery, resp, sendErr, delivered)
+		return txErr
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if delivered {
+		return true, nil
+	}
+
+	logger.Warn(ctx, "Webhook delivery failed",
+		zap.String("webhook_uuid", delivery.WebhookUUID),
+		zap.String("event_uuid", delivery.EventUUID),
+		zap.Int("attempt", delivery.Attempts+1),
+		zap.Int("status_code", resp.StatusCode),
+		zap.Error(sendErr),
+	)
+	if !webhook.Enabled && webhook.ConsecutiveFailures == s.cfg.DisableAfterFailures {
+		logger.Warn(ctx, "Webhook disabled after consecutive delivery failures",
+			zap.String("webhook_uuid", delivery.WebhookUUID),
+			zap.Int("failures", webhook.ConsecutiveFailures),
+		)
+	}
+
+	return false, nil
+}
+
+// recordAttempt сохраняет результат попытки: запись в журнале, новое состояние доставки
+// и счётчик ошибок вебхука. После неудачи возвращает вебхук с обновлённым счётчиком
+func (s *service) recordAttempt(
+	ctx context.Context,
+	delivery model.PendingWebhookDelivery,
+	resp model.WebhookResponse,
+	sendErr error,
+	delivered bool,
+) (model.Webhook, error) {
+	attempt := delivery.Attempts + 1
+	record := model.WebhookDeliveryAttempt{
+		Attempt:     attempt,
+		Duration:    resp.Duration,
+		AttemptedAt: time.Now(),
+	}
+	if sendErr != nil {
+		record.Error = lo.ToPtr(sendErr.Error())
+	} else {
+		record.StatusCode = lo.ToPtr(resp.StatusCode)
+	}
+	if !delivered && sendErr == nil && resp.Body != "" {
+		record.Error = lo.ToPtr(resp.Body)
+	}
+
+	if err := s.webhookRepository.AddWebhookDeliveryAttempt(ctx, delivery.ID, record); err != nil {
+		return model.Webhook{}, err
+	}
+
+	update := delivery.WebhookDelivery
+	update.Attempts = attempt
+	if delivered {
+		update.Status = model.WebhookDeliveryDelivered
+		if err := s.webhookRepository.UpdateWebhookDelivery(ctx, update); err != nil {
+			return model.Webhook{}, err
+		}
+		return model.Webhook{}, s.webhookRepository.ResetWebhookFailures(ctx, delivery.WebhookUUID)
+	}
+
+	if attempt >= s.cfg.MaxAttempts {
+		update.Status = model.WebhookDeliveryFailed
+	} else {
+		update.NextAttemptAt = time.Now().Add(s.backoff(attempt))
+	}
+	if err := s.webhookRepository.UpdateWebhookDelivery(ctx, update); err != nil {
+		return model.Webhook{}, err
+	}
+
+	return s.webhookRepository.RecordWebhookFailure(ctx, delivery.WebhookUUID, s.cfg.DisableAfterFailures)
+}
+
+// backoff - экспоненциальная задержка RetryBackoff, 2*RetryBackoff, 4*RetryBackoff...
+// ограниченная MaxRetryBackoff
+func (s *service) backoff(attempt int) time.Duration {
+	if attempt > 30 {
+		return s.cfg.MaxRetryBackoff
+	}
+
+	delay := s.cfg.RetryBackoff << (attempt - 1)
+	if delay <= 0 || delay > s.cfg.MaxRetryBackoff {
+		return s.cfg.MaxRetryBackoff
+	}
+
+	return delay
+}
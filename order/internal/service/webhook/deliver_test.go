@@ -0,0 +1,196 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	httpClientMocks "github.com/Alexey-step/rocket-factory/order/internal/client/http/mocks"
+	webhookClient "github.com/Alexey-step/rocket-factory/order/internal/client/http/webhook"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	orderServiceMocks "github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
+)
+
+func TestDeliverPendingDelivered(t *testing.T) {
+	ctx := context.Background()
+	delivery := getMockedPendingDelivery(0)
+
+	var signature string
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get(webhookClient.HeaderSignature)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+	delivery.URL = receiver.URL
+
+	webhookRepository := mocks.NewWebhookRepository(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+	webhookService := NewService(webhookRepository, webhookClient.NewClient(time.Second, true), txManager, testConfig)
+
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	webhookRepository.On("ClaimPendingWebhookDeliveries", ctx, 10, mock.AnythingOfType("time.Time")).Return([]model.PendingWebhookDelivery{delivery}, nil).Once()
+	webhookRepository.On("AddWebhookDeliveryAttempt", ctx, delivery.ID, mock.MatchedBy(func(attempt model.WebhookDeliveryAttempt) bool {
+		return attempt.Attempt == 1 && attempt.StatusCode != nil && *attempt.StatusCode == http.StatusNoContent && attempt.Error == nil
+	})).Return(nil).Once()
+	webhookRepository.On("UpdateWebhookDelivery", ctx, mock.MatchedBy(func(update model.WebhookDelivery) bool {
+		return update.ID == delivery.ID && update.Status == model.WebhookDeliveryDelivered && update.Attempts == 1
+	})).Return(nil).Once()
+	webhookRepository.On("ResetWebhookFailures", ctx, delivery.WebhookUUID).Return(nil).Once()
+
+	processed, err := webhookService.DeliverPending(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.NotEmpty(t, signature)
+}
+
+func TestDeliverPendingSchedulesRetry(t *testing.T) {
+	ctx := context.Background()
+
+	logger.SetNopLogger()
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	// Две доставки одного вебхука: после неудачи первой вторая ждёт следующего прохода
+	first := getMockedPendingDelivery(1)
+	first.URL = receiver.URL
+	second := first
+	second.ID++
+	second.EventUUID = gofakeit.UUID()
+
+	webhookRepository := mocks.NewWebhookRepository(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+	webhookService := NewService(webhookRepository, webhookClient.NewClient(time.Second, true), txManager, testConfig)
+
+	started := time.Now()
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	webhookRepository.On("ClaimPendingWebhookDeliveries", ctx, 10, mock.MatchedBy(func(leaseUntil time.Time) bool {
+		lease := leaseUntil.Sub(started)
+		return lease >= testConfig.ClaimLease && lease < testConfig.ClaimLease+time.Second
+	})).Return([]model.PendingWebhookDelivery{first, second}, nil).Once()
+	webhookRepository.On("AddWebhookDeliveryAttempt", ctx, first.ID, mock.MatchedBy(func(attempt model.WebhookDeliveryAttempt) bool {
+		return attempt.Attempt == 2 && *attempt.StatusCode == http.StatusInternalServerError
+	})).Return(nil).Once()
+	webhookRepository.On("UpdateWebhookDelivery", ctx, mock.MatchedBy(func(update model.WebhookDelivery) bool {
+		// Вторая попытка неудачна - следующая через 2*RetryBackoff
+		delay := update.NextAttemptAt.Sub(started)
+		return update.ID == first.ID && update.Status == model.WebhookDeliveryPending && update.Attempts == 2 &&
+			delay >= 20*time.Second && delay < 21*time.Second
+	})).Return(nil).Once()
+	webhookRepository.On("RecordWebhookFailure", ctx, first.WebhookUUID, testConfig.DisableAfterFailures).
+		Return(model.Webhook{UUID: first.WebhookUUID, Enabled: true, ConsecutiveFailures: 1}, nil).Once()
+	// Вторая доставка не отправлялась и возвращается в очередь в прежнем состоянии
+	webhookRepository.On("UpdateWebhookDelivery", ctx, second.WebhookDelivery).Return(nil).Once()
+
+	processed, err := webhookService.DeliverPending(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, processed)
+}
+
+func TestDeliverPendingExhaustsAttempts(t *testing.T) {
+	ctx := context.Background()
+	delivery := getMockedPendingDelivery(testConfig.MaxAttempts - 1)
+	sendErr := gofakeit.Error()
+
+	logger.SetNopLogger()
+	client := httpClientMocks.NewWebhookClient(t)
+	webhookRepository := mocks.NewWebhookRepository(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+	webhookService := NewService(webhookRepository, client, txManager, testConfig)
+
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(runInTx).Once()
+	webhookRepository.On("ClaimPendingWebhookDeliveries", ctx, 10, mock.AnythingOfType("time.Time")).Return([]model.PendingWebhookDelivery{delivery}, nil).Once()
+	client.On("Send", ctx, model.WebhookMessage{
+		URL:       delivery.URL,
+		Secret:    delivery.Secret,
+		EventUUID: delivery.EventUUID,
+		EventType: delivery.EventType,
+		Payload:   delivery.Payload,
+	}).Return(model.WebhookResponse{Duration: time.Millisecond}, sendErr).Once()
+	webhookRepository.On("AddWebhookDeliveryAttempt", ctx, delivery.ID, mock.MatchedBy(func(attempt model.WebhookDeliveryAttempt) bool {
+		return attempt.StatusCode == nil && attempt.Error != nil && *attempt.Error == sendErr.Error()
+	})).Return(nil).Once()
+	webhookRepository.On("UpdateWebhookDelivery", ctx, mock.MatchedBy(func(update model.WebhookDelivery) bool {
+		return update.Status == model.WebhookDeliveryFailed && update.Attempts == testConfig.MaxAttempts
+	})).Return(nil).Once()
+	webhookRepository.On("RecordWebhookFailure", ctx, delivery.WebhookUUID, testConfig.DisableAfterFailures).
+		Return(model.Webhook{UUID: delivery.WebhookUUID, ConsecutiveFailures: testConfig.DisableAfterFailures}, nil).Once()
+
+	processed, err := webhookService.DeliverPending(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+}
+
+func TestDeliverPendingSendsOutsideTx(t *testing.T) {
+	ctx := context.Background()
+	delivery := getMockedPendingDelivery(0)
+
+	var inTx, sentInTx atomic.Bool
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		sentInTx.Store(inTx.Load())
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+	delivery.URL = receiver.URL
+
+	webhookRepository := mocks.NewWebhookRepository(t)
+	txManager := orderServiceMocks.NewTxManager(t)
+	webhookService := NewService(webhookRepository, webhookClient.NewClient(time.Second, true), txManager, testConfig)
+
+	txManager.On("ReadCommitted", ctx, mock.Anything).Return(func(ctx context.Context, fn txmanager.Handler) error {
+		inTx.Store(true)
+		defer inTx.Store(false)
+		return fn(ctx)
+	}).Once()
+	webhookRepository.On("ClaimPendingWebhookDeliveries", ctx, 10, mock.AnythingOfType("time.Time")).Return([]model.PendingWebhookDelivery{delivery}, nil).Once()
+	webhookRepository.On("AddWebhookDeliveryAttempt", ctx, delivery.ID, mock.AnythingOfType("model.WebhookDeliveryAttempt")).Return(nil).Once()
+	webhookRepository.On("UpdateWebhookDelivery", ctx, mock.AnythingOfType("model.WebhookDelivery")).Return(nil).Once()
+	webhookRepository.On("ResetWebhookFailures", ctx, delivery.WebhookUUID).Return(nil).Once()
+
+	processed, err := webhookService.DeliverPending(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.False(t, sentInTx.Load(), "webhook must be sent outside of a transaction")
+}
+
+func TestBackoff(t *testing.T) {
+	webhookService := NewService(nil, nil, nil, testConfig)
+
+	assert.Equal(t, 10*time.Second, webhookService.backoff(1))
+	assert.Equal(t, 40*time.Second, webhookService.backoff(3))
+	assert.Equal(t, time.Minute, webhookService.backoff(4))
+	assert.Equal(t, time.Minute, webhookService.backoff(100))
+}
+
+func getMockedPendingDelivery(attempts int) model.PendingWebhookDelivery {
+	return model.PendingWebhookDelivery{
+		WebhookDelivery: model.WebhookDelivery{
+			ID:            gofakeit.Int64(),
+			WebhookUUID:   gofakeit.UUID(),
+			EventUUID:     gofakeit.UUID(),
+			EventType:     model.WebhookEventOrderPaid,
+			Payload:       []byte(`{"event_type":"ORDER_PAID"}`),
+			Status:        model.WebhookDeliveryPending,
+			Attempts:      attempts,
+			NextAttemptAt: time.Now(),
+		},
+		URL:    "https://example.com/hooks",
+		Secret: "0123456789abcdef",
+	}
+}
+
+func runInTx(ctx context.Context, fn txmanager.Handler) error {
+	return fn(ctx)
+}
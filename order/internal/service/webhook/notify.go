@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+// payload - тело запроса к получателю. Статусы называются так же, как в REST API
+type payload struct {
+	EventUUID  string    `json:"event_uuid"`
+	EventType  string    `json:"event_type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	OrderUUID  string    `json:"order_uuid"`
+	FromStatus *string   `json:"from_status,omitempty"`
+	ToStatus   string    `json:"to_status"`
+}
+
+// NotifyStatusChange создаёт доставки события на все вебхуки владельца заказа, подписанные
+// на этот тип события. Вызывается в транзакции смены статуса, поэтому событие не теряется
+// и не уходит, если транзакция откатилась
+func (s *service) NotifyStatusChange(ctx context.Context, change model.OrderStatusChange) error {
+	eventType, ok := model.WebhookEventTypeForChange(change.FromStatus, change.ToStatus)
+	if !ok {
+		return nil
+	}
+
+	event := payload{
+		EventUUID:  uuid.NewString(),
+		EventType:  string(eventType),
+		OccurredAt: change.OccurredAt.UTC(),
+		OrderUUID:  change.OrderUUID,
+		ToStatus:   statusToPayload(change.ToStatus),
+	}
+	if change.FromStatus != nil {
+		fromStatus := statusToPayload(*change.FromStatus)
+		event.FromStatus = &fromStatus
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.webhookRepository.EnqueueWebhookDeliveries(ctx, change.UserUUID, model.WebhookDelivery{
+		EventUUID: event.EventUUID,
+		EventType: eventType,
+		Payload:   body,
+	})
+	return err
+}
+
+// statusToPayload - в API статус отмены называется CANCELLED, в модели - CANCELED
+func statusToPayload(status model.OrderStatus) string {
+	if status == model.OrderStatusCanceled {
+		return "CANCELLED"
+	}
+
+	return string(status)
+}
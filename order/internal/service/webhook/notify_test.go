@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	httpClientMocks "github.com/Alexey-step/rocket-factory/order/internal/client/http/mocks"
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+	"github.com/Alexey-step/rocket-factory/order/internal/repository/mocks"
+	orderServiceMocks "github.com/Alexey-step/rocket-factory/order/internal/service/mocks"
+)
+
+func TestNotifyStatusChangeEnqueuesDeliveries(t *testing.T) {
+	ctx := context.Background()
+	userUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+	occurredAt := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	webhookRepository := mocks.NewWebhookRepository(t)
+	webhookService := NewService(webhookRepository, httpClientMocks.NewWebhookClient(t), orderServiceMocks.NewTxManager(t), testConfig)
+
+	var enqueued model.WebhookDelivery
+	webhookRepository.On("EnqueueWebhookDeliveries", ctx, userUUID, mock.AnythingOfType("model.WebhookDelivery")).
+		Run(func(args mock.Arguments) {
+			enqueued = args.Get(2).(model.WebhookDelivery)
+		}).
+		Return(1, nil).Once()
+
+	err := webhookService.NotifyStatusChange(ctx, model.OrderStatusChange{
+		OrderUUID:  orderUUID,
+		UserUUID:   userUUID,
+		FromStatus: lo.ToPtr(model.OrderStatusPendingPayment),
+		ToStatus:   model.OrderStatusCanceled,
+		OccurredAt: occurredAt,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, model.WebhookEventOrderCancelled, enqueued.EventType)
+	assert.NotEmpty(t, enqueued.EventUUID)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(enqueued.Payload, &body))
+	assert.Equal(t, map[string]any{
+		"event_uuid":  enqueued.EventUUID,
+		"event_type":  "ORDER_CANCELLED",
+		"occurred_at": "2025-10-01T12:00:00Z",
+		"order_uuid":  orderUUID,
+		"from_status": "PENDING_PAYMENT",
+		"to_status":   "CANCELLED",
+	}, body)
+}
+
+func TestNotifyStatusChangeSkipsDraftCancel(t *testing.T) {
+	webhookService := NewService(mocks.NewWebhookRepository(t), httpClientMocks.NewWebhookClient(t), orderServiceMocks.NewTxManager(t), testConfig)
+
+	err := webhookService.NotifyStatusChange(context.Background(), model.OrderStatusChange{
+		OrderUUID:  gofakeit.UUID(),
+		UserUUID:   gofakeit.UUID(),
+		FromStatus: lo.ToPtr(model.OrderStatusDraft),
+		ToStatus:   model.OrderStatusCanceled,
+		OccurredAt: time.Now(),
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"time"
+
+	clientHTTP "github.com/Alexey-step/rocket-factory/order/internal/client/http"
+	def "github.com/Alexey-step/rocket-factory/order/internal/service"
+)
+
+var _ def.WebhookService = (*service)(nil)
+
+// Config - правила повторной доставки
+type Config struct {
+	MaxAttempts          int           // Сколько всего попыток на одну доставку
+	RetryBackoff         time.Duration // Задержка перед второй попыткой, удваивается с каждой следующей
+	MaxRetryBackoff      time.Duration // Верхняя граница задержки
+	DisableAfterFailures int           // Сколько неудачных попыток подряд отключают вебхук
+	ClaimLease           time.Duration // На сколько пачка доставок закрепляется за репликой, должно хватать на её отправку
+}
+
+type service struct {
+	webhookRepository def.WebhookRepository
+	webhookClient     clientHTTP.WebhookClient
+	txManager         def.TxManager
+	cfg               Config
+}
+
+func NewService(
+	webhookRepository def.WebhookRepository,
+	webhookClient clientHTTP.WebhookClient,
+	txManager def.TxManager,
+	cfg Config,
+) *service {
+	return &service{
+		webhookRepository: webhookRepository,
+		webhookClient:     webhookClient,
+		txManager:         txManager,
+		cfg:               cfg,
+	}
+}
@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/samber/lo"
+
+	"github.com/Alexey-step/rocket-factory/order/internal/model"
+)
+
+const (
+	maxURLLength    = 2048
+	minSecretLength = 16
+	maxSecretLength = 256
+)
+
+func (s *service) CreateWebhook(ctx context.Context, userUUID string, request model.WebhookRequest) (model.Webhook, error) {
+	if err := validateWebhook(request); err != nil {
+		return model.Webhook{}, err
+	}
+
+	return s.webhookRepository.CreateWebhook(ctx, model.Webhook{
+		UserUUID:   userUUID,
+		URL:        request.URL,
+		Secret:     request.Secret,
+		EventTypes: lo.Uniq(request.EventTypes),
+	})
+}
+
+func (s *service) ListWebhooks(ctx context.Context, userUUID string) ([]model.Webhook, error) {
+	return s.webhookRepository.ListWebhooks(ctx, userUUID)
+}
+
+func (s *service) DeleteWebhook(ctx context.Context, userUUID, webhookUUID string) error {
+	if _, err := s.getUserWebhook(ctx, userUUID, webhookUUID); err != nil {
+		return err
+	}
+
+	return s.webhookRepository.DeleteWebhook(ctx, webhookUUID)
+}
+
+// EnableWebhook включает вебхук, отключённый после ошибок доставки
+func (s *service) EnableWebhook(ctx context.Context, userUUID, webhookUUID string) (model.Webhook, error) {
+	if _, err := s.getUserWebhook(ctx, userUUID, webhookUUID); err != nil {
+		return model.Webhook{}, err
+	}
+
+	return s.webhookRepository.EnableWebhook(ctx, webhookUUID)
+}
+
+func (s *service) ListWebhookDeliveries(ctx context.Context, userUUID, webhookUUID string, limit int) ([]model.WebhookDelivery, error) {
+	if _, err := s.getUserWebhook(ctx, userUUID, webhookUUID); err != nil {
+		return nil, err
+	}
+
+	return s.webhookRepository.ListWebhookDeliveries(ctx, webhookUUID, limit)
+}
+
+// getUserWebhook возвращает вебхук пользователя. Чужой вебхук не отличается от
+// несуществующего, чтобы по UUID нельзя было узнать о вебхуках других пользователей
+func (s *service) getUserWebhook(ctx context.Context, userUUID, webhookUUID string) (model.Webhook, error) {
+	webhook, err := s.webhookRepository.GetWebhook(ctx, webhookUUID)
+	if err != nil {
+		return model.Webhook{}, err
+	}
+
+	if webhook.UserUUID != userUUID {
+		return model.Webhook{}, model.ErrWebhookNotFound
+	}
+
+	return webhook, nil
+}
+
+func validateWebhook(request model.WebhookRequest) error {
+	if len(request.URL) > maxURLLength {
+		return fmt.Errorf("%w: url is longer than %d", model.ErrWebhookInvalid, maxURLLength)
+	}
+
+	target, err := url.Parse(request.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http(s) url", model.ErrWebhookInvalid)
+	}
+
+	if target.User != nil {
+		return fmt.Errorf("%w: url must not contain credentials", model.ErrWebhookInvalid)
+	}
+
+	if len(request.Secret) < minSecretLength || len(request.Secret) > maxSecretLength {
+		return fmt.Errorf("%w: secret length must be between %d and %d", model.ErrWebhookInvalid, minSecretLength, maxSecretLength)
+	}
+
+	if len(request.EventTypes) == 0 {
+		return fmt.Errorf("%w: no event types", model.ErrWebhookInvalid)
+	}
+
+	for _, eventType := range request.EventTypes {
+		if !eventType.Valid() {
+			return fmt.Errorf("%w: unknown event type %q", model.ErrWebhookInvalid, eventType)
+		}
+	}
+
+	return nil
+}
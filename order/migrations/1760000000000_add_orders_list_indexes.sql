@@ -0,0 +1,9 @@
+-- +goose UP
+create index if not exists orders_user_uuid_created_at_id_idx on orders (user_uuid, created_at, id);
+create index if not exists orders_user_uuid_total_price_id_idx on orders (user_uuid, total_price, id);
+create index if not exists orders_user_uuid_status_idx on orders (user_uuid, status);
+
+-- +goose Down
+drop index if exists orders_user_uuid_status_idx;
+drop index if exists orders_user_uuid_total_price_id_idx;
+drop index if exists orders_user_uuid_created_at_id_idx;
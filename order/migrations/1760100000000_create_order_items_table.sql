@@ -0,0 +1,40 @@
+-- +goose UP
+create table if not exists order_items
+(
+    id bigint generated always as identity primary key,
+    order_uuid uuid not null references orders (uuid) on delete cascade,
+    part_uuid uuid not null,
+    name text not null default '',
+    category text not null default 'UNKNOWN',
+    quantity integer not null check (quantity > 0),
+    unit_price double precision not null,
+    unique (order_uuid, part_uuid)
+);
+
+create index if not exists order_items_order_uuid_idx on order_items (order_uuid);
+
+-- Переносим part_uuids в позиции: одинаковые детали схлопываются в количество,
+-- цена за единицу восстанавливается из итоговой стоимости заказа.
+-- Название и категория для старых заказов неизвестны - inventory хранится в другой БД.
+insert into order_items (order_uuid, part_uuid, quantity, unit_price)
+select o.uuid, p.part_uuid, count(*), o.total_price / cardinality(o.part_uuids)
+from orders o
+    cross join lateral unnest(o.part_uuids) as p(part_uuid)
+group by o.uuid, o.total_price, o.part_uuids, p.part_uuid;
+
+alter table orders drop column part_uuids;
+
+-- +goose Down
+alter table orders add column part_uuids uuid[] not null default '{}';
+
+update orders o
+set part_uuids = coalesce((
+    select array_agg(i.part_uuid order by i.id)
+    from order_items i
+        cross join lateral generate_series(1, i.quantity)
+    where i.order_uuid = o.uuid
+), '{}');
+
+alter table orders alter column part_uuids drop default;
+
+drop table if exists order_items;
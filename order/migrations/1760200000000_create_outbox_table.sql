@@ -0,0 +1,18 @@
+-- +goose UP
+create table if not exists outbox
+(
+    id              bigint generated always as identity primary key,
+    topic           text        not null,
+    key             text        not null,
+    payload         bytea       not null,
+    attempts        integer     not null default 0,
+    last_error      text,
+    created_at      timestamptz not null default now(),
+    next_attempt_at timestamptz not null default now(),
+    sent_at         timestamptz
+);
+
+create index if not exists outbox_pending_idx on outbox (next_attempt_at, id) where sent_at is null;
+
+-- +goose Down
+drop table if exists outbox;
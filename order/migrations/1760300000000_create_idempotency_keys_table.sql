@@ -0,0 +1,16 @@
+-- +goose UP
+create table if not exists idempotency_keys
+(
+    user_uuid    uuid        not null,
+    operation    text        not null,
+    key          text        not null,
+    request_hash text        not null,
+    response     jsonb,
+    created_at   timestamptz not null default now(),
+    locked_at    timestamptz not null default now(),
+    completed_at timestamptz,
+    primary key (user_uuid, operation, key)
+);
+
+-- +goose Down
+drop table if exists idempotency_keys;
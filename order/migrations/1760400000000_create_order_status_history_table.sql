@@ -0,0 +1,22 @@
+-- +goose UP
+create table if not exists order_status_history
+(
+    id          bigint generated always as identity primary key,
+    order_uuid  uuid        not null references orders (uuid) on delete cascade,
+    from_status text,
+    to_status   text        not null,
+    actor_type  text        not null,
+    actor_id    text        not null,
+    reason      text        not null,
+    created_at  timestamptz not null default now()
+);
+
+create index if not exists order_status_history_order_uuid_idx on order_status_history (order_uuid, id);
+
+-- Для уже существующих заказов известен только текущий статус
+insert into order_status_history (order_uuid, from_status, to_status, actor_type, actor_id, reason, created_at)
+select uuid, null, status, 'SYSTEM', 'migration', 'status before history tracking', coalesce(updated_at, created_at)
+from orders;
+
+-- +goose Down
+drop table if exists order_status_history;
@@ -0,0 +1,11 @@
+-- +goose UP
+alter table orders add column if not exists cancel_reason text;
+
+-- Заказы, ожидающие оплаты, отбираются по времени создания для автоматической отмены
+create index if not exists orders_pending_payment_created_at_idx on orders (created_at, id)
+    where status = 'PENDING_PAYMENT';
+
+-- +goose Down
+drop index if exists orders_pending_payment_created_at_idx;
+
+alter table orders drop column if exists cancel_reason;
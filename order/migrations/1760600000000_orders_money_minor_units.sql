@@ -0,0 +1,19 @@
+-- +goose UP
+-- Суммы храним в целых минимальных единицах валюты: float-арифметика копила ошибку в долях копейки.
+-- Все существующие заказы оформлены в рублях.
+alter table orders
+    alter column total_price type bigint using round(total_price * 100)::bigint,
+    add column if not exists currency text not null default 'RUB';
+
+alter table order_items
+    alter column unit_price type bigint using round(unit_price * 100)::bigint,
+    add column if not exists currency text not null default 'RUB';
+
+-- +goose Down
+alter table order_items
+    drop column if exists currency,
+    alter column unit_price type double precision using unit_price / 100.0;
+
+alter table orders
+    drop column if exists currency,
+    alter column total_price type double precision using total_price / 100.0;
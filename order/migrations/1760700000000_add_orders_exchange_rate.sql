@@ -0,0 +1,18 @@
+-- +goose UP
+-- Курс из базовой валюты каталога в валюту заказа фиксируется при оформлении.
+-- exchange_rate хранится целым числом миллионных долей (money.RateScale), как и суммы - без float.
+-- Существующие заказы оформлены в валюте каталога, курс для них единичный.
+alter table orders
+    add column if not exists base_currency text,
+    add column if not exists exchange_rate bigint not null default 1000000 check (exchange_rate > 0);
+
+update orders set base_currency = currency where base_currency is null;
+
+alter table orders
+    alter column base_currency set not null,
+    alter column exchange_rate drop default;
+
+-- +goose Down
+alter table orders
+    drop column if exists exchange_rate,
+    drop column if exists base_currency;
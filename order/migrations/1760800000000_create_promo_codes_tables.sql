@@ -0,0 +1,37 @@
+-- +goose UP
+-- Промокоды заводятся вручную. Суммы - в минимальных единицах базовой валюты каталога
+create table if not exists promo_codes
+(
+    code             text primary key check (code = upper(code)),
+    description      text        not null default '',
+    discount_type    text        not null check (discount_type in ('PERCENT', 'FIXED')),
+    percent          integer     not null default 0 check (percent between 0 and 100),
+    amount           bigint      not null default 0 check (amount >= 0),
+    currency         text        not null default 'RUB',
+    category         text,
+    manufacturer     text,
+    min_order_amount bigint check (min_order_amount > 0),
+    valid_from       timestamptz not null default now(),
+    valid_to         timestamptz check (valid_to > valid_from),
+    usage_limit      integer check (usage_limit > 0),
+    used_count       integer     not null default 0,
+    created_at       timestamptz not null default now(),
+    check ((discount_type = 'PERCENT' and percent > 0) or (discount_type = 'FIXED' and amount > 0))
+);
+
+create table if not exists order_discounts
+(
+    id          bigint generated always as identity primary key,
+    order_uuid  uuid   not null references orders (uuid) on delete cascade,
+    promo_code  text   not null references promo_codes (code),
+    description text   not null default '',
+    amount      bigint not null check (amount > 0),
+    currency    text   not null
+);
+
+create index if not exists order_discounts_order_uuid_idx on order_discounts (order_uuid);
+
+-- +goose Down
+drop table if exists order_discounts;
+
+drop table if exists promo_codes;
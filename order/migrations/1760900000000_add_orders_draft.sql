@@ -0,0 +1,8 @@
+-- +goose UP
+-- Черновик заказа (корзина) хранится как заказ в статусе DRAFT, у пользователя он один
+create unique index if not exists orders_user_draft_idx on orders (user_uuid) where status = 'DRAFT';
+
+-- +goose Down
+delete from orders where status = 'DRAFT';
+
+drop index if exists orders_user_draft_idx;
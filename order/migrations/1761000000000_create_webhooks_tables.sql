@@ -0,0 +1,53 @@
+-- +goose UP
+-- Секрет хранится открытым: он нужен для подписи каждого запроса
+create table if not exists webhooks
+(
+    uuid                 uuid primary key     default uuid_generate_v4(),
+    user_uuid            uuid        not null,
+    url                  text        not null,
+    secret               text        not null,
+    event_types          text[]      not null check (cardinality(event_types) > 0),
+    enabled              boolean     not null default true,
+    consecutive_failures integer     not null default 0,
+    disabled_at          timestamptz,
+    created_at           timestamptz not null default now()
+);
+
+create index if not exists webhooks_user_uuid_idx on webhooks (user_uuid);
+
+-- Доставки создаются в транзакции смены статуса, тело запроса фиксируется сразу
+create table if not exists webhook_deliveries
+(
+    id              bigint generated always as identity primary key,
+    webhook_uuid    uuid        not null references webhooks (uuid) on delete cascade,
+    event_uuid      uuid        not null,
+    event_type      text        not null,
+    payload         jsonb       not null,
+    status          text        not null default 'PENDING' check (status in ('PENDING', 'DELIVERED', 'FAILED')),
+    attempts        integer     not null default 0,
+    next_attempt_at timestamptz not null default now(),
+    created_at      timestamptz not null default now()
+);
+
+create index if not exists webhook_deliveries_pending_idx on webhook_deliveries (next_attempt_at, id) where status = 'PENDING';
+create index if not exists webhook_deliveries_webhook_uuid_idx on webhook_deliveries (webhook_uuid, id);
+
+create table if not exists webhook_delivery_attempts
+(
+    id           bigint generated always as identity primary key,
+    delivery_id  bigint      not null references webhook_deliveries (id) on delete cascade,
+    attempt      integer     not null,
+    status_code  integer,
+    error        text,
+    duration_ms  bigint      not null,
+    attempted_at timestamptz not null default now()
+);
+
+create index if not exists webhook_delivery_attempts_delivery_id_idx on webhook_delivery_attempts (delivery_id, attempt);
+
+-- +goose Down
+drop table if exists webhook_delivery_attempts;
+
+drop table if exists webhook_deliveries;
+
+drop table if exists webhooks;
@@ -0,0 +1,9 @@
+-- +goose NO TRANSACTION
+
+-- +goose UP
+-- Индекс для отчётов по заказам за период. Строится concurrently, чтобы не
+-- блокировать запись в orders на время построения
+create index concurrently if not exists orders_created_at_idx on orders (created_at) where status <> 'DRAFT';
+
+-- +goose Down
+drop index concurrently if exists orders_created_at_idx;
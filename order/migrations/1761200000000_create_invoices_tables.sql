@@ -0,0 +1,47 @@
+-- +goose Up
+-- Счётчик номеров счетов по годам. Строка года блокируется до конца транзакции
+-- выставления, поэтому номера идут без пропусков: откат транзакции откатывает и счётчик
+create table if not exists invoice_counters
+(
+    year        integer primary key,
+    last_number bigint not null
+);
+
+create table if not exists invoices
+(
+    uuid        uuid primary key     default uuid_generate_v4(),
+    order_uuid  uuid        not null unique,
+    user_uuid   uuid        not null,
+    number      text        not null unique,
+    year        integer     not null,
+    sequence    bigint      not null,
+    total       bigint      not null,
+    currency    text        not null,
+    issued_at   timestamptz not null,
+    html        bytea       not null,
+    pdf         bytea       not null,
+    unique (year, sequence)
+);
+
+-- Выставленный счёт - бухгалтерский документ, его нельзя исправить или удалить
+-- +goose StatementBegin
+create or replace function invoices_immutable() returns trigger as
+$$
+begin
+    raise exception 'invoices are immutable';
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+create trigger invoices_immutable
+    before update or delete
+    on invoices
+    for each row
+execute function invoices_immutable();
+
+-- +goose Down
+drop table if exists invoices;
+
+drop function if exists invoices_immutable();
+
+drop table if exists invoice_counters;
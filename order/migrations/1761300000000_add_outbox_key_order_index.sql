@@ -0,0 +1,7 @@
+-- +goose Up
+-- Relay не отправляет запись, пока перед ней есть неотправленная запись того же
+-- топика и ключа; индекс нужен этой проверке
+create index if not exists outbox_pending_key_idx on outbox (topic, key, id) where sent_at is null;
+
+-- +goose Down
+drop index if exists outbox_pending_key_idx;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- Ключ резерва в inventory. Черновик резервирует детали под новым ключом при каждой
+-- попытке оформления: снятый после неудачной попытки резерв не мешает следующей.
+-- Пустое значение - резерв создан под uuid заказа
+alter table orders add column if not exists stock_reservation_key text;
+
+-- +goose Down
+alter table orders drop column if exists stock_reservation_key;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Время заказа хранится с часовым поясом: иначе запись time.Now() в локальном поясе
+-- сервиса и сравнение с границами в UTC расходятся на смещение пояса.
+-- Сервисы работают в UTC, поэтому прежние значения считаются временем UTC
+alter table orders
+    alter column created_at type timestamptz using created_at at time zone 'UTC',
+    alter column created_at set default now(),
+    alter column updated_at type timestamptz using updated_at at time zone 'UTC';
+
+-- +goose Down
+alter table orders
+    alter column created_at type timestamp using created_at at time zone 'UTC',
+    alter column created_at set default current_timestamp,
+    alter column updated_at type timestamp using updated_at at time zone 'UTC';
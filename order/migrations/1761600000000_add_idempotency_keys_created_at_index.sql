@@ -0,0 +1,8 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+-- Индекс для удаления истёкших ключей идемпотентности
+create index concurrently if not exists idempotency_keys_created_at_idx on idempotency_keys (created_at);
+
+-- +goose Down
+drop index concurrently if exists idempotency_keys_created_at_idx;
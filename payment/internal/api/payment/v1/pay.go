@@ -12,7 +12,7 @@ import (
 )
 
 func (a *api) PayOrder(ctx context.Context, req *paymentV1.PayOrderRequest) (*paymentV1.PayOrderResponse, error) {
-	transactionUUID, err := a.service.PayOrder(ctx, req.GetOrderUuid(), req.GetOrderUuid(), req.GetPaymentMethod().String())
+	transactionUUID, err := a.service.PayOrder(ctx, req.GetOrderUuid(), req.GetUserUuid(), req.GetPaymentMethod().String(), req.GetIdempotencyKey())
 	if err != nil {
 		if errors.Is(err, model.ErrPaymentInternalError) {
 			return nil, status.Errorf(codes.Internal, "Payment service error: %v", err)
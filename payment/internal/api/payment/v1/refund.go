@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexey-step/rocket-factory/payment/internal/model"
+	paymentV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) RefundPayment(ctx context.Context, req *paymentV1.RefundPaymentRequest) (*paymentV1.RefundPaymentResponse, error) {
+	refundUUID, err := a.service.RefundPayment(ctx, req.GetOrderUuid(), req.GetUserUuid(), req.GetTransactionUuid())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPaymentInvalidTransaction):
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid transaction: %v", err)
+		case errors.Is(err, model.ErrPaymentInternalError):
+			return nil, status.Errorf(codes.Internal, "Payment service error: %v", err)
+		}
+		return nil, err
+	}
+
+	return &paymentV1.RefundPaymentResponse{
+		RefundUuid: refundUUID,
+	}, nil
+}
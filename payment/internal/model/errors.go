@@ -2,4 +2,7 @@ package model
 
 import "errors"
 
-var ErrPaymentInternalError = errors.New("internal error while processing payment")
+var (
+	ErrPaymentInternalError      = errors.New("internal error while processing payment")
+	ErrPaymentInvalidTransaction = errors.New("invalid payment transaction")
+)
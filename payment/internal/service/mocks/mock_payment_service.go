@@ -23,9 +23,9 @@ func (_m *PaymentService) EXPECT() *PaymentService_Expecter {
 	return &PaymentService_Expecter{mock: &_m.Mock}
 }
 
-// PayOrder provides a mock function with given fields: ctx, orderUUID, userUUID, paymentMethod
-func (_m *PaymentService) PayOrder(ctx context.Context, orderUUID string, userUUID string, paymentMethod string) (string, error) {
-	ret := _m.Called(ctx, orderUUID, userUUID, paymentMethod)
+// PayOrder provides a mock function with given fields: ctx, orderUUID, userUUID, paymentMethod, idempotencyKey
+func (_m *PaymentService) PayOrder(ctx context.Context, orderUUID string, userUUID string, paymentMethod string, idempotencyKey string) (string, error) {
+	ret := _m.Called(ctx, orderUUID, userUUID, paymentMethod, idempotencyKey)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
@@ -33,17 +33,17 @@ func (_m *PaymentService) PayOrder(ctx context.Context, orderUUID string, userUU
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
-		return rf(ctx, orderUUID, userUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (string, error)); ok {
+		return rf(ctx, orderUUID, userUUID, paymentMethod, idempotencyKey)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
-		r0 = rf(ctx, orderUUID, userUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) string); ok {
+		r0 = rf(ctx, orderUUID, userUUID, paymentMethod, idempotencyKey)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
-		r1 = rf(ctx, orderUUID, userUUID, paymentMethod)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, orderUUID, userUUID, paymentMethod, idempotencyKey)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,13 +61,14 @@ type PaymentService_PayOrder_Call struct {
 //   - orderUUID string
 //   - userUUID string
 //   - paymentMethod string
-func (_e *PaymentService_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, userUUID interface{}, paymentMethod interface{}) *PaymentService_PayOrder_Call {
-	return &PaymentService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, userUUID, paymentMethod)}
+//   - idempotencyKey string
+func (_e *PaymentService_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, userUUID interface{}, paymentMethod interface{}, idempotencyKey interface{}) *PaymentService_PayOrder_Call {
+	return &PaymentService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, userUUID, paymentMethod, idempotencyKey)}
 }
 
-func (_c *PaymentService_PayOrder_Call) Run(run func(ctx context.Context, orderUUID string, userUUID string, paymentMethod string)) *PaymentService_PayOrder_Call {
+func (_c *PaymentService_PayOrder_Call) Run(run func(ctx context.Context, orderUUID string, userUUID string, paymentMethod string, idempotencyKey string)) *PaymentService_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
 	})
 	return _c
 }
@@ -77,7 +78,66 @@ func (_c *PaymentService_PayOrder_Call) Return(transactionUUID string, err error
 	return _c
 }
 
-func (_c *PaymentService_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, string) (string, error)) *PaymentService_PayOrder_Call {
+func (_c *PaymentService_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, string, string) (string, error)) *PaymentService_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundPayment provides a mock function with given fields: ctx, orderUUID, userUUID, transactionUUID
+func (_m *PaymentService) RefundPayment(ctx context.Context, orderUUID string, userUUID string, transactionUUID string) (string, error) {
+	ret := _m.Called(ctx, orderUUID, userUUID, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+		return rf(ctx, orderUUID, userUUID, transactionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = rf(ctx, orderUUID, userUUID, transactionUUID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, orderUUID, userUUID, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type PaymentService_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - userUUID string
+//   - transactionUUID string
+func (_e *PaymentService_Expecter) RefundPayment(ctx interface{}, orderUUID interface{}, userUUID interface{}, transactionUUID interface{}) *PaymentService_RefundPayment_Call {
+	return &PaymentService_RefundPayment_Call{Call: _e.mock.On("RefundPayment", ctx, orderUUID, userUUID, transactionUUID)}
+}
+
+func (_c *PaymentService_RefundPayment_Call) Run(run func(ctx context.Context, orderUUID string, userUUID string, transactionUUID string)) *PaymentService_RefundPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentService_RefundPayment_Call) Return(refundUUID string, err error) *PaymentService_RefundPayment_Call {
+	_c.Call.Return(refundUUID, err)
+	return _c
+}
+
+func (_c *PaymentService_RefundPayment_Call) RunAndReturn(run func(context.Context, string, string, string) (string, error)) *PaymentService_RefundPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -7,7 +7,10 @@ import (
 	"github.com/google/uuid"
 )
 
-func (s *service) PayOrder(ctx context.Context, orderUUID, userUUID, paymentMethod string) (transactionUUID string, err error) {
+// transactionNamespace - пространство имён для UUID транзакций, выведенных из ключа идемпотентности
+var transactionNamespace = uuid.MustParse("6f1c2a7e-3b5d-4e8f-9a1b-2c3d4e5f6a7b")
+
+func (s *service) PayOrder(ctx context.Context, orderUUID, userUUID, paymentMethod, idempotencyKey string) (transactionUUID string, err error) {
 	log.Printf(`
 💳 [Order Paid]
 • 🆔 Order UUID: %s
@@ -16,9 +19,19 @@ func (s *service) PayOrder(ctx context.Context, orderUUID, userUUID, paymentMeth
 `, orderUUID, userUUID, paymentMethod,
 	)
 
-	res := uuid.New().String()
+	res := newTransactionUUID(orderUUID, idempotencyKey)
 
-	log.Printf("✅Оплата прошла успешно, transaction_uuid: %v\n", transactionUUID)
+	log.Printf("✅Оплата прошла успешно, transaction_uuid: %v\n", res)
 
 	return res, nil
 }
+
+// newTransactionUUID выдаёт UUID транзакции. Для одного заказа и ключа идемпотентности
+// он всегда один и тот же: повтор запроса возвращает прежнюю транзакцию, а не списывает деньги ещё раз
+func newTransactionUUID(orderUUID, idempotencyKey string) string {
+	if idempotencyKey == "" {
+		return uuid.New().String()
+	}
+
+	return uuid.NewSHA1(transactionNamespace, []byte(orderUUID+":"+idempotencyKey)).String()
+}
@@ -19,10 +19,32 @@ func TestPayOrder(t *testing.T) {
 
 	paymentService := NewService()
 
-	transactionUUID, err := paymentService.PayOrder(ctx, orderUUID, userUUID, paymentMethod)
+	transactionUUID, err := paymentService.PayOrder(ctx, orderUUID, userUUID, paymentMethod, "")
 	assert.NoError(t, err)
 	assert.NotEmpty(t, transactionUUID)
 	parsed, err := uuid.Parse(transactionUUID)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, parsed)
 }
+
+func TestPayOrderSameIdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+	var (
+		orderUUID      = gofakeit.UUID()
+		userUUID       = gofakeit.UUID()
+		idempotencyKey = gofakeit.UUID()
+	)
+
+	paymentService := NewService()
+
+	first, err := paymentService.PayOrder(ctx, orderUUID, userUUID, "CARD", idempotencyKey)
+	assert.NoError(t, err)
+
+	retry, err := paymentService.PayOrder(ctx, orderUUID, userUUID, "CARD", idempotencyKey)
+	assert.NoError(t, err)
+	assert.Equal(t, first, retry)
+
+	other, err := paymentService.PayOrder(ctx, orderUUID, userUUID, "CARD", gofakeit.UUID())
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, other)
+}
@@ -0,0 +1,35 @@
+package payment
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+
+	"github.com/Alexey-step/rocket-factory/payment/internal/model"
+)
+
+// refundNamespace - пространство имён для UUID возвратов, выведенных из UUID транзакции
+var refundNamespace = uuid.MustParse("0b7d4c2e-8a19-4f3b-b6e5-1d2c3b4a5f60")
+
+// RefundPayment возвращает оплату транзакции. У транзакции один возврат: повторный
+// вызов возвращает прежний refund_uuid, а не возвращает деньги ещё раз
+func (s *service) RefundPayment(ctx context.Context, orderUUID, userUUID, transactionUUID string) (refundUUID string, err error) {
+	if _, err = uuid.Parse(transactionUUID); err != nil {
+		return "", model.ErrPaymentInvalidTransaction
+	}
+
+	log.Printf(`
+💸 [Payment Refunded]
+• 🆔 Order UUID: %s
+• 👤 User UUID: %s
+• 💳 Transaction UUID: %s
+`, orderUUID, userUUID, transactionUUID,
+	)
+
+	res := uuid.NewSHA1(refundNamespace, []byte(transactionUUID)).String()
+
+	log.Printf("✅Возврат оплаты выполнен, refund_uuid: %v\n", res)
+
+	return res, nil
+}
@@ -0,0 +1,56 @@
+package payment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Alexey-step/rocket-factory/payment/internal/model"
+)
+
+func TestRefundPayment(t *testing.T) {
+	ctx := context.Background()
+	var (
+		orderUUID       = gofakeit.UUID()
+		userUUID        = gofakeit.UUID()
+		transactionUUID = gofakeit.UUID()
+	)
+
+	paymentService := NewService()
+
+	refundUUID, err := paymentService.RefundPayment(ctx, orderUUID, userUUID, transactionUUID)
+	assert.NoError(t, err)
+	parsed, err := uuid.Parse(refundUUID)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, parsed)
+}
+
+func TestRefundPaymentRepeated(t *testing.T) {
+	ctx := context.Background()
+	transactionUUID := gofakeit.UUID()
+
+	paymentService := NewService()
+
+	first, err := paymentService.RefundPayment(ctx, gofakeit.UUID(), gofakeit.UUID(), transactionUUID)
+	assert.NoError(t, err)
+	second, err := paymentService.RefundPayment(ctx, gofakeit.UUID(), gofakeit.UUID(), transactionUUID)
+	assert.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	other, err := paymentService.RefundPayment(ctx, gofakeit.UUID(), gofakeit.UUID(), gofakeit.UUID())
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, other)
+}
+
+func TestRefundPaymentInvalidTransaction(t *testing.T) {
+	ctx := context.Background()
+
+	paymentService := NewService()
+
+	refundUUID, err := paymentService.RefundPayment(ctx, gofakeit.UUID(), gofakeit.UUID(), "")
+	assert.ErrorIs(t, err, model.ErrPaymentInvalidTransaction)
+	assert.Empty(t, refundUUID)
+}
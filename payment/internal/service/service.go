@@ -3,5 +3,6 @@ package service
 import "context"
 
 type PaymentService interface {
-	PayOrder(ctx context.Context, orderUUID, userUUID, paymentMethod string) (transactionUUID string, err error)
+	PayOrder(ctx context.Context, orderUUID, userUUID, paymentMethod, idempotencyKey string) (transactionUUID string, err error)
+	RefundPayment(ctx context.Context, orderUUID, userUUID, transactionUUID string) (refundUUID string, err error)
 }
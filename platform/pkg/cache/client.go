@@ -16,6 +16,8 @@ type RedisClient interface {
 	Expire(ctx context.Context, key string, expiration time.Duration) error
 	Ping(ctx context.Context) error
 	SetOperator
+	ScriptRunner
+	PubSub
 }
 
 type SetOperator interface {
@@ -24,3 +26,16 @@ type SetOperator interface {
 	SIsMember(ctx context.Context, key, value string) (bool, error)
 	SMembers(ctx context.Context, key string) ([]string, error)
 }
+
+// ScriptRunner выполняет Lua-скрипты атомарно на стороне Redis
+type ScriptRunner interface {
+	Eval(ctx context.Context, script string, keys []string, args ...any) (any, error)
+}
+
+// PubSub - рассылка сообщений через каналы Redis всем подписчикам, в том числе в других процессах
+type PubSub interface {
+	Publish(ctx context.Context, channel string, message []byte) error
+	// Subscribe возвращает сообщения канала. Канал закрывается при отмене ctx или потере
+	// соединения с Redis; сообщения, пришедшие до переподписки, теряются
+	Subscribe(ctx context.Context, channel string) (<-chan []byte, error)
+}
@@ -0,0 +1,58 @@
+package redis
+
+import (
+	"context"
+
+	redigo "github.com/gomodule/redigo/redis"
+	"go.uber.org/zap"
+)
+
+func (c *client) Publish(ctx context.Context, channel string, message []byte) error {
+	return c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
+		_, err := redigo.DoContext(conn, ctx, "PUBLISH", channel, message)
+		return err
+	})
+}
+
+// Subscribe держит отдельное соединение из пула, пока подписка активна.
+// Соединение возвращается в пул, когда канал сообщений закрывается
+func (c *client) Subscribe(ctx context.Context, channel string) (<-chan []byte, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	psc := redigo.PubSubConn{Conn: conn}
+	if err = psc.Subscribe(channel); err != nil {
+		_ = psc.Close()
+		return nil, err
+	}
+
+	messages := make(chan []byte)
+	go func() {
+		defer close(messages)
+		defer func() {
+			if cerr := psc.Close(); cerr != nil {
+				c.logger.Error(ctx, "failed to close redis subscription", zap.Error(cerr))
+			}
+		}()
+
+		for {
+			switch msg := psc.ReceiveContext(ctx).(type) {
+			case redigo.Message:
+				select {
+				case messages <- msg.Data:
+				case <-ctx.Done():
+					return
+				}
+			case error:
+				if ctx.Err() == nil {
+					c.logger.Error(ctx, "redis subscription failed", zap.String("channel", channel), zap.Error(msg))
+				}
+				return
+			}
+		}
+	}()
+
+	return messages, nil
+}
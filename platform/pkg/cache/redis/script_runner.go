@@ -0,0 +1,25 @@
+package redis
+
+import (
+	"context"
+
+	redigo "github.com/gomodule/redigo/redis"
+)
+
+// Eval выполняет скрипт через EVALSHA, при отсутствии скрипта в кэше Redis отправляет его целиком
+func (c *client) Eval(ctx context.Context, script string, keys []string, args ...any) (any, error) {
+	var result any
+	err := c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
+		keysAndArgs := redigo.Args{}.AddFlat(keys).Add(args...)
+
+		reply, err := redigo.NewScript(len(keys), script).DoContext(ctx, conn, keysAndArgs...)
+		if err != nil {
+			return err
+		}
+
+		result = reply
+		return nil
+	})
+
+	return result, err
+}
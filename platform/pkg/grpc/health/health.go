@@ -4,29 +4,71 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 )
 
+// Checker - зависимость, состояние которой можно запросить по её имени в HealthCheckRequest.Service
+type Checker interface {
+	Name() string
+	Healthy() bool
+}
+
 // Server implements the gRPC Health Checking Protocol (GRPC Health v1)
 type Server struct {
 	grpc_health_v1.UnimplementedHealthServer
+
+	checkers map[string]Checker
 }
 
-// Check implements the standard grpc health check protocol
+// Check implements the standard grpc health check protocol.
+// Пустое имя сервиса - состояние самого сервиса, имя зависимости - её состояние
 func (s *Server) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	servingStatus, err := s.servingStatus(req.GetService())
+	if err != nil {
+		return nil, err
+	}
+
 	return &grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
+		Status: servingStatus,
 	}, nil
 }
 
 // Watch implements the standard grpc health check protocol
 func (s *Server) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
+	servingStatus, err := s.servingStatus(req.GetService())
+	if err != nil {
+		servingStatus = grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+	}
+
 	return stream.Send(&grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
+		Status: servingStatus,
 	})
 }
 
+func (s *Server) servingStatus(service string) (grpc_health_v1.HealthCheckResponse_ServingStatus, error) {
+	if service == "" {
+		return grpc_health_v1.HealthCheckResponse_SERVING, nil
+	}
+
+	checker, ok := s.checkers[service]
+	if !ok {
+		return grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN, status.Errorf(codes.NotFound, "unknown service %q", service)
+	}
+	if !checker.Healthy() {
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING, nil
+	}
+
+	return grpc_health_v1.HealthCheckResponse_SERVING, nil
+}
+
 // RegisterService registers the health service with the gRPC server
-func RegisterService(s *grpc.Server) {
-	grpc_health_v1.RegisterHealthServer(s, &Server{})
+func RegisterService(s *grpc.Server, checkers ...Checker) {
+	server := &Server{checkers: make(map[string]Checker, len(checkers))}
+	for _, checker := range checkers {
+		server.checkers[checker.Name()] = checker
+	}
+
+	grpc_health_v1.RegisterHealthServer(s, server)
 }
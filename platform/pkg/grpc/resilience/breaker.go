@@ -0,0 +1,138 @@
+package resilience
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// State - состояние circuit breaker
+type State int
+
+const (
+	StateClosed   State = iota // Вызовы проходят, отказы подряд считаются
+	StateOpen                  // Вызовы отклоняются без обращения к сервису
+	StateHalfOpen              // Пропускается один пробный вызов
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// breaker - circuit breaker по числу отказов подряд
+type breaker struct {
+	name             string
+	failureThreshold int
+	openTimeout      time.Duration
+	logger           Logger
+	now              func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newBreaker(name string, failureThreshold int, openTimeout time.Duration, logger Logger) *breaker {
+	return &breaker{
+		name:             name,
+		failureThreshold: failureThreshold,
+		openTimeout:      openTimeout,
+		logger:           logger,
+		now:              time.Now,
+	}
+}
+
+// allow решает, можно ли сделать вызов. В half-open пропускается только один пробный вызов,
+// остальные отклоняются, пока он не завершится
+func (b *breaker) allow(ctx context.Context) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		if b.now().Sub(b.openedAt) < b.openTimeout {
+			return false
+		}
+		b.setState(ctx, StateHalfOpen)
+		b.probing = true
+		return true
+	case StateHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// success - сервис ответил, пусть и бизнес-ошибкой
+func (b *breaker) success(ctx context.Context) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	b.probing = false
+	if b.state != StateClosed {
+		b.setState(ctx, StateClosed)
+	}
+}
+
+// failure - сервис недоступен или не ответил вовремя
+func (b *breaker) failure(ctx context.Context) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+	if b.state == StateHalfOpen || (b.state == StateClosed && b.failures >= b.failureThreshold) {
+		b.openedAt = b.now()
+		b.setState(ctx, StateOpen)
+	}
+}
+
+// abandon - вызов отменил сам клиент, о здоровье сервиса он ничего не говорит
+func (b *breaker) abandon() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+func (b *breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state
+}
+
+func (b *breaker) setState(ctx context.Context, state State) {
+	from := b.state
+	b.state = state
+
+	fields := []zap.Field{
+		zap.String("downstream", b.name),
+		zap.String("from", from.String()),
+		zap.String("to", state.String()),
+		zap.Int("failures", b.failures),
+	}
+	if state == StateOpen {
+		b.logger.Error(ctx, "circuit breaker opened", fields...)
+		return
+	}
+	b.logger.Info(ctx, "circuit breaker state changed", fields...)
+}
@@ -0,0 +1,151 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Client - таймауты, повторы и circuit breaker для соединения с одним смежным сервисом
+type Client struct {
+	cfg     Config
+	breaker *breaker
+	logger  Logger
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+func NewClient(cfg Config, logger Logger) *Client {
+	cfg = cfg.withDefaults()
+
+	return &Client{
+		cfg:     cfg,
+		breaker: newBreaker(cfg.Name, cfg.BreakerFailureThreshold, cfg.BreakerOpenTimeout, logger),
+		logger:  logger,
+		sleep:   sleepContext,
+	}
+}
+
+// Name - имя смежного сервиса
+func (c *Client) Name() string {
+	return c.cfg.Name
+}
+
+// State - текущее состояние circuit breaker
+func (c *Client) State() State {
+	return c.breaker.State()
+}
+
+// Healthy - смежный сервис считается доступным, пока breaker не открыт
+func (c *Client) Healthy() bool {
+	return c.breaker.State() != StateOpen
+}
+
+// UnaryInterceptor возвращает перехватчик для grpc.WithChainUnaryInterceptor
+func (c *Client) UnaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		attempts := 1
+		if c.cfg.retryable(method) {
+			attempts = c.cfg.MaxAttempts
+		}
+
+		var err error
+		for attempt := 1; attempt <= attempts; attempt++ {
+			if attempt > 1 {
+				if sleepErr := c.sleep(ctx, c.backoff(attempt-1)); sleepErr != nil {
+					return err
+				}
+				c.logger.Info(ctx, "retrying grpc call",
+					zap.String("downstream", c.cfg.Name),
+					zap.String("method", method),
+					zap.Int("attempt", attempt),
+					zap.Error(err),
+				)
+			}
+
+			err = c.invoke(ctx, method, req, reply, cc, invoker, opts...)
+			if !c.shouldRetry(ctx, err) {
+				return err
+			}
+		}
+
+		return err
+	}
+}
+
+func (c *Client) invoke(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if !c.breaker.allow(ctx) {
+		return status.Errorf(codes.Unavailable, "%s: circuit breaker is open", c.cfg.Name)
+	}
+
+	attemptCtx, cancel := context.WithTimeout(ctx, c.cfg.timeout(method))
+	defer cancel()
+
+	err := invoker(attemptCtx, method, req, reply, cc, opts...)
+	switch {
+	case ctx.Err() != nil:
+		// Запрос отменил или не дождался вызывающий код, сервис тут ни при чём
+		c.breaker.abandon()
+	case isDownstreamFailure(err):
+		c.breaker.failure(ctx)
+	default:
+		c.breaker.success(ctx)
+	}
+
+	return err
+}
+
+// shouldRetry - повторяем только отказы самого сервиса, пока жив контекст вызывающего и breaker не открылся
+func (c *Client) shouldRetry(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil || c.breaker.State() == StateOpen {
+		return false
+	}
+
+	code := status.Code(err)
+	return code == codes.Unavailable || code == codes.DeadlineExceeded
+}
+
+// backoff - экспоненциальная задержка с полным джиттером: случайное значение от 0 до base*2^(retry-1)
+func (c *Client) backoff(retry int) time.Duration {
+	limit := c.cfg.RetryBackoff << (retry - 1)
+	if limit <= 0 || limit > c.cfg.MaxRetryBackoff {
+		limit = c.cfg.MaxRetryBackoff
+	}
+
+	return rand.N(limit) + 1 //nolint:gosec
+}
+
+// isDownstreamFailure отличает недоступность сервиса от бизнес-ошибок (NotFound, FailedPrecondition и т.п.),
+// которые breaker не должны открывать
+func isDownstreamFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
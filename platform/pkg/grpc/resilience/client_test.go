@@ -0,0 +1,144 @@
+package resilience
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	listPartsMethod    = "/inventory.v1.InventoryService/ListParts"
+	reserveStockMethod = "/inventory.v1.InventoryService/ReserveStock"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Info(context.Context, string, ...zap.Field)  {}
+func (nopLogger) Error(context.Context, string, ...zap.Field) {}
+
+func newTestClient(cfg Config) *Client {
+	c := NewClient(cfg, nopLogger{})
+	c.sleep = func(context.Context, time.Duration) error { return nil }
+	return c
+}
+
+// invokerReturning отдаёт ошибки по очереди и запоминает дедлайны попыток
+func invokerReturning(calls *int, deadlines *[]time.Duration, errs ...error) grpc.UnaryInvoker {
+	return func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		if deadline, ok := ctx.Deadline(); ok && deadlines != nil {
+			*deadlines = append(*deadlines, time.Until(deadline))
+		}
+		err := errs[min(*calls, len(errs)-1)]
+		*calls++
+		return err
+	}
+}
+
+func TestRetryIdempotentMethod(t *testing.T) {
+	c := newTestClient(Config{Name: "inventory", RetryMethods: []string{"ListParts"}})
+
+	calls := 0
+	err := c.UnaryInterceptor()(context.Background(), listPartsMethod, nil, nil, nil,
+		invokerReturning(&calls, nil, status.Error(codes.Unavailable, "down"), status.Error(codes.Unavailable, "down"), nil))
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, StateClosed, c.State())
+}
+
+func TestNoRetryForNonIdempotentMethod(t *testing.T) {
+	c := newTestClient(Config{Name: "inventory", RetryMethods: []string{"ListParts"}})
+
+	calls := 0
+	err := c.UnaryInterceptor()(context.Background(), reserveStockMethod, nil, nil, nil,
+		invokerReturning(&calls, nil, status.Error(codes.Unavailable, "down"), nil))
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 1, calls)
+}
+
+func TestNoRetryOnBusinessError(t *testing.T) {
+	c := newTestClient(Config{Name: "inventory", RetryMethods: []string{"ListParts"}, BreakerFailureThreshold: 1})
+
+	calls := 0
+	err := c.UnaryInterceptor()(context.Background(), listPartsMethod, nil, nil, nil,
+		invokerReturning(&calls, nil, status.Error(codes.NotFound, "part not found")))
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, StateClosed, c.State())
+}
+
+func TestMethodTimeout(t *testing.T) {
+	c := newTestClient(Config{
+		Name:           "inventory",
+		Timeout:        5 * time.Second,
+		MethodTimeouts: map[string]time.Duration{"ReserveStock": 500 * time.Millisecond},
+	})
+
+	calls := 0
+	var deadlines []time.Duration
+	interceptor := c.UnaryInterceptor()
+	require.NoError(t, interceptor(context.Background(), reserveStockMethod, nil, nil, nil, invokerReturning(&calls, &deadlines, nil)))
+	require.NoError(t, interceptor(context.Background(), listPartsMethod, nil, nil, nil, invokerReturning(&calls, &deadlines, nil)))
+
+	require.Len(t, deadlines, 2)
+	assert.LessOrEqual(t, deadlines[0], 500*time.Millisecond)
+	assert.Greater(t, deadlines[1], time.Second)
+}
+
+func TestBreakerOpensAndRecovers(t *testing.T) {
+	c := newTestClient(Config{Name: "payment", BreakerFailureThreshold: 2, BreakerOpenTimeout: time.Minute})
+	now := time.Now()
+	c.breaker.now = func() time.Time { return now }
+	interceptor := c.UnaryInterceptor()
+	const method = "/payment.v1.PaymentService/PayOrder"
+
+	calls := 0
+	failing := invokerReturning(&calls, nil, status.Error(codes.Unavailable, "down"))
+	for range 2 {
+		assert.Equal(t, codes.Unavailable, status.Code(interceptor(context.Background(), method, nil, nil, nil, failing)))
+	}
+	assert.Equal(t, StateOpen, c.State())
+	assert.False(t, c.Healthy())
+
+	// Пока breaker открыт, сервис не вызывается
+	err := interceptor(context.Background(), method, nil, nil, nil, failing)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Contains(t, err.Error(), "circuit breaker is open")
+	assert.Equal(t, 2, calls)
+
+	// По истечении OpenTimeout пробный вызов закрывает breaker
+	now = now.Add(time.Minute)
+	succeeded := 0
+	require.NoError(t, interceptor(context.Background(), method, nil, nil, nil, invokerReturning(&succeeded, nil, nil)))
+	assert.Equal(t, 1, succeeded)
+	assert.Equal(t, StateClosed, c.State())
+	assert.True(t, c.Healthy())
+}
+
+func TestBreakerReopensOnFailedProbe(t *testing.T) {
+	c := newTestClient(Config{Name: "payment", BreakerFailureThreshold: 1, BreakerOpenTimeout: time.Minute})
+	now := time.Now()
+	c.breaker.now = func() time.Time { return now }
+	interceptor := c.UnaryInterceptor()
+	const method = "/payment.v1.PaymentService/PayOrder"
+
+	calls := 0
+	failing := invokerReturning(&calls, nil, status.Error(codes.DeadlineExceeded, "slow"))
+	_ = interceptor(context.Background(), method, nil, nil, nil, failing)
+	require.Equal(t, StateOpen, c.State())
+
+	now = now.Add(time.Minute)
+	_ = interceptor(context.Background(), method, nil, nil, nil, failing)
+
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, StateOpen, c.State())
+}
@@ -0,0 +1,75 @@
+// Package resilience защищает вызовы смежных сервисов на стороне gRPC-клиента.
+//
+// Client подключается к соединению через grpc.WithChainUnaryInterceptor и даёт:
+//   - таймаут на каждую попытку вызова, общий или для конкретного метода;
+//   - ограниченные повторы с экспоненциальной задержкой и джиттером - только для
+//     идемпотентных методов и только на Unavailable/DeadlineExceeded;
+//   - circuit breaker на весь смежный сервис: после серии отказов вызовы сразу
+//     завершаются с Unavailable, пока сервис не ответит на пробный запрос.
+package resilience
+
+import (
+	"context"
+	"path"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type Logger interface {
+	Info(ctx context.Context, msg string, fields ...zap.Field)
+	Error(ctx context.Context, msg string, fields ...zap.Field)
+}
+
+// Config - настройки клиента одного смежного сервиса. Методы в MethodTimeouts и RetryMethods
+// задаются коротким именем (ListParts) или полным (/inventory.v1.InventoryService/ListParts)
+type Config struct {
+	Name                    string                   // Имя смежного сервиса для логов и health
+	Timeout                 time.Duration            // Таймаут одной попытки по умолчанию
+	MethodTimeouts          map[string]time.Duration // Таймауты отдельных методов
+	RetryMethods            []string                 // Идемпотентные методы, которые можно повторять
+	MaxAttempts             int                      // Сколько всего попыток делать для RetryMethods
+	RetryBackoff            time.Duration            // Базовая задержка перед повтором, удваивается с каждой попыткой
+	MaxRetryBackoff         time.Duration            // Верхняя граница задержки перед повтором
+	BreakerFailureThreshold int                      // Сколько отказов подряд открывает breaker
+	BreakerOpenTimeout      time.Duration            // Сколько breaker остаётся открытым до пробного запроса
+}
+
+func (cfg Config) withDefaults() Config {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 2 * time.Second
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 3
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = 100 * time.Millisecond
+	}
+	if cfg.MaxRetryBackoff <= 0 {
+		cfg.MaxRetryBackoff = time.Second
+	}
+	if cfg.BreakerFailureThreshold <= 0 {
+		cfg.BreakerFailureThreshold = 5
+	}
+	if cfg.BreakerOpenTimeout <= 0 {
+		cfg.BreakerOpenTimeout = 10 * time.Second
+	}
+
+	return cfg
+}
+
+func (cfg Config) timeout(fullMethod string) time.Duration {
+	if timeout, ok := cfg.MethodTimeouts[fullMethod]; ok {
+		return timeout
+	}
+	if timeout, ok := cfg.MethodTimeouts[path.Base(fullMethod)]; ok {
+		return timeout
+	}
+
+	return cfg.Timeout
+}
+
+func (cfg Config) retryable(fullMethod string) bool {
+	return slices.Contains(cfg.RetryMethods, fullMethod) || slices.Contains(cfg.RetryMethods, path.Base(fullMethod))
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 
 	"google.golang.org/grpc"
@@ -16,6 +17,9 @@ import (
 const (
 	// SessionUUIDMetadataKey ключ для передачи UUID сессии в gRPC metadata
 	SessionUUIDMetadataKey = "session-uuid"
+	// ServiceTokenMetadataKey ключ для передачи токена сервиса в gRPC metadata.
+	// Им авторизуются фоновые вызовы между сервисами, у которых нет сессии пользователя
+	ServiceTokenMetadataKey = "service-token"
 )
 
 type contextKey string
@@ -35,14 +39,40 @@ type IAMClient interface {
 
 // AuthInterceptor interceptor для аутентификации gRPC запросов
 type AuthInterceptor struct {
-	iamClient IAMClient
+	iamClient      IAMClient
+	serviceToken   string
+	serviceMethods map[string]struct{}
+}
+
+// AuthOption настраивает AuthInterceptor
+type AuthOption func(*AuthInterceptor)
+
+// WithServiceToken разрешает вызывать перечисленные методы с токеном сервиса вместо сессии.
+// Пустой токен ничего не разрешает
+func WithServiceToken(token string, fullMethods ...string) AuthOption {
+	return func(i *AuthInterceptor) {
+		if token == "" {
+			return
+		}
+
+		i.serviceToken = token
+		for _, method := range fullMethods {
+			i.serviceMethods[method] = struct{}{}
+		}
+	}
 }
 
 // NewAuthInterceptor создает новый interceptor аутентификации
-func NewAuthInterceptor(iamClient IAMClient) *AuthInterceptor {
-	return &AuthInterceptor{
-		iamClient: iamClient,
+func NewAuthInterceptor(iamClient IAMClient, opts ...AuthOption) *AuthInterceptor {
+	i := &AuthInterceptor{
+		iamClient:      iamClient,
+		serviceMethods: make(map[string]struct{}),
 	}
+	for _, opt := range opts {
+		opt(i)
+	}
+
+	return i
 }
 
 // Unary возвращает unary server interceptor для аутентификации
@@ -53,6 +83,10 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (any, error) {
+		if i.isServiceCall(ctx, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
 		authCtx, err := i.authenticate(ctx)
 		if err != nil {
 			return nil, err
@@ -95,6 +129,25 @@ func (i *AuthInterceptor) authenticate(ctx context.Context) (context.Context, er
 	return authCtx, nil
 }
 
+// isServiceCall проверяет, что метод разрешён сервисам и запрос пришёл с верным токеном сервиса
+func (i *AuthInterceptor) isServiceCall(ctx context.Context, fullMethod string) bool {
+	if _, ok := i.serviceMethods[fullMethod]; !ok {
+		return false
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	tokens := md.Get(ServiceTokenMetadataKey)
+	if len(tokens) == 0 {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(i.serviceToken)) == 1
+}
+
 // GetUserFromContext извлекает пользователя из контекста
 func GetUserFromContext(ctx context.Context) (*commonV1.User, bool) {
 	user, ok := ctx.Value(userContextKey).(*commonV1.User)
@@ -126,3 +179,12 @@ func ForwardSessionUUIDToGRPC(ctx context.Context) context.Context {
 
 	return metadata.AppendToOutgoingContext(ctx, SessionUUIDMetadataKey, sessionUUID)
 }
+
+// AddServiceTokenToGRPC добавляет токен сервиса в исходящие gRPC metadata
+func AddServiceTokenToGRPC(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, ServiceTokenMetadataKey, token)
+}
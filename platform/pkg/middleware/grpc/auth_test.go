@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	authV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/auth/v1"
+	commonV1 "github.com/Alexey-step/rocket-factory/shared/pkg/proto/common/v1"
+)
+
+const (
+	testServiceToken  = "service-secret"
+	testServiceMethod = "/inventory.v1.InventoryService/ReleaseStock"
+	testUserMethod    = "/inventory.v1.InventoryService/ReserveStock"
+)
+
+// stubIAMClient принимает только сессию validSession
+type stubIAMClient struct {
+	IAMClient
+
+	validSession string
+	calls        int
+}
+
+func (c *stubIAMClient) Whoami(_ context.Context, in *authV1.WhoamiRequest) (*authV1.WhoamiResponse, error) {
+	c.calls++
+	if in.GetSessionUuid() != c.validSession {
+		return nil, errors.New("session not found")
+	}
+	return &authV1.WhoamiResponse{User: &commonV1.User{Uuid: "user-uuid"}}, nil
+}
+
+func callUnary(t *testing.T, interceptor *AuthInterceptor, ctx context.Context, method string) error {
+	t.Helper()
+
+	_, err := interceptor.Unary()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+		func(ctx context.Context, _ any) (any, error) {
+			return nil, nil
+		},
+	)
+	return err
+}
+
+func TestAuthInterceptorServiceToken(t *testing.T) {
+	iamClient := &stubIAMClient{validSession: "session-uuid"}
+	interceptor := NewAuthInterceptor(iamClient, WithServiceToken(testServiceToken, testServiceMethod))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ServiceTokenMetadataKey, testServiceToken))
+
+	assert.NoError(t, callUnary(t, interceptor, ctx, testServiceMethod))
+	assert.Zero(t, iamClient.calls)
+}
+
+func TestAuthInterceptorServiceTokenRejected(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   []AuthOption
+		token  string
+		method string
+	}{
+		{
+			name:   "wrong token",
+			opts:   []AuthOption{WithServiceToken(testServiceToken, testServiceMethod)},
+			token:  "other-secret",
+			method: testServiceMethod,
+		},
+		{
+			name:   "method not allowed for services",
+			opts:   []AuthOption{WithServiceToken(testServiceToken, testServiceMethod)},
+			token:  testServiceToken,
+			method: testUserMethod,
+		},
+		{
+			name:   "service token not configured",
+			opts:   []AuthOption{WithServiceToken("", testServiceMethod)},
+			token:  "",
+			method: testServiceMethod,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := NewAuthInterceptor(&stubIAMClient{validSession: "session-uuid"}, tt.opts...)
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ServiceTokenMetadataKey, tt.token))
+
+			err := callUnary(t, interceptor, ctx, tt.method)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		})
+	}
+}
+
+func TestAuthInterceptorSessionStillAccepted(t *testing.T) {
+	interceptor := NewAuthInterceptor(&stubIAMClient{validSession: "session-uuid"}, WithServiceToken(testServiceToken, testServiceMethod))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(SessionUUIDMetadataKey, "session-uuid"))
+
+	assert.NoError(t, callUnary(t, interceptor, ctx, testServiceMethod))
+	assert.NoError(t, callUnary(t, interceptor, ctx, testUserMethod))
+}
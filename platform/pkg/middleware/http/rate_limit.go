@@ -0,0 +1,284 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexey-step/rocket-factory/platform/pkg/cache"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+const (
+	RateLimitLimitHeader     = "X-RateLimit-Limit"
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+	RateLimitResetHeader     = "X-RateLimit-Reset"
+	RetryAfterHeader         = "Retry-After"
+
+	defaultRateLimitRuleName = "default"
+	rateLimitKeyPrefix       = "ratelimit"
+)
+
+// tokenBucketScript атомарно пополняет корзину по прошедшему времени и забирает из неё один токен.
+// Время берётся у Redis, чтобы реплики с разными часами делили одну корзину честно.
+// Возвращает {разрешено (0/1), остаток токенов, мс до следующего токена, мс до полной корзины}
+const tokenBucketScript = `
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1]) or capacity
+local ts = tonumber(bucket[2]) or now
+tokens = math.min(capacity, tokens + math.max(0, now - ts) * rate / 1000)
+
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  retry = math.ceil((1 - tokens) * 1000 / rate)
+end
+
+local reset = math.ceil((capacity - tokens) * 1000 / rate)
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.max(reset, 1000))
+
+return {allowed, math.floor(tokens), retry, reset}
+`
+
+// RateLimit - параметры token bucket. Burst - ёмкость корзины, RatePerSecond - скорость пополнения.
+// Нулевой Burst отключает ограничение
+type RateLimit struct {
+	Burst         int64   `json:"burst"`
+	RatePerSecond float64 `json:"rate_per_second"`
+}
+
+func (l RateLimit) enabled() bool {
+	return l.Burst > 0 && l.RatePerSecond > 0
+}
+
+// RateLimitRule - лимиты для маршрута: отдельно на сессию и на IP-адрес клиента.
+// Path - шаблон в формате path.Match, например /api/v1/orders/*/pay. Пустой Method подходит к любому методу
+type RateLimitRule struct {
+	Name    string    `json:"name"`
+	Method  string    `json:"method"`
+	Path    string    `json:"path"`
+	Session RateLimit `json:"session"`
+	IP      RateLimit `json:"ip"`
+}
+
+func (r RateLimitRule) matches(req *http.Request) bool {
+	if r.Method != "" && r.Method != req.Method {
+		return false
+	}
+
+	ok, err := path.Match(r.Path, req.URL.Path)
+	return err == nil && ok
+}
+
+// RateLimitPolicy - лимиты по умолчанию и переопределения для отдельных маршрутов.
+// Маршруты проверяются по порядку, применяется первый подходящий
+type RateLimitPolicy struct {
+	Default RateLimitRule   `json:"default"`
+	Routes  []RateLimitRule `json:"routes"`
+}
+
+func (p RateLimitPolicy) rule(req *http.Request) RateLimitRule {
+	for _, route := range p.Routes {
+		if route.matches(req) {
+			return route
+		}
+	}
+
+	rule := p.Default
+	if rule.Name == "" {
+		rule.Name = defaultRateLimitRuleName
+	}
+	return rule
+}
+
+// LoadRateLimitPolicy читает политику ограничений из JSON-файла
+func LoadRateLimitPolicy(file string) (RateLimitPolicy, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return RateLimitPolicy{}, fmt.Errorf("read rate limit policy: %w", err)
+	}
+
+	var policy RateLimitPolicy
+	if err = json.Unmarshal(data, &policy); err != nil {
+		return RateLimitPolicy{}, fmt.Errorf("parse rate limit policy: %w", err)
+	}
+
+	for _, route := range policy.Routes {
+		if route.Name == "" {
+			return RateLimitPolicy{}, fmt.Errorf("rate limit route %q has no name", route.Path)
+		}
+		if _, err = path.Match(route.Path, "/"); err != nil {
+			return RateLimitPolicy{}, fmt.Errorf("rate limit route %q: %w", route.Name, err)
+		}
+	}
+
+	return policy, nil
+}
+
+// rateLimitBucket - корзина в Redis и её параметры
+type rateLimitBucket struct {
+	key   string
+	limit RateLimit
+}
+
+// rateLimitDecision - результат списания токена из одной корзины
+type rateLimitDecision struct {
+	limit      int64
+	allowed    bool
+	remaining  int64
+	retryAfter time.Duration
+	reset      time.Duration
+}
+
+// RateLimitMiddleware ограничивает частоту запросов token bucket'ами в Redis,
+// поэтому лимит общий для всех реплик сервиса
+type RateLimitMiddleware struct {
+	redisClient cache.RedisClient
+	policy      RateLimitPolicy
+}
+
+// NewRateLimitMiddleware создает middleware ограничения частоты запросов
+func NewRateLimitMiddleware(redisClient cache.RedisClient, policy RateLimitPolicy) *RateLimitMiddleware {
+	return &RateLimitMiddleware{
+		redisClient: redisClient,
+		policy:      policy,
+	}
+}
+
+// Handle списывает токен из корзины сессии и из корзины IP-адреса. Запрос отклоняется с 429,
+// если пуста любая из них. При недоступности Redis запросы пропускаются без ограничений
+func (m *RateLimitMiddleware) Handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		rule := m.policy.rule(r)
+
+		var buckets []rateLimitBucket
+		// Сессию здесь ещё не проверяли: лимит по IP не даёт обойти ограничение подменой заголовка
+		if sessionUUID := r.Header.Get(SessionUUIDHeader); sessionUUID != "" && rule.Session.enabled() {
+			buckets = append(buckets, rateLimitBucket{rateLimitKey(rule.Name, "session", sessionUUID), rule.Session})
+		}
+		if rule.IP.enabled() {
+			buckets = append(buckets, rateLimitBucket{rateLimitKey(rule.Name, "ip", clientIP(r)), rule.IP})
+		}
+
+		// В заголовки отдаём самую строгую из корзин: отклонившую запрос или с наименьшим остатком
+		var tightest *rateLimitDecision
+		for _, bucket := range buckets {
+			decision, err := m.take(ctx, bucket)
+			if err != nil {
+				logger.Error(ctx, "failed to check rate limit, request is let through",
+					zap.String("key", bucket.key),
+					zap.Error(err),
+				)
+				continue
+			}
+
+			if tightest == nil || !decision.allowed || decision.remaining < tightest.remaining {
+				tightest = &decision
+			}
+			if !decision.allowed {
+				break
+			}
+		}
+
+		if tightest == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set(RateLimitLimitHeader, strconv.FormatInt(tightest.limit, 10))
+		w.Header().Set(RateLimitRemainingHeader, strconv.FormatInt(tightest.remaining, 10))
+		w.Header().Set(RateLimitResetHeader, strconv.FormatInt(ceilSeconds(tightest.reset), 10))
+
+		if !tightest.allowed {
+			w.Header().Set(RetryAfterHeader, strconv.FormatInt(ceilSeconds(tightest.retryAfter), 10))
+			writeRateLimitResponse(w)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (m *RateLimitMiddleware) take(ctx context.Context, bucket rateLimitBucket) (rateLimitDecision, error) {
+	reply, err := m.redisClient.Eval(ctx, tokenBucketScript, []string{bucket.key}, bucket.limit.Burst, bucket.limit.RatePerSecond)
+	if err != nil {
+		return rateLimitDecision{}, err
+	}
+
+	values, ok := reply.([]any)
+	if !ok || len(values) != 4 {
+		return rateLimitDecision{}, fmt.Errorf("unexpected rate limit script reply: %v", reply)
+	}
+
+	ints := make([]int64, 0, len(values))
+	for _, v := range values {
+		n, ok := v.(int64)
+		if !ok {
+			return rateLimitDecision{}, fmt.Errorf("unexpected rate limit script reply: %v", reply)
+		}
+		ints = append(ints, n)
+	}
+
+	return rateLimitDecision{
+		limit:      bucket.limit.Burst,
+		allowed:    ints[0] == 1,
+		remaining:  ints[1],
+		retryAfter: time.Duration(ints[2]) * time.Millisecond,
+		reset:      time.Duration(ints[3]) * time.Millisecond,
+	}, nil
+}
+
+func rateLimitKey(rule, scope, id string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", rateLimitKeyPrefix, rule, scope, id)
+}
+
+// clientIP берёт адрес TCP-соединения. X-Forwarded-For не учитываем: его может подставить сам клиент
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func ceilSeconds(d time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
+}
+
+// rateLimitResponse повторяет схему RateLimitError из OpenAPI-спецификаций сервисов
+type rateLimitResponse struct {
+	Code      int    `json:"code"`
+	ErrorCode string `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+func writeRateLimitResponse(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+
+	//nolint:errcheck,gosec
+	json.NewEncoder(w).Encode(rateLimitResponse{
+		Code:      http.StatusTooManyRequests,
+		ErrorCode: "RATE_LIMITED",
+		Message:   "Слишком много запросов, повторите позже",
+	})
+}
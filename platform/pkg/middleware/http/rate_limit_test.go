@@ -0,0 +1,115 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Alexey-step/rocket-factory/platform/pkg/cache"
+	"github.com/Alexey-step/rocket-factory/platform/pkg/logger"
+)
+
+// scriptRedisClient отвечает на Eval заранее заданными ответами по ключу корзины
+type scriptRedisClient struct {
+	cache.RedisClient
+
+	replies map[string]any
+	err     error
+	keys    []string
+}
+
+func (c *scriptRedisClient) Eval(_ context.Context, _ string, keys []string, _ ...any) (any, error) {
+	c.keys = append(c.keys, keys...)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.replies[keys[0]], nil
+}
+
+var testRateLimitPolicy = RateLimitPolicy{
+	Default: RateLimitRule{
+		Session: RateLimit{Burst: 20, RatePerSecond: 5},
+		IP:      RateLimit{Burst: 60, RatePerSecond: 20},
+	},
+	Routes: []RateLimitRule{
+		{
+			Name:    "pay",
+			Method:  http.MethodPost,
+			Path:    "/api/v1/orders/*/pay",
+			Session: RateLimit{Burst: 3, RatePerSecond: 0.1},
+		},
+	},
+}
+
+func serveRateLimited(client cache.RedisClient, req *http.Request) (*httptest.ResponseRecorder, bool) {
+	called := false
+	handler := NewRateLimitMiddleware(client, testRateLimitPolicy).Handle(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec, called
+}
+
+func TestRateLimitAllowed(t *testing.T) {
+	client := &scriptRedisClient{replies: map[string]any{
+		"ratelimit:default:session:session-1": []any{int64(1), int64(7), int64(0), int64(2600)},
+		"ratelimit:default:ip:10.0.0.1":       []any{int64(1), int64(42), int64(0), int64(900)},
+	}}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/orders", nil)
+	req.RemoteAddr = "10.0.0.1:51234"
+	req.Header.Set(SessionUUIDHeader, "session-1")
+
+	rec, called := serveRateLimited(client, req)
+
+	assert.True(t, called)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []string{"ratelimit:default:session:session-1", "ratelimit:default:ip:10.0.0.1"}, client.keys)
+	assert.Equal(t, "20", rec.Header().Get(RateLimitLimitHeader))
+	assert.Equal(t, "7", rec.Header().Get(RateLimitRemainingHeader))
+	assert.Equal(t, "3", rec.Header().Get(RateLimitResetHeader))
+	assert.Empty(t, rec.Header().Get(RetryAfterHeader))
+}
+
+func TestRateLimitRejectedOnRoute(t *testing.T) {
+	client := &scriptRedisClient{replies: map[string]any{
+		"ratelimit:pay:session:session-1": []any{int64(0), int64(0), int64(4200), int64(30000)},
+	}}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/orders/8a1f7c0e-5d4b-4f3a-9b8e-2c6d7e8f9a0b/pay", nil)
+	req.RemoteAddr = "10.0.0.1:51234"
+	req.Header.Set(SessionUUIDHeader, "session-1")
+
+	rec, called := serveRateLimited(client, req)
+
+	assert.False(t, called)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	// У маршрута оплаты нет лимита по IP, поэтому проверяется только корзина сессии
+	assert.Equal(t, []string{"ratelimit:pay:session:session-1"}, client.keys)
+	assert.Equal(t, "3", rec.Header().Get(RateLimitLimitHeader))
+	assert.Equal(t, "0", rec.Header().Get(RateLimitRemainingHeader))
+	assert.Equal(t, "5", rec.Header().Get(RetryAfterHeader))
+	assert.JSONEq(t, `{"code":429,"error_code":"RATE_LIMITED","message":"Слишком много запросов, повторите позже"}`, rec.Body.String())
+}
+
+func TestRateLimitFailOpen(t *testing.T) {
+	logger.SetNopLogger()
+	client := &scriptRedisClient{err: errors.New("connection refused")}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/orders", nil)
+	req.RemoteAddr = "10.0.0.1:51234"
+
+	rec, called := serveRateLimited(client, req)
+
+	require.True(t, called)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get(RateLimitLimitHeader))
+}
@@ -0,0 +1,95 @@
+// Package money - денежные суммы в целых минимальных единицах валюты (копейках, центах)
+// с кодом валюты ISO 4217. Арифметика целочисленная, поэтому суммы не накапливают ошибку округления.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// RUB - валюта, в которой хранились цены до появления Money
+const RUB = "RUB"
+
+// minorUnitsPerMajor - минимальных единиц в основной (копеек в рубле)
+const minorUnitsPerMajor = 100
+
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// Money - сумма в минимальных единицах валюты
+type Money struct {
+	Amount   int64  // Сумма в минимальных единицах: 12345 RUB - это 123.45 ₽
+	Currency string // Код валюты ISO 4217
+}
+
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Zero - нулевая сумма в валюте currency, начальное значение для Add
+func Zero(currency string) Money {
+	return Money{Currency: currency}
+}
+
+// FromMajor переводит сумму в основных единицах (рублях) в Money с округлением до копейки.
+// Нужна только для миграции старых данных, хранившихся во float64.
+func FromMajor(amount float64, currency string) Money {
+	return Money{Amount: int64(math.Round(amount * minorUnitsPerMajor)), Currency: currency}
+}
+
+// Add складывает суммы одной валюты
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+// Sub вычитает сумму той же валюты
+func (m Money) Sub(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+
+	return Money{Amount: m.Amount - other.Amount, Currency: m.Currency}, nil
+}
+
+// Min возвращает меньшую из сумм одной валюты
+func (m Money) Min(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+
+	if other.Amount < m.Amount {
+		return other, nil
+	}
+	return m, nil
+}
+
+// Percent возвращает percent процентов от суммы с округлением до минимальной единицы
+func (m Money) Percent(percent int64) Money {
+	return Money{Amount: mulDivRound(m.Amount, percent, 100), Currency: m.Currency}
+}
+
+// Mul умножает сумму на количество
+func (m Money) Mul(quantity int64) Money {
+	return Money{Amount: m.Amount * quantity, Currency: m.Currency}
+}
+
+// IsZero сообщает, что сумма не задана
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// String форматирует сумму в основных единицах: "123.45 RUB"
+func (m Money) String() string {
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	return fmt.Sprintf("%s%d.%02d %s", sign, amount/minorUnitsPerMajor, amount%minorUnitsPerMajor, m.Currency)
+}
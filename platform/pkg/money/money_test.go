@@ -0,0 +1,114 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdd(t *testing.T) {
+	total := Zero(RUB)
+	for range 10 {
+		var err error
+		total, err = total.Add(New(10, RUB))
+		require.NoError(t, err)
+	}
+
+	// 10 раз по 0.10 ₽ - ровно 1 ₽, без дрейфа float64
+	assert.Equal(t, New(100, RUB), total)
+}
+
+func TestAddCurrencyMismatch(t *testing.T) {
+	_, err := New(100, RUB).Add(New(100, "USD"))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+func TestMul(t *testing.T) {
+	assert.Equal(t, New(3_702, RUB), New(1_234, RUB).Mul(3))
+}
+
+func TestSub(t *testing.T) {
+	diff, err := New(1_000, RUB).Sub(New(1, RUB))
+	require.NoError(t, err)
+	assert.Equal(t, New(999, RUB), diff)
+
+	_, err = New(100, RUB).Sub(New(100, "USD"))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+func TestMin(t *testing.T) {
+	least, err := New(1_000, RUB).Min(New(999, RUB))
+	require.NoError(t, err)
+	assert.Equal(t, New(999, RUB), least)
+}
+
+func TestPercent(t *testing.T) {
+	// 10% от 123.45 ₽ - 12.345 ₽, половина копейки округляется от нуля
+	assert.Equal(t, New(1_235, RUB), New(12_345, RUB).Percent(10))
+	assert.Equal(t, New(12_345, RUB), New(12_345, RUB).Percent(100))
+}
+
+func TestFromMajor(t *testing.T) {
+	assert.Equal(t, New(12_345, RUB), FromMajor(123.45, RUB))
+	assert.Equal(t, New(30, RUB), FromMajor(0.1+0.2, RUB))
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "123.45 RUB", New(12_345, RUB).String())
+	assert.Equal(t, "-0.05 RUB", New(-5, RUB).String())
+}
+
+func TestParseRate(t *testing.T) {
+	rate, err := ParseRate(RUB, "USD", "0.0108")
+	require.NoError(t, err)
+	assert.Equal(t, Rate{From: RUB, To: "USD", Value: 10_800}, rate)
+	assert.Equal(t, "0.010800", rate.String())
+
+	rate, err = ParseRate("USD", RUB, "92")
+	require.NoError(t, err)
+	assert.Equal(t, int64(92*RateScale), rate.Value)
+
+	for _, value := range []string{"", "0", "-1", "1.1234567", "abc", ".5"} {
+		_, err = ParseRate(RUB, "USD", value)
+		assert.ErrorIs(t, err, ErrInvalidRate, value)
+	}
+}
+
+func TestRateConvert(t *testing.T) {
+	rate := Rate{From: RUB, To: "USD", Value: 10_800}
+
+	// 1234.56 ₽ * 0.0108 = 13.333248 $ -> 13.33 $
+	converted, err := rate.Convert(New(123_456, RUB))
+	require.NoError(t, err)
+	assert.Equal(t, New(1_333, "USD"), converted)
+
+	// 0.50 ₽ * 0.0108 = 0.0054 $ -> 0.01 $: половина округляется от нуля
+	converted, err = rate.Convert(New(50, RUB))
+	require.NoError(t, err)
+	assert.Equal(t, New(1, "USD"), converted)
+
+	converted, err = Identity(RUB).Convert(New(123_456, RUB))
+	require.NoError(t, err)
+	assert.Equal(t, New(123_456, RUB), converted)
+
+	_, err = rate.Convert(New(100, "EUR"))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+func TestCrossRate(t *testing.T) {
+	usd := Rate{From: RUB, To: "USD", Value: 12_500} // 1 ₽ = 0.0125 $
+	eur := Rate{From: RUB, To: "EUR", Value: 10_000} // 1 ₽ = 0.01 €
+
+	rate, err := CrossRate(usd, eur)
+	require.NoError(t, err)
+	assert.Equal(t, Rate{From: "USD", To: "EUR", Value: 800_000}, rate)
+
+	// Обратный курс - кросс-курс к самой базовой валюте
+	rate, err = CrossRate(usd, Identity(RUB))
+	require.NoError(t, err)
+	assert.Equal(t, Rate{From: "USD", To: RUB, Value: 80 * RateScale}, rate)
+
+	_, err = CrossRate(usd, Rate{From: "USD", To: "EUR", Value: RateScale})
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
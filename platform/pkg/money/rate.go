@@ -0,0 +1,96 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// RateScale - точность курса: курс хранится целым числом миллионных долей
+const RateScale = 1_000_000
+
+// rateDecimals - знаков после запятой в RateScale
+const rateDecimals = 6
+
+var ErrInvalidRate = errors.New("invalid exchange rate")
+
+// Rate - курс обмена: за одну единицу From дают Value/RateScale единиц To.
+// Все поддерживаемые валюты делятся на 100 минимальных единиц, поэтому курс применяется к ним напрямую
+type Rate struct {
+	From  string
+	To    string
+	Value int64
+}
+
+// Identity - курс валюты к самой себе
+func Identity(currency string) Rate {
+	return Rate{From: currency, To: currency, Value: RateScale}
+}
+
+// ParseRate разбирает десятичную запись курса ("0.0108") без промежуточного float64
+func ParseRate(from, to, value string) (Rate, error) {
+	intPart, fracPart, _ := strings.Cut(strings.TrimSpace(value), ".")
+	if intPart == "" || len(fracPart) > rateDecimals || strings.HasPrefix(intPart, "-") || strings.HasPrefix(intPart, "+") {
+		return Rate{}, fmt.Errorf("%w: %q", ErrInvalidRate, value)
+	}
+
+	scaled, err := strconv.ParseInt(intPart+fracPart+strings.Repeat("0", rateDecimals-len(fracPart)), 10, 64)
+	if err != nil || scaled <= 0 {
+		return Rate{}, fmt.Errorf("%w: %q", ErrInvalidRate, value)
+	}
+
+	return Rate{From: from, To: to, Value: scaled}, nil
+}
+
+// CrossRate выводит курс a.To -> b.To из двух курсов от общей базовой валюты
+func CrossRate(a, b Rate) (Rate, error) {
+	if a.From != b.From {
+		return Rate{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, a.From, b.From)
+	}
+	if a.Value <= 0 {
+		return Rate{}, fmt.Errorf("%w: %s -> %s", ErrInvalidRate, a.From, a.To)
+	}
+
+	value := mulDivRound(b.Value, RateScale, a.Value)
+	if value <= 0 {
+		return Rate{}, fmt.Errorf("%w: %s -> %s", ErrInvalidRate, a.To, b.To)
+	}
+
+	return Rate{From: a.To, To: b.To, Value: value}, nil
+}
+
+// Convert переводит сумму в валюту To с округлением до минимальной единицы (половина - от нуля)
+func (r Rate) Convert(m Money) (Money, error) {
+	if m.Currency != r.From {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, r.From)
+	}
+
+	return Money{Amount: mulDivRound(m.Amount, r.Value, RateScale), Currency: r.To}, nil
+}
+
+// String форматирует курс десятичной дробью: "0.010800"
+func (r Rate) String() string {
+	return fmt.Sprintf("%d.%0*d", r.Value/RateScale, rateDecimals, r.Value%RateScale)
+}
+
+// mulDivRound считает a*b/c с округлением половины от нуля; промежуточное произведение не переполняется
+func mulDivRound(a, b, c int64) int64 {
+	num := new(big.Int).Mul(big.NewInt(a), big.NewInt(b))
+	den := big.NewInt(c)
+
+	negative := num.Sign()*den.Sign() < 0
+
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	// |rem| * 2 >= |den| - округляем от нуля
+	if rem.Abs(rem).Lsh(rem, 1).Cmp(den.Abs(den)) >= 0 {
+		if negative {
+			quo.Sub(quo, big.NewInt(1))
+		} else {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+
+	return quo.Int64()
+}
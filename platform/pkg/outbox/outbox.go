@@ -0,0 +1,78 @@
+// Package outbox реализует transactional outbox поверх Postgres.
+//
+// Сервис пишет сообщение через Writer в той же транзакции, что и изменение своих данных
+// (см. txmanager), а Relay в фоне публикует накопившиеся записи в Kafka.
+// Таблица создаётся миграцией сервиса:
+//
+//	create table outbox
+//	(
+//	    id              bigint generated always as identity primary key,
+//	    topic           text        not null,
+//	    key             text        not null,
+//	    payload         bytea       not null,
+//	    attempts        integer     not null default 0,
+//	    last_error      text,
+//	    created_at      timestamptz not null default now(),
+//	    next_attempt_at timestamptz not null default now(),
+//	    sent_at         timestamptz
+//	);
+//	create index outbox_pending_idx on outbox (next_attempt_at, id) where sent_at is null;
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultTable - имя таблицы outbox по умолчанию
+const DefaultTable = "outbox"
+
+// Message - сообщение, ожидающее публикации в Kafka
+type Message struct {
+	Topic   string
+	Key     string
+	Payload []byte
+}
+
+// Writer сохраняет сообщение в outbox в транзакции из контекста
+type Writer interface {
+	Write(ctx context.Context, msg Message) error
+}
+
+// Relay публикует сообщения из outbox в Kafka, пока не отменён контекст
+type Relay interface {
+	Run(ctx context.Context) error
+}
+
+type Logger interface {
+	Info(ctx context.Context, msg string, fields ...zap.Field)
+	Error(ctx context.Context, msg string, fields ...zap.Field)
+}
+
+// RelayConfig - настройки фоновой публикации
+type RelayConfig struct {
+	Table        string        // Имя таблицы, по умолчанию DefaultTable
+	BatchSize    int           // Сколько записей забирать за один проход
+	PollInterval time.Duration // Пауза между проходами, когда outbox пуст
+	MaxBackoff   time.Duration // Верхняя граница задержки между повторными попытками
+	Retention    time.Duration // Сколько хранить отправленные записи; 0 - не удалять
+}
+
+func (cfg RelayConfig) withDefaults() RelayConfig {
+	if cfg.Table == "" {
+		cfg.Table = DefaultTable
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = time.Second
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = 5 * time.Minute
+	}
+
+	return cfg
+}
@@ -0,0 +1,214 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+
+	"github.com/Alexey-step/rocket-factory/platform/pkg/kafka"
+)
+
+// cleanupInterval - как часто удалять отправленные записи старше Retention
+const cleanupInterval = time.Minute
+
+type record struct {
+	ID       int64
+	Topic    string
+	Key      string
+	Payload  []byte
+	Attempts int
+}
+
+type relay struct {
+	pool      *pgxpool.Pool
+	producers map[string]kafka.Producer
+	cfg       RelayConfig
+	logger    Logger
+}
+
+// NewRelay создаёт Relay; producers - продюсеры по имени топика, в который они пишут
+func NewRelay(pool *pgxpool.Pool, producers map[string]kafka.Producer, cfg RelayConfig, logger Logger) Relay {
+	return &relay{
+		pool:      pool,
+		producers: producers,
+		cfg:       cfg.withDefaults(),
+		logger:    logger,
+	}
+}
+
+// Run забирает неотправленные записи пачками через FOR UPDATE SKIP LOCKED,
+// поэтому несколько реплик сервиса могут работать с одной таблицей одновременно
+func (r *relay) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	var lastCleanup time.Time
+	for {
+		// Пока записи идут полными пачками, разгребаем outbox без пауз
+		for {
+			processed, err := r.publishBatch(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				r.logger.Error(ctx, "outbox relay batch failed", zap.String("table", r.cfg.Table), zap.Error(err))
+				break
+			}
+			if processed < r.cfg.BatchSize {
+				break
+			}
+		}
+
+		if r.cfg.Retention > 0 && time.Since(lastCleanup) >= cleanupInterval {
+			r.cleanup(ctx)
+			lastCleanup = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *relay) publishBatch(ctx context.Context) (int, error) {
+	query, args, err := r.batchQuery(time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	var processed int
+	err = pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		rows, txErr := tx.Query(ctx, query, args...)
+		if txErr != nil {
+			return txErr
+		}
+
+		records, txErr := pgx.CollectRows(rows, pgx.RowToStructByPos[record])
+		if txErr != nil {
+			return txErr
+		}
+		processed = len(records)
+
+		for _, rec := range records {
+			if sendErr := r.send(ctx, rec); sendErr != nil {
+				r.logger.Error(ctx, "failed to publish outbox message",
+					zap.Int64("id", rec.ID),
+					zap.String("topic", rec.Topic),
+					zap.Int("attempts", rec.Attempts+1),
+					zap.Error(sendErr),
+				)
+				if txErr = r.markFailed(ctx, tx, rec, sendErr); txErr != nil {
+					return txErr
+				}
+				continue
+			}
+
+			if txErr = r.markSent(ctx, tx, rec.ID); txErr != nil {
+				return txErr
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return processed, nil
+}
+
+// batchQuery выбирает готовые к отправке записи. Запись берётся, только если перед
+// ней нет неотправленных записей с тем же топиком и ключом: иначе после неудачной
+// попытки, отложенной на backoff, следующая пачка или другая реплика отправили бы
+// более позднее событие той же сущности раньше. Поэтому в пачку попадает не больше
+// одной записи на ключ, и следующая уходит только после того, как отправлена предыдущая
+func (r *relay) batchQuery(now time.Time) (string, []any, error) {
+	return sq.Select("o.id", "o.topic", "o.key", "o.payload", "o.attempts").
+		From(r.cfg.Table + " AS o").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"o.sent_at": nil}).
+		Where(sq.LtOrEq{"o.next_attempt_at": now}).
+		Where(fmt.Sprintf(
+			"NOT EXISTS (SELECT 1 FROM %s AS prev WHERE prev.topic = o.topic AND prev.key = o.key AND prev.id < o.id AND prev.sent_at IS NULL)",
+			r.cfg.Table,
+		)).
+		OrderBy("o.id").
+		Limit(uint64(r.cfg.BatchSize)). //nolint:gosec // BatchSize всегда положительный после withDefaults
+		Suffix("FOR UPDATE OF o SKIP LOCKED").
+		ToSql()
+}
+
+func (r *relay) send(ctx context.Context, rec record) error {
+	producer, ok := r.producers[rec.Topic]
+	if !ok {
+		return fmt.Errorf("no producer for topic %q", rec.Topic)
+	}
+
+	return producer.Send(ctx, rec.Key, rec.Payload)
+}
+
+func (r *relay) markSent(ctx context.Context, tx pgx.Tx, id int64) error {
+	query, args, err := sq.Update(r.cfg.Table).
+		PlaceholderFormat(sq.Dollar).
+		Set("sent_at", time.Now()).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
+
+func (r *relay) markFailed(ctx context.Context, tx pgx.Tx, rec record, sendErr error) error {
+	query, args, err := sq.Update(r.cfg.Table).
+		PlaceholderFormat(sq.Dollar).
+		Set("attempts", rec.Attempts+1).
+		Set("last_error", sendErr.Error()).
+		Set("next_attempt_at", time.Now().Add(r.backoff(rec.Attempts+1))).
+		Where(sq.Eq{"id": rec.ID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
+
+// backoff - экспоненциальная задержка 1s, 2s, 4s... ограниченная MaxBackoff
+func (r *relay) backoff(attempts int) time.Duration {
+	if attempts > 30 {
+		return r.cfg.MaxBackoff
+	}
+
+	delay := time.Second << (attempts - 1)
+	if delay <= 0 || delay > r.cfg.MaxBackoff {
+		return r.cfg.MaxBackoff
+	}
+
+	return delay
+}
+
+func (r *relay) cleanup(ctx context.Context) {
+	query, args, err := sq.Delete(r.cfg.Table).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.NotEq{"sent_at": nil}).
+		Where(sq.Lt{"sent_at": time.Now().Add(-r.cfg.Retention)}).
+		ToSql()
+	if err == nil {
+		_, err = r.pool.Exec(ctx, query, args...)
+	}
+	if err != nil && !errors.Is(err, context.Canceled) {
+		r.logger.Error(ctx, "failed to clean up outbox", zap.String("table", r.cfg.Table), zap.Error(err))
+	}
+}
@@ -0,0 +1,34 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayBackoff(t *testing.T) {
+	r := &relay{cfg: RelayConfig{MaxBackoff: time.Minute}.withDefaults()}
+
+	assert.Equal(t, time.Second, r.backoff(1))
+	assert.Equal(t, 2*time.Second, r.backoff(2))
+	assert.Equal(t, 32*time.Second, r.backoff(6))
+	assert.Equal(t, time.Minute, r.backoff(7))
+	assert.Equal(t, time.Minute, r.backoff(100))
+}
+
+func TestRelayBatchQueryKeepsKeyOrder(t *testing.T) {
+	r := &relay{cfg: RelayConfig{Table: "outbox", BatchSize: 50}.withDefaults()}
+	now := time.Now()
+
+	query, args, err := r.batchQuery(now)
+	require.NoError(t, err)
+
+	// Запись с более ранней неотправленной записью того же ключа не выбирается,
+	// даже если та ждёт повтора и в эту пачку не попала
+	assert.Contains(t, query, "NOT EXISTS (SELECT 1 FROM outbox AS prev WHERE prev.topic = o.topic AND prev.key = o.key AND prev.id < o.id AND prev.sent_at IS NULL)")
+	assert.Contains(t, query, "o.next_attempt_at <= $1")
+	assert.Contains(t, query, "ORDER BY o.id LIMIT 50 FOR UPDATE OF o SKIP LOCKED")
+	assert.Equal(t, []any{now}, args)
+}
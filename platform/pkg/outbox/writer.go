@@ -0,0 +1,41 @@
+package outbox
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/Alexey-step/rocket-factory/platform/pkg/txmanager"
+)
+
+type writer struct {
+	pool  *pgxpool.Pool
+	table string
+}
+
+// NewWriter создаёт Writer для таблицы table (DefaultTable, если пусто)
+func NewWriter(pool *pgxpool.Pool, table string) Writer {
+	if table == "" {
+		table = DefaultTable
+	}
+
+	return &writer{
+		pool:  pool,
+		table: table,
+	}
+}
+
+func (w *writer) Write(ctx context.Context, msg Message) error {
+	query, args, err := sq.Insert(w.table).
+		PlaceholderFormat(sq.Dollar).
+		Columns("topic", "key", "payload").
+		Values(msg.Topic, msg.Key, msg.Payload).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = txmanager.GetQuerier(ctx, w.pool).Exec(ctx, query, args...)
+	return err
+}
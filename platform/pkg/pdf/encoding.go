@@ -0,0 +1,65 @@
+package pdf
+
+import (
+	"strings"
+	"unicode"
+)
+
+// winAnsiExtra - символы WinAnsiEncoding из диапазона 0x80-0x9f, которые не
+// совпадают с Latin-1
+var winAnsiExtra = map[rune]byte{
+	'€': 0x80,
+	'‚': 0x82,
+	'„': 0x84,
+	'…': 0x85,
+	'‘': 0x91,
+	'’': 0x92,
+	'“': 0x93,
+	'”': 0x94,
+	'•': 0x95,
+	'–': 0x96,
+	'—': 0x97,
+	'™': 0x99,
+}
+
+// cyrillic - транслитерация строчных букв русского алфавита
+var cyrillic = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "",
+	'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+	'№': "No.",
+}
+
+// encode переводит строку в однобайтовую WinAnsiEncoding стандартных шрифтов
+func encode(text string) []byte {
+	out := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r >= 0x20 && r < 0x7f, r >= 0xa0 && r <= 0xff:
+			out = append(out, byte(r))
+		case winAnsiExtra[r] != 0:
+			out = append(out, winAnsiExtra[r])
+		default:
+			out = append(out, transliterate(r)...)
+		}
+	}
+
+	return out
+}
+
+func transliterate(r rune) string {
+	if latin, ok := cyrillic[r]; ok {
+		return latin
+	}
+
+	latin, ok := cyrillic[unicode.ToLower(r)]
+	if !ok {
+		return "?"
+	}
+	if latin == "" {
+		return ""
+	}
+	return strings.ToUpper(latin[:1]) + latin[1:]
+}
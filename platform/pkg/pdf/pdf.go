@@ -0,0 +1,164 @@
+// Package pdf - минимальный генератор PDF для текстовых документов вроде счетов.
+// Используются только стандартные шрифты Courier: они есть в любом просмотрщике,
+// поэтому шрифты не встраиваются, а ширина моноширинного текста считается без метрик.
+// Стандартные шрифты не содержат кириллицы, она транслитерируется; остальные символы
+// вне WinAnsiEncoding заменяются на "?". Одинаковый документ всегда даёт одинаковые байты
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// Размер страницы A4 в пунктах
+const (
+	PageWidth  = 595.0
+	PageHeight = 842.0
+)
+
+// charWidth - ширина любого символа Courier в долях размера шрифта
+const charWidth = 0.6
+
+type Font int
+
+const (
+	Regular Font = iota
+	Bold
+)
+
+var fontNames = [...]string{
+	Regular: "Courier",
+	Bold:    "Courier-Bold",
+}
+
+// Document - документ из страниц с текстом и линиями. Координаты отсчитываются
+// от левого верхнего угла страницы, в пунктах
+type Document struct {
+	title     string
+	createdAt time.Time
+	pages     []*bytes.Buffer
+}
+
+// New создаёт пустой документ. createdAt попадает в метаданные, поэтому задаётся
+// явно: текущее время сделало бы документ невоспроизводимым
+func New(title string, createdAt time.Time) *Document {
+	return &Document{
+		title:     title,
+		createdAt: createdAt,
+	}
+}
+
+// AddPage начинает новую страницу, дальнейший вывод идёт на неё
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+}
+
+// PageCount - количество страниц документа
+func (d *Document) PageCount() int {
+	return len(d.pages)
+}
+
+// Text выводит строку; y - базовая линия текста
+func (d *Document) Text(x, y float64, font Font, size float64, text string) {
+	page := d.currentPage()
+	fmt.Fprintf(page, "BT /F%d %s Tf %s %s Td (", font+1, formatNumber(size), formatNumber(x), formatNumber(PageHeight-y))
+	writeEscaped(page, encode(text))
+	page.WriteString(") Tj ET\n")
+}
+
+// Line рисует отрезок толщиной width
+func (d *Document) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(d.currentPage(), "%s w %s %s m %s %s l S\n",
+		formatNumber(width),
+		formatNumber(x1), formatNumber(PageHeight-y1),
+		formatNumber(x2), formatNumber(PageHeight-y2),
+	)
+}
+
+// TextWidth - ширина строки при выводе шрифтом size, с учётом транслитерации
+func TextWidth(size float64, text string) float64 {
+	return float64(len(encode(text))) * size * charWidth
+}
+
+// Bytes собирает документ. Документ без страниц получает одну пустую страницу
+func (d *Document) Bytes() []byte {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+
+	// 1 - каталог, 2 - дерево страниц, 3-4 - шрифты, 5 - метаданные,
+	// дальше на каждую страницу пара объектов: страница и её содержимое
+	const firstPageObject = 6
+	objectCount := firstPageObject - 1 + 2*len(d.pages)
+
+	var out bytes.Buffer
+	offsets := make([]int, objectCount+1)
+	writeObject := func(id int, body string) {
+		offsets[id] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", id, body)
+	}
+
+	// Вторая строка с байтами старше 0x7f подсказывает, что файл двоичный
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	writeObject(1, "<< /Type /Catalog /Pages 2 0 R >>")
+
+	var kids bytes.Buffer
+	for i := range d.pages {
+		if i > 0 {
+			kids.WriteByte(' ')
+		}
+		fmt.Fprintf(&kids, "%d 0 R", firstPageObject+2*i)
+	}
+	writeObject(2, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d /MediaBox [0 0 %s %s] >>",
+		kids.String(), len(d.pages), formatNumber(PageWidth), formatNumber(PageHeight)))
+
+	for font, name := range fontNames {
+		writeObject(3+font, fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", name))
+	}
+
+	var title bytes.Buffer
+	writeEscaped(&title, encode(d.title))
+	writeObject(5, fmt.Sprintf("<< /Title (%s) /Producer (rocket-factory) /CreationDate (D:%sZ) >>",
+		title.String(), d.createdAt.UTC().Format("20060102150405")))
+
+	for i, content := range d.pages {
+		pageID := firstPageObject + 2*i
+		writeObject(pageID, fmt.Sprintf("<< /Type /Page /Parent 2 0 R /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>", pageID+1))
+		writeObject(pageID+1, fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()))
+	}
+
+	xrefOffset := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", objectCount+1)
+	for id := 1; id <= objectCount; id++ {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offsets[id])
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", objectCount+1, xrefOffset)
+
+	return out.Bytes()
+}
+
+func (d *Document) currentPage() *bytes.Buffer {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+	return d.pages[len(d.pages)-1]
+}
+
+// writeEscaped экранирует символы, которые нельзя оставить в строке PDF как есть
+func writeEscaped(buf *bytes.Buffer, text []byte) {
+	for _, b := range text {
+		if b == '(' || b == ')' || b == '\\' {
+			buf.WriteByte('\\')
+		}
+		buf.WriteByte(b)
+	}
+}
+
+// formatNumber округляет координаты до сотых пункта: точнее не видно, а запись короче
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
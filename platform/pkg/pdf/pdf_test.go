@@ -0,0 +1,86 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testCreatedAt = time.Date(2026, 10, 18, 12, 30, 0, 0, time.UTC)
+
+func buildDocument() []byte {
+	doc := New("Invoice INV-2026-000001", testCreatedAt)
+	doc.AddPage()
+	doc.Text(50, 60, Bold, 16, "Invoice (copy)")
+	doc.Line(50, 70, 545, 70, 0.5)
+	doc.AddPage()
+	doc.Text(50, 60, Regular, 10, "Двигатель")
+	return doc.Bytes()
+}
+
+func TestBytesDeterministic(t *testing.T) {
+	assert.Equal(t, buildDocument(), buildDocument())
+}
+
+func TestBytesStructure(t *testing.T) {
+	out := buildDocument()
+
+	assert.True(t, bytes.HasPrefix(out, []byte("%PDF-1.4\n")))
+	assert.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")))
+	assert.Contains(t, string(out), "/Count 2")
+	assert.Contains(t, string(out), "/CreationDate (D:20261018123000Z)")
+	assert.Contains(t, string(out), "BT /F2 16 Tf 50 782 Td (Invoice \\(copy\\)) Tj ET")
+	assert.Contains(t, string(out), "0.5 w 50 772 m 545 772 l S")
+	assert.Contains(t, string(out), "(Dvigatel) Tj")
+
+	// Каждая запись таблицы xref указывает на начало своего объекта
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	require.NotNil(t, startxref)
+	xrefOffset, err := strconv.Atoi(string(startxref[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(out[xrefOffset:], []byte("xref\n")))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out[xrefOffset:], -1)
+	require.Len(t, entries, 9)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(string(entry[1]))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(out[offset:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))), "object %d", i+1)
+	}
+}
+
+func TestBytesEmptyDocument(t *testing.T) {
+	out := New("", testCreatedAt).Bytes()
+	assert.Contains(t, string(out), "/Count 1")
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		text string
+		want []byte
+	}{
+		{text: "Engine X-100", want: []byte("Engine X-100")},
+		{text: "Щит Ёжика", want: []byte("Shchit Ezhika")},
+		{text: "Объём", want: []byte("Obem")},
+		{text: "Café – 5 €", want: []byte("Caf\xe9 \x96 5 \x80")},
+		{text: "火箭\t", want: []byte("???")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, encode(tt.text))
+		})
+	}
+}
+
+func TestTextWidth(t *testing.T) {
+	assert.InDelta(t, 60.0, TextWidth(10, "0123456789"), 1e-9)
+	// "Щ" выводится четырьмя символами "Shch"
+	assert.InDelta(t, 24.0, TextWidth(10, "Щ"), 1e-9)
+}
@@ -0,0 +1,83 @@
+package txmanager
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Handler - функция, выполняемая внутри транзакции
+type Handler func(ctx context.Context) error
+
+// TxManager открывает транзакцию и прокидывает её через контекст
+type TxManager interface {
+	ReadCommitted(ctx context.Context, fn Handler) error
+}
+
+// Querier - общий интерфейс pgxpool.Pool и pgx.Tx; Begin внутри транзакции открывает savepoint
+type Querier interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type (
+	txKey          struct{}
+	afterCommitKey struct{}
+)
+
+type manager struct {
+	pool *pgxpool.Pool
+}
+
+func NewTxManager(pool *pgxpool.Pool) TxManager {
+	return &manager{
+		pool: pool,
+	}
+}
+
+// ReadCommitted выполняет fn в транзакции READ COMMITTED.
+// Если транзакция уже открыта выше по стеку, fn выполняется в ней же.
+func (m *manager) ReadCommitted(ctx context.Context, fn Handler) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	var hooks []func(ctx context.Context)
+	err := pgx.BeginTxFunc(ctx, m.pool, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pgx.Tx) error {
+		txCtx := context.WithValue(ctx, txKey{}, tx)
+		return fn(context.WithValue(txCtx, afterCommitKey{}, &hooks))
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, hook := range hooks {
+		hook(ctx)
+	}
+
+	return nil
+}
+
+// AfterCommit откладывает fn до коммита транзакции из контекста; при откате fn не вызывается.
+// Вне транзакции fn выполняется сразу. Нужен для побочных эффектов, которые нельзя откатить
+func AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	if hooks, ok := ctx.Value(afterCommitKey{}).(*[]func(ctx context.Context)); ok {
+		*hooks = append(*hooks, fn)
+		return
+	}
+
+	fn(ctx)
+}
+
+// GetQuerier возвращает транзакцию из контекста, если она открыта, иначе пул соединений
+func GetQuerier(ctx context.Context, pool *pgxpool.Pool) Querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+
+	return pool
+}
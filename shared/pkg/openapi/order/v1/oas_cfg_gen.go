@@ -12,9 +12,14 @@ import (
 	ht "github.com/ogen-go/ogen/http"
 	"github.com/ogen-go/ogen/middleware"
 	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/ogen-go/ogen/ogenregex"
 	"github.com/ogen-go/ogen/otelogen"
 )
 
+var regexMap = map[string]ogenregex.Regexp{
+	"^[0-9]+\\.[0-9]+$": ogenregex.MustCompile("^[0-9]+\\.[0-9]+$"),
+	"^[A-Z]{3}$":        ogenregex.MustCompile("^[A-Z]{3}$"),
+}
 var (
 	// Allocate option closure once.
 	clientSpanKind = trace.WithSpanKind(trace.SpanKindClient)
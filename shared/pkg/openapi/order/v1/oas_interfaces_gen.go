@@ -1,18 +1,78 @@
 // Code generated by ogen, DO NOT EDIT.
 package order_v1
 
+type AddDraftItemRes interface {
+	addDraftItemRes()
+}
+
 type CancelOrderRes interface {
 	cancelOrderRes()
 }
 
+type CheckoutDraftRes interface {
+	checkoutDraftRes()
+}
+
 type CreateOrderRes interface {
 	createOrderRes()
 }
 
+type CreateWebhookRes interface {
+	createWebhookRes()
+}
+
+type DeleteWebhookRes interface {
+	deleteWebhookRes()
+}
+
+type EnableWebhookRes interface {
+	enableWebhookRes()
+}
+
+type GetDraftRes interface {
+	getDraftRes()
+}
+
+type GetOrderHistoryRes interface {
+	getOrderHistoryRes()
+}
+
+type GetOrderInvoiceRes interface {
+	getOrderInvoiceRes()
+}
+
 type GetOrderRes interface {
 	getOrderRes()
 }
 
+type GetOrdersReportRes interface {
+	getOrdersReportRes()
+}
+
+type ListOrdersRes interface {
+	listOrdersRes()
+}
+
+type ListWebhookDeliveriesRes interface {
+	listWebhookDeliveriesRes()
+}
+
+type ListWebhooksRes interface {
+	listWebhooksRes()
+}
+
 type PayOrderRes interface {
 	payOrderRes()
 }
+
+type QuoteOrderRes interface {
+	quoteOrderRes()
+}
+
+type RemoveDraftItemRes interface {
+	removeDraftItemRes()
+}
+
+type SetDraftItemQuantityRes interface {
+	setDraftItemQuantityRes()
+}
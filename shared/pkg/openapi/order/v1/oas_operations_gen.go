@@ -6,8 +6,23 @@ package order_v1
 type OperationName = string
 
 const (
-	CancelOrderOperation OperationName = "CancelOrder"
-	CreateOrderOperation OperationName = "CreateOrder"
-	GetOrderOperation    OperationName = "GetOrder"
-	PayOrderOperation    OperationName = "PayOrder"
+	AddDraftItemOperation          OperationName = "AddDraftItem"
+	CancelOrderOperation           OperationName = "CancelOrder"
+	CheckoutDraftOperation         OperationName = "CheckoutDraft"
+	CreateOrderOperation           OperationName = "CreateOrder"
+	CreateWebhookOperation         OperationName = "CreateWebhook"
+	DeleteWebhookOperation         OperationName = "DeleteWebhook"
+	EnableWebhookOperation         OperationName = "EnableWebhook"
+	GetDraftOperation              OperationName = "GetDraft"
+	GetOrderOperation              OperationName = "GetOrder"
+	GetOrderHistoryOperation       OperationName = "GetOrderHistory"
+	GetOrderInvoiceOperation       OperationName = "GetOrderInvoice"
+	GetOrdersReportOperation       OperationName = "GetOrdersReport"
+	ListOrdersOperation            OperationName = "ListOrders"
+	ListWebhookDeliveriesOperation OperationName = "ListWebhookDeliveries"
+	ListWebhooksOperation          OperationName = "ListWebhooks"
+	PayOrderOperation              OperationName = "PayOrder"
+	QuoteOrderOperation            OperationName = "QuoteOrder"
+	RemoveDraftItemOperation       OperationName = "RemoveDraftItem"
+	SetDraftItemQuantityOperation  OperationName = "SetDraftItemQuantity"
 )
@@ -4,6 +4,7 @@ package order_v1
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/go-faster/errors"
@@ -14,10 +15,56 @@ func (s *GenericErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
+// Инициатор изменения статуса:
+// - USER: Пользователь из сессии
+// - SYSTEM: Система по событию из Kafka.
+// Ref: #/components/schemas/actor_type
+type ActorType string
+
+const (
+	ActorTypeUSER   ActorType = "USER"
+	ActorTypeSYSTEM ActorType = "SYSTEM"
+)
+
+// AllValues returns all ActorType values.
+func (ActorType) AllValues() []ActorType {
+	return []ActorType{
+		ActorTypeUSER,
+		ActorTypeSYSTEM,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ActorType) MarshalText() ([]byte, error) {
+	switch s {
+	case ActorTypeUSER:
+		return []byte(s), nil
+	case ActorTypeSYSTEM:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ActorType) UnmarshalText(data []byte) error {
+	switch ActorType(data) {
+	case ActorTypeUSER:
+		*s = ActorTypeUSER
+		return nil
+	case ActorTypeSYSTEM:
+		*s = ActorTypeSYSTEM
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/bad_gateway_error
 type BadGatewayError struct {
 	// HTTP-код ошибки.
-	Code int `json:"code"`
+	Code      int       `json:"code"`
+	ErrorCode ErrorCode `json:"error_code"`
 	// Описание ошибки.
 	Message string `json:"message"`
 }
@@ -27,6 +74,11 @@ func (s *BadGatewayError) GetCode() int {
 	return s.Code
 }
 
+// GetErrorCode returns the value of ErrorCode.
+func (s *BadGatewayError) GetErrorCode() ErrorCode {
+	return s.ErrorCode
+}
+
 // GetMessage returns the value of Message.
 func (s *BadGatewayError) GetMessage() string {
 	return s.Message
@@ -37,20 +89,27 @@ func (s *BadGatewayError) SetCode(val int) {
 	s.Code = val
 }
 
+// SetErrorCode sets the value of ErrorCode.
+func (s *BadGatewayError) SetErrorCode(val ErrorCode) {
+	s.ErrorCode = val
+}
+
 // SetMessage sets the value of Message.
 func (s *BadGatewayError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*BadGatewayError) cancelOrderRes() {}
-func (*BadGatewayError) createOrderRes() {}
-func (*BadGatewayError) getOrderRes()    {}
-func (*BadGatewayError) payOrderRes()    {}
+func (*BadGatewayError) cancelOrderRes()     {}
+func (*BadGatewayError) createOrderRes()     {}
+func (*BadGatewayError) getOrderInvoiceRes() {}
+func (*BadGatewayError) getOrderRes()        {}
+func (*BadGatewayError) payOrderRes()        {}
 
 // Ref: #/components/schemas/bad_request_error
 type BadRequestError struct {
 	// HTTP-код ошибки.
-	Code int `json:"code"`
+	Code      int       `json:"code"`
+	ErrorCode ErrorCode `json:"error_code"`
 	// Описание ошибки.
 	Message string `json:"message"`
 }
@@ -60,6 +119,11 @@ func (s *BadRequestError) GetCode() int {
 	return s.Code
 }
 
+// GetErrorCode returns the value of ErrorCode.
+func (s *BadRequestError) GetErrorCode() ErrorCode {
+	return s.ErrorCode
+}
+
 // GetMessage returns the value of Message.
 func (s *BadRequestError) GetMessage() string {
 	return s.Message
@@ -70,528 +134,3351 @@ func (s *BadRequestError) SetCode(val int) {
 	s.Code = val
 }
 
+// SetErrorCode sets the value of ErrorCode.
+func (s *BadRequestError) SetErrorCode(val ErrorCode) {
+	s.ErrorCode = val
+}
+
 // SetMessage sets the value of Message.
 func (s *BadRequestError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*BadRequestError) createOrderRes() {}
-func (*BadRequestError) payOrderRes()    {}
+func (*BadRequestError) addDraftItemRes()          {}
+func (*BadRequestError) checkoutDraftRes()         {}
+func (*BadRequestError) createOrderRes()           {}
+func (*BadRequestError) createWebhookRes()         {}
+func (*BadRequestError) getOrdersReportRes()       {}
+func (*BadRequestError) listOrdersRes()            {}
+func (*BadRequestError) listWebhookDeliveriesRes() {}
+func (*BadRequestError) payOrderRes()              {}
+func (*BadRequestError) quoteOrderRes()            {}
+func (*BadRequestError) setDraftItemQuantityRes()  {}
 
-// CancelOrderNoContent is response for CancelOrder operation.
-type CancelOrderNoContent struct{}
+// Ref: #/components/schemas/bom_violation
+type BomViolation struct {
+	// Категория деталей.
+	Category string `json:"category"`
+	// Вид нарушения:
+	// - MISSING: деталей категории не хватает для сборки
+	// - EXCESS: деталей категории больше, чем нужно, или
+	// категория не используется в сборке.
+	Kind BomViolationKind `json:"kind"`
+	// Минимальное количество деталей категории.
+	Min int64 `json:"min"`
+	// Максимальное количество деталей категории, если
+	// ограничено.
+	Max OptInt64 `json:"max"`
+	// Количество деталей категории в заказе.
+	Actual int64 `json:"actual"`
+}
 
-func (*CancelOrderNoContent) cancelOrderRes() {}
+// GetCategory returns the value of Category.
+func (s *BomViolation) GetCategory() string {
+	return s.Category
+}
 
-// Ref: #/components/schemas/conflict_error
-type ConflictError struct {
-	// HTTP-код ошибки.
-	Code int `json:"code"`
-	// Заказ уже оплачен и не может быть отменён.
-	Message string `json:"message"`
+// GetKind returns the value of Kind.
+func (s *BomViolation) GetKind() BomViolationKind {
+	return s.Kind
 }
 
-// GetCode returns the value of Code.
-func (s *ConflictError) GetCode() int {
-	return s.Code
+// GetMin returns the value of Min.
+func (s *BomViolation) GetMin() int64 {
+	return s.Min
 }
 
-// GetMessage returns the value of Message.
-func (s *ConflictError) GetMessage() string {
-	return s.Message
+// GetMax returns the value of Max.
+func (s *BomViolation) GetMax() OptInt64 {
+	return s.Max
 }
 
-// SetCode sets the value of Code.
-func (s *ConflictError) SetCode(val int) {
-	s.Code = val
+// GetActual returns the value of Actual.
+func (s *BomViolation) GetActual() int64 {
+	return s.Actual
 }
 
-// SetMessage sets the value of Message.
-func (s *ConflictError) SetMessage(val string) {
-	s.Message = val
+// SetCategory sets the value of Category.
+func (s *BomViolation) SetCategory(val string) {
+	s.Category = val
 }
 
-func (*ConflictError) cancelOrderRes() {}
-func (*ConflictError) payOrderRes()    {}
+// SetKind sets the value of Kind.
+func (s *BomViolation) SetKind(val BomViolationKind) {
+	s.Kind = val
+}
 
-// Ref: #/components/schemas/create_order_request
-type CreateOrderRequest struct {
-	// Уникальный идентификатор пользователя.
-	UserUUID uuid.UUID `json:"user_uuid"`
-	// Список UUID деталей.
-	PartUuids []uuid.UUID `json:"part_uuids"`
+// SetMin sets the value of Min.
+func (s *BomViolation) SetMin(val int64) {
+	s.Min = val
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *CreateOrderRequest) GetUserUUID() uuid.UUID {
-	return s.UserUUID
+// SetMax sets the value of Max.
+func (s *BomViolation) SetMax(val OptInt64) {
+	s.Max = val
 }
 
-// GetPartUuids returns the value of PartUuids.
-func (s *CreateOrderRequest) GetPartUuids() []uuid.UUID {
-	return s.PartUuids
+// SetActual sets the value of Actual.
+func (s *BomViolation) SetActual(val int64) {
+	s.Actual = val
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *CreateOrderRequest) SetUserUUID(val uuid.UUID) {
-	s.UserUUID = val
+// Вид нарушения:
+// - MISSING: деталей категории не хватает для сборки
+// - EXCESS: деталей категории больше, чем нужно, или
+// категория не используется в сборке.
+type BomViolationKind string
+
+const (
+	BomViolationKindMISSING BomViolationKind = "MISSING"
+	BomViolationKindEXCESS  BomViolationKind = "EXCESS"
+)
+
+// AllValues returns all BomViolationKind values.
+func (BomViolationKind) AllValues() []BomViolationKind {
+	return []BomViolationKind{
+		BomViolationKindMISSING,
+		BomViolationKindEXCESS,
+	}
 }
 
-// SetPartUuids sets the value of PartUuids.
-func (s *CreateOrderRequest) SetPartUuids(val []uuid.UUID) {
-	s.PartUuids = val
+// MarshalText implements encoding.TextMarshaler.
+func (s BomViolationKind) MarshalText() ([]byte, error) {
+	switch s {
+	case BomViolationKindMISSING:
+		return []byte(s), nil
+	case BomViolationKindEXCESS:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// Ref: #/components/schemas/create_order_response
-type CreateOrderResponse struct {
-	// Уникальный идентификатор заказа.
-	OrderUUID uuid.UUID `json:"order_uuid"`
-	// Итоговая стоимость заказа.
-	TotalPrice float64 `json:"total_price"`
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BomViolationKind) UnmarshalText(data []byte) error {
+	switch BomViolationKind(data) {
+	case BomViolationKindMISSING:
+		*s = BomViolationKindMISSING
+		return nil
+	case BomViolationKindEXCESS:
+		*s = BomViolationKindEXCESS
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetOrderUUID returns the value of OrderUUID.
-func (s *CreateOrderResponse) GetOrderUUID() uuid.UUID {
-	return s.OrderUUID
+// CancelOrderNoContent is response for CancelOrder operation.
+type CancelOrderNoContent struct{}
+
+func (*CancelOrderNoContent) cancelOrderRes() {}
+
+// Причина отмены заказа:
+// - CANCELLED_BY_CUSTOMER: Заказ отменён пользователем
+// - PAYMENT_TIMEOUT: Заказ не оплачен вовремя и отменён
+// автоматически.
+// Ref: #/components/schemas/cancel_reason
+type CancelReason string
+
+const (
+	CancelReasonCANCELLEDBYCUSTOMER CancelReason = "CANCELLED_BY_CUSTOMER"
+	CancelReasonPAYMENTTIMEOUT      CancelReason = "PAYMENT_TIMEOUT"
+)
+
+// AllValues returns all CancelReason values.
+func (CancelReason) AllValues() []CancelReason {
+	return []CancelReason{
+		CancelReasonCANCELLEDBYCUSTOMER,
+		CancelReasonPAYMENTTIMEOUT,
+	}
 }
 
-// GetTotalPrice returns the value of TotalPrice.
-func (s *CreateOrderResponse) GetTotalPrice() float64 {
-	return s.TotalPrice
+// MarshalText implements encoding.TextMarshaler.
+func (s CancelReason) MarshalText() ([]byte, error) {
+	switch s {
+	case CancelReasonCANCELLEDBYCUSTOMER:
+		return []byte(s), nil
+	case CancelReasonPAYMENTTIMEOUT:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetOrderUUID sets the value of OrderUUID.
-func (s *CreateOrderResponse) SetOrderUUID(val uuid.UUID) {
-	s.OrderUUID = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CancelReason) UnmarshalText(data []byte) error {
+	switch CancelReason(data) {
+	case CancelReasonCANCELLEDBYCUSTOMER:
+		*s = CancelReasonCANCELLEDBYCUSTOMER
+		return nil
+	case CancelReasonPAYMENTTIMEOUT:
+		*s = CancelReasonPAYMENTTIMEOUT
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetTotalPrice sets the value of TotalPrice.
-func (s *CreateOrderResponse) SetTotalPrice(val float64) {
-	s.TotalPrice = val
+// CheckoutDraftConflict represents sum type.
+type CheckoutDraftConflict struct {
+	Type                   CheckoutDraftConflictType // switch on this field
+	InsufficientStockError InsufficientStockError
+	ConflictError          ConflictError
 }
 
-func (*CreateOrderResponse) createOrderRes() {}
+// CheckoutDraftConflictType is oneOf type of CheckoutDraftConflict.
+type CheckoutDraftConflictType string
 
-// Ref: #/components/schemas/generic_error
-type GenericError struct {
-	// HTTP-код ошибки.
-	Code int `json:"code"`
-	// Что то пошло не так.
-	Message string `json:"message"`
-}
+// Possible values for CheckoutDraftConflictType.
+const (
+	InsufficientStockErrorCheckoutDraftConflict CheckoutDraftConflictType = "InsufficientStockError"
+	ConflictErrorCheckoutDraftConflict          CheckoutDraftConflictType = "ConflictError"
+)
 
-// GetCode returns the value of Code.
-func (s *GenericError) GetCode() int {
-	return s.Code
+// IsInsufficientStockError reports whether CheckoutDraftConflict is InsufficientStockError.
+func (s CheckoutDraftConflict) IsInsufficientStockError() bool {
+	return s.Type == InsufficientStockErrorCheckoutDraftConflict
 }
 
-// GetMessage returns the value of Message.
-func (s *GenericError) GetMessage() string {
-	return s.Message
+// IsConflictError reports whether CheckoutDraftConflict is ConflictError.
+func (s CheckoutDraftConflict) IsConflictError() bool {
+	return s.Type == ConflictErrorCheckoutDraftConflict
 }
 
-// SetCode sets the value of Code.
-func (s *GenericError) SetCode(val int) {
-	s.Code = val
+// SetInsufficientStockError sets CheckoutDraftConflict to InsufficientStockError.
+func (s *CheckoutDraftConflict) SetInsufficientStockError(v InsufficientStockError) {
+	s.Type = InsufficientStockErrorCheckoutDraftConflict
+	s.InsufficientStockError = v
 }
 
-// SetMessage sets the value of Message.
-func (s *GenericError) SetMessage(val string) {
-	s.Message = val
+// GetInsufficientStockError returns InsufficientStockError and true boolean if CheckoutDraftConflict is InsufficientStockError.
+func (s CheckoutDraftConflict) GetInsufficientStockError() (v InsufficientStockError, ok bool) {
+	if !s.IsInsufficientStockError() {
+		return v, false
+	}
+	return s.InsufficientStockError, true
 }
 
-// GenericErrorStatusCode wraps GenericError with StatusCode.
-type GenericErrorStatusCode struct {
-	StatusCode int
-	Response   GenericError
+// NewInsufficientStockErrorCheckoutDraftConflict returns new CheckoutDraftConflict from InsufficientStockError.
+func NewInsufficientStockErrorCheckoutDraftConflict(v InsufficientStockError) CheckoutDraftConflict {
+	var s CheckoutDraftConflict
+	s.SetInsufficientStockError(v)
+	return s
 }
 
-// GetStatusCode returns the value of StatusCode.
-func (s *GenericErrorStatusCode) GetStatusCode() int {
-	return s.StatusCode
+// SetConflictError sets CheckoutDraftConflict to ConflictError.
+func (s *CheckoutDraftConflict) SetConflictError(v ConflictError) {
+	s.Type = ConflictErrorCheckoutDraftConflict
+	s.ConflictError = v
 }
 
-// GetResponse returns the value of Response.
-func (s *GenericErrorStatusCode) GetResponse() GenericError {
-	return s.Response
+// GetConflictError returns ConflictError and true boolean if CheckoutDraftConflict is ConflictError.
+func (s CheckoutDraftConflict) GetConflictError() (v ConflictError, ok bool) {
+	if !s.IsConflictError() {
+		return v, false
+	}
+	return s.ConflictError, true
 }
 
-// SetStatusCode sets the value of StatusCode.
-func (s *GenericErrorStatusCode) SetStatusCode(val int) {
-	s.StatusCode = val
+// NewConflictErrorCheckoutDraftConflict returns new CheckoutDraftConflict from ConflictError.
+func NewConflictErrorCheckoutDraftConflict(v ConflictError) CheckoutDraftConflict {
+	var s CheckoutDraftConflict
+	s.SetConflictError(v)
+	return s
 }
 
-// SetResponse sets the value of Response.
-func (s *GenericErrorStatusCode) SetResponse(val GenericError) {
-	s.Response = val
+func (*CheckoutDraftConflict) checkoutDraftRes() {}
+
+// Ref: #/components/schemas/checkout_draft_request
+type CheckoutDraftRequest struct {
+	// Валюта заказа (код ISO 4217). По умолчанию - базовая валюта
+	// каталога.
+	Currency OptString `json:"currency"`
+	// Промокод на скидку.
+	PromoCode OptString `json:"promo_code"`
 }
 
-// Ref: #/components/schemas/get_order_response
-type GetOrderResponse struct {
-	Data OrderDto `json:"data"`
+// GetCurrency returns the value of Currency.
+func (s *CheckoutDraftRequest) GetCurrency() OptString {
+	return s.Currency
 }
 
-// GetData returns the value of Data.
-func (s *GetOrderResponse) GetData() OrderDto {
-	return s.Data
+// GetPromoCode returns the value of PromoCode.
+func (s *CheckoutDraftRequest) GetPromoCode() OptString {
+	return s.PromoCode
 }
 
-// SetData sets the value of Data.
-func (s *GetOrderResponse) SetData(val OrderDto) {
-	s.Data = val
+// SetCurrency sets the value of Currency.
+func (s *CheckoutDraftRequest) SetCurrency(val OptString) {
+	s.Currency = val
 }
 
-func (*GetOrderResponse) getOrderRes() {}
+// SetPromoCode sets the value of PromoCode.
+func (s *CheckoutDraftRequest) SetPromoCode(val OptString) {
+	s.PromoCode = val
+}
 
-// Ref: #/components/schemas/internal_server_error
-type InternalServerError struct {
+// Ref: #/components/schemas/conflict_error
+type ConflictError struct {
 	// HTTP-код ошибки.
-	Code int `json:"code"`
-	// Ошибка сервера.
+	Code      int       `json:"code"`
+	ErrorCode ErrorCode `json:"error_code"`
+	// Заказ уже оплачен и не может быть отменён.
 	Message string `json:"message"`
 }
 
 // GetCode returns the value of Code.
-func (s *InternalServerError) GetCode() int {
+func (s *ConflictError) GetCode() int {
 	return s.Code
 }
 
+// GetErrorCode returns the value of ErrorCode.
+func (s *ConflictError) GetErrorCode() ErrorCode {
+	return s.ErrorCode
+}
+
 // GetMessage returns the value of Message.
-func (s *InternalServerError) GetMessage() string {
+func (s *ConflictError) GetMessage() string {
 	return s.Message
 }
 
 // SetCode sets the value of Code.
-func (s *InternalServerError) SetCode(val int) {
+func (s *ConflictError) SetCode(val int) {
 	s.Code = val
 }
 
+// SetErrorCode sets the value of ErrorCode.
+func (s *ConflictError) SetErrorCode(val ErrorCode) {
+	s.ErrorCode = val
+}
+
 // SetMessage sets the value of Message.
-func (s *InternalServerError) SetMessage(val string) {
+func (s *ConflictError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*InternalServerError) cancelOrderRes() {}
-func (*InternalServerError) createOrderRes() {}
-func (*InternalServerError) getOrderRes()    {}
-func (*InternalServerError) payOrderRes()    {}
+func (*ConflictError) cancelOrderRes()     {}
+func (*ConflictError) getOrderInvoiceRes() {}
+func (*ConflictError) payOrderRes()        {}
+func (*ConflictError) quoteOrderRes()      {}
 
-// Ref: #/components/schemas/not_found_error
-type NotFoundError struct {
-	// HTTP-код ошибки.
-	Code int `json:"code"`
-	// Заказ не найден.
-	Message string `json:"message"`
+// CreateOrderConflict represents sum type.
+type CreateOrderConflict struct {
+	Type                   CreateOrderConflictType // switch on this field
+	InsufficientStockError InsufficientStockError
+	ConflictError          ConflictError
 }
 
-// GetCode returns the value of Code.
-func (s *NotFoundError) GetCode() int {
-	return s.Code
-}
+// CreateOrderConflictType is oneOf type of CreateOrderConflict.
+type CreateOrderConflictType string
 
-// GetMessage returns the value of Message.
-func (s *NotFoundError) GetMessage() string {
-	return s.Message
-}
+// Possible values for CreateOrderConflictType.
+const (
+	InsufficientStockErrorCreateOrderConflict CreateOrderConflictType = "InsufficientStockError"
+	ConflictErrorCreateOrderConflict          CreateOrderConflictType = "ConflictError"
+)
 
-// SetCode sets the value of Code.
-func (s *NotFoundError) SetCode(val int) {
-	s.Code = val
+// IsInsufficientStockError reports whether CreateOrderConflict is InsufficientStockError.
+func (s CreateOrderConflict) IsInsufficientStockError() bool {
+	return s.Type == InsufficientStockErrorCreateOrderConflict
 }
 
-// SetMessage sets the value of Message.
-func (s *NotFoundError) SetMessage(val string) {
-	s.Message = val
+// IsConflictError reports whether CreateOrderConflict is ConflictError.
+func (s CreateOrderConflict) IsConflictError() bool {
+	return s.Type == ConflictErrorCreateOrderConflict
 }
 
-func (*NotFoundError) cancelOrderRes() {}
-func (*NotFoundError) createOrderRes() {}
-func (*NotFoundError) getOrderRes()    {}
-func (*NotFoundError) payOrderRes()    {}
+// SetInsufficientStockError sets CreateOrderConflict to InsufficientStockError.
+func (s *CreateOrderConflict) SetInsufficientStockError(v InsufficientStockError) {
+	s.Type = InsufficientStockErrorCreateOrderConflict
+	s.InsufficientStockError = v
+}
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
-		Value: v,
-		Set:   true,
+// GetInsufficientStockError returns InsufficientStockError and true boolean if CreateOrderConflict is InsufficientStockError.
+func (s CreateOrderConflict) GetInsufficientStockError() (v InsufficientStockError, ok bool) {
+	if !s.IsInsufficientStockError() {
+		return v, false
 	}
+	return s.InsufficientStockError, true
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
-	Set   bool
+// NewInsufficientStockErrorCreateOrderConflict returns new CreateOrderConflict from InsufficientStockError.
+func NewInsufficientStockErrorCreateOrderConflict(v InsufficientStockError) CreateOrderConflict {
+	var s CreateOrderConflict
+	s.SetInsufficientStockError(v)
+	return s
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
+// SetConflictError sets CreateOrderConflict to ConflictError.
+func (s *CreateOrderConflict) SetConflictError(v ConflictError) {
+	s.Type = ConflictErrorCreateOrderConflict
+	s.ConflictError = v
+}
 
-// Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
+// GetConflictError returns ConflictError and true boolean if CreateOrderConflict is ConflictError.
+func (s CreateOrderConflict) GetConflictError() (v ConflictError, ok bool) {
+	if !s.IsConflictError() {
+		return v, false
+	}
+	return s.ConflictError, true
 }
 
-// SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
-	o.Set = true
-	o.Value = v
+// NewConflictErrorCreateOrderConflict returns new CreateOrderConflict from ConflictError.
+func NewConflictErrorCreateOrderConflict(v ConflictError) CreateOrderConflict {
+	var s CreateOrderConflict
+	s.SetConflictError(v)
+	return s
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+func (*CreateOrderConflict) createOrderRes() {}
+
+// Ref: #/components/schemas/create_order_item
+type CreateOrderItem struct {
+	// Уникальный идентификатор детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Количество деталей.
+	Quantity int64 `json:"quantity"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetPartUUID returns the value of PartUUID.
+func (s *CreateOrderItem) GetPartUUID() uuid.UUID {
+	return s.PartUUID
 }
 
-// NewOptPaymentMethod returns new OptPaymentMethod with value set to v.
-func NewOptPaymentMethod(v PaymentMethod) OptPaymentMethod {
-	return OptPaymentMethod{
-		Value: v,
-		Set:   true,
-	}
+// GetQuantity returns the value of Quantity.
+func (s *CreateOrderItem) GetQuantity() int64 {
+	return s.Quantity
 }
 
-// OptPaymentMethod is optional PaymentMethod.
-type OptPaymentMethod struct {
-	Value PaymentMethod
-	Set   bool
+// SetPartUUID sets the value of PartUUID.
+func (s *CreateOrderItem) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *CreateOrderItem) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// Ref: #/components/schemas/create_order_request
+type CreateOrderRequest struct {
+	// Позиции заказа.
+	Items []CreateOrderItem `json:"items"`
+	// Валюта заказа (код ISO 4217). По умолчанию - базовая валюта
+	// каталога.
+	Currency OptString `json:"currency"`
+	// Промокод на скидку.
+	PromoCode OptString `json:"promo_code"`
+}
+
+// GetItems returns the value of Items.
+func (s *CreateOrderRequest) GetItems() []CreateOrderItem {
+	return s.Items
+}
+
+// GetCurrency returns the value of Currency.
+func (s *CreateOrderRequest) GetCurrency() OptString {
+	return s.Currency
+}
+
+// GetPromoCode returns the value of PromoCode.
+func (s *CreateOrderRequest) GetPromoCode() OptString {
+	return s.PromoCode
+}
+
+// SetItems sets the value of Items.
+func (s *CreateOrderRequest) SetItems(val []CreateOrderItem) {
+	s.Items = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *CreateOrderRequest) SetCurrency(val OptString) {
+	s.Currency = val
+}
+
+// SetPromoCode sets the value of PromoCode.
+func (s *CreateOrderRequest) SetPromoCode(val OptString) {
+	s.PromoCode = val
+}
+
+// Ref: #/components/schemas/create_order_response
+type CreateOrderResponse struct {
+	// Уникальный идентификатор заказа.
+	OrderUUID  uuid.UUID `json:"order_uuid"`
+	TotalPrice Money     `json:"total_price"`
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *CreateOrderResponse) GetOrderUUID() uuid.UUID {
+	return s.OrderUUID
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *CreateOrderResponse) GetTotalPrice() Money {
+	return s.TotalPrice
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *CreateOrderResponse) SetOrderUUID(val uuid.UUID) {
+	s.OrderUUID = val
+}
+
+// SetTotalPrice sets the value of TotalPrice.
+func (s *CreateOrderResponse) SetTotalPrice(val Money) {
+	s.TotalPrice = val
+}
+
+func (*CreateOrderResponse) checkoutDraftRes() {}
+func (*CreateOrderResponse) createOrderRes()   {}
+
+// Ref: #/components/schemas/create_webhook_request
+type CreateWebhookRequest struct {
+	// Адрес, на который отправляются события (http или https).
+	URL string `json:"url"`
+	// Ключ подписи HMAC-SHA256. Сохраняется на сервере и больше
+	// не возвращается.
+	Secret string `json:"secret"`
+	// События, о которых нужно уведомлять.
+	EventTypes []WebhookEventType `json:"event_types"`
+}
+
+// GetURL returns the value of URL.
+func (s *CreateWebhookRequest) GetURL() string {
+	return s.URL
+}
+
+// GetSecret returns the value of Secret.
+func (s *CreateWebhookRequest) GetSecret() string {
+	return s.Secret
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *CreateWebhookRequest) GetEventTypes() []WebhookEventType {
+	return s.EventTypes
+}
+
+// SetURL sets the value of URL.
+func (s *CreateWebhookRequest) SetURL(val string) {
+	s.URL = val
+}
+
+// SetSecret sets the value of Secret.
+func (s *CreateWebhookRequest) SetSecret(val string) {
+	s.Secret = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *CreateWebhookRequest) SetEventTypes(val []WebhookEventType) {
+	s.EventTypes = val
+}
+
+// DeleteWebhookNoContent is response for DeleteWebhook operation.
+type DeleteWebhookNoContent struct{}
+
+func (*DeleteWebhookNoContent) deleteWebhookRes() {}
+
+// Машинно-читаемый код ошибки, не меняется между
+// версиями API:
+// - UNAUTHORIZED: Пользователь не авторизован
+// - ORDER_FORBIDDEN: Заказ принадлежит другому пользователю
+// - ORDER_NOT_FOUND: Заказ не найден
+// - DRAFT_NOT_FOUND: Черновик заказа не найден
+// - DRAFT_ITEM_NOT_FOUND: Детали нет в черновике
+// - PART_NOT_FOUND: Деталь не найдена
+// - PAYMENT_NOT_FOUND: Платёж не найден
+// - WEBHOOK_NOT_FOUND: Вебхук не найден
+// - RESOURCE_NOT_FOUND: Смежный сервис не нашёл запрошенный
+// ресурс
+// - INVALID_ITEMS: Некорректный состав заказа
+// - DRAFT_EMPTY: В черновике нет деталей
+// - INVALID_FILTER: Некорректные параметры фильтрации
+// - INVALID_CURSOR: Некорректный курсор страницы
+// - INVALID_WEBHOOK: Некорректные параметры вебхука
+// - CURRENCY_NOT_SUPPORTED: Валюта не поддерживается
+// - PROMO_CODE_NOT_FOUND: Промокод не найден
+// - PROMO_CODE_INACTIVE: Промокод не действует
+// - PROMO_CODE_NOT_APPLICABLE: Промокод не подходит к заказу
+// - PROMO_CODE_EXHAUSTED: Лимит использований промокода исчерпан
+// - ORDER_INVALID_STATUS: Операция недоступна в текущем статусе
+// заказа
+// - ORDER_CONFLICT: Конфликт при изменении заказа
+// - INVOICE_NOT_AVAILABLE: Счёт доступен только для оплаченного
+// заказа
+// - IDEMPOTENCY_KEY_REUSED: Ключ идемпотентности использован с
+// другим запросом
+// - IDEMPOTENCY_KEY_IN_PROGRESS: Запрос с ключом идемпотентности ещё
+// выполняется
+// - RATE_LIMITED: Превышен лимит частоты запросов
+// - INSUFFICIENT_STOCK: Недостаточно деталей на складе
+// - BOM_INVALID: Детали не складываются в комплектную ракету
+// - INVALID_REQUEST: Смежный сервис отклонил запрос
+// - INTERNAL_ERROR: Внутренняя ошибка сервера
+// - BAD_GATEWAY: Смежный сервис вернул ошибку
+// - SERVICE_UNAVAILABLE: Смежный сервис недоступен.
+// Ref: #/components/schemas/error_code
+type ErrorCode string
+
+const (
+	ErrorCodeUNAUTHORIZED             ErrorCode = "UNAUTHORIZED"
+	ErrorCodeORDERFORBIDDEN           ErrorCode = "ORDER_FORBIDDEN"
+	ErrorCodeADMINREQUIRED            ErrorCode = "ADMIN_REQUIRED"
+	ErrorCodeORDERNOTFOUND            ErrorCode = "ORDER_NOT_FOUND"
+	ErrorCodeDRAFTNOTFOUND            ErrorCode = "DRAFT_NOT_FOUND"
+	ErrorCodeDRAFTITEMNOTFOUND        ErrorCode = "DRAFT_ITEM_NOT_FOUND"
+	ErrorCodePARTNOTFOUND             ErrorCode = "PART_NOT_FOUND"
+	ErrorCodePAYMENTNOTFOUND          ErrorCode = "PAYMENT_NOT_FOUND"
+	ErrorCodeWEBHOOKNOTFOUND          ErrorCode = "WEBHOOK_NOT_FOUND"
+	ErrorCodeRESOURCENOTFOUND         ErrorCode = "RESOURCE_NOT_FOUND"
+	ErrorCodeINVALIDITEMS             ErrorCode = "INVALID_ITEMS"
+	ErrorCodeDRAFTEMPTY               ErrorCode = "DRAFT_EMPTY"
+	ErrorCodeINVALIDFILTER            ErrorCode = "INVALID_FILTER"
+	ErrorCodeINVALIDCURSOR            ErrorCode = "INVALID_CURSOR"
+	ErrorCodeINVALIDWEBHOOK           ErrorCode = "INVALID_WEBHOOK"
+	ErrorCodeINVALIDREPORT            ErrorCode = "INVALID_REPORT"
+	ErrorCodeCURRENCYNOTSUPPORTED     ErrorCode = "CURRENCY_NOT_SUPPORTED"
+	ErrorCodePROMOCODENOTFOUND        ErrorCode = "PROMO_CODE_NOT_FOUND"
+	ErrorCodePROMOCODEINACTIVE        ErrorCode = "PROMO_CODE_INACTIVE"
+	ErrorCodePROMOCODENOTAPPLICABLE   ErrorCode = "PROMO_CODE_NOT_APPLICABLE"
+	ErrorCodePROMOCODEEXHAUSTED       ErrorCode = "PROMO_CODE_EXHAUSTED"
+	ErrorCodeORDERINVALIDSTATUS       ErrorCode = "ORDER_INVALID_STATUS"
+	ErrorCodeORDERCONFLICT            ErrorCode = "ORDER_CONFLICT"
+	ErrorCodeINVOICENOTAVAILABLE      ErrorCode = "INVOICE_NOT_AVAILABLE"
+	ErrorCodeIDEMPOTENCYKEYREUSED     ErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	ErrorCodeIDEMPOTENCYKEYINPROGRESS ErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	ErrorCodeRATELIMITED              ErrorCode = "RATE_LIMITED"
+	ErrorCodeINSUFFICIENTSTOCK        ErrorCode = "INSUFFICIENT_STOCK"
+	ErrorCodeBOMINVALID               ErrorCode = "BOM_INVALID"
+	ErrorCodeINVALIDREQUEST           ErrorCode = "INVALID_REQUEST"
+	ErrorCodeINTERNALERROR            ErrorCode = "INTERNAL_ERROR"
+	ErrorCodeBADGATEWAY               ErrorCode = "BAD_GATEWAY"
+	ErrorCodeSERVICEUNAVAILABLE       ErrorCode = "SERVICE_UNAVAILABLE"
+)
+
+// AllValues returns all ErrorCode values.
+func (ErrorCode) AllValues() []ErrorCode {
+	return []ErrorCode{
+		ErrorCodeUNAUTHORIZED,
+		ErrorCodeORDERFORBIDDEN,
+		ErrorCodeADMINREQUIRED,
+		ErrorCodeORDERNOTFOUND,
+		ErrorCodeDRAFTNOTFOUND,
+		ErrorCodeDRAFTITEMNOTFOUND,
+		ErrorCodePARTNOTFOUND,
+		ErrorCodePAYMENTNOTFOUND,
+		ErrorCodeWEBHOOKNOTFOUND,
+		ErrorCodeRESOURCENOTFOUND,
+		ErrorCodeINVALIDITEMS,
+		ErrorCodeDRAFTEMPTY,
+		ErrorCodeINVALIDFILTER,
+		ErrorCodeINVALIDCURSOR,
+		ErrorCodeINVALIDWEBHOOK,
+		ErrorCodeINVALIDREPORT,
+		ErrorCodeCURRENCYNOTSUPPORTED,
+		ErrorCodePROMOCODENOTFOUND,
+		ErrorCodePROMOCODEINACTIVE,
+		ErrorCodePROMOCODENOTAPPLICABLE,
+		ErrorCodePROMOCODEEXHAUSTED,
+		ErrorCodeORDERINVALIDSTATUS,
+		ErrorCodeORDERCONFLICT,
+		ErrorCodeINVOICENOTAVAILABLE,
+		ErrorCodeIDEMPOTENCYKEYREUSED,
+		ErrorCodeIDEMPOTENCYKEYINPROGRESS,
+		ErrorCodeRATELIMITED,
+		ErrorCodeINSUFFICIENTSTOCK,
+		ErrorCodeBOMINVALID,
+		ErrorCodeINVALIDREQUEST,
+		ErrorCodeINTERNALERROR,
+		ErrorCodeBADGATEWAY,
+		ErrorCodeSERVICEUNAVAILABLE,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ErrorCode) MarshalText() ([]byte, error) {
+	switch s {
+	case ErrorCodeUNAUTHORIZED:
+		return []byte(s), nil
+	case ErrorCodeORDERFORBIDDEN:
+		return []byte(s), nil
+	case ErrorCodeADMINREQUIRED:
+		return []byte(s), nil
+	case ErrorCodeORDERNOTFOUND:
+		return []byte(s), nil
+	case ErrorCodeDRAFTNOTFOUND:
+		return []byte(s), nil
+	case ErrorCodeDRAFTITEMNOTFOUND:
+		return []byte(s), nil
+	case ErrorCodePARTNOTFOUND:
+		return []byte(s), nil
+	case ErrorCodePAYMENTNOTFOUND:
+		return []byte(s), nil
+	case ErrorCodeWEBHOOKNOTFOUND:
+		return []byte(s), nil
+	case ErrorCodeRESOURCENOTFOUND:
+		return []byte(s), nil
+	case ErrorCodeINVALIDITEMS:
+		return []byte(s), nil
+	case ErrorCodeDRAFTEMPTY:
+		return []byte(s), nil
+	case ErrorCodeINVALIDFILTER:
+		return []byte(s), nil
+	case ErrorCodeINVALIDCURSOR:
+		return []byte(s), nil
+	case ErrorCodeINVALIDWEBHOOK:
+		return []byte(s), nil
+	case ErrorCodeINVALIDREPORT:
+		return []byte(s), nil
+	case ErrorCodeCURRENCYNOTSUPPORTED:
+		return []byte(s), nil
+	case ErrorCodePROMOCODENOTFOUND:
+		return []byte(s), nil
+	case ErrorCodePROMOCODEINACTIVE:
+		return []byte(s), nil
+	case ErrorCodePROMOCODENOTAPPLICABLE:
+		return []byte(s), nil
+	case ErrorCodePROMOCODEEXHAUSTED:
+		return []byte(s), nil
+	case ErrorCodeORDERINVALIDSTATUS:
+		return []byte(s), nil
+	case ErrorCodeORDERCONFLICT:
+		return []byte(s), nil
+	case ErrorCodeINVOICENOTAVAILABLE:
+		return []byte(s), nil
+	case ErrorCodeIDEMPOTENCYKEYREUSED:
+		return []byte(s), nil
+	case ErrorCodeIDEMPOTENCYKEYINPROGRESS:
+		return []byte(s), nil
+	case ErrorCodeRATELIMITED:
+		return []byte(s), nil
+	case ErrorCodeINSUFFICIENTSTOCK:
+		return []byte(s), nil
+	case ErrorCodeBOMINVALID:
+		return []byte(s), nil
+	case ErrorCodeINVALIDREQUEST:
+		return []byte(s), nil
+	case ErrorCodeINTERNALERROR:
+		return []byte(s), nil
+	case ErrorCodeBADGATEWAY:
+		return []byte(s), nil
+	case ErrorCodeSERVICEUNAVAILABLE:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ErrorCode) UnmarshalText(data []byte) error {
+	switch ErrorCode(data) {
+	case ErrorCodeUNAUTHORIZED:
+		*s = ErrorCodeUNAUTHORIZED
+		return nil
+	case ErrorCodeORDERFORBIDDEN:
+		*s = ErrorCodeORDERFORBIDDEN
+		return nil
+	case ErrorCodeADMINREQUIRED:
+		*s = ErrorCodeADMINREQUIRED
+		return nil
+	case ErrorCodeORDERNOTFOUND:
+		*s = ErrorCodeORDERNOTFOUND
+		return nil
+	case ErrorCodeDRAFTNOTFOUND:
+		*s = ErrorCodeDRAFTNOTFOUND
+		return nil
+	case ErrorCodeDRAFTITEMNOTFOUND:
+		*s = ErrorCodeDRAFTITEMNOTFOUND
+		return nil
+	case ErrorCodePARTNOTFOUND:
+		*s = ErrorCodePARTNOTFOUND
+		return nil
+	case ErrorCodePAYMENTNOTFOUND:
+		*s = ErrorCodePAYMENTNOTFOUND
+		return nil
+	case ErrorCodeWEBHOOKNOTFOUND:
+		*s = ErrorCodeWEBHOOKNOTFOUND
+		return nil
+	case ErrorCodeRESOURCENOTFOUND:
+		*s = ErrorCodeRESOURCENOTFOUND
+		return nil
+	case ErrorCodeINVALIDITEMS:
+		*s = ErrorCodeINVALIDITEMS
+		return nil
+	case ErrorCodeDRAFTEMPTY:
+		*s = ErrorCodeDRAFTEMPTY
+		return nil
+	case ErrorCodeINVALIDFILTER:
+		*s = ErrorCodeINVALIDFILTER
+		return nil
+	case ErrorCodeINVALIDCURSOR:
+		*s = ErrorCodeINVALIDCURSOR
+		return nil
+	case ErrorCodeINVALIDWEBHOOK:
+		*s = ErrorCodeINVALIDWEBHOOK
+		return nil
+	case ErrorCodeINVALIDREPORT:
+		*s = ErrorCodeINVALIDREPORT
+		return nil
+	case ErrorCodeCURRENCYNOTSUPPORTED:
+		*s = ErrorCodeCURRENCYNOTSUPPORTED
+		return nil
+	case ErrorCodePROMOCODENOTFOUND:
+		*s = ErrorCodePROMOCODENOTFOUND
+		return nil
+	case ErrorCodePROMOCODEINACTIVE:
+		*s = ErrorCodePROMOCODEINACTIVE
+		return nil
+	case ErrorCodePROMOCODENOTAPPLICABLE:
+		*s = ErrorCodePROMOCODENOTAPPLICABLE
+		return nil
+	case ErrorCodePROMOCODEEXHAUSTED:
+		*s = ErrorCodePROMOCODEEXHAUSTED
+		return nil
+	case ErrorCodeORDERINVALIDSTATUS:
+		*s = ErrorCodeORDERINVALIDSTATUS
+		return nil
+	case ErrorCodeORDERCONFLICT:
+		*s = ErrorCodeORDERCONFLICT
+		return nil
+	case ErrorCodeINVOICENOTAVAILABLE:
+		*s = ErrorCodeINVOICENOTAVAILABLE
+		return nil
+	case ErrorCodeIDEMPOTENCYKEYREUSED:
+		*s = ErrorCodeIDEMPOTENCYKEYREUSED
+		return nil
+	case ErrorCodeIDEMPOTENCYKEYINPROGRESS:
+		*s = ErrorCodeIDEMPOTENCYKEYINPROGRESS
+		return nil
+	case ErrorCodeRATELIMITED:
+		*s = ErrorCodeRATELIMITED
+		return nil
+	case ErrorCodeINSUFFICIENTSTOCK:
+		*s = ErrorCodeINSUFFICIENTSTOCK
+		return nil
+	case ErrorCodeBOMINVALID:
+		*s = ErrorCodeBOMINVALID
+		return nil
+	case ErrorCodeINVALIDREQUEST:
+		*s = ErrorCodeINVALIDREQUEST
+		return nil
+	case ErrorCodeINTERNALERROR:
+		*s = ErrorCodeINTERNALERROR
+		return nil
+	case ErrorCodeBADGATEWAY:
+		*s = ErrorCodeBADGATEWAY
+		return nil
+	case ErrorCodeSERVICEUNAVAILABLE:
+		*s = ErrorCodeSERVICEUNAVAILABLE
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Курс пересчёта цен каталога в валюту заказа,
+// зафиксированный при оформлении.
+// Ref: #/components/schemas/exchange_rate
+type ExchangeRate struct {
+	// Базовая валюта каталога.
+	BaseCurrency string `json:"base_currency"`
+	// Валюта заказа.
+	Currency string `json:"currency"`
+	// Сколько единиц валюты заказа дают за единицу базовой
+	// валюты (десятичная дробь).
+	Rate string `json:"rate"`
+}
+
+// GetBaseCurrency returns the value of BaseCurrency.
+func (s *ExchangeRate) GetBaseCurrency() string {
+	return s.BaseCurrency
+}
+
+// GetCurrency returns the value of Currency.
+func (s *ExchangeRate) GetCurrency() string {
+	return s.Currency
+}
+
+// GetRate returns the value of Rate.
+func (s *ExchangeRate) GetRate() string {
+	return s.Rate
+}
+
+// SetBaseCurrency sets the value of BaseCurrency.
+func (s *ExchangeRate) SetBaseCurrency(val string) {
+	s.BaseCurrency = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *ExchangeRate) SetCurrency(val string) {
+	s.Currency = val
+}
+
+// SetRate sets the value of Rate.
+func (s *ExchangeRate) SetRate(val string) {
+	s.Rate = val
+}
+
+// Ref: #/components/schemas/forbidden_error
+type ForbiddenError struct {
+	// HTTP-код ошибки.
+	Code      int       `json:"code"`
+	ErrorCode ErrorCode `json:"error_code"`
+	// Доступ запрещён.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *ForbiddenError) GetCode() int {
+	return s.Code
+}
+
+// GetErrorCode returns the value of ErrorCode.
+func (s *ForbiddenError) GetErrorCode() ErrorCode {
+	return s.ErrorCode
+}
+
+// GetMessage returns the value of Message.
+func (s *ForbiddenError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *ForbiddenError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetErrorCode sets the value of ErrorCode.
+func (s *ForbiddenError) SetErrorCode(val ErrorCode) {
+	s.ErrorCode = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ForbiddenError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*ForbiddenError) cancelOrderRes()     {}
+func (*ForbiddenError) getOrderHistoryRes() {}
+func (*ForbiddenError) getOrderInvoiceRes() {}
+func (*ForbiddenError) getOrderRes()        {}
+func (*ForbiddenError) getOrdersReportRes() {}
+func (*ForbiddenError) payOrderRes()        {}
+
+// Ref: #/components/schemas/generic_error
+type GenericError struct {
+	// HTTP-код ошибки.
+	Code      int       `json:"code"`
+	ErrorCode ErrorCode `json:"error_code"`
+	// Что то пошло не так.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *GenericError) GetCode() int {
+	return s.Code
+}
+
+// GetErrorCode returns the value of ErrorCode.
+func (s *GenericError) GetErrorCode() ErrorCode {
+	return s.ErrorCode
+}
+
+// GetMessage returns the value of Message.
+func (s *GenericError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *GenericError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetErrorCode sets the value of ErrorCode.
+func (s *GenericError) SetErrorCode(val ErrorCode) {
+	s.ErrorCode = val
+}
+
+// SetMessage sets the value of Message.
+func (s *GenericError) SetMessage(val string) {
+	s.Message = val
+}
+
+// GenericErrorStatusCode wraps GenericError with StatusCode.
+type GenericErrorStatusCode struct {
+	StatusCode int
+	Response   GenericError
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *GenericErrorStatusCode) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// GetResponse returns the value of Response.
+func (s *GenericErrorStatusCode) GetResponse() GenericError {
+	return s.Response
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *GenericErrorStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GenericErrorStatusCode) SetResponse(val GenericError) {
+	s.Response = val
+}
+
+// Ref: #/components/schemas/get_order_history_response
+type GetOrderHistoryResponse struct {
+	// Изменения статуса заказа в хронологическом порядке.
+	Data []OrderStatusChange `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *GetOrderHistoryResponse) GetData() []OrderStatusChange {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *GetOrderHistoryResponse) SetData(val []OrderStatusChange) {
+	s.Data = val
+}
+
+func (*GetOrderHistoryResponse) getOrderHistoryRes() {}
+
+type GetOrderInvoiceOKApplicationPdf struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetOrderInvoiceOKApplicationPdf) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetOrderInvoiceOKApplicationPdf) getOrderInvoiceRes() {}
+
+type GetOrderInvoiceOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetOrderInvoiceOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetOrderInvoiceOKTextHTML) getOrderInvoiceRes() {}
+
+// Ref: #/components/schemas/get_order_response
+type GetOrderResponse struct {
+	Data OrderDto `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *GetOrderResponse) GetData() OrderDto {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *GetOrderResponse) SetData(val OrderDto) {
+	s.Data = val
+}
+
+func (*GetOrderResponse) addDraftItemRes()         {}
+func (*GetOrderResponse) getDraftRes()             {}
+func (*GetOrderResponse) getOrderRes()             {}
+func (*GetOrderResponse) removeDraftItemRes()      {}
+func (*GetOrderResponse) setDraftItemQuantityRes() {}
+
+// Ref: #/components/schemas/insufficient_stock_error
+type InsufficientStockError struct {
+	// HTTP-код ошибки.
+	Code      int       `json:"code"`
+	ErrorCode ErrorCode `json:"error_code"`
+	// Описание ошибки.
+	Message string `json:"message"`
+	// Детали, которых не хватает на складе.
+	ShortParts []InsufficientStockErrorShortPartsItem `json:"short_parts"`
+}
+
+// GetCode returns the value of Code.
+func (s *InsufficientStockError) GetCode() int {
+	return s.Code
+}
+
+// GetErrorCode returns the value of ErrorCode.
+func (s *InsufficientStockError) GetErrorCode() ErrorCode {
+	return s.ErrorCode
+}
+
+// GetMessage returns the value of Message.
+func (s *InsufficientStockError) GetMessage() string {
+	return s.Message
+}
+
+// GetShortParts returns the value of ShortParts.
+func (s *InsufficientStockError) GetShortParts() []InsufficientStockErrorShortPartsItem {
+	return s.ShortParts
+}
+
+// SetCode sets the value of Code.
+func (s *InsufficientStockError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetErrorCode sets the value of ErrorCode.
+func (s *InsufficientStockError) SetErrorCode(val ErrorCode) {
+	s.ErrorCode = val
+}
+
+// SetMessage sets the value of Message.
+func (s *InsufficientStockError) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetShortParts sets the value of ShortParts.
+func (s *InsufficientStockError) SetShortParts(val []InsufficientStockErrorShortPartsItem) {
+	s.ShortParts = val
+}
+
+type InsufficientStockErrorShortPartsItem struct {
+	// Уникальный идентификатор детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Запрошенное количество.
+	Requested int64 `json:"requested"`
+	// Доступное количество.
+	Available int64 `json:"available"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *InsufficientStockErrorShortPartsItem) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetRequested returns the value of Requested.
+func (s *InsufficientStockErrorShortPartsItem) GetRequested() int64 {
+	return s.Requested
+}
+
+// GetAvailable returns the value of Available.
+func (s *InsufficientStockErrorShortPartsItem) GetAvailable() int64 {
+	return s.Available
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *InsufficientStockErrorShortPartsItem) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetRequested sets the value of Requested.
+func (s *InsufficientStockErrorShortPartsItem) SetRequested(val int64) {
+	s.Requested = val
+}
+
+// SetAvailable sets the value of Available.
+func (s *InsufficientStockErrorShortPartsItem) SetAvailable(val int64) {
+	s.Available = val
+}
+
+// Ref: #/components/schemas/internal_server_error
+type InternalServerError struct {
+	// HTTP-код ошибки.
+	Code      int       `json:"code"`
+	ErrorCode ErrorCode `json:"error_code"`
+	// Ошибка сервера.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *InternalServerError) GetCode() int {
+	return s.Code
+}
+
+// GetErrorCode returns the value of ErrorCode.
+func (s *InternalServerError) GetErrorCode() ErrorCode {
+	return s.ErrorCode
+}
+
+// GetMessage returns the value of Message.
+func (s *InternalServerError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *InternalServerError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetErrorCode sets the value of ErrorCode.
+func (s *InternalServerError) SetErrorCode(val ErrorCode) {
+	s.ErrorCode = val
+}
+
+// SetMessage sets the value of Message.
+func (s *InternalServerError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*InternalServerError) addDraftItemRes()          {}
+func (*InternalServerError) cancelOrderRes()           {}
+func (*InternalServerError) checkoutDraftRes()         {}
+func (*InternalServerError) createOrderRes()           {}
+func (*InternalServerError) createWebhookRes()         {}
+func (*InternalServerError) deleteWebhookRes()         {}
+func (*InternalServerError) enableWebhookRes()         {}
+func (*InternalServerError) getDraftRes()              {}
+func (*InternalServerError) getOrderHistoryRes()       {}
+func (*InternalServerError) getOrderInvoiceRes()       {}
+func (*InternalServerError) getOrderRes()              {}
+func (*InternalServerError) getOrdersReportRes()       {}
+func (*InternalServerError) listOrdersRes()            {}
+func (*InternalServerError) listWebhookDeliveriesRes() {}
+func (*InternalServerError) listWebhooksRes()          {}
+func (*InternalServerError) payOrderRes()              {}
+func (*InternalServerError) quoteOrderRes()            {}
+func (*InternalServerError) removeDraftItemRes()       {}
+func (*InternalServerError) setDraftItemQuantityRes()  {}
+
+// Формат счёта:
+// - HTML: Страница для просмотра в браузере
+// - PDF: Документ для печати и бухгалтерии.
+// Ref: #/components/schemas/invoice_format
+type InvoiceFormat string
+
+const (
+	InvoiceFormatHTML InvoiceFormat = "HTML"
+	InvoiceFormatPDF  InvoiceFormat = "PDF"
+)
+
+// AllValues returns all InvoiceFormat values.
+func (InvoiceFormat) AllValues() []InvoiceFormat {
+	return []InvoiceFormat{
+		InvoiceFormatHTML,
+		InvoiceFormatPDF,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s InvoiceFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case InvoiceFormatHTML:
+		return []byte(s), nil
+	case InvoiceFormatPDF:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *InvoiceFormat) UnmarshalText(data []byte) error {
+	switch InvoiceFormat(data) {
+	case InvoiceFormatHTML:
+		*s = InvoiceFormatHTML
+		return nil
+	case InvoiceFormatPDF:
+		*s = InvoiceFormatPDF
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/list_orders_response
+type ListOrdersResponse struct {
+	// Страница заказов пользователя.
+	Data []OrderDto `json:"data"`
+	// Курсор следующей страницы, отсутствует на последней
+	// странице.
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetData returns the value of Data.
+func (s *ListOrdersResponse) GetData() []OrderDto {
+	return s.Data
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListOrdersResponse) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetData sets the value of Data.
+func (s *ListOrdersResponse) SetData(val []OrderDto) {
+	s.Data = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ListOrdersResponse) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*ListOrdersResponse) listOrdersRes() {}
+
+// Ref: #/components/schemas/list_webhook_deliveries_response
+type ListWebhookDeliveriesResponse struct {
+	Data []WebhookDelivery `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *ListWebhookDeliveriesResponse) GetData() []WebhookDelivery {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *ListWebhookDeliveriesResponse) SetData(val []WebhookDelivery) {
+	s.Data = val
+}
+
+func (*ListWebhookDeliveriesResponse) listWebhookDeliveriesRes() {}
+
+// Ref: #/components/schemas/list_webhooks_response
+type ListWebhooksResponse struct {
+	Data []WebhookDto `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *ListWebhooksResponse) GetData() []WebhookDto {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *ListWebhooksResponse) SetData(val []WebhookDto) {
+	s.Data = val
+}
+
+func (*ListWebhooksResponse) listWebhooksRes() {}
+
+// Денежная сумма в минимальных единицах валюты.
+// Ref: #/components/schemas/money
+type Money struct {
+	// Сумма в минимальных единицах валюты (копейках,
+	// центах), 12345 RUB - это 123.45 ₽.
+	Amount int64 `json:"amount"`
+	// Код валюты ISO 4217.
+	Currency string `json:"currency"`
+}
+
+// GetAmount returns the value of Amount.
+func (s *Money) GetAmount() int64 {
+	return s.Amount
+}
+
+// GetCurrency returns the value of Currency.
+func (s *Money) GetCurrency() string {
+	return s.Currency
+}
+
+// SetAmount sets the value of Amount.
+func (s *Money) SetAmount(val int64) {
+	s.Amount = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *Money) SetCurrency(val string) {
+	s.Currency = val
+}
+
+// Ref: #/components/schemas/not_found_error
+type NotFoundError struct {
+	// HTTP-код ошибки.
+	Code      int       `json:"code"`
+	ErrorCode ErrorCode `json:"error_code"`
+	// Заказ не найден.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *NotFoundError) GetCode() int {
+	return s.Code
+}
+
+// GetErrorCode returns the value of ErrorCode.
+func (s *NotFoundError) GetErrorCode() ErrorCode {
+	return s.ErrorCode
+}
+
+// GetMessage returns the value of Message.
+func (s *NotFoundError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *NotFoundError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetErrorCode sets the value of ErrorCode.
+func (s *NotFoundError) SetErrorCode(val ErrorCode) {
+	s.ErrorCode = val
+}
+
+// SetMessage sets the value of Message.
+func (s *NotFoundError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*NotFoundError) addDraftItemRes()          {}
+func (*NotFoundError) cancelOrderRes()           {}
+func (*NotFoundError) checkoutDraftRes()         {}
+func (*NotFoundError) createOrderRes()           {}
+func (*NotFoundError) deleteWebhookRes()         {}
+func (*NotFoundError) enableWebhookRes()         {}
+func (*NotFoundError) getDraftRes()              {}
+func (*NotFoundError) getOrderHistoryRes()       {}
+func (*NotFoundError) getOrderInvoiceRes()       {}
+func (*NotFoundError) getOrderRes()              {}
+func (*NotFoundError) listWebhookDeliveriesRes() {}
+func (*NotFoundError) payOrderRes()              {}
+func (*NotFoundError) quoteOrderRes()            {}
+func (*NotFoundError) removeDraftItemRes()       {}
+func (*NotFoundError) setDraftItemQuantityRes()  {}
+
+// NewOptCancelReason returns new OptCancelReason with value set to v.
+func NewOptCancelReason(v CancelReason) OptCancelReason {
+	return OptCancelReason{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCancelReason is optional CancelReason.
+type OptCancelReason struct {
+	Value CancelReason
+	Set   bool
+}
+
+// IsSet returns true if OptCancelReason was set.
+func (o OptCancelReason) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCancelReason) Reset() {
+	var v CancelReason
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCancelReason) SetTo(v CancelReason) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCancelReason) Get() (v CancelReason, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCancelReason) Or(d CancelReason) CancelReason {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInvoiceFormat returns new OptInvoiceFormat with value set to v.
+func NewOptInvoiceFormat(v InvoiceFormat) OptInvoiceFormat {
+	return OptInvoiceFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInvoiceFormat is optional InvoiceFormat.
+type OptInvoiceFormat struct {
+	Value InvoiceFormat
+	Set   bool
+}
+
+// IsSet returns true if OptInvoiceFormat was set.
+func (o OptInvoiceFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInvoiceFormat) Reset() {
+	var v InvoiceFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInvoiceFormat) SetTo(v InvoiceFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInvoiceFormat) Get() (v InvoiceFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInvoiceFormat) Or(d InvoiceFormat) InvoiceFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOrderStatus returns new OptOrderStatus with value set to v.
+func NewOptOrderStatus(v OrderStatus) OptOrderStatus {
+	return OptOrderStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrderStatus is optional OrderStatus.
+type OptOrderStatus struct {
+	Value OrderStatus
+	Set   bool
+}
+
+// IsSet returns true if OptOrderStatus was set.
+func (o OptOrderStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrderStatus) Reset() {
+	var v OrderStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrderStatus) SetTo(v OrderStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrderStatus) Get() (v OrderStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrderStatus) Or(d OrderStatus) OrderStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOrdersSortBy returns new OptOrdersSortBy with value set to v.
+func NewOptOrdersSortBy(v OrdersSortBy) OptOrdersSortBy {
+	return OptOrdersSortBy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrdersSortBy is optional OrdersSortBy.
+type OptOrdersSortBy struct {
+	Value OrdersSortBy
+	Set   bool
+}
+
+// IsSet returns true if OptOrdersSortBy was set.
+func (o OptOrdersSortBy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrdersSortBy) Reset() {
+	var v OrdersSortBy
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrdersSortBy) SetTo(v OrdersSortBy) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrdersSortBy) Get() (v OrdersSortBy, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrdersSortBy) Or(d OrdersSortBy) OrdersSortBy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPaymentMethod returns new OptPaymentMethod with value set to v.
+func NewOptPaymentMethod(v PaymentMethod) OptPaymentMethod {
+	return OptPaymentMethod{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPaymentMethod is optional PaymentMethod.
+type OptPaymentMethod struct {
+	Value PaymentMethod
+	Set   bool
 }
 
 // IsSet returns true if OptPaymentMethod was set.
 func (o OptPaymentMethod) IsSet() bool { return o.Set }
 
-// Reset unsets value.
-func (o *OptPaymentMethod) Reset() {
-	var v PaymentMethod
-	o.Value = v
-	o.Set = false
+// Reset unsets value.
+func (o *OptPaymentMethod) Reset() {
+	var v PaymentMethod
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPaymentMethod) SetTo(v PaymentMethod) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPaymentMethod) Get() (v PaymentMethod, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPaymentMethod) Or(d PaymentMethod) PaymentMethod {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSortOrder returns new OptSortOrder with value set to v.
+func NewOptSortOrder(v SortOrder) OptSortOrder {
+	return OptSortOrder{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSortOrder is optional SortOrder.
+type OptSortOrder struct {
+	Value SortOrder
+	Set   bool
+}
+
+// IsSet returns true if OptSortOrder was set.
+func (o OptSortOrder) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSortOrder) Reset() {
+	var v SortOrder
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSortOrder) SetTo(v SortOrder) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSortOrder) Get() (v SortOrder, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSortOrder) Or(d SortOrder) SortOrder {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/order_discount
+type OrderDiscount struct {
+	// Применённый промокод.
+	PromoCode string `json:"promo_code"`
+	// Описание скидки.
+	Description string `json:"description"`
+	Amount      Money  `json:"amount"`
+}
+
+// GetPromoCode returns the value of PromoCode.
+func (s *OrderDiscount) GetPromoCode() string {
+	return s.PromoCode
+}
+
+// GetDescription returns the value of Description.
+func (s *OrderDiscount) GetDescription() string {
+	return s.Description
+}
+
+// GetAmount returns the value of Amount.
+func (s *OrderDiscount) GetAmount() Money {
+	return s.Amount
+}
+
+// SetPromoCode sets the value of PromoCode.
+func (s *OrderDiscount) SetPromoCode(val string) {
+	s.PromoCode = val
+}
+
+// SetDescription sets the value of Description.
+func (s *OrderDiscount) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *OrderDiscount) SetAmount(val Money) {
+	s.Amount = val
+}
+
+// Ref: #/components/schemas/order_dto
+type OrderDto struct {
+	// Уникальный идентификатор заказа.
+	OrderUUID uuid.UUID `json:"order_uuid"`
+	// Уникальный идентификатор пользователя.
+	UserUUID uuid.UUID `json:"user_uuid"`
+	// Позиции заказа.
+	Items      []OrderItem `json:"items"`
+	TotalPrice Money       `json:"total_price"`
+	// Применённые скидки; total_price указан с их учётом.
+	Discounts    []OrderDiscount `json:"discounts"`
+	ExchangeRate ExchangeRate    `json:"exchange_rate"`
+	// Уникальный идентификатор транзакции.
+	TransactionUUID OptUUID          `json:"transaction_uuid"`
+	PaymentMethod   OptPaymentMethod `json:"payment_method"`
+	Status          OrderStatus      `json:"status"`
+	CancelReason    OptCancelReason  `json:"cancel_reason"`
+	// Дата и время создания заказа.
+	CreatedAt time.Time `json:"created_at"`
+	// Дата и время последнего обновления заказа.
+	UpdatedAt OptDateTime `json:"updated_at"`
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *OrderDto) GetOrderUUID() uuid.UUID {
+	return s.OrderUUID
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *OrderDto) GetUserUUID() uuid.UUID {
+	return s.UserUUID
+}
+
+// GetItems returns the value of Items.
+func (s *OrderDto) GetItems() []OrderItem {
+	return s.Items
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *OrderDto) GetTotalPrice() Money {
+	return s.TotalPrice
+}
+
+// GetDiscounts returns the value of Discounts.
+func (s *OrderDto) GetDiscounts() []OrderDiscount {
+	return s.Discounts
+}
+
+// GetExchangeRate returns the value of ExchangeRate.
+func (s *OrderDto) GetExchangeRate() ExchangeRate {
+	return s.ExchangeRate
+}
+
+// GetTransactionUUID returns the value of TransactionUUID.
+func (s *OrderDto) GetTransactionUUID() OptUUID {
+	return s.TransactionUUID
+}
+
+// GetPaymentMethod returns the value of PaymentMethod.
+func (s *OrderDto) GetPaymentMethod() OptPaymentMethod {
+	return s.PaymentMethod
+}
+
+// GetStatus returns the value of Status.
+func (s *OrderDto) GetStatus() OrderStatus {
+	return s.Status
+}
+
+// GetCancelReason returns the value of CancelReason.
+func (s *OrderDto) GetCancelReason() OptCancelReason {
+	return s.CancelReason
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OrderDto) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *OrderDto) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *OrderDto) SetOrderUUID(val uuid.UUID) {
+	s.OrderUUID = val
+}
+
+// SetUserUUID sets the value of UserUUID.
+func (s *OrderDto) SetUserUUID(val uuid.UUID) {
+	s.UserUUID = val
+}
+
+// SetItems sets the value of Items.
+func (s *OrderDto) SetItems(val []OrderItem) {
+	s.Items = val
+}
+
+// SetTotalPrice sets the value of TotalPrice.
+func (s *OrderDto) SetTotalPrice(val Money) {
+	s.TotalPrice = val
+}
+
+// SetDiscounts sets the value of Discounts.
+func (s *OrderDto) SetDiscounts(val []OrderDiscount) {
+	s.Discounts = val
+}
+
+// SetExchangeRate sets the value of ExchangeRate.
+func (s *OrderDto) SetExchangeRate(val ExchangeRate) {
+	s.ExchangeRate = val
+}
+
+// SetTransactionUUID sets the value of TransactionUUID.
+func (s *OrderDto) SetTransactionUUID(val OptUUID) {
+	s.TransactionUUID = val
+}
+
+// SetPaymentMethod sets the value of PaymentMethod.
+func (s *OrderDto) SetPaymentMethod(val OptPaymentMethod) {
+	s.PaymentMethod = val
+}
+
+// SetStatus sets the value of Status.
+func (s *OrderDto) SetStatus(val OrderStatus) {
+	s.Status = val
+}
+
+// SetCancelReason sets the value of CancelReason.
+func (s *OrderDto) SetCancelReason(val OptCancelReason) {
+	s.CancelReason = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OrderDto) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *OrderDto) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// Ref: #/components/schemas/order_item
+type OrderItem struct {
+	// Уникальный идентификатор детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Название детали на момент оформления заказа.
+	Name string `json:"name"`
+	// Категория детали на момент оформления заказа.
+	Category string `json:"category"`
+	// Количество деталей.
+	Quantity  int64 `json:"quantity"`
+	UnitPrice Money `json:"unit_price"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderItem) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetName returns the value of Name.
+func (s *OrderItem) GetName() string {
+	return s.Name
+}
+
+// GetCategory returns the value of Category.
+func (s *OrderItem) GetCategory() string {
+	return s.Category
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OrderItem) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// GetUnitPrice returns the value of UnitPrice.
+func (s *OrderItem) GetUnitPrice() Money {
+	return s.UnitPrice
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderItem) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetName sets the value of Name.
+func (s *OrderItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetCategory sets the value of Category.
+func (s *OrderItem) SetCategory(val string) {
+	s.Category = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OrderItem) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// SetUnitPrice sets the value of UnitPrice.
+func (s *OrderItem) SetUnitPrice(val Money) {
+	s.UnitPrice = val
+}
+
+// Статус платежа:
+// - DRAFT: Черновик (корзина), ещё не оформлен
+// - PENDING_PAYMENT: Ожидает оплаты
+// - PAID: Оплачен
+// - ASSEMBLING: Собирается
+// - CANCELLED: Отменён
+// - COMPLETED: Завершён
+// - REFUNDING: Отменён после оплаты, возврат денег ещё не
+// завершён
+// - REFUNDED: Отменён после оплаты, деньги возвращены.
+// Ref: #/components/schemas/order_status
+type OrderStatus string
+
+const (
+	OrderStatusDRAFT          OrderStatus = "DRAFT"
+	OrderStatusPENDINGPAYMENT OrderStatus = "PENDING_PAYMENT"
+	OrderStatusPAID           OrderStatus = "PAID"
+	OrderStatusASSEMBLING     OrderStatus = "ASSEMBLING"
+	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
+	OrderStatusCOMPLETED      OrderStatus = "COMPLETED"
+	OrderStatusREFUNDING      OrderStatus = "REFUNDING"
+	OrderStatusREFUNDED       OrderStatus = "REFUNDED"
+)
+
+// AllValues returns all OrderStatus values.
+func (OrderStatus) AllValues() []OrderStatus {
+	return []OrderStatus{
+		OrderStatusDRAFT,
+		OrderStatusPENDINGPAYMENT,
+		OrderStatusPAID,
+		OrderStatusASSEMBLING,
+		OrderStatusCANCELLED,
+		OrderStatusCOMPLETED,
+		OrderStatusREFUNDING,
+		OrderStatusREFUNDED,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OrderStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case OrderStatusDRAFT:
+		return []byte(s), nil
+	case OrderStatusPENDINGPAYMENT:
+		return []byte(s), nil
+	case OrderStatusPAID:
+		return []byte(s), nil
+	case OrderStatusASSEMBLING:
+		return []byte(s), nil
+	case OrderStatusCANCELLED:
+		return []byte(s), nil
+	case OrderStatusCOMPLETED:
+		return []byte(s), nil
+	case OrderStatusREFUNDING:
+		return []byte(s), nil
+	case OrderStatusREFUNDED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OrderStatus) UnmarshalText(data []byte) error {
+	switch OrderStatus(data) {
+	case OrderStatusDRAFT:
+		*s = OrderStatusDRAFT
+		return nil
+	case OrderStatusPENDINGPAYMENT:
+		*s = OrderStatusPENDINGPAYMENT
+		return nil
+	case OrderStatusPAID:
+		*s = OrderStatusPAID
+		return nil
+	case OrderStatusASSEMBLING:
+		*s = OrderStatusASSEMBLING
+		return nil
+	case OrderStatusCANCELLED:
+		*s = OrderStatusCANCELLED
+		return nil
+	case OrderStatusCOMPLETED:
+		*s = OrderStatusCOMPLETED
+		return nil
+	case OrderStatusREFUNDING:
+		*s = OrderStatusREFUNDING
+		return nil
+	case OrderStatusREFUNDED:
+		*s = OrderStatusREFUNDED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/order_status_change
+type OrderStatusChange struct {
+	FromStatus OptOrderStatus `json:"from_status"`
+	ToStatus   OrderStatus    `json:"to_status"`
+	ActorType  ActorType      `json:"actor_type"`
+	// UUID пользователя для USER или UUID события Kafka для SYSTEM.
+	ActorID string `json:"actor_id"`
+	// Причина изменения статуса.
+	Reason string `json:"reason"`
+	// Дата и время изменения статуса.
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// GetFromStatus returns the value of FromStatus.
+func (s *OrderStatusChange) GetFromStatus() OptOrderStatus {
+	return s.FromStatus
+}
+
+// GetToStatus returns the value of ToStatus.
+func (s *OrderStatusChange) GetToStatus() OrderStatus {
+	return s.ToStatus
+}
+
+// GetActorType returns the value of ActorType.
+func (s *OrderStatusChange) GetActorType() ActorType {
+	return s.ActorType
+}
+
+// GetActorID returns the value of ActorID.
+func (s *OrderStatusChange) GetActorID() string {
+	return s.ActorID
+}
+
+// GetReason returns the value of Reason.
+func (s *OrderStatusChange) GetReason() string {
+	return s.Reason
+}
+
+// GetChangedAt returns the value of ChangedAt.
+func (s *OrderStatusChange) GetChangedAt() time.Time {
+	return s.ChangedAt
+}
+
+// SetFromStatus sets the value of FromStatus.
+func (s *OrderStatusChange) SetFromStatus(val OptOrderStatus) {
+	s.FromStatus = val
+}
+
+// SetToStatus sets the value of ToStatus.
+func (s *OrderStatusChange) SetToStatus(val OrderStatus) {
+	s.ToStatus = val
+}
+
+// SetActorType sets the value of ActorType.
+func (s *OrderStatusChange) SetActorType(val ActorType) {
+	s.ActorType = val
+}
+
+// SetActorID sets the value of ActorID.
+func (s *OrderStatusChange) SetActorID(val string) {
+	s.ActorID = val
+}
+
+// SetReason sets the value of Reason.
+func (s *OrderStatusChange) SetReason(val string) {
+	s.Reason = val
+}
+
+// SetChangedAt sets the value of ChangedAt.
+func (s *OrderStatusChange) SetChangedAt(val time.Time) {
+	s.ChangedAt = val
+}
+
+// Ref: #/components/schemas/orders_report_response
+type OrdersReportResponse struct {
+	From    time.Time         `json:"from"`
+	To      time.Time         `json:"to"`
+	GroupBy []ReportGroupBy   `json:"group_by"`
+	Data    []OrdersReportRow `json:"data"`
+}
+
+// GetFrom returns the value of From.
+func (s *OrdersReportResponse) GetFrom() time.Time {
+	return s.From
+}
+
+// GetTo returns the value of To.
+func (s *OrdersReportResponse) GetTo() time.Time {
+	return s.To
+}
+
+// GetGroupBy returns the value of GroupBy.
+func (s *OrdersReportResponse) GetGroupBy() []ReportGroupBy {
+	return s.GroupBy
+}
+
+// GetData returns the value of Data.
+func (s *OrdersReportResponse) GetData() []OrdersReportRow {
+	return s.Data
+}
+
+// SetFrom sets the value of From.
+func (s *OrdersReportResponse) SetFrom(val time.Time) {
+	s.From = val
+}
+
+// SetTo sets the value of To.
+func (s *OrdersReportResponse) SetTo(val time.Time) {
+	s.To = val
+}
+
+// SetGroupBy sets the value of GroupBy.
+func (s *OrdersReportResponse) SetGroupBy(val []ReportGroupBy) {
+	s.GroupBy = val
+}
+
+// SetData sets the value of Data.
+func (s *OrdersReportResponse) SetData(val []OrdersReportRow) {
+	s.Data = val
+}
+
+func (*OrdersReportResponse) getOrdersReportRes() {}
+
+// Агрегаты по группе заказов. Суммы разных валют не
+// складываются, поэтому строки всегда разбиты по
+// валюте. Выручка - заказы в статусах PAID, ASSEMBLING и COMPLETED.
+// Ref: #/components/schemas/orders_report_row
+type OrdersReportRow struct {
+	// Начало периода, только при группировке по DAY, WEEK или MONTH.
+	PeriodStart   OptDateTime      `json:"period_start"`
+	Status        OptOrderStatus   `json:"status"`
+	PaymentMethod OptPaymentMethod `json:"payment_method"`
+	// Код валюты ISO 4217.
+	Currency string `json:"currency"`
+	// Все оформленные заказы группы, черновики не
+	// учитываются.
+	OrdersCount int64 `json:"orders_count"`
+	// Заказы, принёсшие выручку.
+	PaidOrdersCount   int64 `json:"paid_orders_count"`
+	Revenue           Money `json:"revenue"`
+	AverageOrderValue Money `json:"average_order_value"`
+	// Заказы, отменённые до оплаты.
+	CancelledCount int64 `json:"cancelled_count"`
+	// Заказы, отменённые после оплаты с возвратом денег.
+	RefundedCount int64 `json:"refunded_count"`
+	// Доля отменённых до оплаты заказов от всех заказов
+	// группы, от 0 до 1.
+	CancellationRate float64 `json:"cancellation_rate"`
+}
+
+// GetPeriodStart returns the value of PeriodStart.
+func (s *OrdersReportRow) GetPeriodStart() OptDateTime {
+	return s.PeriodStart
+}
+
+// GetStatus returns the value of Status.
+func (s *OrdersReportRow) GetStatus() OptOrderStatus {
+	return s.Status
+}
+
+// GetPaymentMethod returns the value of PaymentMethod.
+func (s *OrdersReportRow) GetPaymentMethod() OptPaymentMethod {
+	return s.PaymentMethod
+}
+
+// GetCurrency returns the value of Currency.
+func (s *OrdersReportRow) GetCurrency() string {
+	return s.Currency
+}
+
+// GetOrdersCount returns the value of OrdersCount.
+func (s *OrdersReportRow) GetOrdersCount() int64 {
+	return s.OrdersCount
+}
+
+// GetPaidOrdersCount returns the value of PaidOrdersCount.
+func (s *OrdersReportRow) GetPaidOrdersCount() int64 {
+	return s.PaidOrdersCount
+}
+
+// GetRevenue returns the value of Revenue.
+func (s *OrdersReportRow) GetRevenue() Money {
+	return s.Revenue
+}
+
+// GetAverageOrderValue returns the value of AverageOrderValue.
+func (s *OrdersReportRow) GetAverageOrderValue() Money {
+	return s.AverageOrderValue
+}
+
+// GetCancelledCount returns the value of CancelledCount.
+func (s *OrdersReportRow) GetCancelledCount() int64 {
+	return s.CancelledCount
+}
+
+// GetRefundedCount returns the value of RefundedCount.
+func (s *OrdersReportRow) GetRefundedCount() int64 {
+	return s.RefundedCount
+}
+
+// GetCancellationRate returns the value of CancellationRate.
+func (s *OrdersReportRow) GetCancellationRate() float64 {
+	return s.CancellationRate
+}
+
+// SetPeriodStart sets the value of PeriodStart.
+func (s *OrdersReportRow) SetPeriodStart(val OptDateTime) {
+	s.PeriodStart = val
+}
+
+// SetStatus sets the value of Status.
+func (s *OrdersReportRow) SetStatus(val OptOrderStatus) {
+	s.Status = val
+}
+
+// SetPaymentMethod sets the value of PaymentMethod.
+func (s *OrdersReportRow) SetPaymentMethod(val OptPaymentMethod) {
+	s.PaymentMethod = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *OrdersReportRow) SetCurrency(val string) {
+	s.Currency = val
+}
+
+// SetOrdersCount sets the value of OrdersCount.
+func (s *OrdersReportRow) SetOrdersCount(val int64) {
+	s.OrdersCount = val
+}
+
+// SetPaidOrdersCount sets the value of PaidOrdersCount.
+func (s *OrdersReportRow) SetPaidOrdersCount(val int64) {
+	s.PaidOrdersCount = val
+}
+
+// SetRevenue sets the value of Revenue.
+func (s *OrdersReportRow) SetRevenue(val Money) {
+	s.Revenue = val
+}
+
+// SetAverageOrderValue sets the value of AverageOrderValue.
+func (s *OrdersReportRow) SetAverageOrderValue(val Money) {
+	s.AverageOrderValue = val
+}
+
+// SetCancelledCount sets the value of CancelledCount.
+func (s *OrdersReportRow) SetCancelledCount(val int64) {
+	s.CancelledCount = val
+}
+
+// SetRefundedCount sets the value of RefundedCount.
+func (s *OrdersReportRow) SetRefundedCount(val int64) {
+	s.RefundedCount = val
+}
+
+// SetCancellationRate sets the value of CancellationRate.
+func (s *OrdersReportRow) SetCancellationRate(val float64) {
+	s.CancellationRate = val
+}
+
+// Поле сортировки списка заказов:
+// - CREATED_AT: По дате создания
+// - TOTAL_PRICE: По итоговой стоимости.
+// Ref: #/components/schemas/orders_sort_by
+type OrdersSortBy string
+
+const (
+	OrdersSortByCREATEDAT  OrdersSortBy = "CREATED_AT"
+	OrdersSortByTOTALPRICE OrdersSortBy = "TOTAL_PRICE"
+)
+
+// AllValues returns all OrdersSortBy values.
+func (OrdersSortBy) AllValues() []OrdersSortBy {
+	return []OrdersSortBy{
+		OrdersSortByCREATEDAT,
+		OrdersSortByTOTALPRICE,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OrdersSortBy) MarshalText() ([]byte, error) {
+	switch s {
+	case OrdersSortByCREATEDAT:
+		return []byte(s), nil
+	case OrdersSortByTOTALPRICE:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OrdersSortBy) UnmarshalText(data []byte) error {
+	switch OrdersSortBy(data) {
+	case OrdersSortByCREATEDAT:
+		*s = OrdersSortByCREATEDAT
+		return nil
+	case OrdersSortByTOTALPRICE:
+		*s = OrdersSortByTOTALPRICE
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/pay_order_request
+type PayOrderRequest struct {
+	PaymentMethod PaymentMethod `json:"payment_method"`
+}
+
+// GetPaymentMethod returns the value of PaymentMethod.
+func (s *PayOrderRequest) GetPaymentMethod() PaymentMethod {
+	return s.PaymentMethod
+}
+
+// SetPaymentMethod sets the value of PaymentMethod.
+func (s *PayOrderRequest) SetPaymentMethod(val PaymentMethod) {
+	s.PaymentMethod = val
+}
+
+// Ref: #/components/schemas/pay_order_response
+type PayOrderResponse struct {
+	// Уникальный идентификатор транзакции.
+	TransactionUUID uuid.UUID `json:"transaction_uuid"`
+}
+
+// GetTransactionUUID returns the value of TransactionUUID.
+func (s *PayOrderResponse) GetTransactionUUID() uuid.UUID {
+	return s.TransactionUUID
+}
+
+// SetTransactionUUID sets the value of TransactionUUID.
+func (s *PayOrderResponse) SetTransactionUUID(val uuid.UUID) {
+	s.TransactionUUID = val
+}
+
+func (*PayOrderResponse) payOrderRes() {}
+
+// Способ оплаты.
+// Ref: #/components/schemas/payment_method
+type PaymentMethod string
+
+const (
+	PaymentMethodUNKNOWN       PaymentMethod = "UNKNOWN"
+	PaymentMethodCARD          PaymentMethod = "CARD"
+	PaymentMethodSBP           PaymentMethod = "SBP"
+	PaymentMethodCREDITCARD    PaymentMethod = "CREDIT_CARD"
+	PaymentMethodINVESTORMONEY PaymentMethod = "INVESTOR_MONEY"
+)
+
+// AllValues returns all PaymentMethod values.
+func (PaymentMethod) AllValues() []PaymentMethod {
+	return []PaymentMethod{
+		PaymentMethodUNKNOWN,
+		PaymentMethodCARD,
+		PaymentMethodSBP,
+		PaymentMethodCREDITCARD,
+		PaymentMethodINVESTORMONEY,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PaymentMethod) MarshalText() ([]byte, error) {
+	switch s {
+	case PaymentMethodUNKNOWN:
+		return []byte(s), nil
+	case PaymentMethodCARD:
+		return []byte(s), nil
+	case PaymentMethodSBP:
+		return []byte(s), nil
+	case PaymentMethodCREDITCARD:
+		return []byte(s), nil
+	case PaymentMethodINVESTORMONEY:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PaymentMethod) UnmarshalText(data []byte) error {
+	switch PaymentMethod(data) {
+	case PaymentMethodUNKNOWN:
+		*s = PaymentMethodUNKNOWN
+		return nil
+	case PaymentMethodCARD:
+		*s = PaymentMethodCARD
+		return nil
+	case PaymentMethodSBP:
+		*s = PaymentMethodSBP
+		return nil
+	case PaymentMethodCREDITCARD:
+		*s = PaymentMethodCREDITCARD
+		return nil
+	case PaymentMethodINVESTORMONEY:
+		*s = PaymentMethodINVESTORMONEY
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetTo sets value to v.
-func (o *OptPaymentMethod) SetTo(v PaymentMethod) {
-	o.Set = true
-	o.Value = v
+// Ref: #/components/schemas/quote_item
+type QuoteItem struct {
+	// Уникальный идентификатор детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Название детали.
+	Name string `json:"name"`
+	// Категория детали.
+	Category string `json:"category"`
+	// Запрошенное количество.
+	Quantity  int64 `json:"quantity"`
+	UnitPrice Money `json:"unit_price"`
+	LineTotal Money `json:"line_total"`
+	// Количество детали на складе.
+	AvailableQuantity int64 `json:"available_quantity"`
+	// Хватает ли детали на складе для заказа.
+	InStock bool `json:"in_stock"`
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptPaymentMethod) Get() (v PaymentMethod, ok bool) {
-	if !o.Set {
-		return v, false
+// GetPartUUID returns the value of PartUUID.
+func (s *QuoteItem) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetName returns the value of Name.
+func (s *QuoteItem) GetName() string {
+	return s.Name
+}
+
+// GetCategory returns the value of Category.
+func (s *QuoteItem) GetCategory() string {
+	return s.Category
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *QuoteItem) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// GetUnitPrice returns the value of UnitPrice.
+func (s *QuoteItem) GetUnitPrice() Money {
+	return s.UnitPrice
+}
+
+// GetLineTotal returns the value of LineTotal.
+func (s *QuoteItem) GetLineTotal() Money {
+	return s.LineTotal
+}
+
+// GetAvailableQuantity returns the value of AvailableQuantity.
+func (s *QuoteItem) GetAvailableQuantity() int64 {
+	return s.AvailableQuantity
+}
+
+// GetInStock returns the value of InStock.
+func (s *QuoteItem) GetInStock() bool {
+	return s.InStock
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *QuoteItem) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetName sets the value of Name.
+func (s *QuoteItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetCategory sets the value of Category.
+func (s *QuoteItem) SetCategory(val string) {
+	s.Category = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *QuoteItem) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// SetUnitPrice sets the value of UnitPrice.
+func (s *QuoteItem) SetUnitPrice(val Money) {
+	s.UnitPrice = val
+}
+
+// SetLineTotal sets the value of LineTotal.
+func (s *QuoteItem) SetLineTotal(val Money) {
+	s.LineTotal = val
+}
+
+// SetAvailableQuantity sets the value of AvailableQuantity.
+func (s *QuoteItem) SetAvailableQuantity(val int64) {
+	s.AvailableQuantity = val
+}
+
+// SetInStock sets the value of InStock.
+func (s *QuoteItem) SetInStock(val bool) {
+	s.InStock = val
+}
+
+// Ref: #/components/schemas/quote_order_response
+type QuoteOrderResponse struct {
+	// Позиции заказа с ценами и наличием на складе.
+	Items    []QuoteItem `json:"items"`
+	Subtotal Money       `json:"subtotal"`
+	// Скидки, которые будут применены к заказу.
+	Discounts    []OrderDiscount `json:"discounts"`
+	TotalPrice   Money           `json:"total_price"`
+	ExchangeRate ExchangeRate    `json:"exchange_rate"`
+}
+
+// GetItems returns the value of Items.
+func (s *QuoteOrderResponse) GetItems() []QuoteItem {
+	return s.Items
+}
+
+// GetSubtotal returns the value of Subtotal.
+func (s *QuoteOrderResponse) GetSubtotal() Money {
+	return s.Subtotal
+}
+
+// GetDiscounts returns the value of Discounts.
+func (s *QuoteOrderResponse) GetDiscounts() []OrderDiscount {
+	return s.Discounts
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *QuoteOrderResponse) GetTotalPrice() Money {
+	return s.TotalPrice
+}
+
+// GetExchangeRate returns the value of ExchangeRate.
+func (s *QuoteOrderResponse) GetExchangeRate() ExchangeRate {
+	return s.ExchangeRate
+}
+
+// SetItems sets the value of Items.
+func (s *QuoteOrderResponse) SetItems(val []QuoteItem) {
+	s.Items = val
+}
+
+// SetSubtotal sets the value of Subtotal.
+func (s *QuoteOrderResponse) SetSubtotal(val Money) {
+	s.Subtotal = val
+}
+
+// SetDiscounts sets the value of Discounts.
+func (s *QuoteOrderResponse) SetDiscounts(val []OrderDiscount) {
+	s.Discounts = val
+}
+
+// SetTotalPrice sets the value of TotalPrice.
+func (s *QuoteOrderResponse) SetTotalPrice(val Money) {
+	s.TotalPrice = val
+}
+
+// SetExchangeRate sets the value of ExchangeRate.
+func (s *QuoteOrderResponse) SetExchangeRate(val ExchangeRate) {
+	s.ExchangeRate = val
+}
+
+func (*QuoteOrderResponse) quoteOrderRes() {}
+
+// Ref: #/components/schemas/rate_limit_error
+type RateLimitError struct {
+	// HTTP-код ошибки.
+	Code      int       `json:"code"`
+	ErrorCode ErrorCode `json:"error_code"`
+	// Слишком много запросов.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *RateLimitError) GetCode() int {
+	return s.Code
+}
+
+// GetErrorCode returns the value of ErrorCode.
+func (s *RateLimitError) GetErrorCode() ErrorCode {
+	return s.ErrorCode
+}
+
+// GetMessage returns the value of Message.
+func (s *RateLimitError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *RateLimitError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetErrorCode sets the value of ErrorCode.
+func (s *RateLimitError) SetErrorCode(val ErrorCode) {
+	s.ErrorCode = val
+}
+
+// SetMessage sets the value of Message.
+func (s *RateLimitError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*RateLimitError) addDraftItemRes()          {}
+func (*RateLimitError) cancelOrderRes()           {}
+func (*RateLimitError) checkoutDraftRes()         {}
+func (*RateLimitError) createOrderRes()           {}
+func (*RateLimitError) createWebhookRes()         {}
+func (*RateLimitError) deleteWebhookRes()         {}
+func (*RateLimitError) enableWebhookRes()         {}
+func (*RateLimitError) getDraftRes()              {}
+func (*RateLimitError) getOrderHistoryRes()       {}
+func (*RateLimitError) getOrderInvoiceRes()       {}
+func (*RateLimitError) getOrderRes()              {}
+func (*RateLimitError) getOrdersReportRes()       {}
+func (*RateLimitError) listOrdersRes()            {}
+func (*RateLimitError) listWebhookDeliveriesRes() {}
+func (*RateLimitError) listWebhooksRes()          {}
+func (*RateLimitError) payOrderRes()              {}
+func (*RateLimitError) quoteOrderRes()            {}
+func (*RateLimitError) removeDraftItemRes()       {}
+func (*RateLimitError) setDraftItemQuantityRes()  {}
+
+// Разрез отчёта: DAY, WEEK, MONTH - по периоду создания заказа
+// (UTC, неделя начинается с понедельника), STATUS - по
+// текущему статусу, PAYMENT_METHOD - по способу оплаты.
+// Ref: #/components/schemas/report_group_by
+type ReportGroupBy string
+
+const (
+	ReportGroupByDAY           ReportGroupBy = "DAY"
+	ReportGroupByWEEK          ReportGroupBy = "WEEK"
+	ReportGroupByMONTH         ReportGroupBy = "MONTH"
+	ReportGroupBySTATUS        ReportGroupBy = "STATUS"
+	ReportGroupByPAYMENTMETHOD ReportGroupBy = "PAYMENT_METHOD"
+)
+
+// AllValues returns all ReportGroupBy values.
+func (ReportGroupBy) AllValues() []ReportGroupBy {
+	return []ReportGroupBy{
+		ReportGroupByDAY,
+		ReportGroupByWEEK,
+		ReportGroupByMONTH,
+		ReportGroupBySTATUS,
+		ReportGroupByPAYMENTMETHOD,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptPaymentMethod) Or(d PaymentMethod) PaymentMethod {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// MarshalText implements encoding.TextMarshaler.
+func (s ReportGroupBy) MarshalText() ([]byte, error) {
+	switch s {
+	case ReportGroupByDAY:
+		return []byte(s), nil
+	case ReportGroupByWEEK:
+		return []byte(s), nil
+	case ReportGroupByMONTH:
+		return []byte(s), nil
+	case ReportGroupBySTATUS:
+		return []byte(s), nil
+	case ReportGroupByPAYMENTMETHOD:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReportGroupBy) UnmarshalText(data []byte) error {
+	switch ReportGroupBy(data) {
+	case ReportGroupByDAY:
+		*s = ReportGroupByDAY
+		return nil
+	case ReportGroupByWEEK:
+		*s = ReportGroupByWEEK
+		return nil
+	case ReportGroupByMONTH:
+		*s = ReportGroupByMONTH
+		return nil
+	case ReportGroupBySTATUS:
+		*s = ReportGroupBySTATUS
+		return nil
+	case ReportGroupByPAYMENTMETHOD:
+		*s = ReportGroupByPAYMENTMETHOD
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/service_unavailable_error
+type ServiceUnavailableError struct {
+	// HTTP-код ошибки.
+	Code      int       `json:"code"`
+	ErrorCode ErrorCode `json:"error_code"`
+	// Сервис не доступен.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *ServiceUnavailableError) GetCode() int {
+	return s.Code
+}
+
+// GetErrorCode returns the value of ErrorCode.
+func (s *ServiceUnavailableError) GetErrorCode() ErrorCode {
+	return s.ErrorCode
+}
+
+// GetMessage returns the value of Message.
+func (s *ServiceUnavailableError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *ServiceUnavailableError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetErrorCode sets the value of ErrorCode.
+func (s *ServiceUnavailableError) SetErrorCode(val ErrorCode) {
+	s.ErrorCode = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ServiceUnavailableError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*ServiceUnavailableError) cancelOrderRes()     {}
+func (*ServiceUnavailableError) createOrderRes()     {}
+func (*ServiceUnavailableError) getOrderInvoiceRes() {}
+func (*ServiceUnavailableError) getOrderRes()        {}
+func (*ServiceUnavailableError) payOrderRes()        {}
+
+// Ref: #/components/schemas/set_draft_item_request
+type SetDraftItemRequest struct {
+	// Новое количество детали в черновике. 0 убирает деталь
+	// из черновика.
+	Quantity int64 `json:"quantity"`
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *SetDraftItemRequest) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *SetDraftItemRequest) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// Направление сортировки:
+// - ASC: По возрастанию
+// - DESC: По убыванию.
+// Ref: #/components/schemas/sort_order
+type SortOrder string
+
+const (
+	SortOrderASC  SortOrder = "ASC"
+	SortOrderDESC SortOrder = "DESC"
+)
+
+// AllValues returns all SortOrder values.
+func (SortOrder) AllValues() []SortOrder {
+	return []SortOrder{
+		SortOrderASC,
+		SortOrderDESC,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SortOrder) MarshalText() ([]byte, error) {
+	switch s {
+	case SortOrderASC:
+		return []byte(s), nil
+	case SortOrderDESC:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SortOrder) UnmarshalText(data []byte) error {
+	switch SortOrder(data) {
+	case SortOrderASC:
+		*s = SortOrderASC
+		return nil
+	case SortOrderDESC:
+		*s = SortOrderDESC
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/unauthorized_error
+type UnauthorizedError struct {
+	// HTTP-код ошибки.
+	Code      int       `json:"code"`
+	ErrorCode ErrorCode `json:"error_code"`
+	// Не авторизован.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *UnauthorizedError) GetCode() int {
+	return s.Code
+}
+
+// GetErrorCode returns the value of ErrorCode.
+func (s *UnauthorizedError) GetErrorCode() ErrorCode {
+	return s.ErrorCode
+}
+
+// GetMessage returns the value of Message.
+func (s *UnauthorizedError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *UnauthorizedError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetErrorCode sets the value of ErrorCode.
+func (s *UnauthorizedError) SetErrorCode(val ErrorCode) {
+	s.ErrorCode = val
+}
+
+// SetMessage sets the value of Message.
+func (s *UnauthorizedError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*UnauthorizedError) addDraftItemRes()          {}
+func (*UnauthorizedError) cancelOrderRes()           {}
+func (*UnauthorizedError) checkoutDraftRes()         {}
+func (*UnauthorizedError) createOrderRes()           {}
+func (*UnauthorizedError) createWebhookRes()         {}
+func (*UnauthorizedError) deleteWebhookRes()         {}
+func (*UnauthorizedError) enableWebhookRes()         {}
+func (*UnauthorizedError) getDraftRes()              {}
+func (*UnauthorizedError) getOrderHistoryRes()       {}
+func (*UnauthorizedError) getOrderInvoiceRes()       {}
+func (*UnauthorizedError) getOrderRes()              {}
+func (*UnauthorizedError) getOrdersReportRes()       {}
+func (*UnauthorizedError) listOrdersRes()            {}
+func (*UnauthorizedError) listWebhookDeliveriesRes() {}
+func (*UnauthorizedError) listWebhooksRes()          {}
+func (*UnauthorizedError) payOrderRes()              {}
+func (*UnauthorizedError) quoteOrderRes()            {}
+func (*UnauthorizedError) removeDraftItemRes()       {}
+func (*UnauthorizedError) setDraftItemQuantityRes()  {}
+
+// Ref: #/components/schemas/validation_error
+type ValidationError struct {
+	// HTTP-код ошибки.
+	Code      int       `json:"code"`
+	ErrorCode ErrorCode `json:"error_code"`
+	// Описание валидации.
+	Message string `json:"message"`
+	// Нарушения правил сборки ракеты.
+	Violations []BomViolation `json:"violations"`
+}
+
+// GetCode returns the value of Code.
+func (s *ValidationError) GetCode() int {
+	return s.Code
+}
+
+// GetErrorCode returns the value of ErrorCode.
+func (s *ValidationError) GetErrorCode() ErrorCode {
+	return s.ErrorCode
+}
+
+// GetMessage returns the value of Message.
+func (s *ValidationError) GetMessage() string {
+	return s.Message
+}
+
+// GetViolations returns the value of Violations.
+func (s *ValidationError) GetViolations() []BomViolation {
+	return s.Violations
+}
+
+// SetCode sets the value of Code.
+func (s *ValidationError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetErrorCode sets the value of ErrorCode.
+func (s *ValidationError) SetErrorCode(val ErrorCode) {
+	s.ErrorCode = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ValidationError) SetMessage(val string) {
+	s.Message = val
 }
 
-// NewOptUUID returns new OptUUID with value set to v.
-func NewOptUUID(v uuid.UUID) OptUUID {
-	return OptUUID{
-		Value: v,
-		Set:   true,
-	}
+// SetViolations sets the value of Violations.
+func (s *ValidationError) SetViolations(val []BomViolation) {
+	s.Violations = val
+}
+
+func (*ValidationError) checkoutDraftRes() {}
+func (*ValidationError) createOrderRes()   {}
+func (*ValidationError) quoteOrderRes()    {}
+
+// Ref: #/components/schemas/webhook_delivery
+type WebhookDelivery struct {
+	// Идентификатор события, передаётся в заголовке
+	// X-Webhook-Delivery.
+	EventUUID uuid.UUID             `json:"event_uuid"`
+	EventType WebhookEventType      `json:"event_type"`
+	Status    WebhookDeliveryStatus `json:"status"`
+	// Сколько попыток уже сделано.
+	Attempts int `json:"attempts"`
+	// Когда будет следующая попытка, если доставка ещё не
+	// завершена.
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	// Дата и время события.
+	CreatedAt time.Time `json:"created_at"`
+	// Журнал попыток доставки.
+	Log []WebhookDeliveryAttempt `json:"log"`
 }
 
-// OptUUID is optional uuid.UUID.
-type OptUUID struct {
-	Value uuid.UUID
-	Set   bool
+// GetEventUUID returns the value of EventUUID.
+func (s *WebhookDelivery) GetEventUUID() uuid.UUID {
+	return s.EventUUID
 }
 
-// IsSet returns true if OptUUID was set.
-func (o OptUUID) IsSet() bool { return o.Set }
+// GetEventType returns the value of EventType.
+func (s *WebhookDelivery) GetEventType() WebhookEventType {
+	return s.EventType
+}
 
-// Reset unsets value.
-func (o *OptUUID) Reset() {
-	var v uuid.UUID
-	o.Value = v
-	o.Set = false
+// GetStatus returns the value of Status.
+func (s *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	return s.Status
 }
 
-// SetTo sets value to v.
-func (o *OptUUID) SetTo(v uuid.UUID) {
-	o.Set = true
-	o.Value = v
+// GetAttempts returns the value of Attempts.
+func (s *WebhookDelivery) GetAttempts() int {
+	return s.Attempts
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptUUID) Get() (v uuid.UUID, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetNextAttemptAt returns the value of NextAttemptAt.
+func (s *WebhookDelivery) GetNextAttemptAt() time.Time {
+	return s.NextAttemptAt
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetCreatedAt returns the value of CreatedAt.
+func (s *WebhookDelivery) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// Ref: #/components/schemas/order_dto
-type OrderDto struct {
-	// Уникальный идентификатор заказа.
-	OrderUUID uuid.UUID `json:"order_uuid"`
-	// Уникальный идентификатор пользователя.
-	UserUUID uuid.UUID `json:"user_uuid"`
-	// Список UUID деталей.
-	PartUuids []uuid.UUID `json:"part_uuids"`
-	// Итоговая стоимость заказа.
-	TotalPrice float64 `json:"total_price"`
-	// Уникальный идентификатор транзакции.
-	TransactionUUID OptUUID          `json:"transaction_uuid"`
-	PaymentMethod   OptPaymentMethod `json:"payment_method"`
-	Status          OrderStatus      `json:"status"`
-	// Дата и время создания заказа.
-	CreatedAt time.Time `json:"created_at"`
-	// Дата и время последнего обновления заказа.
-	UpdatedAt OptDateTime `json:"updated_at"`
+// GetLog returns the value of Log.
+func (s *WebhookDelivery) GetLog() []WebhookDeliveryAttempt {
+	return s.Log
 }
 
-// GetOrderUUID returns the value of OrderUUID.
-func (s *OrderDto) GetOrderUUID() uuid.UUID {
-	return s.OrderUUID
+// SetEventUUID sets the value of EventUUID.
+func (s *WebhookDelivery) SetEventUUID(val uuid.UUID) {
+	s.EventUUID = val
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *OrderDto) GetUserUUID() uuid.UUID {
-	return s.UserUUID
+// SetEventType sets the value of EventType.
+func (s *WebhookDelivery) SetEventType(val WebhookEventType) {
+	s.EventType = val
 }
 
-// GetPartUuids returns the value of PartUuids.
-func (s *OrderDto) GetPartUuids() []uuid.UUID {
-	return s.PartUuids
+// SetStatus sets the value of Status.
+func (s *WebhookDelivery) SetStatus(val WebhookDeliveryStatus) {
+	s.Status = val
 }
 
-// GetTotalPrice returns the value of TotalPrice.
-func (s *OrderDto) GetTotalPrice() float64 {
-	return s.TotalPrice
+// SetAttempts sets the value of Attempts.
+func (s *WebhookDelivery) SetAttempts(val int) {
+	s.Attempts = val
 }
 
-// GetTransactionUUID returns the value of TransactionUUID.
-func (s *OrderDto) GetTransactionUUID() OptUUID {
-	return s.TransactionUUID
+// SetNextAttemptAt sets the value of NextAttemptAt.
+func (s *WebhookDelivery) SetNextAttemptAt(val time.Time) {
+	s.NextAttemptAt = val
 }
 
-// GetPaymentMethod returns the value of PaymentMethod.
-func (s *OrderDto) GetPaymentMethod() OptPaymentMethod {
-	return s.PaymentMethod
+// SetCreatedAt sets the value of CreatedAt.
+func (s *WebhookDelivery) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// GetStatus returns the value of Status.
-func (s *OrderDto) GetStatus() OrderStatus {
-	return s.Status
+// SetLog sets the value of Log.
+func (s *WebhookDelivery) SetLog(val []WebhookDeliveryAttempt) {
+	s.Log = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *OrderDto) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// Ref: #/components/schemas/webhook_delivery_attempt
+type WebhookDeliveryAttempt struct {
+	// Номер попытки, начиная с 1.
+	Attempt int `json:"attempt"`
+	// HTTP-код ответа получателя; нет, если ответа не было.
+	StatusCode OptInt `json:"status_code"`
+	// Ошибка соединения или начало тела неуспешного ответа.
+	Error OptString `json:"error"`
+	// Длительность запроса в миллисекундах.
+	DurationMs int64 `json:"duration_ms"`
+	// Дата и время попытки.
+	AttemptedAt time.Time `json:"attempted_at"`
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *OrderDto) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// GetAttempt returns the value of Attempt.
+func (s *WebhookDeliveryAttempt) GetAttempt() int {
+	return s.Attempt
 }
 
-// SetOrderUUID sets the value of OrderUUID.
-func (s *OrderDto) SetOrderUUID(val uuid.UUID) {
-	s.OrderUUID = val
+// GetStatusCode returns the value of StatusCode.
+func (s *WebhookDeliveryAttempt) GetStatusCode() OptInt {
+	return s.StatusCode
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *OrderDto) SetUserUUID(val uuid.UUID) {
-	s.UserUUID = val
+// GetError returns the value of Error.
+func (s *WebhookDeliveryAttempt) GetError() OptString {
+	return s.Error
 }
 
-// SetPartUuids sets the value of PartUuids.
-func (s *OrderDto) SetPartUuids(val []uuid.UUID) {
-	s.PartUuids = val
+// GetDurationMs returns the value of DurationMs.
+func (s *WebhookDeliveryAttempt) GetDurationMs() int64 {
+	return s.DurationMs
 }
 
-// SetTotalPrice sets the value of TotalPrice.
-func (s *OrderDto) SetTotalPrice(val float64) {
-	s.TotalPrice = val
+// GetAttemptedAt returns the value of AttemptedAt.
+func (s *WebhookDeliveryAttempt) GetAttemptedAt() time.Time {
+	return s.AttemptedAt
 }
 
-// SetTransactionUUID sets the value of TransactionUUID.
-func (s *OrderDto) SetTransactionUUID(val OptUUID) {
-	s.TransactionUUID = val
+// SetAttempt sets the value of Attempt.
+func (s *WebhookDeliveryAttempt) SetAttempt(val int) {
+	s.Attempt = val
 }
 
-// SetPaymentMethod sets the value of PaymentMethod.
-func (s *OrderDto) SetPaymentMethod(val OptPaymentMethod) {
-	s.PaymentMethod = val
+// SetStatusCode sets the value of StatusCode.
+func (s *WebhookDeliveryAttempt) SetStatusCode(val OptInt) {
+	s.StatusCode = val
 }
 
-// SetStatus sets the value of Status.
-func (s *OrderDto) SetStatus(val OrderStatus) {
-	s.Status = val
+// SetError sets the value of Error.
+func (s *WebhookDeliveryAttempt) SetError(val OptString) {
+	s.Error = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *OrderDto) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// SetDurationMs sets the value of DurationMs.
+func (s *WebhookDeliveryAttempt) SetDurationMs(val int64) {
+	s.DurationMs = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *OrderDto) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+// SetAttemptedAt sets the value of AttemptedAt.
+func (s *WebhookDeliveryAttempt) SetAttemptedAt(val time.Time) {
+	s.AttemptedAt = val
 }
 
-// Статус платежа:
-// - PENDING_PAYMENT: Ожидает оплаты
-// - PAID: Оплачен
-// - CANCELLED: Отменён
-// - COMPLETED: Завершён.
-// Ref: #/components/schemas/order_status
-type OrderStatus string
+// Статус доставки события:
+// - PENDING: Ждёт первой или повторной попытки
+// - DELIVERED: Получатель ответил кодом 2xx
+// - FAILED: Все попытки исчерпаны.
+// Ref: #/components/schemas/webhook_delivery_status
+type WebhookDeliveryStatus string
 
 const (
-	OrderStatusPENDINGPAYMENT OrderStatus = "PENDING_PAYMENT"
-	OrderStatusPAID           OrderStatus = "PAID"
-	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
-	OrderStatusCOMPLETED      OrderStatus = "COMPLETED"
+	WebhookDeliveryStatusPENDING   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusDELIVERED WebhookDeliveryStatus = "DELIVERED"
+	WebhookDeliveryStatusFAILED    WebhookDeliveryStatus = "FAILED"
 )
 
-// AllValues returns all OrderStatus values.
-func (OrderStatus) AllValues() []OrderStatus {
-	return []OrderStatus{
-		OrderStatusPENDINGPAYMENT,
-		OrderStatusPAID,
-		OrderStatusCANCELLED,
-		OrderStatusCOMPLETED,
+// AllValues returns all WebhookDeliveryStatus values.
+func (WebhookDeliveryStatus) AllValues() []WebhookDeliveryStatus {
+	return []WebhookDeliveryStatus{
+		WebhookDeliveryStatusPENDING,
+		WebhookDeliveryStatusDELIVERED,
+		WebhookDeliveryStatusFAILED,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s OrderStatus) MarshalText() ([]byte, error) {
+func (s WebhookDeliveryStatus) MarshalText() ([]byte, error) {
 	switch s {
-	case OrderStatusPENDINGPAYMENT:
-		return []byte(s), nil
-	case OrderStatusPAID:
+	case WebhookDeliveryStatusPENDING:
 		return []byte(s), nil
-	case OrderStatusCANCELLED:
+	case WebhookDeliveryStatusDELIVERED:
 		return []byte(s), nil
-	case OrderStatusCOMPLETED:
+	case WebhookDeliveryStatusFAILED:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -599,93 +3486,160 @@ func (s OrderStatus) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *OrderStatus) UnmarshalText(data []byte) error {
-	switch OrderStatus(data) {
-	case OrderStatusPENDINGPAYMENT:
-		*s = OrderStatusPENDINGPAYMENT
-		return nil
-	case OrderStatusPAID:
-		*s = OrderStatusPAID
+func (s *WebhookDeliveryStatus) UnmarshalText(data []byte) error {
+	switch WebhookDeliveryStatus(data) {
+	case WebhookDeliveryStatusPENDING:
+		*s = WebhookDeliveryStatusPENDING
 		return nil
-	case OrderStatusCANCELLED:
-		*s = OrderStatusCANCELLED
+	case WebhookDeliveryStatusDELIVERED:
+		*s = WebhookDeliveryStatusDELIVERED
 		return nil
-	case OrderStatusCOMPLETED:
-		*s = OrderStatusCOMPLETED
+	case WebhookDeliveryStatusFAILED:
+		*s = WebhookDeliveryStatusFAILED
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Ref: #/components/schemas/pay_order_request
-type PayOrderRequest struct {
-	PaymentMethod PaymentMethod `json:"payment_method"`
+// Ref: #/components/schemas/webhook_dto
+type WebhookDto struct {
+	// Уникальный идентификатор вебхука.
+	WebhookUUID uuid.UUID `json:"webhook_uuid"`
+	// Адрес получателя.
+	URL string `json:"url"`
+	// События, о которых уведомляет вебхук.
+	EventTypes []WebhookEventType `json:"event_types"`
+	// False - вебхук отключён после серии неудачных доставок,
+	// события копятся до повторного включения.
+	Enabled bool `json:"enabled"`
+	// Неудачных попыток доставки подряд.
+	ConsecutiveFailures int `json:"consecutive_failures"`
+	// Когда вебхук отключён из-за ошибок доставки.
+	DisabledAt OptDateTime `json:"disabled_at"`
+	// Дата и время регистрации.
+	CreatedAt time.Time `json:"created_at"`
 }
 
-// GetPaymentMethod returns the value of PaymentMethod.
-func (s *PayOrderRequest) GetPaymentMethod() PaymentMethod {
-	return s.PaymentMethod
+// GetWebhookUUID returns the value of WebhookUUID.
+func (s *WebhookDto) GetWebhookUUID() uuid.UUID {
+	return s.WebhookUUID
 }
 
-// SetPaymentMethod sets the value of PaymentMethod.
-func (s *PayOrderRequest) SetPaymentMethod(val PaymentMethod) {
-	s.PaymentMethod = val
+// GetURL returns the value of URL.
+func (s *WebhookDto) GetURL() string {
+	return s.URL
 }
 
-// Ref: #/components/schemas/pay_order_response
-type PayOrderResponse struct {
-	// Уникальный идентификатор транзакции.
-	TransactionUUID uuid.UUID `json:"transaction_uuid"`
+// GetEventTypes returns the value of EventTypes.
+func (s *WebhookDto) GetEventTypes() []WebhookEventType {
+	return s.EventTypes
 }
 
-// GetTransactionUUID returns the value of TransactionUUID.
-func (s *PayOrderResponse) GetTransactionUUID() uuid.UUID {
-	return s.TransactionUUID
+// GetEnabled returns the value of Enabled.
+func (s *WebhookDto) GetEnabled() bool {
+	return s.Enabled
 }
 
-// SetTransactionUUID sets the value of TransactionUUID.
-func (s *PayOrderResponse) SetTransactionUUID(val uuid.UUID) {
-	s.TransactionUUID = val
+// GetConsecutiveFailures returns the value of ConsecutiveFailures.
+func (s *WebhookDto) GetConsecutiveFailures() int {
+	return s.ConsecutiveFailures
 }
 
-func (*PayOrderResponse) payOrderRes() {}
+// GetDisabledAt returns the value of DisabledAt.
+func (s *WebhookDto) GetDisabledAt() OptDateTime {
+	return s.DisabledAt
+}
 
-// Способ оплаты.
-// Ref: #/components/schemas/payment_method
-type PaymentMethod string
+// GetCreatedAt returns the value of CreatedAt.
+func (s *WebhookDto) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetWebhookUUID sets the value of WebhookUUID.
+func (s *WebhookDto) SetWebhookUUID(val uuid.UUID) {
+	s.WebhookUUID = val
+}
+
+// SetURL sets the value of URL.
+func (s *WebhookDto) SetURL(val string) {
+	s.URL = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *WebhookDto) SetEventTypes(val []WebhookEventType) {
+	s.EventTypes = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *WebhookDto) SetEnabled(val bool) {
+	s.Enabled = val
+}
+
+// SetConsecutiveFailures sets the value of ConsecutiveFailures.
+func (s *WebhookDto) SetConsecutiveFailures(val int) {
+	s.ConsecutiveFailures = val
+}
+
+// SetDisabledAt sets the value of DisabledAt.
+func (s *WebhookDto) SetDisabledAt(val OptDateTime) {
+	s.DisabledAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *WebhookDto) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*WebhookDto) createWebhookRes() {}
+func (*WebhookDto) enableWebhookRes() {}
+
+// Событие заказа, о котором уведомляет вебхук:
+// - ORDER_CREATED: Заказ создан и ждёт оплаты
+// - ORDER_PAID: Заказ оплачен
+// - ORDER_ASSEMBLING: Началась сборка корабля
+// - ORDER_COMPLETED: Корабль собран
+// - ORDER_CANCELLED: Заказ отменён пользователем или по
+// таймауту оплаты
+// - ORDER_REFUNDED: Оплаченный заказ отменён, деньги возвращены.
+// Ref: #/components/schemas/webhook_event_type
+type WebhookEventType string
 
 const (
-	PaymentMethodUNKNOWN       PaymentMethod = "UNKNOWN"
-	PaymentMethodCARD          PaymentMethod = "CARD"
-	PaymentMethodSBP           PaymentMethod = "SBP"
-	PaymentMethodCREDITCARD    PaymentMethod = "CREDIT_CARD"
-	PaymentMethodINVESTORMONEY PaymentMethod = "INVESTOR_MONEY"
+	WebhookEventTypeORDERCREATED    WebhookEventType = "ORDER_CREATED"
+	WebhookEventTypeORDERPAID       WebhookEventType = "ORDER_PAID"
+	WebhookEventTypeORDERASSEMBLING WebhookEventType = "ORDER_ASSEMBLING"
+	WebhookEventTypeORDERCOMPLETED  WebhookEventType = "ORDER_COMPLETED"
+	WebhookEventTypeORDERCANCELLED  WebhookEventType = "ORDER_CANCELLED"
+	WebhookEventTypeORDERREFUNDED   WebhookEventType = "ORDER_REFUNDED"
 )
 
-// AllValues returns all PaymentMethod values.
-func (PaymentMethod) AllValues() []PaymentMethod {
-	return []PaymentMethod{
-		PaymentMethodUNKNOWN,
-		PaymentMethodCARD,
-		PaymentMethodSBP,
-		PaymentMethodCREDITCARD,
-		PaymentMethodINVESTORMONEY,
+// AllValues returns all WebhookEventType values.
+func (WebhookEventType) AllValues() []WebhookEventType {
+	return []WebhookEventType{
+		WebhookEventTypeORDERCREATED,
+		WebhookEventTypeORDERPAID,
+		WebhookEventTypeORDERASSEMBLING,
+		WebhookEventTypeORDERCOMPLETED,
+		WebhookEventTypeORDERCANCELLED,
+		WebhookEventTypeORDERREFUNDED,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s PaymentMethod) MarshalText() ([]byte, error) {
+func (s WebhookEventType) MarshalText() ([]byte, error) {
 	switch s {
-	case PaymentMethodUNKNOWN:
+	case WebhookEventTypeORDERCREATED:
 		return []byte(s), nil
-	case PaymentMethodCARD:
+	case WebhookEventTypeORDERPAID:
 		return []byte(s), nil
-	case PaymentMethodSBP:
+	case WebhookEventTypeORDERASSEMBLING:
 		return []byte(s), nil
-	case PaymentMethodCREDITCARD:
+	case WebhookEventTypeORDERCOMPLETED:
 		return []byte(s), nil
-	case PaymentMethodINVESTORMONEY:
+	case WebhookEventTypeORDERCANCELLED:
+		return []byte(s), nil
+	case WebhookEventTypeORDERREFUNDED:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -693,57 +3647,27 @@ func (s PaymentMethod) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *PaymentMethod) UnmarshalText(data []byte) error {
-	switch PaymentMethod(data) {
-	case PaymentMethodUNKNOWN:
-		*s = PaymentMethodUNKNOWN
+func (s *WebhookEventType) UnmarshalText(data []byte) error {
+	switch WebhookEventType(data) {
+	case WebhookEventTypeORDERCREATED:
+		*s = WebhookEventTypeORDERCREATED
 		return nil
-	case PaymentMethodCARD:
-		*s = PaymentMethodCARD
+	case WebhookEventTypeORDERPAID:
+		*s = WebhookEventTypeORDERPAID
 		return nil
-	case PaymentMethodSBP:
-		*s = PaymentMethodSBP
+	case WebhookEventTypeORDERASSEMBLING:
+		*s = WebhookEventTypeORDERASSEMBLING
 		return nil
-	case PaymentMethodCREDITCARD:
-		*s = PaymentMethodCREDITCARD
+	case WebhookEventTypeORDERCOMPLETED:
+		*s = WebhookEventTypeORDERCOMPLETED
 		return nil
-	case PaymentMethodINVESTORMONEY:
-		*s = PaymentMethodINVESTORMONEY
+	case WebhookEventTypeORDERCANCELLED:
+		*s = WebhookEventTypeORDERCANCELLED
+		return nil
+	case WebhookEventTypeORDERREFUNDED:
+		*s = WebhookEventTypeORDERREFUNDED
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
-
-// Ref: #/components/schemas/service_unavailable_error
-type ServiceUnavailableError struct {
-	// HTTP-код ошибки.
-	Code int `json:"code"`
-	// Сервис не доступен.
-	Message string `json:"message"`
-}
-
-// GetCode returns the value of Code.
-func (s *ServiceUnavailableError) GetCode() int {
-	return s.Code
-}
-
-// GetMessage returns the value of Message.
-func (s *ServiceUnavailableError) GetMessage() string {
-	return s.Message
-}
-
-// SetCode sets the value of Code.
-func (s *ServiceUnavailableError) SetCode(val int) {
-	s.Code = val
-}
-
-// SetMessage sets the value of Message.
-func (s *ServiceUnavailableError) SetMessage(val string) {
-	s.Message = val
-}
-
-func (*ServiceUnavailableError) cancelOrderRes() {}
-func (*ServiceUnavailableError) createOrderRes() {}
-func (*ServiceUnavailableError) getOrderRes()    {}
-func (*ServiceUnavailableError) payOrderRes()    {}
@@ -8,30 +8,153 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AddDraftItem implements AddDraftItem operation.
+	//
+	// Добавляет деталь в черновик, создавая его при первом
+	// добавлении. Количество уже добавленной детали
+	// увеличивается.
+	//
+	// POST /api/v1/orders/draft/items
+	AddDraftItem(ctx context.Context, req *CreateOrderItem, params AddDraftItemParams) (AddDraftItemRes, error)
 	// CancelOrder implements CancelOrder operation.
 	//
-	// Order canceled.
+	// Неоплаченный заказ переходит в CANCELLED, резерв деталей
+	// снимается.
+	// Оплаченный заказ, который ещё не собран, переходит в
+	// REFUNDING, а после возврата оплаты - в REFUNDED;
+	// сборка прерывается. Если возврат не удался, заказ
+	// остаётся в REFUNDING и повторная отмена его завершает.
 	//
 	// POST /api/v1/orders/{order_uuid}/cancel
 	CancelOrder(ctx context.Context, params CancelOrderParams) (CancelOrderRes, error)
+	// CheckoutDraft implements CheckoutDraft operation.
+	//
+	// Оформляет черновик в заказ - цены, скидки и наличие
+	// деталей проверяются заново, заказ переходит в
+	// PENDING_PAYMENT.
+	//
+	// POST /api/v1/orders/draft/checkout
+	CheckoutDraft(ctx context.Context, req *CheckoutDraftRequest, params CheckoutDraftParams) (CheckoutDraftRes, error)
 	// CreateOrder implements CreateOrder operation.
 	//
 	// Create order.
 	//
 	// POST /api/v1/orders
 	CreateOrder(ctx context.Context, req *CreateOrderRequest, params CreateOrderParams) (CreateOrderRes, error)
+	// CreateWebhook implements CreateWebhook operation.
+	//
+	// Регистрирует адрес, на который будут отправляться
+	// события заказов пользователя.
+	// Каждое событие - POST с JSON-телом и заголовками:
+	// - X-Webhook-Event: тип события
+	// - X-Webhook-Delivery: UUID события, одинаковый во всех повторах
+	// - X-Webhook-Timestamp: время отправки, Unix-секунды
+	// - X-Webhook-Signature: sha256=<hex HMAC-SHA256 от "<timestamp>.<тело>" с секретом
+	// вебхука>
+	// Ответ 2xx считается доставкой. Иначе попытка
+	// повторяется с экспоненциальной задержкой,
+	// а после серии неудач подряд вебхук отключается.
+	// Порядок доставки не гарантируется,
+	// используйте occurred_at из тела.
+	//
+	// POST /api/v1/webhooks
+	CreateWebhook(ctx context.Context, req *CreateWebhookRequest, params CreateWebhookParams) (CreateWebhookRes, error)
+	// DeleteWebhook implements DeleteWebhook operation.
+	//
+	// Удаляет вебхук вместе с недоставленными событиями и
+	// журналом доставок.
+	//
+	// DELETE /api/v1/webhooks/{webhook_uuid}
+	DeleteWebhook(ctx context.Context, params DeleteWebhookParams) (DeleteWebhookRes, error)
+	// EnableWebhook implements EnableWebhook operation.
+	//
+	// Включает вебхук, отключённый после ошибок доставки.
+	// Накопленные события будут доставлены.
+	//
+	// POST /api/v1/webhooks/{webhook_uuid}/enable
+	EnableWebhook(ctx context.Context, params EnableWebhookParams) (EnableWebhookRes, error)
+	// GetDraft implements GetDraft operation.
+	//
+	// Возвращает активный черновик заказа (корзину)
+	// пользователя.
+	//
+	// GET /api/v1/orders/draft
+	GetDraft(ctx context.Context, params GetDraftParams) (GetDraftRes, error)
 	// GetOrder implements GetOrder operation.
 	//
 	// Get order by uuid.
 	//
 	// GET /api/v1/orders/{order_uuid}
 	GetOrder(ctx context.Context, params GetOrderParams) (GetOrderRes, error)
+	// GetOrderHistory implements GetOrderHistory operation.
+	//
+	// Get order status history.
+	//
+	// GET /api/v1/orders/{order_uuid}/history
+	GetOrderHistory(ctx context.Context, params GetOrderHistoryParams) (GetOrderHistoryRes, error)
+	// GetOrderInvoice implements GetOrderInvoice operation.
+	//
+	// Счёт выставляется при первом запросе оплаченного
+	// заказа и дальше не меняется:
+	// повторные запросы возвращают тот же документ с тем же
+	// номером.
+	// Номера счетов сквозные в пределах календарного года.
+	//
+	// GET /api/v1/orders/{order_uuid}/invoice
+	GetOrderInvoice(ctx context.Context, params GetOrderInvoiceParams) (GetOrderInvoiceRes, error)
+	// GetOrdersReport implements GetOrdersReport operation.
+	//
+	// Выручка, средний чек, разбивка по способам оплаты и
+	// доля отмен за период. Доступно только
+	// администраторам. Период ограничен, запрос
+	// выполняется с таймаутом.
+	//
+	// GET /api/v1/reports/orders
+	GetOrdersReport(ctx context.Context, params GetOrdersReportParams) (GetOrdersReportRes, error)
+	// ListOrders implements ListOrders operation.
+	//
+	// List orders.
+	//
+	// GET /api/v1/orders
+	ListOrders(ctx context.Context, params ListOrdersParams) (ListOrdersRes, error)
+	// ListWebhookDeliveries implements ListWebhookDeliveries operation.
+	//
+	// Последние доставки событий на вебхук, от новых к
+	// старым, с журналом попыток.
+	//
+	// GET /api/v1/webhooks/{webhook_uuid}/deliveries
+	ListWebhookDeliveries(ctx context.Context, params ListWebhookDeliveriesParams) (ListWebhookDeliveriesRes, error)
+	// ListWebhooks implements ListWebhooks operation.
+	//
+	// List webhooks.
+	//
+	// GET /api/v1/webhooks
+	ListWebhooks(ctx context.Context, params ListWebhooksParams) (ListWebhooksRes, error)
 	// PayOrder implements PayOrder operation.
 	//
 	// Order payment.
 	//
 	// POST /api/v1/orders/{order_uuid}/pay
 	PayOrder(ctx context.Context, req *PayOrderRequest, params PayOrderParams) (PayOrderRes, error)
+	// QuoteOrder implements QuoteOrder operation.
+	//
+	// Считает стоимость заказа так же, как при создании, но
+	// ничего не сохраняет и не резервирует.
+	//
+	// POST /api/v1/orders/quote
+	QuoteOrder(ctx context.Context, req *CreateOrderRequest, params QuoteOrderParams) (QuoteOrderRes, error)
+	// RemoveDraftItem implements RemoveDraftItem operation.
+	//
+	// Remove part from draft order.
+	//
+	// DELETE /api/v1/orders/draft/items/{part_uuid}
+	RemoveDraftItem(ctx context.Context, params RemoveDraftItemParams) (RemoveDraftItemRes, error)
+	// SetDraftItemQuantity implements SetDraftItemQuantity operation.
+	//
+	// Set part quantity in draft order.
+	//
+	// PUT /api/v1/orders/draft/items/{part_uuid}
+	SetDraftItemQuantity(ctx context.Context, req *SetDraftItemRequest, params SetDraftItemQuantityParams) (SetDraftItemQuantityRes, error)
 	// NewError creates *GenericErrorStatusCode from error returned by handler.
 	//
 	// Used for common default response.
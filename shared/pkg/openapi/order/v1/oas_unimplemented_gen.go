@@ -13,15 +13,42 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AddDraftItem implements AddDraftItem operation.
+//
+// Добавляет деталь в черновик, создавая его при первом
+// добавлении. Количество уже добавленной детали
+// увеличивается.
+//
+// POST /api/v1/orders/draft/items
+func (UnimplementedHandler) AddDraftItem(ctx context.Context, req *CreateOrderItem, params AddDraftItemParams) (r AddDraftItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CancelOrder implements CancelOrder operation.
 //
-// Order canceled.
+// Неоплаченный заказ переходит в CANCELLED, резерв деталей
+// снимается.
+// Оплаченный заказ, который ещё не собран, переходит в
+// REFUNDING, а после возврата оплаты - в REFUNDED;
+// сборка прерывается. Если возврат не удался, заказ
+// остаётся в REFUNDING и повторная отмена его завершает.
 //
 // POST /api/v1/orders/{order_uuid}/cancel
 func (UnimplementedHandler) CancelOrder(ctx context.Context, params CancelOrderParams) (r CancelOrderRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// CheckoutDraft implements CheckoutDraft operation.
+//
+// Оформляет черновик в заказ - цены, скидки и наличие
+// деталей проверяются заново, заказ переходит в
+// PENDING_PAYMENT.
+//
+// POST /api/v1/orders/draft/checkout
+func (UnimplementedHandler) CheckoutDraft(ctx context.Context, req *CheckoutDraftRequest, params CheckoutDraftParams) (r CheckoutDraftRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateOrder implements CreateOrder operation.
 //
 // Create order.
@@ -31,6 +58,57 @@ func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderReq
 	return r, ht.ErrNotImplemented
 }
 
+// CreateWebhook implements CreateWebhook operation.
+//
+// Регистрирует адрес, на который будут отправляться
+// события заказов пользователя.
+// Каждое событие - POST с JSON-телом и заголовками:
+// - X-Webhook-Event: тип события
+// - X-Webhook-Delivery: UUID события, одинаковый во всех повторах
+// - X-Webhook-Timestamp: время отправки, Unix-секунды
+// - X-Webhook-Signature: sha256=<hex HMAC-SHA256 от "<timestamp>.<тело>" с секретом
+// вебхука>
+// Ответ 2xx считается доставкой. Иначе попытка
+// повторяется с экспоненциальной задержкой,
+// а после серии неудач подряд вебхук отключается.
+// Порядок доставки не гарантируется,
+// используйте occurred_at из тела.
+//
+// POST /api/v1/webhooks
+func (UnimplementedHandler) CreateWebhook(ctx context.Context, req *CreateWebhookRequest, params CreateWebhookParams) (r CreateWebhookRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteWebhook implements DeleteWebhook operation.
+//
+// Удаляет вебхук вместе с недоставленными событиями и
+// журналом доставок.
+//
+// DELETE /api/v1/webhooks/{webhook_uuid}
+func (UnimplementedHandler) DeleteWebhook(ctx context.Context, params DeleteWebhookParams) (r DeleteWebhookRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EnableWebhook implements EnableWebhook operation.
+//
+// Включает вебхук, отключённый после ошибок доставки.
+// Накопленные события будут доставлены.
+//
+// POST /api/v1/webhooks/{webhook_uuid}/enable
+func (UnimplementedHandler) EnableWebhook(ctx context.Context, params EnableWebhookParams) (r EnableWebhookRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetDraft implements GetDraft operation.
+//
+// Возвращает активный черновик заказа (корзину)
+// пользователя.
+//
+// GET /api/v1/orders/draft
+func (UnimplementedHandler) GetDraft(ctx context.Context, params GetDraftParams) (r GetDraftRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetOrder implements GetOrder operation.
 //
 // Get order by uuid.
@@ -40,6 +118,68 @@ func (UnimplementedHandler) GetOrder(ctx context.Context, params GetOrderParams)
 	return r, ht.ErrNotImplemented
 }
 
+// GetOrderHistory implements GetOrderHistory operation.
+//
+// Get order status history.
+//
+// GET /api/v1/orders/{order_uuid}/history
+func (UnimplementedHandler) GetOrderHistory(ctx context.Context, params GetOrderHistoryParams) (r GetOrderHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrderInvoice implements GetOrderInvoice operation.
+//
+// Счёт выставляется при первом запросе оплаченного
+// заказа и дальше не меняется:
+// повторные запросы возвращают тот же документ с тем же
+// номером.
+// Номера счетов сквозные в пределах календарного года.
+//
+// GET /api/v1/orders/{order_uuid}/invoice
+func (UnimplementedHandler) GetOrderInvoice(ctx context.Context, params GetOrderInvoiceParams) (r GetOrderInvoiceRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrdersReport implements GetOrdersReport operation.
+//
+// Выручка, средний чек, разбивка по способам оплаты и
+// доля отмен за период. Доступно только
+// администраторам. Период ограничен, запрос
+// выполняется с таймаутом.
+//
+// GET /api/v1/reports/orders
+func (UnimplementedHandler) GetOrdersReport(ctx context.Context, params GetOrdersReportParams) (r GetOrdersReportRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListOrders implements ListOrders operation.
+//
+// List orders.
+//
+// GET /api/v1/orders
+func (UnimplementedHandler) ListOrders(ctx context.Context, params ListOrdersParams) (r ListOrdersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListWebhookDeliveries implements ListWebhookDeliveries operation.
+//
+// Последние доставки событий на вебхук, от новых к
+// старым, с журналом попыток.
+//
+// GET /api/v1/webhooks/{webhook_uuid}/deliveries
+func (UnimplementedHandler) ListWebhookDeliveries(ctx context.Context, params ListWebhookDeliveriesParams) (r ListWebhookDeliveriesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListWebhooks implements ListWebhooks operation.
+//
+// List webhooks.
+//
+// GET /api/v1/webhooks
+func (UnimplementedHandler) ListWebhooks(ctx context.Context, params ListWebhooksParams) (r ListWebhooksRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PayOrder implements PayOrder operation.
 //
 // Order payment.
@@ -49,6 +189,34 @@ func (UnimplementedHandler) PayOrder(ctx context.Context, req *PayOrderRequest,
 	return r, ht.ErrNotImplemented
 }
 
+// QuoteOrder implements QuoteOrder operation.
+//
+// Считает стоимость заказа так же, как при создании, но
+// ничего не сохраняет и не резервирует.
+//
+// POST /api/v1/orders/quote
+func (UnimplementedHandler) QuoteOrder(ctx context.Context, req *CreateOrderRequest, params QuoteOrderParams) (r QuoteOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RemoveDraftItem implements RemoveDraftItem operation.
+//
+// Remove part from draft order.
+//
+// DELETE /api/v1/orders/draft/items/{part_uuid}
+func (UnimplementedHandler) RemoveDraftItem(ctx context.Context, params RemoveDraftItemParams) (r RemoveDraftItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SetDraftItemQuantity implements SetDraftItemQuantity operation.
+//
+// Set part quantity in draft order.
+//
+// PUT /api/v1/orders/draft/items/{part_uuid}
+func (UnimplementedHandler) SetDraftItemQuantity(ctx context.Context, req *SetDraftItemRequest, params SetDraftItemQuantityParams) (r SetDraftItemQuantityRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewError creates *GenericErrorStatusCode from error returned by handler.
 //
 // Used for common default response.
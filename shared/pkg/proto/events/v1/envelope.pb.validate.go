@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: events/v1/envelope.proto
+
+package events_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on EventEnvelope with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventEnvelope) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventEnvelope with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventEnvelopeMultiError, or
+// nil if none found.
+func (m *EventEnvelope) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventEnvelope) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventUuid
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventEnvelopeValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventEnvelopeValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventEnvelopeValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CausationId
+
+	// no validation rules for SchemaVersion
+
+	if len(errors) > 0 {
+		return EventEnvelopeMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventEnvelopeMultiError is an error wrapping multiple validation errors
+// returned by EventEnvelope.ValidateAll() if the designated constraints
+// aren't met.
+type EventEnvelopeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventEnvelopeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventEnvelopeMultiError) AllErrors() []error { return m }
+
+// EventEnvelopeValidationError is the validation error returned by
+// EventEnvelope.Validate if the designated constraints aren't met.
+type EventEnvelopeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventEnvelopeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventEnvelopeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventEnvelopeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventEnvelopeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventEnvelopeValidationError) ErrorName() string { return "EventEnvelopeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventEnvelopeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventEnvelope.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventEnvelopeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventEnvelopeValidationError{}
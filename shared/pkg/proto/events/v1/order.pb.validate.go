@@ -144,6 +144,114 @@ var _ interface {
 	ErrorName() string
 } = OrderPaidValidationError{}
 
+// Validate checks the field values on ShipAssemblyStarted with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShipAssemblyStarted) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShipAssemblyStarted with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShipAssemblyStartedMultiError, or nil if none found.
+func (m *ShipAssemblyStarted) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShipAssemblyStarted) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventUuid
+
+	// no validation rules for OrderUuid
+
+	// no validation rules for UserUuid
+
+	if len(errors) > 0 {
+		return ShipAssemblyStartedMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShipAssemblyStartedMultiError is an error wrapping multiple validation
+// errors returned by ShipAssemblyStarted.ValidateAll() if the designated
+// constraints aren't met.
+type ShipAssemblyStartedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShipAssemblyStartedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShipAssemblyStartedMultiError) AllErrors() []error { return m }
+
+// ShipAssemblyStartedValidationError is the validation error returned by
+// ShipAssemblyStarted.Validate if the designated constraints aren't met.
+type ShipAssemblyStartedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShipAssemblyStartedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShipAssemblyStartedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShipAssemblyStartedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShipAssemblyStartedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShipAssemblyStartedValidationError) ErrorName() string {
+	return "ShipAssemblyStartedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShipAssemblyStartedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShipAssemblyStarted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShipAssemblyStartedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShipAssemblyStartedValidationError{}
+
 // Validate checks the field values on ShipAssembled with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -251,3 +359,682 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ShipAssembledValidationError{}
+
+// Validate checks the field values on OrderExpired with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderExpired) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderExpired with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderExpiredMultiError, or
+// nil if none found.
+func (m *OrderExpired) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderExpired) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventUuid
+
+	// no validation rules for OrderUuid
+
+	// no validation rules for UserUuid
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetExpiredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderExpiredValidationError{
+					field:  "ExpiredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderExpiredValidationError{
+					field:  "ExpiredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderExpiredValidationError{
+				field:  "ExpiredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnvelope()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderExpiredValidationError{
+					field:  "Envelope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderExpiredValidationError{
+					field:  "Envelope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnvelope()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderExpiredValidationError{
+				field:  "Envelope",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderExpiredMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderExpiredMultiError is an error wrapping multiple validation errors
+// returned by OrderExpired.ValidateAll() if the designated constraints aren't met.
+type OrderExpiredMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderExpiredMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderExpiredMultiError) AllErrors() []error { return m }
+
+// OrderExpiredValidationError is the validation error returned by
+// OrderExpired.Validate if the designated constraints aren't met.
+type OrderExpiredValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderExpiredValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderExpiredValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderExpiredValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderExpiredValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderExpiredValidationError) ErrorName() string { return "OrderExpiredValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderExpiredValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderExpired.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderExpiredValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderExpiredValidationError{}
+
+// Validate checks the field values on OrderRefunded with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderRefunded) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderRefunded with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderRefundedMultiError, or
+// nil if none found.
+func (m *OrderRefunded) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderRefunded) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventUuid
+
+	// no validation rules for OrderUuid
+
+	// no validation rules for UserUuid
+
+	// no validation rules for TransactionUuid
+
+	// no validation rules for RefundUuid
+
+	if len(errors) > 0 {
+		return OrderRefundedMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderRefundedMultiError is an error wrapping multiple validation errors
+// returned by OrderRefunded.ValidateAll() if the designated constraints
+// aren't met.
+type OrderRefundedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderRefundedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderRefundedMultiError) AllErrors() []error { return m }
+
+// OrderRefundedValidationError is the validation error returned by
+// OrderRefunded.Validate if the designated constraints aren't met.
+type OrderRefundedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderRefundedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderRefundedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderRefundedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderRefundedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderRefundedValidationError) ErrorName() string { return "OrderRefundedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderRefundedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderRefunded.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderRefundedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderRefundedValidationError{}
+
+// Validate checks the field values on OrderCreated with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderCreated) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderCreated with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderCreatedMultiError, or
+// nil if none found.
+func (m *OrderCreated) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderCreated) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEnvelope()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderCreatedValidationError{
+					field:  "Envelope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderCreatedValidationError{
+					field:  "Envelope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnvelope()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderCreatedValidationError{
+				field:  "Envelope",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OrderUuid
+
+	// no validation rules for UserUuid
+
+	// no validation rules for TotalPrice
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return OrderCreatedMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderCreatedMultiError is an error wrapping multiple validation errors
+// returned by OrderCreated.ValidateAll() if the designated constraints aren't met.
+type OrderCreatedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderCreatedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderCreatedMultiError) AllErrors() []error { return m }
+
+// OrderCreatedValidationError is the validation error returned by
+// OrderCreated.Validate if the designated constraints aren't met.
+type OrderCreatedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderCreatedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderCreatedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderCreatedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderCreatedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderCreatedValidationError) ErrorName() string { return "OrderCreatedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderCreatedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderCreated.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderCreatedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderCreatedValidationError{}
+
+// Validate checks the field values on OrderCancelled with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderCancelled) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderCancelled with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderCancelledMultiError,
+// or nil if none found.
+func (m *OrderCancelled) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderCancelled) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEnvelope()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderCancelledValidationError{
+					field:  "Envelope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderCancelledValidationError{
+					field:  "Envelope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnvelope()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderCancelledValidationError{
+				field:  "Envelope",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OrderUuid
+
+	// no validation rules for UserUuid
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return OrderCancelledMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderCancelledMultiError is an error wrapping multiple validation errors
+// returned by OrderCancelled.ValidateAll() if the designated constraints
+// aren't met.
+type OrderCancelledMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderCancelledMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderCancelledMultiError) AllErrors() []error { return m }
+
+// OrderCancelledValidationError is the validation error returned by
+// OrderCancelled.Validate if the designated constraints aren't met.
+type OrderCancelledValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderCancelledValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderCancelledValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderCancelledValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderCancelledValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderCancelledValidationError) ErrorName() string { return "OrderCancelledValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderCancelledValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderCancelled.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderCancelledValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderCancelledValidationError{}
+
+// Validate checks the field values on OrderCompleted with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderCompleted) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderCompleted with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderCompletedMultiError,
+// or nil if none found.
+func (m *OrderCompleted) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderCompleted) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEnvelope()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderCompletedValidationError{
+					field:  "Envelope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderCompletedValidationError{
+					field:  "Envelope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnvelope()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderCompletedValidationError{
+				field:  "Envelope",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OrderUuid
+
+	// no validation rules for UserUuid
+
+	if len(errors) > 0 {
+		return OrderCompletedMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderCompletedMultiError is an error wrapping multiple validation errors
+// returned by OrderCompleted.ValidateAll() if the designated constraints
+// aren't met.
+type OrderCompletedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderCompletedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderCompletedMultiError) AllErrors() []error { return m }
+
+// OrderCompletedValidationError is the validation error returned by
+// OrderCompleted.Validate if the designated constraints aren't met.
+type OrderCompletedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderCompletedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderCompletedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderCompletedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderCompletedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderCompletedValidationError) ErrorName() string { return "OrderCompletedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderCompletedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderCompleted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderCompletedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderCompletedValidationError{}
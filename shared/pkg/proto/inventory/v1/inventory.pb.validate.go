@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _inventory_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on GetPartRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -293,7 +296,34 @@ func (m *Part) validate(all bool) error {
 
 	// no validation rules for Description
 
-	// no validation rules for Price
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	// no validation rules for StockQuantity
 
@@ -1352,3 +1382,850 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = PartsFilterValidationError{}
+
+// Validate checks the field values on StockItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StockItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StockItemMultiError, or nil
+// if none found.
+func (m *StockItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPartUuid()); err != nil {
+		err = StockItemValidationError{
+			field:  "PartUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() <= 0 {
+		err := StockItemValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StockItemMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *StockItem) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// StockItemMultiError is an error wrapping multiple validation errors returned
+// by StockItem.ValidateAll() if the designated constraints aren't met.
+type StockItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockItemMultiError) AllErrors() []error { return m }
+
+// StockItemValidationError is the validation error returned by
+// StockItem.Validate if the designated constraints aren't met.
+type StockItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockItemValidationError) ErrorName() string { return "StockItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StockItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockItemValidationError{}
+
+// Validate checks the field values on ReserveStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReserveStockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReserveStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReserveStockRequestMultiError, or nil if none found.
+func (m *ReserveStockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReserveStockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderUuid()); err != nil {
+		err = ReserveStockRequestValidationError{
+			field:  "OrderUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetItems()) < 1 {
+		err := ReserveStockRequestValidationError{
+			field:  "Items",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReserveStockRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReserveStockRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReserveStockRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReserveStockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReserveStockRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReserveStockRequestMultiError is an error wrapping multiple validation
+// errors returned by ReserveStockRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReserveStockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReserveStockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReserveStockRequestMultiError) AllErrors() []error { return m }
+
+// ReserveStockRequestValidationError is the validation error returned by
+// ReserveStockRequest.Validate if the designated constraints aren't met.
+type ReserveStockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReserveStockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReserveStockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReserveStockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReserveStockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReserveStockRequestValidationError) ErrorName() string {
+	return "ReserveStockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReserveStockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReserveStockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReserveStockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReserveStockRequestValidationError{}
+
+// Validate checks the field values on ReserveStockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReserveStockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReserveStockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReserveStockResponseMultiError, or nil if none found.
+func (m *ReserveStockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReserveStockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReserveStockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReserveStockResponseMultiError is an error wrapping multiple validation
+// errors returned by ReserveStockResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReserveStockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReserveStockResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReserveStockResponseMultiError) AllErrors() []error { return m }
+
+// ReserveStockResponseValidationError is the validation error returned by
+// ReserveStockResponse.Validate if the designated constraints aren't met.
+type ReserveStockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReserveStockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReserveStockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReserveStockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReserveStockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReserveStockResponseValidationError) ErrorName() string {
+	return "ReserveStockResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReserveStockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReserveStockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReserveStockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReserveStockResponseValidationError{}
+
+// Validate checks the field values on ReleaseStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseStockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseStockRequestMultiError, or nil if none found.
+func (m *ReleaseStockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseStockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderUuid()); err != nil {
+		err = ReleaseStockRequestValidationError{
+			field:  "OrderUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReleaseStockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReleaseStockRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReleaseStockRequestMultiError is an error wrapping multiple validation
+// errors returned by ReleaseStockRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReleaseStockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseStockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseStockRequestMultiError) AllErrors() []error { return m }
+
+// ReleaseStockRequestValidationError is the validation error returned by
+// ReleaseStockRequest.Validate if the designated constraints aren't met.
+type ReleaseStockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseStockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseStockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseStockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseStockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseStockRequestValidationError) ErrorName() string {
+	return "ReleaseStockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseStockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseStockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseStockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseStockRequestValidationError{}
+
+// Validate checks the field values on ReleaseStockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseStockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseStockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseStockResponseMultiError, or nil if none found.
+func (m *ReleaseStockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseStockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReleaseStockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseStockResponseMultiError is an error wrapping multiple validation
+// errors returned by ReleaseStockResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReleaseStockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseStockResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseStockResponseMultiError) AllErrors() []error { return m }
+
+// ReleaseStockResponseValidationError is the validation error returned by
+// ReleaseStockResponse.Validate if the designated constraints aren't met.
+type ReleaseStockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseStockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseStockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseStockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseStockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseStockResponseValidationError) ErrorName() string {
+	return "ReleaseStockResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseStockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseStockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseStockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseStockResponseValidationError{}
+
+// Validate checks the field values on CommitStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitStockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitStockRequestMultiError, or nil if none found.
+func (m *CommitStockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitStockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderUuid()); err != nil {
+		err = CommitStockRequestValidationError{
+			field:  "OrderUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CommitStockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CommitStockRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CommitStockRequestMultiError is an error wrapping multiple validation errors
+// returned by CommitStockRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CommitStockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitStockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitStockRequestMultiError) AllErrors() []error { return m }
+
+// CommitStockRequestValidationError is the validation error returned by
+// CommitStockRequest.Validate if the designated constraints aren't met.
+type CommitStockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitStockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitStockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitStockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitStockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitStockRequestValidationError) ErrorName() string {
+	return "CommitStockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitStockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitStockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitStockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitStockRequestValidationError{}
+
+// Validate checks the field values on CommitStockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitStockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitStockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitStockResponseMultiError, or nil if none found.
+func (m *CommitStockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitStockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CommitStockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitStockResponseMultiError is an error wrapping multiple validation
+// errors returned by CommitStockResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CommitStockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitStockResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitStockResponseMultiError) AllErrors() []error { return m }
+
+// CommitStockResponseValidationError is the validation error returned by
+// CommitStockResponse.Validate if the designated constraints aren't met.
+type CommitStockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitStockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitStockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitStockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitStockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitStockResponseValidationError) ErrorName() string {
+	return "CommitStockResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitStockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitStockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitStockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitStockResponseValidationError{}